@@ -48,11 +48,12 @@ func main() {
 		// Dependency injection modules
 		di.CoreModule(*configFile, *watchConfig), // Core: context, config, wait group
 		di.LoggerModule(),                        // Logger: logger infrastructure
-		di.DatabasesModule(),                     // Databases: database infrastructures
+		di.DatabasesModule(),                     // Databases: database infrastructures and ID generation
 		di.RepositoriesModule(),                  // Repositories: database repositories
 		di.ApplicationServicesModule(),           // Services: application services
 		di.DomainServicesModule(),                // Services: complex domain services business logic
 		di.UseCasesModule(),                      // UseCases: specifics business logic
+		di.JobsModule(),                          // Jobs: background jobs
 		di.HTTPHandlersModule(),                  // HTTPHandler: HTTP handlers
 		di.HTTPServerModule(),                    // HTTPServer: HTTP server setup
 
@@ -11,6 +11,8 @@ package auth
 import (
 	"context"
 	"errors"
+	"maps"
+	"strconv"
 	"time"
 	"todolist/internal/service"
 	"todolist/pkg/auth"
@@ -33,9 +35,26 @@ func NewJWTTokenAdapter(secret string, accessDuration, refreshDuration time.Dura
 	}, nil
 }
 
+// NewJWTTokenAdapterWithKeys creates a new adapter instance signing with a key set
+func NewJWTTokenAdapterWithKeys(keys *auth.KeySet, accessDuration, refreshDuration time.Duration) (service.TokenService, error) {
+	jwtService, err := auth.NewJWTTokenWithKeys(keys, accessDuration, refreshDuration)
+	if err != nil {
+		return nil, mapJWTError(err)
+	}
+
+	return &JWTTokenAdapter{
+		jwtService: jwtService,
+	}, nil
+}
+
 // GenerateTokens creates a new set of access and refresh tokens
-func (a *JWTTokenAdapter) GenerateTokens(ctx context.Context, issuerName string, userID int64) (*service.AuthTokens, error) {
-	accessToken, refreshToken, err := a.jwtService.GenerateTokens(ctx, issuerName, userID)
+func (a *JWTTokenAdapter) GenerateTokens(
+	ctx context.Context,
+	issuerName string,
+	userID int64,
+	claims map[string]any,
+) (*service.AuthTokens, error) {
+	accessToken, refreshToken, err := a.jwtService.GenerateTokens(ctx, issuerName, userID, claims)
 	if err != nil {
 		return nil, &service.TokenServiceError{
 			Code:    service.ErrCodeTokenGeneration,
@@ -94,6 +113,37 @@ func (a *JWTTokenAdapter) RefreshTokens(ctx context.Context, refreshToken string
 	}, nil
 }
 
+// GenerateImpersonationToken creates a time-limited access token for the subject on behalf of the actor
+func (a *JWTTokenAdapter) GenerateImpersonationToken(
+	ctx context.Context,
+	issuerName string,
+	actorID, subjectID int64,
+	duration time.Duration,
+	claims map[string]any,
+) (string, *service.TokenMetadata, error) {
+	impersonationClaims := make(map[string]any, len(claims)+1)
+	maps.Copy(impersonationClaims, claims)
+	impersonationClaims[service.ClaimActorID] = strconv.FormatInt(actorID, 10)
+
+	accessToken, err := a.jwtService.GenerateAccessToken(ctx, issuerName, subjectID, duration, impersonationClaims)
+	if err != nil {
+		return "", nil, &service.TokenServiceError{
+			Code:    service.ErrCodeTokenGeneration,
+			Message: "failed to generate impersonation token",
+			Err:     err,
+		}
+	}
+
+	_, _, expiresAt, issuedAt, tokenID, _, _ := a.jwtService.GetTokenInfo(accessToken)
+
+	return accessToken, &service.TokenMetadata{
+		TokenID:   tokenID,
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+		TokenType: service.TypeAccess,
+	}, nil
+}
+
 // ValidateToken validates any type of token and returns its claims
 func (a *JWTTokenAdapter) ValidateToken(ctx context.Context, token string) (*service.ValidationResult, error) {
 	userID, err := a.jwtService.ValidateAccessToken(ctx, token)
@@ -118,8 +168,15 @@ func (a *JWTTokenAdapter) ValidateToken(ctx context.Context, token string) (*ser
 		}
 	}
 
+	// Impersonation tokens carry the acting user next to the subject
+	var actorID int64
+	if value, ok := customClaims[service.ClaimActorID].(string); ok {
+		actorID, _ = strconv.ParseInt(value, 10, 64)
+	}
+
 	return &service.ValidationResult{
 		UserID:     userID,
+		ActorID:    actorID,
 		IssuerName: issuer,
 		Metadata: service.TokenMetadata{
 			TokenID:   tokenID,
@@ -140,6 +197,31 @@ func (a *JWTTokenAdapter) RevokeToken(ctx context.Context, token string) error {
 	return nil
 }
 
+// PublicKeys returns the public keys that can validate issued tokens
+func (a *JWTTokenAdapter) PublicKeys(ctx context.Context) ([]service.PublicKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	jwks := a.jwtService.JWKS()
+	keys := make([]service.PublicKey, len(jwks.Keys))
+	for i, key := range jwks.Keys {
+		keys[i] = service.PublicKey{
+			KeyType:   key.KeyType,
+			Use:       key.Use,
+			KeyID:     key.KeyID,
+			Algorithm: key.Algorithm,
+			N:         key.N,
+			E:         key.E,
+			Curve:     key.Curve,
+			X:         key.X,
+			Y:         key.Y,
+		}
+	}
+
+	return keys, nil
+}
+
 // mapJWTError maps JWT service errors to domain errors
 func mapJWTError(err error) error {
 	if err == nil {
@@ -27,6 +27,11 @@ func (g *GinAdapter) BindQuery(dest any) error {
 	return g.Ctx.BindQuery(dest)
 }
 
+// ClientIP implements http.RequestContext.
+func (g *GinAdapter) ClientIP() string {
+	return g.Ctx.ClientIP()
+}
+
 // Context implements http.RequestContext.
 func (g *GinAdapter) Context() context.Context {
 	return g.Ctx.Request.Context()
@@ -0,0 +1,381 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"strings"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	entUser "todolist/internal/domain/user/entity"
+	voUser "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+	ucUser "todolist/internal/usecase/user"
+)
+
+// AdminUserHandler handles user administration HTTP requests
+type AdminUserHandler struct {
+	listUsersUseCase          ucUser.ListUsersUseCase
+	changeUserRoleUseCase     ucUser.ChangeUserRoleUseCase
+	changeUserStatusUseCase   ucUser.ChangeUserStatusUseCase
+	forcePasswordResetUseCase ucUser.ForcePasswordResetUseCase
+	listLoginAttemptsUseCase  ucUser.ListLoginAttemptsUseCase
+	listUserAuditTrailUseCase ucUser.ListUserAuditTrailUseCase
+	impersonateUserUseCase    ucUser.ImpersonateUserUseCase
+}
+
+// NewAdminUserHandler creates a new user administration handler
+func NewAdminUserHandler(
+	listUsersUseCase ucUser.ListUsersUseCase,
+	changeUserRoleUseCase ucUser.ChangeUserRoleUseCase,
+	changeUserStatusUseCase ucUser.ChangeUserStatusUseCase,
+	forcePasswordResetUseCase ucUser.ForcePasswordResetUseCase,
+	listLoginAttemptsUseCase ucUser.ListLoginAttemptsUseCase,
+	listUserAuditTrailUseCase ucUser.ListUserAuditTrailUseCase,
+	impersonateUserUseCase ucUser.ImpersonateUserUseCase,
+) *AdminUserHandler {
+	return &AdminUserHandler{
+		listUsersUseCase:          listUsersUseCase,
+		changeUserRoleUseCase:     changeUserRoleUseCase,
+		changeUserStatusUseCase:   changeUserStatusUseCase,
+		forcePasswordResetUseCase: forcePasswordResetUseCase,
+		listLoginAttemptsUseCase:  listLoginAttemptsUseCase,
+		listUserAuditTrailUseCase: listUserAuditTrailUseCase,
+		impersonateUserUseCase:    impersonateUserUseCase,
+	}
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Description Search and list users with pagination
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Param order_by query string false "Comma separated sort keys, descending when prefixed with -, e.g. -created_at,username. Keys: username, status, role, created_at, updated_at, id" default(-created_at)
+// @Param search query string false "Search in username, name and email"
+// @Param status query []string false "Filter by status" Enums(active,inactive,blocked,pending)
+// @Param role query []string false "Filter by role"
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/users [get]
+func (h *AdminUserHandler) ListUsers(ctx http.RequestContext) {
+	queryParams, options, err := getQueryOptions(ctx, voUser.UserSortKeys(), voUser.DefaultUserSort)
+	if err != nil {
+		writeQueryError(ctx, err)
+		return
+	}
+
+	filters := voUser.UserFilterCriteria{
+		SearchTerm: queryParams.Search,
+	}
+
+	if statusStr := ctx.GetQuery("status"); statusStr != "" {
+		for _, status := range strings.Split(statusStr, ",") {
+			filters.Status = append(filters.Status, voUser.UserStatus(status))
+		}
+	}
+
+	if roleStr := ctx.GetQuery("role"); roleStr != "" {
+		for _, role := range strings.Split(roleStr, ",") {
+			filters.Role = append(filters.Role, voUser.UserRole(role))
+		}
+	}
+
+	result, err := h.listUsersUseCase.Execute(ctx.Context(), filters, options)
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list users", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
+		result.Users,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	))
+}
+
+// ChangeUserRole godoc
+// @Summary Change user role
+// @Description Assign a role to a user, revoking the user sessions
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param role body dto.ChangeUserRoleRequest true "Role data"
+// @Success 200 {object} dto.Response{data=dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/role [put]
+func (h *AdminUserHandler) ChangeUserRole(ctx http.RequestContext) {
+	actorID, userID, ok := h.getManagedUserIDs(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.ChangeUserRoleRequest
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	user, err := h.changeUserRoleUseCase.Execute(ctx.Context(), actorID, userID, input, getClientInfo(ctx))
+	if err != nil {
+		writeAdminUserError(ctx, err, "UPDATE_FAILED", "Failed to change user role")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(user, "User role changed successfully"))
+}
+
+// ChangeUserStatus godoc
+// @Summary Change user status
+// @Description Activate, deactivate or block a user; inactive users have their sessions revoked
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param status body dto.ChangeUserStatusRequest true "Status data"
+// @Success 200 {object} dto.Response{data=dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/status [put]
+func (h *AdminUserHandler) ChangeUserStatus(ctx http.RequestContext) {
+	actorID, userID, ok := h.getManagedUserIDs(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.ChangeUserStatusRequest
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	user, err := h.changeUserStatusUseCase.Execute(ctx.Context(), actorID, userID, input, getClientInfo(ctx))
+	if err != nil {
+		writeAdminUserError(ctx, err, "UPDATE_FAILED", "Failed to change user status")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(user, "User status changed successfully"))
+}
+
+// ForcePasswordReset godoc
+// @Summary Force password reset
+// @Description Require the user to change the password on the next login, revoking the user sessions
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param reset body dto.ForcePasswordResetRequest false "Reset data"
+// @Success 200 {object} dto.Response{data=dto.UserResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/password-reset [post]
+func (h *AdminUserHandler) ForcePasswordReset(ctx http.RequestContext) {
+	actorID, userID, ok := h.getManagedUserIDs(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.ForcePasswordResetRequest
+	if ctx.Request().ContentLength > 0 {
+		if err := ctx.BindJSON(&input); err != nil {
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+			ctx.Abort()
+			return
+		}
+	}
+
+	user, err := h.forcePasswordResetUseCase.Execute(ctx.Context(), actorID, userID, input, getClientInfo(ctx))
+	if err != nil {
+		writeAdminUserError(ctx, err, "UPDATE_FAILED", "Failed to force password reset")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(user, "Password reset required for user"))
+}
+
+// ImpersonateUser godoc
+// @Summary Impersonate user
+// @Description Issue a time-limited token to act as the user; writes are audited and the user is notified
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param impersonation body dto.ImpersonateUserRequest true "Impersonation data"
+// @Success 201 {object} dto.Response{data=dto.ImpersonationResponse}
+// @Failure 403 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/impersonate [post]
+func (h *AdminUserHandler) ImpersonateUser(ctx http.RequestContext) {
+	actorID, userID, ok := h.getManagedUserIDs(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.ImpersonateUserRequest
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	impersonation, err := h.impersonateUserUseCase.Execute(ctx.Context(), actorID, userID, input, getClientInfo(ctx))
+	if err != nil {
+		writeAdminUserError(ctx, err, "IMPERSONATION_FAILED", "Failed to impersonate user")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(impersonation, "Impersonation token issued"))
+}
+
+// ListLoginAttempts godoc
+// @Summary List user login attempts
+// @Description List the login attempts of a user, newest first
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.LoginAttemptResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/login-attempts [get]
+func (h *AdminUserHandler) ListLoginAttempts(ctx http.RequestContext) {
+	userID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_ID", "Invalid user ID", nil))
+		ctx.Abort()
+		return
+	}
+
+	queryParams, options, err := getQueryOptions(ctx, nil, nil)
+	if err != nil {
+		writeQueryError(ctx, err)
+		return
+	}
+
+	result, err := h.listLoginAttemptsUseCase.Execute(ctx.Context(), userID, options)
+	if err != nil {
+		writeAdminUserError(ctx, err, "LIST_FAILED", "Failed to list login attempts")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
+		result.Attempts,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	))
+}
+
+// ListAuditTrail godoc
+// @Summary List user audit trail
+// @Description List the actions performed by or on a user, newest first
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.AuditLogResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/audit [get]
+func (h *AdminUserHandler) ListAuditTrail(ctx http.RequestContext) {
+	userID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_ID", "Invalid user ID", nil))
+		ctx.Abort()
+		return
+	}
+
+	queryParams, options, err := getQueryOptions(ctx, nil, nil)
+	if err != nil {
+		writeQueryError(ctx, err)
+		return
+	}
+
+	result, err := h.listUserAuditTrailUseCase.Execute(ctx.Context(), userID, options)
+	if err != nil {
+		writeAdminUserError(ctx, err, "LIST_FAILED", "Failed to list audit trail")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
+		result.Entries,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	))
+}
+
+// getManagedUserIDs extracts the authenticated administrator and the managed user IDs
+func (h *AdminUserHandler) getManagedUserIDs(ctx http.RequestContext) (int64, int64, bool) {
+	actorID, err := getAuthenticatedUserID(ctx)
+	if err != nil || actorID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return 0, 0, false
+	}
+
+	userID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_ID", "Invalid user ID", nil))
+		ctx.Abort()
+		return 0, 0, false
+	}
+
+	return actorID, userID, true
+}
+
+// writeAdminUserError maps user administration errors to HTTP responses
+func writeAdminUserError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, shared.ErrNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "User not found", nil))
+	case errors.Is(err, entUser.ErrRoleNotFound),
+		errors.Is(err, entUser.ErrInvalidRoleName):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_ROLE", "Role is not defined", nil))
+	case errors.Is(err, ucUser.ErrSelfManagement):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("SELF_MANAGEMENT", "Administrators can not change their own account", nil))
+	case errors.Is(err, service.ErrImpersonationNotAllowed):
+		ctx.JSON(netHttp.StatusForbidden,
+			dto.ErrorResponse("IMPERSONATION_NOT_ALLOWED", "User can not be impersonated", nil))
+	case errors.Is(err, entUser.ErrUserNotActive):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("USER_NOT_ACTIVE", "User is not active", nil))
+	case errors.Is(err, shared.ErrInvalidOperation):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_STATUS", "Invalid user status", nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	netHttp "net/http"
+	"strconv"
+	"strings"
+	"time"
+	"todolist/internal/adapter/delivery/http"
+	voAudit "todolist/internal/domain/audit/valueobject"
+	"todolist/internal/dto"
+	ucAudit "todolist/internal/usecase/audit"
+)
+
+// AuditHandler handles audit log HTTP requests
+type AuditHandler struct {
+	listAuditLogsUseCase ucAudit.ListAuditLogsUseCase
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(listAuditLogsUseCase ucAudit.ListAuditLogsUseCase) *AuditHandler {
+	return &AuditHandler{
+		listAuditLogsUseCase: listAuditLogsUseCase,
+	}
+}
+
+// ListAuditLogs godoc
+// @Summary Search audit log
+// @Description List the recorded changes of todos, people and users, newest first
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Param entity_type query string false "Filter by entity type" Enums(todo,person,user)
+// @Param entity_id query int false "Filter by entity ID"
+// @Param actor_id query int false "Filter by the user who performed the action, including under impersonation"
+// @Param action query []string false "Filter by action, e.g. created,updated,deleted"
+// @Param from query string false "Only entries recorded at or after this time (RFC 3339)"
+// @Param to query string false "Only entries recorded at or before this time (RFC 3339)"
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.AuditLogResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/audit [get]
+func (h *AuditHandler) ListAuditLogs(ctx http.RequestContext) {
+	queryParams, options, err := getQueryOptions(ctx, nil, nil)
+	if err != nil {
+		writeQueryError(ctx, err)
+		return
+	}
+
+	filters, err := getAuditFilters(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_QUERY", "Invalid query parameters", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	result, err := h.listAuditLogsUseCase.Execute(ctx.Context(), filters, options)
+	if err != nil {
+		if errors.Is(err, ucAudit.ErrInvalidTimeRange) {
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_QUERY", "Invalid time range", nil))
+		} else {
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("LIST_FAILED", "Failed to list audit log", nil))
+		}
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
+		result.Entries,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	))
+}
+
+// getAuditFilters binds the audit log filters from the query string
+func getAuditFilters(ctx http.RequestContext) (voAudit.AuditFilterCriteria, error) {
+	filters := voAudit.AuditFilterCriteria{
+		EntityType: ctx.GetQuery("entity_type"),
+	}
+
+	if entityIDStr := ctx.GetQuery("entity_id"); entityIDStr != "" {
+		entityID, err := strconv.ParseInt(entityIDStr, 10, 64)
+		if err != nil {
+			return filters, fmt.Errorf("invalid entity_id value: %s", entityIDStr)
+		}
+		filters.EntityID = entityID
+	}
+
+	if actorIDStr := ctx.GetQuery("actor_id"); actorIDStr != "" {
+		actorID, err := strconv.ParseInt(actorIDStr, 10, 64)
+		if err != nil {
+			return filters, fmt.Errorf("invalid actor_id value: %s", actorIDStr)
+		}
+		filters.ActorID = actorID
+	}
+
+	if actionStr := ctx.GetQuery("action"); actionStr != "" {
+		filters.Action = strings.Split(actionStr, ",")
+	}
+
+	var err error
+
+	if filters.From, err = getQueryTime(ctx, "from"); err != nil {
+		return filters, err
+	}
+
+	if filters.To, err = getQueryTime(ctx, "to"); err != nil {
+		return filters, err
+	}
+
+	return filters, nil
+}
+
+// getQueryTime parses an optional RFC 3339 query parameter, nil when absent
+func getQueryTime(ctx http.RequestContext, param string) (*time.Time, error) {
+	value := ctx.GetQuery(param)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value, expected RFC 3339: %s", param, value)
+	}
+
+	return &t, nil
+}
@@ -9,6 +9,7 @@ import (
 	"todolist/internal/domain/person/valueobject"
 	entUser "todolist/internal/domain/user/entity"
 	"todolist/internal/dto"
+	"todolist/internal/service"
 	ucPerson "todolist/internal/usecase/person"
 	ucUser "todolist/internal/usecase/user"
 )
@@ -19,6 +20,8 @@ type AuthHandler struct {
 	createPersonUseCase   ucPerson.CreatePersonUseCase
 	loginUseCase          ucUser.LoginUseCase
 	changePasswordUseCase ucUser.ChangePasswordUseCase
+	refreshTokenUseCase   ucUser.RefreshTokenUseCase
+	revokeSessionUseCase  ucUser.RevokeSessionUseCase
 }
 
 // NewAuthHandler creates a new auth handler
@@ -27,12 +30,16 @@ func NewAuthHandler(
 	createPersonUseCase ucPerson.CreatePersonUseCase,
 	loginUseCase ucUser.LoginUseCase,
 	changePasswordUseCase ucUser.ChangePasswordUseCase,
+	refreshTokenUseCase ucUser.RefreshTokenUseCase,
+	revokeSessionUseCase ucUser.RevokeSessionUseCase,
 ) *AuthHandler {
 	return &AuthHandler{
 		createUserUseCase:     createUserUseCase,
 		createPersonUseCase:   createPersonUseCase,
 		loginUseCase:          loginUseCase,
 		changePasswordUseCase: changePasswordUseCase,
+		refreshTokenUseCase:   refreshTokenUseCase,
+		revokeSessionUseCase:  revokeSessionUseCase,
 	}
 }
 
@@ -118,7 +125,7 @@ func (h *AuthHandler) Login(ctx http.RequestContext) {
 	}
 
 	// Authenticate user
-	authResponse, err := h.loginUseCase.Execute(ctx.Context(), input)
+	authResponse, err := h.loginUseCase.Execute(ctx.Context(), input, getClientInfo(ctx))
 	if err != nil {
 		switch {
 		case errors.Is(err, ucUser.ErrInvalidCredentials):
@@ -140,7 +147,7 @@ func (h *AuthHandler) Login(ctx http.RequestContext) {
 
 // ChangePassword godoc
 // @Summary Change password
-// @Description Change user password
+// @Description Change user password, optionally terminating every other session
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -168,7 +175,7 @@ func (h *AuthHandler) ChangePassword(ctx http.RequestContext) {
 	}
 
 	// Change password
-	err = h.changePasswordUseCase.Execute(ctx.Context(), userID, input)
+	err = h.changePasswordUseCase.Execute(ctx.Context(), userID, getAuthenticatedSessionID(ctx), input)
 	if err != nil {
 		if err.Error() == "old password is incorrect" {
 			ctx.JSON(netHttp.StatusBadRequest,
@@ -183,3 +190,89 @@ func (h *AuthHandler) ChangePassword(ctx http.RequestContext) {
 
 	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "Password changed successfully"))
 }
+
+// RefreshToken godoc
+// @Summary Refresh tokens
+// @Description Exchange a refresh token for a new token pair, rotating the session refresh token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param token body dto.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} dto.Response{data=dto.AuthResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 401 {object} dto.Response
+// @Router /api/v1/auth/refresh [post]
+func (h *AuthHandler) RefreshToken(ctx http.RequestContext) {
+	var input dto.RefreshTokenRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	authResponse, err := h.refreshTokenUseCase.Execute(ctx.Context(), input, getClientInfo(ctx))
+	if err != nil {
+		var tokenErr *service.TokenServiceError
+
+		switch {
+		case errors.As(err, &tokenErr):
+			ctx.JSON(netHttp.StatusUnauthorized,
+				dto.ErrorResponse("INVALID_TOKEN", "Invalid or expired refresh token", nil))
+		case errors.Is(err, entUser.ErrSessionNotFound),
+			errors.Is(err, entUser.ErrSessionRevoked),
+			errors.Is(err, entUser.ErrSessionExpired),
+			errors.Is(err, entUser.ErrRefreshTokenMismatch):
+			ctx.JSON(netHttp.StatusUnauthorized,
+				dto.ErrorResponse("SESSION_REVOKED", "Session is no longer valid", nil))
+		case errors.Is(err, ucUser.ErrUserNotActive):
+			ctx.JSON(netHttp.StatusUnauthorized,
+				dto.ErrorResponse("USER_INACTIVE", "User account is not active", nil))
+		default:
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("REFRESH_FAILED", "Failed to refresh token", nil))
+		}
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(authResponse, "Token refreshed successfully"))
+}
+
+// Logout godoc
+// @Summary Logout user
+// @Description Terminate the session bound to the current access token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response
+// @Failure 401 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/auth/logout [post]
+func (h *AuthHandler) Logout(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return
+	}
+
+	sessionID := getAuthenticatedSessionID(ctx)
+	if sessionID == 0 {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("NO_SESSION", "Token is not bound to a session", nil))
+		ctx.Abort()
+		return
+	}
+
+	if err := h.revokeSessionUseCase.Execute(ctx.Context(), userID, sessionID); err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LOGOUT_FAILED", "Failed to logout", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "Logout successful"))
+}
@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/board/entity"
+	vo "todolist/internal/domain/board/valueobject"
+	"todolist/internal/domain/shared"
+	todoEntity "todolist/internal/domain/todo/entity"
+	"todolist/internal/dto"
+	ucBoard "todolist/internal/usecase/board"
+	ucTodo "todolist/internal/usecase/todo"
+)
+
+// BoardHandler handles kanban board HTTP requests
+type BoardHandler struct {
+	listBoardsUseCase    ucBoard.ListBoardsUseCase
+	getBoardUseCase      ucBoard.GetBoardUseCase
+	createBoardUseCase   ucBoard.CreateBoardUseCase
+	updateBoardUseCase   ucBoard.UpdateBoardUseCase
+	deleteBoardUseCase   ucBoard.DeleteBoardUseCase
+	moveBoardTodoUseCase ucBoard.MoveBoardTodoUseCase
+}
+
+// NewBoardHandler creates a new board handler
+func NewBoardHandler(
+	listBoardsUseCase ucBoard.ListBoardsUseCase,
+	getBoardUseCase ucBoard.GetBoardUseCase,
+	createBoardUseCase ucBoard.CreateBoardUseCase,
+	updateBoardUseCase ucBoard.UpdateBoardUseCase,
+	deleteBoardUseCase ucBoard.DeleteBoardUseCase,
+	moveBoardTodoUseCase ucBoard.MoveBoardTodoUseCase,
+) *BoardHandler {
+	return &BoardHandler{
+		listBoardsUseCase:    listBoardsUseCase,
+		getBoardUseCase:      getBoardUseCase,
+		createBoardUseCase:   createBoardUseCase,
+		updateBoardUseCase:   updateBoardUseCase,
+		deleteBoardUseCase:   deleteBoardUseCase,
+		moveBoardTodoUseCase: moveBoardTodoUseCase,
+	}
+}
+
+// ListBoards godoc
+// @Summary List boards
+// @Description List the boards the user owns or is a member of
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=[]dto.BoardResponse}
+// @Security BearerAuth
+// @Router /api/v1/boards [get]
+func (h *BoardHandler) ListBoards(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	boards, err := h.listBoardsUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list boards", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(boards, ""))
+}
+
+// GetBoard godoc
+// @Summary Get board
+// @Description Get a board with the todos of each column in their manual order, up to 200 per column, split into swimlanes when the board has them. Only the todos of the board users whose todos the user can read are listed.
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} dto.Response{data=dto.BoardTodosResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/boards/{id} [get]
+func (h *BoardHandler) GetBoard(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	boardID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	board, err := h.getBoardUseCase.Execute(ctx.Context(), userID, boardID)
+	if err != nil {
+		writeBoardError(ctx, err, "GET_FAILED", "Failed to get board")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(board, ""))
+}
+
+// CreateBoard godoc
+// @Summary Create board
+// @Description Create a board with columns mapped to statuses and optional WIP limits, a column for each status when no columns are given, optionally split into swimlanes by priority or assignee
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Param board body dto.CreateBoardRequest true "Board data"
+// @Success 201 {object} dto.Response{data=dto.BoardResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/boards [post]
+func (h *BoardHandler) CreateBoard(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.CreateBoardRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	board, err := h.createBoardUseCase.Execute(ctx.Context(), userID, input)
+	if err != nil {
+		writeBoardError(ctx, err, "CREATE_FAILED", "Failed to create board")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(board, "Board created successfully"))
+}
+
+// UpdateBoard godoc
+// @Summary Update board
+// @Description Change the name, columns, swimlanes or members of a board owned by the user; a swimlane of none removes the swimlanes
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID"
+// @Param board body dto.UpdateBoardRequest true "Board data"
+// @Success 200 {object} dto.Response{data=dto.BoardResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/boards/{id} [put]
+func (h *BoardHandler) UpdateBoard(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	boardID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.UpdateBoardRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	board, err := h.updateBoardUseCase.Execute(ctx.Context(), userID, boardID, input)
+	if err != nil {
+		writeBoardError(ctx, err, "UPDATE_FAILED", "Failed to update board")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(board, "Board updated successfully"))
+}
+
+// DeleteBoard godoc
+// @Summary Delete board
+// @Description Delete a board owned by the user, leaving its todos alone
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/boards/{id} [delete]
+func (h *BoardHandler) DeleteBoard(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	boardID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	if err := h.deleteBoardUseCase.Execute(ctx.Context(), userID, boardID); err != nil {
+		writeBoardError(ctx, err, "DELETE_FAILED", "Failed to delete board")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "Board deleted successfully"))
+}
+
+// MoveBoardTodo godoc
+// @Summary Move todo on board
+// @Description Move a todo of a board user into a column, changing it to the first status of the column unless it already is in the column, and optionally between the todos given by after_id and before_id in the manual order of its user. Columns at their WIP limit refuse new todos.
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID"
+// @Param request body dto.MoveBoardTodoRequest true "Todo, column and todos to place it between"
+// @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/boards/{id}/move [post]
+func (h *BoardHandler) MoveBoardTodo(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	boardID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.MoveBoardTodoRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	todo, err := h.moveBoardTodoUseCase.Execute(ctx.Context(), userID, boardID, input)
+	if err != nil {
+		writeBoardError(ctx, err, "MOVE_FAILED", "Failed to move todo")
+		return
+	}
+
+	setETag(ctx, todo.Version)
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo moved successfully"))
+}
+
+// writeBoardError maps board errors to HTTP responses
+func writeBoardError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, entity.ErrBoardNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Board not found", nil))
+	case errors.Is(err, shared.ErrNotFound), errors.Is(err, todoEntity.ErrUnauthorizedTodoAccess):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
+	case errors.Is(err, entity.ErrBoardAlreadyExists):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("BOARD_EXISTS", "A board with this name already exists", nil))
+	case errors.Is(err, entity.ErrBoardOwnerOnly):
+		ctx.JSON(netHttp.StatusForbidden,
+			dto.ErrorResponse("BOARD_OWNER_ONLY", "Only the owner of the board can change it", nil))
+	case errors.Is(err, entity.ErrWIPLimitReached):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("WIP_LIMIT_REACHED", "The column has reached its WIP limit", nil))
+	case errors.Is(err, entity.ErrBoardColumnNotFound):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_COLUMN", "The board has no such column", nil))
+	case errors.Is(err, entity.ErrColumnUnreachable):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("UNREACHABLE_COLUMN", "The todo can not be moved into the column", nil))
+	case errors.Is(err, todoEntity.ErrInvalidStatusTransition):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_TRANSITION", "Invalid status transition", nil))
+	case errors.Is(err, todoEntity.ErrMissingRequiredField):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("MISSING_FIELD", err.Error(), nil))
+	case errors.Is(err, ucTodo.ErrInvalidMove):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_MOVE", "after_id and before_id must be other todos of the same user, in order", nil))
+	case errors.Is(err, entity.ErrInvalidBoardName),
+		errors.Is(err, entity.ErrInvalidBoardColumns),
+		errors.Is(err, entity.ErrInvalidBoardMembers),
+		errors.Is(err, ucBoard.ErrUnknownBoardMember),
+		errors.Is(err, ucBoard.ErrUnknownBoardState),
+		errors.Is(err, vo.ErrInvalidBoardColumn),
+		errors.Is(err, vo.ErrInvalidBoardSwimlane):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_BOARD", err.Error(), nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
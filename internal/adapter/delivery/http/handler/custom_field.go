@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/customfield/entity"
+	projectEntity "todolist/internal/domain/project/entity"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/dto"
+	ucCustomField "todolist/internal/usecase/customfield"
+)
+
+// CustomFieldHandler handles custom field HTTP requests
+type CustomFieldHandler struct {
+	listCustomFieldsUseCase  ucCustomField.ListCustomFieldsUseCase
+	getCustomFieldUseCase    ucCustomField.GetCustomFieldUseCase
+	createCustomFieldUseCase ucCustomField.CreateCustomFieldUseCase
+	updateCustomFieldUseCase ucCustomField.UpdateCustomFieldUseCase
+	deleteCustomFieldUseCase ucCustomField.DeleteCustomFieldUseCase
+}
+
+// NewCustomFieldHandler creates a new custom field handler
+func NewCustomFieldHandler(
+	listCustomFieldsUseCase ucCustomField.ListCustomFieldsUseCase,
+	getCustomFieldUseCase ucCustomField.GetCustomFieldUseCase,
+	createCustomFieldUseCase ucCustomField.CreateCustomFieldUseCase,
+	updateCustomFieldUseCase ucCustomField.UpdateCustomFieldUseCase,
+	deleteCustomFieldUseCase ucCustomField.DeleteCustomFieldUseCase,
+) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		listCustomFieldsUseCase:  listCustomFieldsUseCase,
+		getCustomFieldUseCase:    getCustomFieldUseCase,
+		createCustomFieldUseCase: createCustomFieldUseCase,
+		updateCustomFieldUseCase: updateCustomFieldUseCase,
+		deleteCustomFieldUseCase: deleteCustomFieldUseCase,
+	}
+}
+
+// ListCustomFields godoc
+// @Summary List custom fields
+// @Description List the custom fields of the user, those of all the user's todos first, then those of each project
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=[]dto.CustomFieldResponse}
+// @Security BearerAuth
+// @Router /api/v1/custom-fields [get]
+func (h *CustomFieldHandler) ListCustomFields(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	fields, err := h.listCustomFieldsUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list custom fields", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(fields, ""))
+}
+
+// GetCustomField godoc
+// @Summary Get custom field
+// @Description Get a custom field of the user
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Param id path string true "Custom field ID"
+// @Success 200 {object} dto.Response{data=dto.CustomFieldResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/custom-fields/{id} [get]
+func (h *CustomFieldHandler) GetCustomField(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	fieldID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	field, err := h.getCustomFieldUseCase.Execute(ctx.Context(), userID, fieldID)
+	if err != nil {
+		writeCustomFieldError(ctx, err, "GET_FAILED", "Failed to get custom field")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(field, ""))
+}
+
+// CreateCustomField godoc
+// @Summary Create custom field
+// @Description Create a typed custom field of all the user's todos, or of the todos of a project: text, number, date, select, multi_select, url or checkbox. Select fields need options to choose from. Todos must have a value for required fields. Keys are unique per user and name the field in todo requests, filters (cf.<key>) and sort orders.
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Param field body dto.CreateCustomFieldRequest true "Custom field data"
+// @Success 201 {object} dto.Response{data=dto.CustomFieldResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/custom-fields [post]
+func (h *CustomFieldHandler) CreateCustomField(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.CreateCustomFieldRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	field, err := h.createCustomFieldUseCase.Execute(ctx.Context(), userID, input)
+	if err != nil {
+		writeCustomFieldError(ctx, err, "CREATE_FAILED", "Failed to create custom field")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(field, "Custom field created successfully"))
+}
+
+// UpdateCustomField godoc
+// @Summary Update custom field
+// @Description Change the name, options or whether a custom field of the user is required. The options chosen by todos must be kept.
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Param id path string true "Custom field ID"
+// @Param field body dto.UpdateCustomFieldRequest true "Custom field data"
+// @Success 200 {object} dto.Response{data=dto.CustomFieldResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/custom-fields/{id} [put]
+func (h *CustomFieldHandler) UpdateCustomField(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	fieldID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.UpdateCustomFieldRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	field, err := h.updateCustomFieldUseCase.Execute(ctx.Context(), userID, fieldID, input)
+	if err != nil {
+		writeCustomFieldError(ctx, err, "UPDATE_FAILED", "Failed to update custom field")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(field, "Custom field updated successfully"))
+}
+
+// DeleteCustomField godoc
+// @Summary Delete custom field
+// @Description Delete a custom field of the user along with its values on todos
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Param id path string true "Custom field ID"
+// @Success 200 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/custom-fields/{id} [delete]
+func (h *CustomFieldHandler) DeleteCustomField(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	fieldID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	if err := h.deleteCustomFieldUseCase.Execute(ctx.Context(), userID, fieldID); err != nil {
+		writeCustomFieldError(ctx, err, "DELETE_FAILED", "Failed to delete custom field")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "Custom field deleted successfully"))
+}
+
+// writeCustomFieldError maps custom field errors to HTTP responses
+func writeCustomFieldError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, entity.ErrCustomFieldNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Custom field not found", nil))
+	case errors.Is(err, entity.ErrCustomFieldAlreadyExists):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("CUSTOM_FIELD_EXISTS", "A custom field with this key already exists", nil))
+	case errors.Is(err, entity.ErrCustomFieldOptionInUse):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("OPTION_IN_USE", err.Error(), nil))
+	case errors.Is(err, projectEntity.ErrProjectNotFound):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_PROJECT", "Project not found", nil))
+	case errors.Is(err, entity.ErrInvalidCustomField),
+		errors.Is(err, todovo.ErrInvalidCustomFieldType):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_CUSTOM_FIELD", err.Error(), nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
@@ -3,9 +3,14 @@ package handler
 import (
 	"errors"
 	"fmt"
+	netHttp "net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/valueobject"
+	"todolist/internal/dto"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -19,6 +24,20 @@ func getIDParam(ctx http.RequestContext) (int64, error) {
 	return id, nil
 }
 
+// filterExpressionErrorDetails describes where and why a filter expression cannot be parsed
+func filterExpressionErrorDetails(err error) map[string]any {
+	var expressionErr *valueobject.FilterExpressionError
+	if !errors.As(err, &expressionErr) {
+		// Expressions comparing unknown custom fields parse but cannot be compiled
+		return map[string]any{"reason": err.Error()}
+	}
+
+	return map[string]any{
+		"position": expressionErr.Position,
+		"reason":   expressionErr.Message,
+	}
+}
+
 // getAuthenticatedUserID extracts and validates the user ID from the context
 func getAuthenticatedUserID(ctx http.RequestContext) (int64, error) {
 	userID, exists := ctx.Get("userID")
@@ -34,6 +53,122 @@ func getAuthenticatedUserID(ctx http.RequestContext) (int64, error) {
 	return id, nil
 }
 
+// getAuthenticatedSessionID extracts the session ID from the context, returning
+// zero for tokens that are not bound to a session
+func getAuthenticatedSessionID(ctx http.RequestContext) int64 {
+	sessionID, exists := ctx.Get("sessionID")
+	if !exists {
+		return 0
+	}
+
+	id, _ := sessionID.(int64)
+	return id
+}
+
+// getQueryOptions binds the pagination and sort query parameters.
+//
+// order_by takes a comma separated list of sortKeys, each one descending when prefixed
+// with a minus, such as -priority,due_date, and order_dir=desc still sorts by a single
+// unprefixed key in descending order. Without an order_by the results are sorted by
+// defaultSort, or left to the repository when nil.
+func getQueryOptions(
+	ctx http.RequestContext,
+	sortKeys []string,
+	defaultSort shared.Sort,
+) (dto.QueryParams, shared.QueryOptions, error) {
+	var queryParams dto.QueryParams
+	if err := ctx.BindQuery(&queryParams); err != nil {
+		return queryParams, shared.QueryOptions{}, err
+	}
+	queryParams.SetDefaults()
+
+	options := shared.QueryOptions{
+		Limit:  queryParams.PageSize,
+		Offset: queryParams.GetOffset(),
+		Sort:   defaultSort,
+	}
+
+	if orderBy := strings.TrimSpace(queryParams.OrderBy); orderBy != "" {
+		sort, err := shared.ParseSort(orderBy, sortKeys)
+		if err != nil {
+			return queryParams, options, err
+		}
+
+		if len(sort) == 1 && !strings.HasPrefix(orderBy, "-") && queryParams.OrderDir == "desc" {
+			sort[0].Desc = true
+		}
+		options.Sort = sort
+	}
+
+	return queryParams, options, nil
+}
+
+// writeQueryError writes the response to invalid query parameters, listing the
+// allowed sort keys when the sort order is invalid
+func writeQueryError(ctx http.RequestContext, err error) {
+	var sortErr *shared.InvalidSortError
+	if errors.As(err, &sortErr) {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_SORT", "Invalid sort order", map[string]any{
+				"order_by": sortErr.Key,
+				"allowed":  sortErr.Allowed,
+			}))
+	} else {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_QUERY", "Invalid query parameters", parseError(err)))
+	}
+
+	ctx.Abort()
+}
+
+// setETag sets the ETag header to the version of the returned entity
+func setETag(ctx http.RequestContext, version int64) {
+	ctx.Writer().Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// getIfMatchVersions parses the If-Match header into the versions the client expects,
+// nil when the header is missing or matches any version.
+//
+// If-Match uses the strong comparison (RFC 9110, section 13.1.1): weak entity tags
+// never match, so a header listing only weak tags matches no version.
+func getIfMatchVersions(ctx http.RequestContext) ([]int64, error) {
+	ifMatch := strings.TrimSpace(ctx.Request().Header.Get("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+
+	versions := []int64{}
+	for _, entityTag := range strings.Split(ifMatch, ",") {
+		entityTag = strings.TrimSpace(entityTag)
+		opaqueTag := strings.TrimPrefix(entityTag, "W/")
+
+		tag, err := strconv.Unquote(opaqueTag)
+		if err != nil || !strings.HasPrefix(opaqueTag, `"`) {
+			return nil, fmt.Errorf("invalid If-Match header: %s", ifMatch)
+		}
+		if opaqueTag != entityTag {
+			continue
+		}
+
+		// Tags not issued by the server match no version
+		version, err := strconv.ParseInt(tag, 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// getClientInfo extracts the client information from the request
+func getClientInfo(ctx http.RequestContext) dto.ClientInfo {
+	return dto.ClientInfo{
+		IPAddress: ctx.ClientIP(),
+		UserAgent: ctx.Request().UserAgent(),
+	}
+}
+
 // parseError parses gin binding errors into a map
 func parseError(err error) map[string]any {
 	errors := make(map[string]any)
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// jwksCacheControl lets clients cache the key set for a short time, so rotated keys are picked up quickly
+const jwksCacheControl = "public, max-age=300"
+
+type JWKSHandler struct {
+	tokenService service.TokenService
+}
+
+// NewJWKSHandler creates a new JWKS handler
+func NewJWKSHandler(
+	tokenService service.TokenService,
+) *JWKSHandler {
+	return &JWKSHandler{
+		tokenService: tokenService,
+	}
+}
+
+// GetJWKS godoc
+// @Summary JSON Web Key Set
+// @Description Returns the public keys that can be used to validate tokens issued by the application
+// @Tags Authentication
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 500 {object} dto.Response
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) GetJWKS(ctx http.RequestContext) {
+	keys, err := h.tokenService.PublicKeys(ctx.Context())
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError, dto.ErrorResponse("JWKS_FAILED", "Failed to load public keys", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.Writer().Header().Set("Cache-Control", jwksCacheControl)
+	ctx.JSON(netHttp.StatusOK, gin.H{"keys": keys})
+}
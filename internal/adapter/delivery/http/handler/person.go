@@ -67,6 +67,7 @@ func (h *PersonHandler) CreatePerson(ctx http.RequestContext) {
 		return
 	}
 
+	setETag(ctx, person.Version)
 	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(person, "Person created successfully"))
 }
 
@@ -78,10 +79,20 @@ func (h *PersonHandler) CreatePerson(ctx http.RequestContext) {
 // @Produce json
 // @Param id path string true "Person ID"
 // @Success 200 {object} dto.Response{data=dto.PersonResponse}
+// @Header 200 {string} ETag "Version of the returned entity"
 // @Failure 404 {object} dto.Response
 // @Security BearerAuth
 // @Router /api/v1/people/{id} [get]
 func (h *PersonHandler) GetPerson(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
 	personID, err := getIDParam(ctx)
 	if err != nil {
 		ctx.JSON(netHttp.StatusBadRequest,
@@ -91,7 +102,7 @@ func (h *PersonHandler) GetPerson(ctx http.RequestContext) {
 		return
 	}
 
-	person, err := h.getPersonUseCase.Execute(ctx.Context(), personID)
+	person, err := h.getPersonUseCase.Execute(ctx.Context(), userID, personID)
 	if err != nil {
 		if errors.Is(err, shared.ErrNotFound) {
 			ctx.JSON(netHttp.StatusNotFound,
@@ -105,6 +116,7 @@ func (h *PersonHandler) GetPerson(ctx http.RequestContext) {
 		return
 	}
 
+	setETag(ctx, person.Version)
 	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(person, ""))
 }
 
@@ -116,12 +128,24 @@ func (h *PersonHandler) GetPerson(ctx http.RequestContext) {
 // @Produce json
 // @Param id path string true "Person ID"
 // @Param person body dto.UpdatePersonRequest true "Person data"
+// @Param If-Match header string false "ETag of the version being changed"
 // @Success 200 {object} dto.Response{data=dto.PersonResponse}
+// @Header 200 {string} ETag "Version of the returned entity"
 // @Failure 400 {object} dto.Response
 // @Failure 404 {object} dto.Response
+// @Failure 412 {object} dto.Response
 // @Security BearerAuth
 // @Router /api/v1/people/{id} [put]
 func (h *PersonHandler) UpdatePerson(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
 	personID, err := getIDParam(ctx)
 	if err != nil {
 		ctx.JSON(netHttp.StatusBadRequest,
@@ -131,15 +155,6 @@ func (h *PersonHandler) UpdatePerson(ctx http.RequestContext) {
 		return
 	}
 
-	// userRole := middleware.GetUserRole(ctx)
-
-	// // Only admin or the person themselves can update
-	// if userRole != "admin" {
-	// 	// In a real app, check if the person belongs to the authenticated user
-	// 	c.JSON(http.StatusForbidden, httpDto.ErrorResponse("FORBIDDEN", "Access denied", nil))
-	// 	return
-	// }
-
 	var input dto.UpdatePersonRequest
 
 	if err := ctx.BindJSON(&input); err != nil {
@@ -150,13 +165,26 @@ func (h *PersonHandler) UpdatePerson(ctx http.RequestContext) {
 		return
 	}
 
+	expectedVersions, err := getIfMatchVersions(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid If-Match header", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
 	// Update person
-	person, err := h.updatePersonUseCase.Execute(ctx.Context(), personID, input)
+	person, err := h.updatePersonUseCase.Execute(ctx.Context(), userID, personID, input, expectedVersions)
 	if err != nil {
-		if err == shared.ErrNotFound {
+		switch {
+		case errors.Is(err, shared.ErrNotFound):
 			ctx.JSON(netHttp.StatusNotFound,
 				dto.ErrorResponse("NOT_FOUND", "Person not found", nil))
-		} else {
+		case errors.Is(err, shared.ErrOptimisticLock):
+			ctx.JSON(netHttp.StatusPreconditionFailed,
+				dto.ErrorResponse("PRECONDITION_FAILED", "Person was modified, reload it and try again", nil))
+		default:
 			ctx.JSON(netHttp.StatusInternalServerError,
 				dto.ErrorResponse("UPDATE_FAILED", "Failed to update person", nil))
 		}
@@ -165,6 +193,7 @@ func (h *PersonHandler) UpdatePerson(ctx http.RequestContext) {
 		return
 	}
 
+	setETag(ctx, person.Version)
 	ctx.JSON(netHttp.StatusOK,
 		dto.SuccessResponse(person, "Person updated successfully"))
 }
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/dto"
+	ucUser "todolist/internal/usecase/user"
+)
+
+// PreferencesHandler handles the preferences of the authenticated user
+type PreferencesHandler struct {
+	getPreferencesUseCase    ucUser.GetPreferencesUseCase
+	updatePreferencesUseCase ucUser.UpdatePreferencesUseCase
+}
+
+// NewPreferencesHandler creates a new preferences handler
+func NewPreferencesHandler(
+	getPreferencesUseCase ucUser.GetPreferencesUseCase,
+	updatePreferencesUseCase ucUser.UpdatePreferencesUseCase,
+) *PreferencesHandler {
+	return &PreferencesHandler{
+		getPreferencesUseCase:    getPreferencesUseCase,
+		updatePreferencesUseCase: updatePreferencesUseCase,
+	}
+}
+
+// GetPreferences godoc
+// @Summary Get preferences
+// @Description Get the preferences of the authenticated user
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=dto.PreferencesResponse}
+// @Security BearerAuth
+// @Router /api/v1/auth/preferences [get]
+func (h *PreferencesHandler) GetPreferences(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	preferences, err := h.getPreferencesUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		writePreferencesError(ctx, err, "GET_FAILED", "Failed to get preferences")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(preferences, ""))
+}
+
+// UpdatePreferences godoc
+// @Summary Update preferences
+// @Description Change the given preferences of the authenticated user. The search language stems the words of the todo searches; an empty one restores the default language.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param preferences body dto.UpdatePreferencesRequest true "Preferences"
+// @Success 200 {object} dto.Response{data=dto.PreferencesResponse}
+// @Failure 400 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/auth/preferences [put]
+func (h *PreferencesHandler) UpdatePreferences(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.UpdatePreferencesRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	preferences, err := h.updatePreferencesUseCase.Execute(ctx.Context(), userID, input)
+	if err != nil {
+		writePreferencesError(ctx, err, "UPDATE_FAILED", "Failed to update preferences")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(preferences, "Preferences updated successfully"))
+}
+
+// writePreferencesError maps preferences errors to HTTP responses
+func writePreferencesError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, shared.ErrNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "User not found", nil))
+	case errors.Is(err, sharedvo.ErrInvalidSearchLanguage):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_LANGUAGE", "Unsupported search language", map[string]any{"supported": sharedvo.SearchLanguages()}))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/project/entity"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/dto"
+	ucProject "todolist/internal/usecase/project"
+)
+
+// ProjectHandler handles project HTTP requests
+type ProjectHandler struct {
+	listProjectsUseCase  ucProject.ListProjectsUseCase
+	getProjectUseCase    ucProject.GetProjectUseCase
+	createProjectUseCase ucProject.CreateProjectUseCase
+	updateProjectUseCase ucProject.UpdateProjectUseCase
+	deleteProjectUseCase ucProject.DeleteProjectUseCase
+}
+
+// NewProjectHandler creates a new project handler
+func NewProjectHandler(
+	listProjectsUseCase ucProject.ListProjectsUseCase,
+	getProjectUseCase ucProject.GetProjectUseCase,
+	createProjectUseCase ucProject.CreateProjectUseCase,
+	updateProjectUseCase ucProject.UpdateProjectUseCase,
+	deleteProjectUseCase ucProject.DeleteProjectUseCase,
+) *ProjectHandler {
+	return &ProjectHandler{
+		listProjectsUseCase:  listProjectsUseCase,
+		getProjectUseCase:    getProjectUseCase,
+		createProjectUseCase: createProjectUseCase,
+		updateProjectUseCase: updateProjectUseCase,
+		deleteProjectUseCase: deleteProjectUseCase,
+	}
+}
+
+// ListProjects godoc
+// @Summary List projects
+// @Description List the projects of the user with their workflows
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=[]dto.ProjectResponse}
+// @Security BearerAuth
+// @Router /api/v1/projects [get]
+func (h *ProjectHandler) ListProjects(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	projects, err := h.listProjectsUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list projects", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(projects, ""))
+}
+
+// GetProject godoc
+// @Summary Get project
+// @Description Get a project of the user with its workflow
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.Response{data=dto.ProjectResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/projects/{id} [get]
+func (h *ProjectHandler) GetProject(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	projectID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	project, err := h.getProjectUseCase.Execute(ctx.Context(), userID, projectID)
+	if err != nil {
+		writeProjectError(ctx, err, "GET_FAILED", "Failed to get project")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(project, ""))
+}
+
+// CreateProject godoc
+// @Summary Create project
+// @Description Create a project with a workflow of custom states, each in a category of open, in_progress, done or cancelled, the transitions allowed between them and the fields required on entering them. Projects without a workflow follow the default workflow of the todo statuses.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param project body dto.CreateProjectRequest true "Project data"
+// @Success 201 {object} dto.Response{data=dto.ProjectResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/projects [post]
+func (h *ProjectHandler) CreateProject(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.CreateProjectRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	project, err := h.createProjectUseCase.Execute(ctx.Context(), userID, input)
+	if err != nil {
+		writeProjectError(ctx, err, "CREATE_FAILED", "Failed to create project")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(project, "Project created successfully"))
+}
+
+// UpdateProject godoc
+// @Summary Update project
+// @Description Change the name or workflow of a project of the user; a workflow without states restores the default workflow. The states todos of the project are in must be kept with the same category.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param project body dto.UpdateProjectRequest true "Project data"
+// @Success 200 {object} dto.Response{data=dto.ProjectResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/projects/{id} [put]
+func (h *ProjectHandler) UpdateProject(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	projectID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.UpdateProjectRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	project, err := h.updateProjectUseCase.Execute(ctx.Context(), userID, projectID, input)
+	if err != nil {
+		writeProjectError(ctx, err, "UPDATE_FAILED", "Failed to update project")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(project, "Project updated successfully"))
+}
+
+// DeleteProject godoc
+// @Summary Delete project
+// @Description Delete a project of the user, its todos moving to the state of their status in the default workflow
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/projects/{id} [delete]
+func (h *ProjectHandler) DeleteProject(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	projectID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	if err := h.deleteProjectUseCase.Execute(ctx.Context(), userID, projectID); err != nil {
+		writeProjectError(ctx, err, "DELETE_FAILED", "Failed to delete project")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "Project deleted successfully"))
+}
+
+// writeProjectError maps project errors to HTTP responses
+func writeProjectError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, entity.ErrProjectNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Project not found", nil))
+	case errors.Is(err, entity.ErrProjectAlreadyExists):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("PROJECT_EXISTS", "A project with this name already exists", nil))
+	case errors.Is(err, entity.ErrWorkflowStateInUse):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("STATE_IN_USE", err.Error(), nil))
+	case errors.Is(err, entity.ErrInvalidProjectName),
+		errors.Is(err, todovo.ErrInvalidWorkflow):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_PROJECT", err.Error(), nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
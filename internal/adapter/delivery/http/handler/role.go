@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	entUser "todolist/internal/domain/user/entity"
+	voUser "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	ucUser "todolist/internal/usecase/user"
+)
+
+// RoleHandler handles role management HTTP requests
+type RoleHandler struct {
+	listRolesUseCase  ucUser.ListRolesUseCase
+	createRoleUseCase ucUser.CreateRoleUseCase
+	updateRoleUseCase ucUser.UpdateRoleUseCase
+	deleteRoleUseCase ucUser.DeleteRoleUseCase
+}
+
+// NewRoleHandler creates a new role handler
+func NewRoleHandler(
+	listRolesUseCase ucUser.ListRolesUseCase,
+	createRoleUseCase ucUser.CreateRoleUseCase,
+	updateRoleUseCase ucUser.UpdateRoleUseCase,
+	deleteRoleUseCase ucUser.DeleteRoleUseCase,
+) *RoleHandler {
+	return &RoleHandler{
+		listRolesUseCase:  listRolesUseCase,
+		createRoleUseCase: createRoleUseCase,
+		updateRoleUseCase: updateRoleUseCase,
+		deleteRoleUseCase: deleteRoleUseCase,
+	}
+}
+
+// ListRoles godoc
+// @Summary List roles
+// @Description List the configured and custom roles with their permissions
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=[]dto.RoleResponse}
+// @Failure 403 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/roles [get]
+func (h *RoleHandler) ListRoles(ctx http.RequestContext) {
+	roles, err := h.listRolesUseCase.Execute(ctx.Context())
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list roles", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(roles, ""))
+}
+
+// CreateRole godoc
+// @Summary Create role
+// @Description Create a custom role with a set of permissions ("resource:action[:own|shared|any]")
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param role body dto.CreateRoleRequest true "Role data"
+// @Success 201 {object} dto.Response{data=dto.RoleResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/roles [post]
+func (h *RoleHandler) CreateRole(ctx http.RequestContext) {
+	var input dto.CreateRoleRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	role, err := h.createRoleUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		writeRoleError(ctx, err, "CREATE_FAILED", "Failed to create role")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(role, "Role created successfully"))
+}
+
+// UpdateRole godoc
+// @Summary Update role
+// @Description Update the description and permissions of a custom role
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param name path string true "Role name"
+// @Param role body dto.UpdateRoleRequest true "Role data"
+// @Success 200 {object} dto.Response{data=dto.RoleResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/roles/{name} [put]
+func (h *RoleHandler) UpdateRole(ctx http.RequestContext) {
+	var input dto.UpdateRoleRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	role, err := h.updateRoleUseCase.Execute(ctx.Context(), ctx.GetParam("name"), input)
+	if err != nil {
+		writeRoleError(ctx, err, "UPDATE_FAILED", "Failed to update role")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(role, "Role updated successfully"))
+}
+
+// DeleteRole godoc
+// @Summary Delete role
+// @Description Delete a custom role that is not assigned to any user
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param name path string true "Role name"
+// @Success 200 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/admin/roles/{name} [delete]
+func (h *RoleHandler) DeleteRole(ctx http.RequestContext) {
+	if err := h.deleteRoleUseCase.Execute(ctx.Context(), ctx.GetParam("name")); err != nil {
+		writeRoleError(ctx, err, "DELETE_FAILED", "Failed to delete role")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "Role deleted successfully"))
+}
+
+// writeRoleError maps role management errors to HTTP responses
+func writeRoleError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, entUser.ErrRoleNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Role not found", nil))
+	case errors.Is(err, entUser.ErrRoleAlreadyExists):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("ROLE_EXISTS", "Role already exists", nil))
+	case errors.Is(err, entUser.ErrRoleInUse):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("ROLE_IN_USE", "Role is assigned to users", nil))
+	case errors.Is(err, entUser.ErrBuiltInRole):
+		ctx.JSON(netHttp.StatusForbidden,
+			dto.ErrorResponse("BUILT_IN_ROLE", "Configured roles can not be changed", nil))
+	case errors.Is(err, entUser.ErrInvalidRoleName),
+		errors.Is(err, entUser.ErrRoleWithoutPermissions),
+		errors.Is(err, voUser.ErrInvalidPermission):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_ROLE", err.Error(), nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
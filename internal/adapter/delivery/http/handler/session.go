@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	entUser "todolist/internal/domain/user/entity"
+	"todolist/internal/dto"
+	ucUser "todolist/internal/usecase/user"
+)
+
+// SessionHandler handles session and device management HTTP requests
+type SessionHandler struct {
+	listSessionsUseCase        ucUser.ListSessionsUseCase
+	revokeSessionUseCase       ucUser.RevokeSessionUseCase
+	revokeOtherSessionsUseCase ucUser.RevokeOtherSessionsUseCase
+}
+
+// NewSessionHandler creates a new session handler
+func NewSessionHandler(
+	listSessionsUseCase ucUser.ListSessionsUseCase,
+	revokeSessionUseCase ucUser.RevokeSessionUseCase,
+	revokeOtherSessionsUseCase ucUser.RevokeOtherSessionsUseCase,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessionsUseCase:        listSessionsUseCase,
+		revokeSessionUseCase:       revokeSessionUseCase,
+		revokeOtherSessionsUseCase: revokeOtherSessionsUseCase,
+	}
+}
+
+// ListSessions godoc
+// @Summary List active sessions
+// @Description List the devices where the authenticated user is logged in
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=[]dto.SessionResponse}
+// @Failure 401 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/auth/sessions [get]
+func (h *SessionHandler) ListSessions(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return
+	}
+
+	sessions, err := h.listSessionsUseCase.Execute(ctx.Context(), userID, getAuthenticatedSessionID(ctx))
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list sessions", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(sessions, ""))
+}
+
+// RevokeSession godoc
+// @Summary Revoke session
+// @Description Terminate one of the sessions of the authenticated user
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 200 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/auth/sessions/{id} [delete]
+func (h *SessionHandler) RevokeSession(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return
+	}
+
+	sessionID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	if err := h.revokeSessionUseCase.Execute(ctx.Context(), userID, sessionID); err != nil {
+		if errors.Is(err, entUser.ErrSessionNotFound) {
+			ctx.JSON(netHttp.StatusNotFound,
+				dto.ErrorResponse("NOT_FOUND", "Session not found", nil))
+		} else {
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("REVOKE_FAILED", "Failed to revoke session", nil))
+		}
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "Session revoked successfully"))
+}
+
+// RevokeOtherSessions godoc
+// @Summary Revoke other sessions
+// @Description Terminate every session of the authenticated user except the current one
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=dto.RevokeSessionsResponse}
+// @Failure 401 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/auth/sessions [delete]
+func (h *SessionHandler) RevokeOtherSessions(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return
+	}
+
+	result, err := h.revokeOtherSessionsUseCase.Execute(ctx.Context(), userID, getAuthenticatedSessionID(ctx))
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("REVOKE_FAILED", "Failed to revoke sessions", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(result, "Sessions revoked successfully"))
+}
@@ -0,0 +1,417 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	netHttp "net/http"
+	"strconv"
+	"strings"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/timetracking/entity"
+	vo "todolist/internal/domain/timetracking/valueobject"
+	todoEntity "todolist/internal/domain/todo/entity"
+	"todolist/internal/dto"
+	ucTimeTracking "todolist/internal/usecase/timetracking"
+)
+
+// TimeEntryHandler handles time tracking HTTP requests
+type TimeEntryHandler struct {
+	startTimerUseCase      ucTimeTracking.StartTimerUseCase
+	stopTimerUseCase       ucTimeTracking.StopTimerUseCase
+	getTimerUseCase        ucTimeTracking.GetTimerUseCase
+	createTimeEntryUseCase ucTimeTracking.CreateTimeEntryUseCase
+	listTimeEntriesUseCase ucTimeTracking.ListTimeEntriesUseCase
+	deleteTimeEntryUseCase ucTimeTracking.DeleteTimeEntryUseCase
+	getTimeReportUseCase   ucTimeTracking.GetTimeReportUseCase
+}
+
+// NewTimeEntryHandler creates a new time entry handler
+func NewTimeEntryHandler(
+	startTimerUseCase ucTimeTracking.StartTimerUseCase,
+	stopTimerUseCase ucTimeTracking.StopTimerUseCase,
+	getTimerUseCase ucTimeTracking.GetTimerUseCase,
+	createTimeEntryUseCase ucTimeTracking.CreateTimeEntryUseCase,
+	listTimeEntriesUseCase ucTimeTracking.ListTimeEntriesUseCase,
+	deleteTimeEntryUseCase ucTimeTracking.DeleteTimeEntryUseCase,
+	getTimeReportUseCase ucTimeTracking.GetTimeReportUseCase,
+) *TimeEntryHandler {
+	return &TimeEntryHandler{
+		startTimerUseCase:      startTimerUseCase,
+		stopTimerUseCase:       stopTimerUseCase,
+		getTimerUseCase:        getTimerUseCase,
+		createTimeEntryUseCase: createTimeEntryUseCase,
+		listTimeEntriesUseCase: listTimeEntriesUseCase,
+		deleteTimeEntryUseCase: deleteTimeEntryUseCase,
+		getTimeReportUseCase:   getTimeReportUseCase,
+	}
+}
+
+// StartTimer godoc
+// @Summary Start timer
+// @Description Start a timer on a todo. Users have one running timer at most, so the running timer is stopped first.
+// @Tags time
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param timer body dto.StartTimerRequest false "Timer data"
+// @Success 201 {object} dto.Response{data=dto.TimeEntryResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/time/start [post]
+func (h *TimeEntryHandler) StartTimer(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	todoID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.StartTimerRequest
+
+	if ctx.Request().ContentLength > 0 {
+		if err := ctx.BindJSON(&input); err != nil {
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+			ctx.Abort()
+			return
+		}
+	}
+
+	entry, err := h.startTimerUseCase.Execute(ctx.Context(), userID, todoID, input)
+	if err != nil {
+		writeTimeEntryError(ctx, err, "START_FAILED", "Failed to start timer")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(entry, "Timer started"))
+}
+
+// StopTimer godoc
+// @Summary Stop timer
+// @Description Stop the running timer, its time being tracked on its todo
+// @Tags time
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=dto.TimeEntryResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/time/stop [post]
+func (h *TimeEntryHandler) StopTimer(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	entry, err := h.stopTimerUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		writeTimeEntryError(ctx, err, "STOP_FAILED", "Failed to stop timer")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(entry, "Timer stopped"))
+}
+
+// GetTimer godoc
+// @Summary Get running timer
+// @Description Get the running timer with the time elapsed so far
+// @Tags time
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=dto.TimeEntryResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/time/current [get]
+func (h *TimeEntryHandler) GetTimer(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	entry, err := h.getTimerUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		writeTimeEntryError(ctx, err, "GET_FAILED", "Failed to get timer")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(entry, ""))
+}
+
+// CreateTimeEntry godoc
+// @Summary Add time entry
+// @Description Enter time spent on a todo by hand. Entries must end after they start and not in the future.
+// @Tags time
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param entry body dto.CreateTimeEntryRequest true "Time entry data"
+// @Success 201 {object} dto.Response{data=dto.TimeEntryResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/time [post]
+func (h *TimeEntryHandler) CreateTimeEntry(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	todoID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.CreateTimeEntryRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	entry, err := h.createTimeEntryUseCase.Execute(ctx.Context(), userID, todoID, input)
+	if err != nil {
+		writeTimeEntryError(ctx, err, "CREATE_FAILED", "Failed to add time entry")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(entry, "Time entry added"))
+}
+
+// ListTimeEntries godoc
+// @Summary List time entries
+// @Description List the time entries of all users on a todo, the latest first
+// @Tags time
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} dto.Response{data=[]dto.TimeEntryResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/time [get]
+func (h *TimeEntryHandler) ListTimeEntries(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	todoID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	entries, err := h.listTimeEntriesUseCase.Execute(ctx.Context(), userID, todoID)
+	if err != nil {
+		writeTimeEntryError(ctx, err, "LIST_FAILED", "Failed to list time entries")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(entries, ""))
+}
+
+// DeleteTimeEntry godoc
+// @Summary Delete time entry
+// @Description Delete a time entry of the user, its time no longer being tracked on its todo
+// @Tags time
+// @Accept json
+// @Produce json
+// @Param id path string true "Time entry ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/time/entries/{id} [delete]
+func (h *TimeEntryHandler) DeleteTimeEntry(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	entryID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	if err := h.deleteTimeEntryUseCase.Execute(ctx.Context(), userID, entryID); err != nil {
+		writeTimeEntryError(ctx, err, "DELETE_FAILED", "Failed to delete time entry")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusNoContent, nil)
+}
+
+// GetTimeReport godoc
+// @Summary Time report
+// @Description Sum the time tracked in a period by day, tag or project. Entries count in the period they start in and time on todos with several tags counts for each of them. The period defaults to the last 30 days and spans 366 days at most.
+// @Tags time
+// @Accept json
+// @Produce json
+// @Produce text/csv
+// @Param from query string false "Start of the period, RFC 3339"
+// @Param to query string false "End of the period, RFC 3339, defaults to now"
+// @Param group_by query string false "day, tag or project, defaults to day"
+// @Param tz query string false "IANA timezone days start in, defaults to UTC"
+// @Param format query string false "csv to download the report as CSV"
+// @Success 200 {object} dto.Response{data=dto.TimeReportResponse}
+// @Failure 400 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/time/report [get]
+func (h *TimeEntryHandler) GetTimeReport(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	format := ctx.GetQuery("format")
+	if format != "" && format != "json" && format != "csv" {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "format must be json or csv", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	input := dto.TimeReportRequest{
+		GroupBy:  ctx.GetQuery("group_by"),
+		Timezone: ctx.GetQuery("tz"),
+	}
+
+	if input.From, err = getQueryTime(ctx, "from"); err == nil {
+		input.To, err = getQueryTime(ctx, "to")
+	}
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", err.Error(), nil))
+
+		ctx.Abort()
+		return
+	}
+
+	report, err := h.getTimeReportUseCase.Execute(ctx.Context(), userID, input)
+	if err != nil {
+		writeTimeEntryError(ctx, err, "REPORT_FAILED", "Failed to report tracked time")
+		return
+	}
+
+	if format == "csv" {
+		writeTimeReportCSV(ctx, report)
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(report, ""))
+}
+
+// writeTimeReportCSV writes a time report as a CSV download, a row per group
+// followed by the total
+func writeTimeReportCSV(ctx http.RequestContext, report *dto.TimeReportResponse) {
+	hours := func(seconds int64) string { return strconv.FormatFloat(float64(seconds)/3600, 'f', 2, 64) }
+
+	header := ctx.Writer().Header()
+	header.Set("Content-Type", "text/csv; charset=utf-8")
+	header.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="time-report-%s-%s.csv"`,
+		report.From.Format("20060102"), report.To.Format("20060102")))
+	ctx.Writer().WriteHeader(netHttp.StatusOK)
+
+	writer := csv.NewWriter(ctx.Writer())
+	_ = writer.Write([]string{report.GroupBy, "name", "seconds", "hours", "entries"})
+	for _, row := range report.Rows {
+		_ = writer.Write([]string{
+			csvText(row.Key), csvText(row.Name), strconv.FormatInt(row.Duration, 10), hours(row.Duration), strconv.Itoa(row.Entries),
+		})
+	}
+	_ = writer.Write([]string{"total", "", strconv.FormatInt(report.Total, 10), hours(report.Total), ""})
+	writer.Flush()
+}
+
+// csvText returns a text cell of a CSV export, prefixed with a quote when it starts
+// like a formula so spreadsheets show it instead of evaluating it
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// writeTimeEntryError writes the response of a failed time tracking request
+func writeTimeEntryError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, shared.ErrNotFound), errors.Is(err, todoEntity.ErrUnauthorizedTodoAccess):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
+	case errors.Is(err, entity.ErrTimeEntryNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Time entry not found", nil))
+	case errors.Is(err, entity.ErrTimerNotRunning):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("TIMER_NOT_RUNNING", "No timer is running", nil))
+	case errors.Is(err, entity.ErrTimerAlreadyRunning):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("TIMER_RUNNING", "A timer is already running", nil))
+	case errors.Is(err, entity.ErrInvalidTimeEntry),
+		errors.Is(err, entity.ErrTimeEntryNoteTooLong):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_TIME_ENTRY", err.Error(), nil))
+	case errors.Is(err, vo.ErrInvalidTimeReportGrouping),
+		errors.Is(err, ucTimeTracking.ErrInvalidTimeReportPeriod),
+		errors.Is(err, ucTimeTracking.ErrInvalidTimezone):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REPORT", err.Error(), nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
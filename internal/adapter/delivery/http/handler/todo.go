@@ -6,54 +6,90 @@ import (
 	"strconv"
 	"strings"
 	"todolist/internal/adapter/delivery/http"
+	cfEntity "todolist/internal/domain/customfield/entity"
+	projectEntity "todolist/internal/domain/project/entity"
 	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
 	"todolist/internal/domain/todo/entity"
 	"todolist/internal/domain/todo/valueobject"
 	"todolist/internal/dto"
 	ucTodo "todolist/internal/usecase/todo"
+	"todolist/pkg/cursor"
 )
 
 // TodoHandler handles todo-related HTTP requests
 type TodoHandler struct {
 	createTodoUseCase    ucTodo.CreateTodoUseCase
 	updateTodoUseCase    ucTodo.UpdateTodoUseCase
+	patchTodoUseCase     ucTodo.PatchTodoUseCase
 	completeTodoUseCase  ucTodo.CompleteTodoUseCase
 	deleteTodoUseCase    ucTodo.DeleteTodoUseCase
 	getTodoUseCase       ucTodo.GetTodoUseCase
 	listTodosUseCase     ucTodo.ListTodosUseCase
 	getStatisticsUseCase ucTodo.GetStatisticsUseCase
+	getHistoryUseCase    ucTodo.GetTodoHistoryUseCase
+	revertTodoUseCase    ucTodo.RevertTodoUseCase
+	undoTodoUseCase      ucTodo.UndoTodoUseCase
+	listTrashUseCase     ucTodo.ListTrashUseCase
+	restoreTodoUseCase   ucTodo.RestoreTodoUseCase
+	emptyTrashUseCase    ucTodo.EmptyTrashUseCase
+	bulkTodoUseCase      ucTodo.BulkTodoUseCase
+	searchTodosUseCase   ucTodo.SearchTodosUseCase
+	moveTodoUseCase      ucTodo.MoveTodoUseCase
 }
 
 // NewTodoHandler creates a new todo handler
 func NewTodoHandler(
 	createTodoUseCase ucTodo.CreateTodoUseCase,
 	updateTodoUseCase ucTodo.UpdateTodoUseCase,
+	patchTodoUseCase ucTodo.PatchTodoUseCase,
 	completeTodoUseCase ucTodo.CompleteTodoUseCase,
 	deleteTodoUseCase ucTodo.DeleteTodoUseCase,
 	getTodoUseCase ucTodo.GetTodoUseCase,
 	listTodosUseCase ucTodo.ListTodosUseCase,
 	getStatisticsUseCase ucTodo.GetStatisticsUseCase,
+	getHistoryUseCase ucTodo.GetTodoHistoryUseCase,
+	revertTodoUseCase ucTodo.RevertTodoUseCase,
+	undoTodoUseCase ucTodo.UndoTodoUseCase,
+	listTrashUseCase ucTodo.ListTrashUseCase,
+	restoreTodoUseCase ucTodo.RestoreTodoUseCase,
+	emptyTrashUseCase ucTodo.EmptyTrashUseCase,
+	bulkTodoUseCase ucTodo.BulkTodoUseCase,
+	searchTodosUseCase ucTodo.SearchTodosUseCase,
+	moveTodoUseCase ucTodo.MoveTodoUseCase,
 ) *TodoHandler {
 	return &TodoHandler{
 		createTodoUseCase:    createTodoUseCase,
 		updateTodoUseCase:    updateTodoUseCase,
+		patchTodoUseCase:     patchTodoUseCase,
 		completeTodoUseCase:  completeTodoUseCase,
 		deleteTodoUseCase:    deleteTodoUseCase,
 		getTodoUseCase:       getTodoUseCase,
 		listTodosUseCase:     listTodosUseCase,
 		getStatisticsUseCase: getStatisticsUseCase,
+		getHistoryUseCase:    getHistoryUseCase,
+		revertTodoUseCase:    revertTodoUseCase,
+		undoTodoUseCase:      undoTodoUseCase,
+		listTrashUseCase:     listTrashUseCase,
+		restoreTodoUseCase:   restoreTodoUseCase,
+		emptyTrashUseCase:    emptyTrashUseCase,
+		bulkTodoUseCase:      bulkTodoUseCase,
+		searchTodosUseCase:   searchTodosUseCase,
+		moveTodoUseCase:      moveTodoUseCase,
 	}
 }
 
 // CreateTodo godoc
 // @Summary Create a new todo
-// @Description Create a new todo item
+// @Description Create a new todo item. With check_duplicates, the todo is not created when open todos have a similar title; they are listed in a 409 response and the todo is created by sending it again, with a new idempotency key, without check_duplicates.
 // @Tags todos
 // @Accept json
 // @Produce json
 // @Param todo body dto.CreateTodoRequest true "Todo data"
+// @Param Idempotency-Key header string false "Key making retries replay the first response"
 // @Success 201 {object} dto.Response{data=dto.TodoResponse}
 // @Failure 400 {object} dto.Response
+// @Failure 409 {object} dto.Response{data=[]dto.SimilarTodoResponse}
 // @Security BearerAuth
 // @Router /api/v1/todos [post]
 func (h *TodoHandler) CreateTodo(ctx http.RequestContext) {
@@ -79,13 +115,29 @@ func (h *TodoHandler) CreateTodo(ctx http.RequestContext) {
 	// Create todo
 	todo, err := h.createTodoUseCase.Execute(ctx.Context(), userID, input)
 	if err != nil {
-		ctx.JSON(netHttp.StatusInternalServerError,
-			dto.ErrorResponse("CREATE_FAILED", "Failed to create todo", nil))
+		var duplicatesErr *ucTodo.PossibleDuplicatesError
+
+		if errors.As(err, &duplicatesErr) {
+			// The similar todos let the user confirm it is not a duplicate
+			response := dto.ErrorResponse("POSSIBLE_DUPLICATE", "Open todos have a similar title", nil)
+			response.Data = duplicatesErr.Duplicates
+			ctx.JSON(netHttp.StatusConflict, response)
+		} else if errors.Is(err, projectEntity.ErrProjectNotFound) {
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_PROJECT", "Project not found", nil))
+		} else if isCustomFieldValueError(err) {
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_CUSTOM_FIELD", err.Error(), nil))
+		} else {
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("CREATE_FAILED", "Failed to create todo", nil))
+		}
 
 		ctx.Abort()
 		return
 	}
 
+	setETag(ctx, todo.Version)
 	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(todo, "Todo created successfully"))
 }
 
@@ -100,9 +152,13 @@ func (h *TodoHandler) CreateTodo(ctx http.RequestContext) {
 // @Param status query []string false "Filter by status" Enums(pending,in_progress,completed,cancelled)
 // @Param priority query []string false "Filter by priority" Enums(low,medium,high,critical)
 // @Param tags query []string false "Filter by tags"
-// @Param search query string false "Search in title and description"
+// @Param search query string false "Full-text search of the title and description, in the search language of the user"
 // @Param is_overdue query bool false "Filter overdue todos"
-// @Success 200 {object} dto.PaginatedResponse{data=[]dto.TodoResponse}
+// @Param filter query string false "Filter expression on status, priority, tag, due, created, updated, title, text and cf.<key> for custom fields, e.g. status:pending AND (tag:work OR priority>=high) AND NOT tag:someday AND cf.points>=3"
+// @Param cursor query string false "Cursor of the page to list, from next_cursor or prev_cursor of a previous page; page is ignored"
+// @Param order_by query string false "Comma separated sort keys, descending when prefixed with -, e.g. -priority,due_date. Keys: created_at, updated_at, due_date, priority, title, status, position (the manual order), id, cf.<key> for custom fields. Todos without due date or custom field value are listed last. Cursors are only issued for sorts by one key other than due_date and custom fields" default(-created_at)
+// @Param facets query bool false "Count the matching todos by status, priority, tag and due date bucket"
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.TodoResponse,facets=dto.TodoFacetsResponse}
 // @Failure 400 {object} dto.Response
 // @Security BearerAuth
 // @Router /api/v1/todos [get]
@@ -117,6 +173,86 @@ func (h *TodoHandler) ListTodos(ctx http.RequestContext) {
 	}
 
 	// Parse query parameters
+	queryParams, options, err := getQueryOptions(ctx, valueobject.TodoSortKeys(), valueobject.DefaultTodoSort)
+	if err != nil {
+		writeQueryError(ctx, err)
+		return
+	}
+
+	// Build filters
+	filters, ok := parseTodoFilters(ctx, userID, queryParams.Search)
+	if !ok {
+		return
+	}
+
+	withFacets, _ := strconv.ParseBool(ctx.GetQuery("facets"))
+
+	// List todos
+	result, err := h.listTodosUseCase.Execute(ctx.Context(), userID, filters, options, ctx.GetQuery("cursor"), withFacets)
+	if err != nil {
+		switch {
+		case errors.Is(err, cursor.ErrInvalidCursor):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_CURSOR", "Invalid or expired cursor", nil))
+		case errors.Is(err, shared.ErrInvalidSort):
+			// Sorts by unknown custom fields are only found by the query
+			writeQueryError(ctx, err)
+			return
+		case errors.Is(err, valueobject.ErrInvalidFilterExpression):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_FILTER", "Invalid filter expression", filterExpressionErrorDetails(err)))
+		default:
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("LIST_FAILED", "Failed to list todos", nil))
+		}
+
+		ctx.Abort()
+		return
+	}
+
+	response := dto.PaginatedSuccessResponse(
+		result.Todos,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	).WithCursors(result.NextCursor, result.PrevCursor)
+
+	if result.Facets != nil {
+		response = response.WithFacets(result.Facets)
+	}
+
+	ctx.JSON(netHttp.StatusOK, response)
+}
+
+// SearchTodos godoc
+// @Summary Search todos
+// @Description Full-text search of the title and description of the todos, the most relevant first, with the matching words highlighted. Searches take the web search syntax: all the words must match, "quoted phrases" match as a whole, or matches either side and a leading - excludes a word. Words are stemmed in the search language of the user unless a language is given. Searches without or nor exclusions also find the todos whose title is similar to the words, despite typos.
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param q query string true "Search terms"
+// @Param language query string false "Search language, the one of the user when omitted" Enums(english,portuguese)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Param status query []string false "Filter by status" Enums(pending,in_progress,completed,cancelled)
+// @Param priority query []string false "Filter by priority" Enums(low,medium,high,critical)
+// @Param tags query []string false "Filter by tags"
+// @Param is_overdue query bool false "Filter overdue todos"
+// @Param filter query string false "Filter expression, as in the todo listing"
+// @Success 200 {object} dto.PaginatedResponse{data=dto.TodoSearchResponse}
+// @Failure 400 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/search [get]
+func (h *TodoHandler) SearchTodos(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
 	var queryParams dto.QueryParams
 	if err := ctx.BindQuery(&queryParams); err != nil {
 		ctx.JSON(netHttp.StatusBadRequest,
@@ -127,10 +263,64 @@ func (h *TodoHandler) ListTodos(ctx http.RequestContext) {
 	}
 	queryParams.SetDefaults()
 
-	// Build filters
+	filters, ok := parseTodoFilters(ctx, userID, ctx.GetQuery("q"))
+	if !ok {
+		return
+	}
+
+	if !filters.HasSearchTerm() {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_QUERY", "The search terms are required", map[string]any{"q": "required"}))
+
+		ctx.Abort()
+		return
+	}
+
+	if languageStr := ctx.GetQuery("language"); languageStr != "" {
+		language, err := sharedvo.NewSearchLanguage(languageStr)
+		if err != nil {
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_LANGUAGE", "Unsupported search language", map[string]any{"supported": sharedvo.SearchLanguages()}))
+
+			ctx.Abort()
+			return
+		}
+		filters.SearchLanguage = language
+	}
+
+	options := shared.QueryOptions{
+		Limit:  queryParams.PageSize,
+		Offset: queryParams.GetOffset(),
+	}
+
+	result, err := h.searchTodosUseCase.Execute(ctx.Context(), userID, filters, options)
+	if err != nil {
+		if errors.Is(err, valueobject.ErrInvalidFilterExpression) {
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_FILTER", "Invalid filter expression", filterExpressionErrorDetails(err)))
+		} else {
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("SEARCH_FAILED", "Failed to search todos", nil))
+		}
+
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
+		result,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	))
+}
+
+// parseTodoFilters parses the todo filters of the query, writing the error
+// response and returning false when they are invalid
+func parseTodoFilters(ctx http.RequestContext, userID int64, search string) (valueobject.TodoFilterCriteria, bool) {
 	filters := valueobject.TodoFilterCriteria{
 		UserID:     userID,
-		SearchTerm: queryParams.Search,
+		SearchTerm: search,
 	}
 
 	// Parse status filter
@@ -154,36 +344,18 @@ func (h *TodoHandler) ListTodos(ctx http.RequestContext) {
 		filters.IsOverdue = &isOverdue
 	}
 
-	// Build query options
-	options := shared.QueryOptions{
-		Limit:     queryParams.PageSize,
-		Offset:    queryParams.GetOffset(),
-		OrderBy:   queryParams.OrderBy,
-		OrderDesc: queryParams.OrderDir == "desc",
-	}
-
-	// Default ordering
-	if options.OrderBy == "" {
-		options.OrderBy = "created_at"
-		options.OrderDesc = true
-	}
-
-	// List todos
-	result, err := h.listTodosUseCase.Execute(ctx.Context(), userID, filters, options)
+	// Parse filter expression
+	expression, err := valueobject.ParseTodoFilterExpression(ctx.GetQuery("filter"))
 	if err != nil {
-		ctx.JSON(netHttp.StatusInternalServerError,
-			dto.ErrorResponse("LIST_FAILED", "Failed to list todos", nil))
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_FILTER", "Invalid filter expression", filterExpressionErrorDetails(err)))
 
 		ctx.Abort()
-		return
+		return filters, false
 	}
+	filters.Expression = expression
 
-	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
-		result.Todos,
-		queryParams.Page,
-		queryParams.PageSize,
-		result.TotalCount,
-	))
+	return filters, true
 }
 
 // GetTodo godoc
@@ -194,6 +366,7 @@ func (h *TodoHandler) ListTodos(ctx http.RequestContext) {
 // @Produce json
 // @Param id path string true "Todo ID"
 // @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Header 200 {string} ETag "Version of the returned entity"
 // @Failure 404 {object} dto.Response
 // @Security BearerAuth
 // @Router /api/v1/todos/{id} [get]
@@ -218,7 +391,7 @@ func (h *TodoHandler) GetTodo(ctx http.RequestContext) {
 
 	todo, err := h.getTodoUseCase.Execute(ctx.Context(), userID, todoID)
 	if err != nil {
-		if err == shared.ErrNotFound || err.Error() == "unauthorized to access this todo" {
+		if errors.Is(err, shared.ErrNotFound) || errors.Is(err, entity.ErrUnauthorizedTodoAccess) {
 			ctx.JSON(netHttp.StatusNotFound,
 				dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
 		} else {
@@ -231,20 +404,24 @@ func (h *TodoHandler) GetTodo(ctx http.RequestContext) {
 		return
 	}
 
+	setETag(ctx, todo.Version)
 	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, ""))
 }
 
 // UpdateTodo godoc
 // @Summary Update todo
-// @Description Update todo details
+// @Description Update todo details. A state moves the todo to a state of the workflow of its project, and a status to the first state in the status the workflow allows; the resolution explains the change and is required by the states asking for it.
 // @Tags todos
 // @Accept json
 // @Produce json
 // @Param id path string true "Todo ID"
 // @Param todo body dto.UpdateTodoRequest true "Todo data"
+// @Param If-Match header string false "ETag of the version being changed"
 // @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Header 200 {string} ETag "Version of the returned entity"
 // @Failure 400 {object} dto.Response
 // @Failure 404 {object} dto.Response
+// @Failure 412 {object} dto.Response
 // @Security BearerAuth
 // @Router /api/v1/todos/{id} [put]
 func (h *TodoHandler) UpdateTodo(ctx http.RequestContext) {
@@ -276,17 +453,40 @@ func (h *TodoHandler) UpdateTodo(ctx http.RequestContext) {
 		return
 	}
 
+	expectedVersions, err := getIfMatchVersions(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid If-Match header", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
 	// Update todo
-	todo, err := h.updateTodoUseCase.Execute(ctx.Context(), userID, todoID, input)
+	todo, err := h.updateTodoUseCase.Execute(ctx.Context(), userID, todoID, input, expectedVersions)
 	if err != nil {
 		switch {
-		case errors.Is(err, shared.ErrNotFound):
-		case errors.Is(err, entity.ErrUnauthorizedTodoAccess):
+		case errors.Is(err, shared.ErrNotFound), errors.Is(err, entity.ErrUnauthorizedTodoAccess):
 			ctx.JSON(netHttp.StatusNotFound,
 				dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
+		case errors.Is(err, shared.ErrOptimisticLock):
+			ctx.JSON(netHttp.StatusPreconditionFailed,
+				dto.ErrorResponse("PRECONDITION_FAILED", "Todo was modified, reload it and try again", nil))
 		case errors.Is(err, entity.ErrInvalidStatusTransition):
 			ctx.JSON(netHttp.StatusBadRequest,
 				dto.ErrorResponse("INVALID_TRANSITION", "Invalid status transition", nil))
+		case errors.Is(err, entity.ErrUnknownState):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_STATE", err.Error(), nil))
+		case errors.Is(err, entity.ErrMissingRequiredField):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("MISSING_FIELD", err.Error(), nil))
+		case errors.Is(err, projectEntity.ErrProjectNotFound):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_PROJECT", "Project not found", nil))
+		case isCustomFieldValueError(err):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_CUSTOM_FIELD", err.Error(), nil))
 		default:
 			ctx.JSON(netHttp.StatusInternalServerError,
 				dto.ErrorResponse("UPDATE_FAILED", "Failed to update todo", nil))
@@ -296,6 +496,7 @@ func (h *TodoHandler) UpdateTodo(ctx http.RequestContext) {
 		return
 	}
 
+	setETag(ctx, todo.Version)
 	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo updated successfully"))
 }
 
@@ -306,9 +507,12 @@ func (h *TodoHandler) UpdateTodo(ctx http.RequestContext) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the version being changed"
 // @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Header 200 {string} ETag "Version of the returned entity"
 // @Failure 400 {object} dto.Response
 // @Failure 404 {object} dto.Response
+// @Failure 412 {object} dto.Response
 // @Security BearerAuth
 // @Router /api/v1/todos/{id}/complete [put]
 func (h *TodoHandler) CompleteTodo(ctx http.RequestContext) {
@@ -330,16 +534,33 @@ func (h *TodoHandler) CompleteTodo(ctx http.RequestContext) {
 		return
 	}
 
-	todo, err := h.completeTodoUseCase.Execute(ctx.Context(), userID, todoID)
+	expectedVersions, err := getIfMatchVersions(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid If-Match header", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	todo, err := h.completeTodoUseCase.Execute(ctx.Context(), userID, todoID, expectedVersions)
 	if err != nil {
 		switch {
-		case errors.Is(err, shared.ErrNotFound):
-		case errors.Is(err, entity.ErrUnauthorizedTodoAccess):
+		case errors.Is(err, shared.ErrNotFound), errors.Is(err, entity.ErrUnauthorizedTodoAccess):
 			ctx.JSON(netHttp.StatusNotFound,
 				dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
+		case errors.Is(err, shared.ErrOptimisticLock):
+			ctx.JSON(netHttp.StatusPreconditionFailed,
+				dto.ErrorResponse("PRECONDITION_FAILED", "Todo was modified, reload it and try again", nil))
 		case errors.Is(err, entity.ErrTodoAlreadyCompleted):
 			ctx.JSON(netHttp.StatusBadRequest,
 				dto.ErrorResponse("ALREADY_COMPLETED", "Todo is already completed", nil))
+		case errors.Is(err, entity.ErrInvalidStatusTransition):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_TRANSITION", "Invalid status transition", nil))
+		case errors.Is(err, entity.ErrMissingRequiredField):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("MISSING_FIELD", err.Error(), nil))
 		default:
 			ctx.JSON(netHttp.StatusInternalServerError,
 				dto.ErrorResponse("COMPLETE_FAILED", "Failed to complete todo", nil))
@@ -349,9 +570,75 @@ func (h *TodoHandler) CompleteTodo(ctx http.RequestContext) {
 		return
 	}
 
+	setETag(ctx, todo.Version)
 	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo completed successfully"))
 }
 
+// MoveTodo godoc
+// @Summary Move todo
+// @Description Move a todo in the manual order of its user, listed with order_by=position, between the todos given by after_id and before_id, or right after or before one of them when only one is given
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param request body dto.MoveTodoRequest true "Todos to place the todo between"
+// @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Header 200 {string} ETag "Version of the returned entity"
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/move [post]
+func (h *TodoHandler) MoveTodo(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	todoID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.MoveTodoRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	todo, err := h.moveTodoUseCase.Execute(ctx.Context(), userID, todoID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, shared.ErrNotFound), errors.Is(err, entity.ErrUnauthorizedTodoAccess):
+			ctx.JSON(netHttp.StatusNotFound,
+				dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
+		case errors.Is(err, ucTodo.ErrInvalidMove):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_MOVE", "after_id and before_id must be other todos of the same user, in order, and at least one is required", nil))
+		default:
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("MOVE_FAILED", "Failed to move todo", nil))
+		}
+
+		ctx.Abort()
+		return
+	}
+
+	setETag(ctx, todo.Version)
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo moved successfully"))
+}
+
 // DeleteTodo godoc
 // @Summary Delete todo
 // @Description Delete a todo item
@@ -359,8 +646,10 @@ func (h *TodoHandler) CompleteTodo(ctx http.RequestContext) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the version being changed"
 // @Success 200 {object} dto.Response
 // @Failure 404 {object} dto.Response
+// @Failure 412 {object} dto.Response
 // @Security BearerAuth
 // @Router /api/v1/todos/{id} [delete]
 func (h *TodoHandler) DeleteTodo(ctx http.RequestContext) {
@@ -382,12 +671,25 @@ func (h *TodoHandler) DeleteTodo(ctx http.RequestContext) {
 		return
 	}
 
-	err = h.deleteTodoUseCase.Execute(ctx.Context(), userID, todoID)
+	expectedVersions, err := getIfMatchVersions(ctx)
 	if err != nil {
-		if err == shared.ErrNotFound || err.Error() == "unauthorized to access this todo" {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid If-Match header", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	err = h.deleteTodoUseCase.Execute(ctx.Context(), userID, todoID, expectedVersions)
+	if err != nil {
+		switch {
+		case errors.Is(err, shared.ErrNotFound), errors.Is(err, entity.ErrUnauthorizedTodoAccess):
 			ctx.JSON(netHttp.StatusNotFound,
 				dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
-		} else {
+		case errors.Is(err, shared.ErrOptimisticLock):
+			ctx.JSON(netHttp.StatusPreconditionFailed,
+				dto.ErrorResponse("PRECONDITION_FAILED", "Todo was modified, reload it and try again", nil))
+		default:
 			ctx.JSON(netHttp.StatusInternalServerError,
 				dto.ErrorResponse("DELETE_FAILED", "Failed to delete todo", nil))
 		}
@@ -429,3 +731,11 @@ func (h *TodoHandler) GetStatistics(ctx http.RequestContext) {
 
 	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(stats, ""))
 }
+
+// isCustomFieldValueError checks if the error reports custom field values of a todo
+// that are invalid, unknown or missing
+func isCustomFieldValueError(err error) bool {
+	return errors.Is(err, valueobject.ErrInvalidCustomFieldValue) ||
+		errors.Is(err, cfEntity.ErrUnknownCustomField) ||
+		errors.Is(err, cfEntity.ErrCustomFieldRequired)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/dto"
+	ucTodo "todolist/internal/usecase/todo"
+)
+
+// BulkTodos godoc
+// @Summary Apply an action to several todos
+// @Description Complete, cancel, reopen, start, set the priority of, add or remove tags from, or delete the todos selected by IDs or by a filter. In atomic mode, the default, a failing todo rolls back the whole operation; in best_effort mode each todo is changed on its own. Each todo is reported in the results.
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkTodoRequest true "Selection and action"
+// @Param Idempotency-Key header string false "Key making retries replay the first response"
+// @Success 200 {object} dto.Response{data=dto.BulkTodoResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 409 {object} dto.Response{data=dto.BulkTodoResponse}
+// @Security BearerAuth
+// @Router /api/v1/todos/bulk [post]
+func (h *TodoHandler) BulkTodos(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.BulkTodoRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	result, err := h.bulkTodoUseCase.Execute(ctx.Context(), userID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, ucTodo.ErrBulkAborted):
+			// The results tell which todo failed
+			response := dto.ErrorResponse("BULK_ABORTED", "A todo could not be changed, no todo was changed", nil)
+			response.Data = result
+			ctx.JSON(netHttp.StatusConflict, response)
+		case errors.Is(err, ucTodo.ErrInvalidBulkRequest):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_REQUEST", "Invalid bulk request", parseError(err)))
+		case errors.Is(err, ucTodo.ErrTooManyBulkTodos):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("TOO_MANY_TODOS", "Too many todos selected", parseError(err)))
+		default:
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("BULK_FAILED", "Failed to change todos", nil))
+		}
+
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(result, ""))
+}
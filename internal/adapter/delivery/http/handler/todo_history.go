@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"strconv"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/dto"
+	ucTodo "todolist/internal/usecase/todo"
+)
+
+// GetTodoHistory godoc
+// @Summary Get todo history
+// @Description List the recorded changes of a todo with the changed fields, newest first
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} dto.Response{data=dto.TodoHistoryResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/history [get]
+func (h *TodoHandler) GetTodoHistory(ctx http.RequestContext) {
+	userID, todoID, ok := getTodoRequestIDs(ctx)
+	if !ok {
+		return
+	}
+
+	history, err := h.getHistoryUseCase.Execute(ctx.Context(), userID, todoID)
+	if err != nil {
+		writeTodoHistoryError(ctx, err, "HISTORY_FAILED", "Failed to get todo history")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(history, ""))
+}
+
+// RevertTodo godoc
+// @Summary Revert todo to a version
+// @Description Restore a todo to a version of its history, applying the status transition and due date rules
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param version path int true "Version to restore"
+// @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/history/{version}/revert [post]
+func (h *TodoHandler) RevertTodo(ctx http.RequestContext) {
+	userID, todoID, ok := getTodoRequestIDs(ctx)
+	if !ok {
+		return
+	}
+
+	version, err := strconv.Atoi(ctx.GetParam("version"))
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid version parameter", nil))
+		ctx.Abort()
+		return
+	}
+
+	todo, err := h.revertTodoUseCase.Execute(ctx.Context(), userID, todoID, version)
+	if err != nil {
+		writeTodoHistoryError(ctx, err, "REVERT_FAILED", "Failed to revert todo")
+		return
+	}
+
+	setETag(ctx, todo.Version)
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo reverted successfully"))
+}
+
+// UndoTodo godoc
+// @Summary Undo last todo change
+// @Description Restore a todo to the version before its last change
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/undo [post]
+func (h *TodoHandler) UndoTodo(ctx http.RequestContext) {
+	userID, todoID, ok := getTodoRequestIDs(ctx)
+	if !ok {
+		return
+	}
+
+	todo, err := h.undoTodoUseCase.Execute(ctx.Context(), userID, todoID)
+	if err != nil {
+		writeTodoHistoryError(ctx, err, "UNDO_FAILED", "Failed to undo todo change")
+		return
+	}
+
+	setETag(ctx, todo.Version)
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo change undone successfully"))
+}
+
+// getTodoRequestIDs extracts the authenticated user and the todo IDs, writing the error response when invalid
+func getTodoRequestIDs(ctx http.RequestContext) (int64, int64, bool) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return 0, 0, false
+	}
+
+	todoID, err := getIDParam(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid ID parameter", parseError(err)))
+		ctx.Abort()
+		return 0, 0, false
+	}
+
+	return userID, todoID, true
+}
+
+// writeTodoHistoryError maps todo history errors to HTTP responses
+func writeTodoHistoryError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, shared.ErrNotFound), errors.Is(err, entity.ErrUnauthorizedTodoAccess):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
+	case errors.Is(err, ucTodo.ErrTodoVersionNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("VERSION_NOT_FOUND", "Todo version not found", nil))
+	case errors.Is(err, ucTodo.ErrNothingToUndo):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("NOTHING_TO_UNDO", "Todo has no change to undo", nil))
+	case errors.Is(err, shared.ErrOptimisticLock):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("CONFLICT", "Todo was modified, reload it and try again", nil))
+	case errors.Is(err, entity.ErrInvalidStatusTransition):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_TRANSITION", "Invalid status transition", nil))
+	case errors.Is(err, entity.ErrMissingRequiredField):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("MISSING_FIELD", err.Error(), nil))
+	case errors.Is(err, entity.ErrInvalidDueDate):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_DUE_DATE", "Due date cannot be in the past", nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
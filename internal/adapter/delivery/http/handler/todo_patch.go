@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	projectEntity "todolist/internal/domain/project/entity"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/dto"
+	ucTodo "todolist/internal/usecase/todo"
+	"todolist/pkg/patch"
+)
+
+// PatchTodo godoc
+// @Summary Patch todo
+// @Description Partially update a todo with a JSON Merge Patch (application/merge-patch+json) or a JSON Patch (application/json-patch+json) over title, description, status, priority, due_date, tags, project_id, state, resolution, estimate_seconds and custom_fields, null or removed fields being cleared
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param patch body object true "Merge patch document or JSON Patch operations"
+// @Param If-Match header string false "ETag of the version being changed"
+// @Param Idempotency-Key header string false "Key making retries replay the first response"
+// @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Header 200 {string} ETag "Version of the returned entity"
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Failure 412 {object} dto.Response
+// @Failure 415 {object} dto.Response
+// @Failure 422 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id} [patch]
+func (h *TodoHandler) PatchTodo(ctx http.RequestContext) {
+	userID, todoID, ok := getTodoRequestIDs(ctx)
+	if !ok {
+		return
+	}
+
+	expectedVersions, err := getIfMatchVersions(ctx)
+	if err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid If-Match header", parseError(err)))
+		ctx.Abort()
+		return
+	}
+
+	input, ok := getPatchTodoRequest(ctx)
+	if !ok {
+		return
+	}
+
+	todo, err := h.patchTodoUseCase.Execute(ctx.Context(), userID, todoID, input, expectedVersions)
+	if err != nil {
+		switch {
+		case errors.Is(err, shared.ErrNotFound), errors.Is(err, entity.ErrUnauthorizedTodoAccess):
+			ctx.JSON(netHttp.StatusNotFound,
+				dto.ErrorResponse("NOT_FOUND", "Todo not found", nil))
+		case errors.Is(err, shared.ErrOptimisticLock):
+			ctx.JSON(netHttp.StatusPreconditionFailed,
+				dto.ErrorResponse("PRECONDITION_FAILED", "Todo was modified, reload it and try again", nil))
+		case errors.Is(err, patch.ErrInvalidPatch):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_PATCH", "Invalid patch", parseError(err)))
+		case errors.Is(err, patch.ErrTestFailed):
+			ctx.JSON(netHttp.StatusConflict,
+				dto.ErrorResponse("PATCH_TEST_FAILED", "Patch test operation failed", parseError(err)))
+		case errors.Is(err, patch.ErrPathNotFound), errors.Is(err, ucTodo.ErrInvalidTodoPatch):
+			ctx.JSON(netHttp.StatusUnprocessableEntity,
+				dto.ErrorResponse("UNPROCESSABLE_PATCH", "Patch cannot be applied to the todo", parseError(err)))
+		case errors.Is(err, entity.ErrInvalidStatusTransition):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_TRANSITION", "Invalid status transition", nil))
+		case errors.Is(err, entity.ErrUnknownState):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_STATE", err.Error(), nil))
+		case errors.Is(err, entity.ErrMissingRequiredField):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("MISSING_FIELD", err.Error(), nil))
+		case errors.Is(err, projectEntity.ErrProjectNotFound):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_PROJECT", "Project not found", nil))
+		case isCustomFieldValueError(err):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_CUSTOM_FIELD", err.Error(), nil))
+		case errors.Is(err, entity.ErrInvalidDueDate):
+			ctx.JSON(netHttp.StatusBadRequest,
+				dto.ErrorResponse("INVALID_DUE_DATE", "Due date cannot be in the past", nil))
+		default:
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("PATCH_FAILED", "Failed to patch todo", nil))
+		}
+
+		ctx.Abort()
+		return
+	}
+
+	setETag(ctx, todo.Version)
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo updated successfully"))
+}
+
+// getPatchTodoRequest decodes the patch in the format of the request content type,
+// plain JSON being read as a merge patch, writing the error response when invalid
+func getPatchTodoRequest(ctx http.RequestContext) (dto.PatchTodoRequest, bool) {
+	var input dto.PatchTodoRequest
+
+	mediaType, _, _ := mime.ParseMediaType(ctx.Request().Header.Get("Content-Type"))
+
+	var target any
+	switch mediaType {
+	case patch.MergePatchMediaType, "application/json":
+		target = &input.MergePatch
+	case patch.JSONPatchMediaType:
+		target = &input.JSONPatch
+	default:
+		ctx.JSON(netHttp.StatusUnsupportedMediaType,
+			dto.ErrorResponse("UNSUPPORTED_MEDIA_TYPE",
+				"Content type must be "+patch.MergePatchMediaType+" or "+patch.JSONPatchMediaType, nil))
+		ctx.Abort()
+		return input, false
+	}
+
+	if err := json.NewDecoder(ctx.Request().Body).Decode(target); err != nil || (input.MergePatch == nil && input.JSONPatch == nil) {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_PATCH", "Invalid patch document", nil))
+		ctx.Abort()
+		return input, false
+	}
+
+	return input, true
+}
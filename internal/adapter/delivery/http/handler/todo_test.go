@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	netHttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	ucTodo "todolist/internal/usecase/todo"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fakeDeleteTodoUseCase deletes the todo when it is at one of the expected versions
+type fakeDeleteTodoUseCase struct {
+	ucTodo.DeleteTodoUseCase
+	entity shared.Entity
+}
+
+func (f fakeDeleteTodoUseCase) Execute(ctx context.Context, userID, todoID int64, expectedVersions []int64) error {
+	return f.entity.CheckLockVersion(expectedVersions)
+}
+
+func TestTodoHandler_DeleteTodo_IfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	entity := shared.NewEntity(1)
+	entity.SetLockVersion(4)
+	h := NewTodoHandler(nil, nil, nil, nil, fakeDeleteTodoUseCase{entity: entity},
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+	}{
+		{name: "no header", ifMatch: "", wantStatus: netHttp.StatusOK},
+		{name: "any version", ifMatch: "*", wantStatus: netHttp.StatusOK},
+		{name: "current version", ifMatch: `"4"`, wantStatus: netHttp.StatusOK},
+		{name: "list with the current version", ifMatch: `"3", "4"`, wantStatus: netHttp.StatusOK},
+		{name: "list without the current version", ifMatch: `"2","3"`, wantStatus: netHttp.StatusPreconditionFailed},
+		{name: "weak current version", ifMatch: `W/"4"`, wantStatus: netHttp.StatusPreconditionFailed},
+		{name: "weak and strong versions", ifMatch: `W/"4", "4"`, wantStatus: netHttp.StatusOK},
+		{name: "unknown tag", ifMatch: `"abc"`, wantStatus: netHttp.StatusPreconditionFailed},
+		{name: "unquoted version", ifMatch: "4", wantStatus: netHttp.StatusBadRequest},
+		{name: "malformed list", ifMatch: `"3", 4`, wantStatus: netHttp.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request = httptest.NewRequest(netHttp.MethodDelete, "/api/v1/todos/1", nil)
+			if tt.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+			c.Params = gin.Params{{Key: "id", Value: "1"}}
+			c.Set("userID", int64(10))
+
+			h.DeleteTodo(&http.GinAdapter{Ctx: c})
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
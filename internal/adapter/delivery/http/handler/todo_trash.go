@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/dto"
+)
+
+// ListTrash godoc
+// @Summary List trash
+// @Description List the deleted todos of the authenticated user that can still be restored, most recently deleted first
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.TrashedTodoResponse}
+// @Failure 400 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/trash [get]
+func (h *TodoHandler) ListTrash(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return
+	}
+
+	queryParams, options, err := getQueryOptions(ctx, nil, nil)
+	if err != nil {
+		writeQueryError(ctx, err)
+		return
+	}
+
+	result, err := h.listTrashUseCase.Execute(ctx.Context(), userID, options)
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list trash", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
+		result.Todos,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	))
+}
+
+// RestoreTodo godoc
+// @Summary Restore todo
+// @Description Move a deleted todo out of the trash
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} dto.Response{data=dto.TodoResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/todos/{id}/restore [post]
+func (h *TodoHandler) RestoreTodo(ctx http.RequestContext) {
+	userID, todoID, ok := getTodoRequestIDs(ctx)
+	if !ok {
+		return
+	}
+
+	todo, err := h.restoreTodoUseCase.Execute(ctx.Context(), userID, todoID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) || errors.Is(err, entity.ErrUnauthorizedTodoAccess) {
+			ctx.JSON(netHttp.StatusNotFound,
+				dto.ErrorResponse("NOT_FOUND", "Todo not found in trash", nil))
+		} else {
+			ctx.JSON(netHttp.StatusInternalServerError,
+				dto.ErrorResponse("RESTORE_FAILED", "Failed to restore todo", nil))
+		}
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(todo, "Todo restored successfully"))
+}
+
+// EmptyTrash godoc
+// @Summary Empty trash
+// @Description Permanently delete the todos in the trash of the authenticated user
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=dto.EmptyTrashResponse}
+// @Security BearerAuth
+// @Router /api/v1/todos/trash [delete]
+func (h *TodoHandler) EmptyTrash(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+		ctx.Abort()
+		return
+	}
+
+	result, err := h.emptyTrashUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("EMPTY_TRASH_FAILED", "Failed to empty trash", nil))
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(result, "Trash emptied successfully"))
+}
@@ -0,0 +1,307 @@
+package handler
+
+import (
+	"errors"
+	netHttp "net/http"
+	"todolist/internal/adapter/delivery/http"
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/domain/view/entity"
+	vo "todolist/internal/domain/view/valueobject"
+	"todolist/internal/dto"
+	ucView "todolist/internal/usecase/view"
+	"todolist/pkg/cursor"
+)
+
+// ViewHandler handles saved view and smart list HTTP requests
+type ViewHandler struct {
+	listViewsUseCase     ucView.ListViewsUseCase
+	getViewUseCase       ucView.GetViewUseCase
+	createViewUseCase    ucView.CreateViewUseCase
+	updateViewUseCase    ucView.UpdateViewUseCase
+	deleteViewUseCase    ucView.DeleteViewUseCase
+	listViewTodosUseCase ucView.ListViewTodosUseCase
+}
+
+// NewViewHandler creates a new view handler
+func NewViewHandler(
+	listViewsUseCase ucView.ListViewsUseCase,
+	getViewUseCase ucView.GetViewUseCase,
+	createViewUseCase ucView.CreateViewUseCase,
+	updateViewUseCase ucView.UpdateViewUseCase,
+	deleteViewUseCase ucView.DeleteViewUseCase,
+	listViewTodosUseCase ucView.ListViewTodosUseCase,
+) *ViewHandler {
+	return &ViewHandler{
+		listViewsUseCase:     listViewsUseCase,
+		getViewUseCase:       getViewUseCase,
+		createViewUseCase:    createViewUseCase,
+		updateViewUseCase:    updateViewUseCase,
+		deleteViewUseCase:    deleteViewUseCase,
+		listViewTodosUseCase: listViewTodosUseCase,
+	}
+}
+
+// ListViews godoc
+// @Summary List views
+// @Description List the built-in smart lists (today, upcoming, overdue, no_due_date) followed by the views saved by the user
+// @Tags views
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.Response{data=[]dto.ViewResponse}
+// @Security BearerAuth
+// @Router /api/v1/views [get]
+func (h *ViewHandler) ListViews(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	views, err := h.listViewsUseCase.Execute(ctx.Context(), userID)
+	if err != nil {
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse("LIST_FAILED", "Failed to list views", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(views, ""))
+}
+
+// GetView godoc
+// @Summary Get view
+// @Description Get a saved view by ID or a smart list by key
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param id path string true "View ID or smart list key"
+// @Success 200 {object} dto.Response{data=dto.ViewResponse}
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/views/{id} [get]
+func (h *ViewHandler) GetView(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	view, err := h.getViewUseCase.Execute(ctx.Context(), userID, ctx.GetParam("id"))
+	if err != nil {
+		writeViewError(ctx, err, "GET_FAILED", "Failed to get view")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(view, ""))
+}
+
+// CreateView godoc
+// @Summary Save view
+// @Description Save a named todo filter with its sort order and grouping
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param view body dto.CreateViewRequest true "View data"
+// @Success 201 {object} dto.Response{data=dto.ViewResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 409 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/views [post]
+func (h *ViewHandler) CreateView(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.CreateViewRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	view, err := h.createViewUseCase.Execute(ctx.Context(), userID, input)
+	if err != nil {
+		writeViewError(ctx, err, "CREATE_FAILED", "Failed to save view")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusCreated, dto.SuccessResponse(view, "View saved successfully"))
+}
+
+// UpdateView godoc
+// @Summary Update view
+// @Description Change the name, filter, sort order or grouping of a saved view; a group_by of none removes the grouping
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param id path string true "View ID"
+// @Param view body dto.UpdateViewRequest true "View data"
+// @Success 200 {object} dto.Response{data=dto.ViewResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/views/{id} [put]
+func (h *ViewHandler) UpdateView(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var input dto.UpdateViewRequest
+
+	if err := ctx.BindJSON(&input); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+
+	view, err := h.updateViewUseCase.Execute(ctx.Context(), userID, ctx.GetParam("id"), input)
+	if err != nil {
+		writeViewError(ctx, err, "UPDATE_FAILED", "Failed to update view")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(view, "View updated successfully"))
+}
+
+// DeleteView godoc
+// @Summary Delete view
+// @Description Delete a saved view
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param id path string true "View ID"
+// @Success 200 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/views/{id} [delete]
+func (h *ViewHandler) DeleteView(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	if err := h.deleteViewUseCase.Execute(ctx.Context(), userID, ctx.GetParam("id")); err != nil {
+		writeViewError(ctx, err, "DELETE_FAILED", "Failed to delete view")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.SuccessResponse(nil, "View deleted successfully"))
+}
+
+// ListViewTodos godoc
+// @Summary List the todos of a view
+// @Description List the todos matching the filter of a saved view or smart list, in the order of the view. The todos of grouped views are also listed by group, with the total of each group.
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param id path string true "View ID or smart list key"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Param cursor query string false "Cursor of the page to list, from next_cursor or prev_cursor of a previous page; page is ignored"
+// @Success 200 {object} dto.PaginatedResponse{data=dto.ViewTodosResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Security BearerAuth
+// @Router /api/v1/views/{id}/todos [get]
+func (h *ViewHandler) ListViewTodos(ctx http.RequestContext) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil || userID == 0 {
+		ctx.JSON(netHttp.StatusUnauthorized,
+			dto.ErrorResponse("UNAUTHENTICATED", "User is not authenticated", nil))
+
+		ctx.Abort()
+		return
+	}
+
+	var queryParams dto.QueryParams
+	if err := ctx.BindQuery(&queryParams); err != nil {
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_QUERY", "Invalid query parameters", parseError(err)))
+
+		ctx.Abort()
+		return
+	}
+	queryParams.SetDefaults()
+
+	options := shared.QueryOptions{
+		Limit:  queryParams.PageSize,
+		Offset: queryParams.GetOffset(),
+	}
+
+	result, err := h.listViewTodosUseCase.Execute(ctx.Context(), userID, ctx.GetParam("id"), options, ctx.GetQuery("cursor"))
+	if err != nil {
+		writeViewError(ctx, err, "LIST_FAILED", "Failed to list the todos of the view")
+		return
+	}
+
+	ctx.JSON(netHttp.StatusOK, dto.PaginatedSuccessResponse(
+		result,
+		queryParams.Page,
+		queryParams.PageSize,
+		result.TotalCount,
+	).WithCursors(result.NextCursor, result.PrevCursor))
+}
+
+// writeViewError maps view errors to HTTP responses
+func writeViewError(ctx http.RequestContext, err error, code, message string) {
+	switch {
+	case errors.Is(err, entity.ErrViewNotFound):
+		ctx.JSON(netHttp.StatusNotFound,
+			dto.ErrorResponse("NOT_FOUND", "View not found", nil))
+	case errors.Is(err, entity.ErrViewAlreadyExists):
+		ctx.JSON(netHttp.StatusConflict,
+			dto.ErrorResponse("VIEW_EXISTS", "A view with this name already exists", nil))
+	case errors.Is(err, entity.ErrBuiltInView):
+		ctx.JSON(netHttp.StatusForbidden,
+			dto.ErrorResponse("BUILT_IN_VIEW", "Built-in views can not be changed", nil))
+	case errors.Is(err, todovo.ErrInvalidFilterExpression):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_FILTER", "Invalid filter expression", filterExpressionErrorDetails(err)))
+	case errors.Is(err, cursor.ErrInvalidCursor):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_CURSOR", "Invalid or expired cursor", nil))
+	case errors.Is(err, shared.ErrInvalidSort):
+		writeQueryError(ctx, err)
+		return
+	case errors.Is(err, entity.ErrInvalidViewName),
+		errors.Is(err, vo.ErrInvalidViewFilter),
+		errors.Is(err, vo.ErrInvalidViewSort),
+		errors.Is(err, vo.ErrInvalidViewGrouping):
+		ctx.JSON(netHttp.StatusBadRequest,
+			dto.ErrorResponse("INVALID_VIEW", err.Error(), nil))
+	default:
+		ctx.JSON(netHttp.StatusInternalServerError,
+			dto.ErrorResponse(code, message, nil))
+	}
+
+	ctx.Abort()
+}
@@ -42,6 +42,9 @@ type RequestContext interface {
 	// GetQuery returns the value of the first query parameter with the specified key.
 	GetQuery(key string) string
 
+	// ClientIP returns the real client IP, honoring trusted proxy headers.
+	ClientIP() string
+
 	// Redirect sends an HTTP redirect to the specified URL with the given status code.
 	Redirect(statusCode int, to string)
 
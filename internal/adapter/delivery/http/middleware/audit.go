@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	vo "todolist/internal/domain/audit/valueobject"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditContext attaches the client of the request to the request context, so the
+// changes recorded in the audit log tell where they were performed from.
+// Anonymous requests, such as registrations, are recorded without an actor.
+func AuditContext() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		setAuditActor(ctx, 0, 0)
+		ctx.Next()
+	}
+}
+
+// setAuditActor attaches the actor performing the request to the request context
+func setAuditActor(ctx *gin.Context, userID, impersonatorID int64) {
+	actor := vo.Actor{
+		UserID:         userID,
+		ImpersonatorID: impersonatorID,
+		IPAddress:      ctx.ClientIP(),
+		UserAgent:      ctx.Request.UserAgent(),
+	}
+
+	ctx.Request = ctx.Request.WithContext(vo.ContextWithActor(ctx.Request.Context(), actor))
+}
@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
+	vo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
 	"todolist/internal/service"
 
@@ -10,7 +12,7 @@ import (
 )
 
 // AuthMiddleware creates an authentication middleware
-func AuthMiddleware(tokenService service.TokenService) gin.HandlerFunc {
+func AuthMiddleware(tokenService service.TokenService, sessionService service.SessionService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authHeader := ctx.GetHeader("Authorization")
 		if authHeader == "" {
@@ -37,26 +39,62 @@ func AuthMiddleware(tokenService service.TokenService) gin.HandlerFunc {
 			return
 		}
 
+		// Tokens bound to a session are only accepted while the session is active
+		sessionID, hasSession := service.SessionIDFromClaims(validationResult.Claims)
+		if hasSession {
+			err := sessionService.ValidateSession(ctx, validationResult.UserID, sessionID, service.SessionInfo{
+				IPAddress: ctx.ClientIP(),
+				UserAgent: ctx.Request.UserAgent(),
+			})
+			if err != nil {
+				ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse("SESSION_REVOKED", "Session is no longer valid", nil))
+				ctx.Abort()
+				return
+			}
+		}
+
 		// Extract custom claims safely
 		username := ""
 		role := ""
+		permissions := []string{}
 
-		if val, ok := validationResult.Claims["username"]; ok {
+		if val, ok := validationResult.Claims[service.ClaimUsername]; ok {
 			if strVal, ok := val.(string); ok {
 				username = strVal
 			}
 		}
 
-		if val, ok := validationResult.Claims["role"]; ok {
+		if val, ok := validationResult.Claims[service.ClaimRole]; ok {
 			if strVal, ok := val.(string); ok {
 				role = strVal
 			}
 		}
 
+		if val, ok := validationResult.Claims[service.ClaimPermissions].([]any); ok {
+			for _, item := range val {
+				if strVal, ok := item.(string); ok {
+					permissions = append(permissions, strVal)
+				}
+			}
+		}
+
+		mustResetPassword, _ := validationResult.Claims[service.ClaimMustResetPassword].(bool)
+
 		// Store user info in Gin context
 		ctx.Set("userID", validationResult.UserID)
 		ctx.Set("username", username)
 		ctx.Set("role", role)
+		ctx.Set("permissions", permissions)
+		ctx.Set("mustResetPassword", mustResetPassword)
+		if hasSession {
+			ctx.Set("sessionID", sessionID)
+		}
+		if validationResult.ActorID != 0 {
+			ctx.Set("impersonatorID", validationResult.ActorID)
+		}
+
+		// Changes performed by the request are audited on behalf of the user
+		setAuditActor(ctx, validationResult.UserID, validationResult.ActorID)
 
 		ctx.Next()
 	}
@@ -90,3 +128,43 @@ func RequireRole(roles ...string) gin.HandlerFunc {
 		ctx.Abort()
 	}
 }
+
+// RequirePermission creates a permission-based authorization middleware.
+//
+// The role read from the token is evaluated against the current access policy, so
+// changes to role definitions apply without waiting for tokens to be renewed.
+// Resource level checks (ownership, shared membership) are done by the use cases.
+func RequirePermission(authorizationService service.AuthorizationService, permissions ...vo.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		role := ctx.GetString("role")
+		if role == "" {
+			ctx.JSON(http.StatusForbidden, dto.ErrorResponse("FORBIDDEN", "Access denied", nil))
+			ctx.Abort()
+			return
+		}
+
+		// Users flagged for a password reset can only change the password
+		if ctx.GetBool("mustResetPassword") {
+			ctx.JSON(http.StatusForbidden, dto.ErrorResponse("PASSWORD_RESET_REQUIRED", "Password must be changed", nil))
+			ctx.Abort()
+			return
+		}
+
+		for _, permission := range permissions {
+			err := authorizationService.Authorize(ctx, vo.UserRole(role), permission)
+			if err == nil {
+				continue
+			}
+
+			if errors.Is(err, service.ErrPermissionDenied) {
+				ctx.JSON(http.StatusForbidden, dto.ErrorResponse("INSUFFICIENT_PERMISSIONS", "Insufficient permissions", nil))
+			} else {
+				ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse("AUTHORIZATION_FAILED", "Failed to check permissions", nil))
+			}
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -23,12 +23,15 @@ var (
 		"Origin",
 		"Cache-Control",
 		"X-Requested-With",
+		"If-Match",
+		"Idempotency-Key",
 	}
 
 	defaultAllowedMethods = []string{
 		http.MethodGet,
 		http.MethodPost,
 		http.MethodPut,
+		http.MethodPatch,
 		http.MethodDelete,
 		http.MethodOptions,
 	}
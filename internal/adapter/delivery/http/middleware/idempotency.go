@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+	"todolist/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayedHeader marks the responses replayed from a previous request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedHeaders are the response headers stored along with the body
+var replayedHeaders = []string{"Content-Type", "ETag", "Location"}
+
+// Idempotency makes POST, PATCH and DELETE requests sent with an Idempotency-Key
+// header safe to retry.
+//
+// The response of the first request is stored for the TTL and replayed to the
+// retries, which must send the same request. Keys are scoped to the authenticated
+// user, so the middleware must run after authentication. Server errors are not
+// stored so the request can be retried.
+func Idempotency(store service.IdempotencyStore, ttl time.Duration, log logger.ExtendedLog) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(IdempotencyKeyHeader)
+		if key == "" || !acceptsIdempotencyKey(ctx.Request.Method) {
+			ctx.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_IDEMPOTENCY_KEY",
+				fmt.Sprintf("Idempotency key must have at most %d characters", maxIdempotencyKeyLength), nil))
+			ctx.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+			ctx.Abort()
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		scopedKey := fmt.Sprintf("%d:%s", ctx.GetInt64("userID"), key)
+		fingerprint := requestFingerprint(ctx.Request, body)
+
+		record, err := store.Reserve(ctx.Request.Context(), scopedKey, fingerprint, time.Now().Add(ttl))
+		if err != nil {
+			log.Failure(fmt.Sprintf("Failed to reserve idempotency key: %v", err))
+			ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse("IDEMPOTENCY_FAILED", "Failed to process idempotency key", nil))
+			ctx.Abort()
+			return
+		}
+
+		if record != nil {
+			replayIdempotentResponse(ctx, record, fingerprint)
+			return
+		}
+
+		// The request context may already be cancelled once the response is written
+		storeCtx := context.WithoutCancel(ctx.Request.Context())
+
+		// A panicking handler must not leave the key in use until it expires
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				_ = store.Release(storeCtx, scopedKey)
+				panic(recovered)
+			}
+		}()
+
+		writer := &recordingWriter{ResponseWriter: ctx.Writer}
+		ctx.Writer = writer
+		ctx.Next()
+
+		if writer.Status() >= http.StatusInternalServerError {
+			if err := store.Release(storeCtx, scopedKey); err != nil {
+				log.Failure(fmt.Sprintf("Failed to release idempotency key: %v", err))
+			}
+			return
+		}
+
+		response := service.IdempotentResponse{
+			StatusCode: writer.Status(),
+			Header:     map[string]string{},
+			Body:       writer.body.Bytes(),
+		}
+		for _, name := range replayedHeaders {
+			if value := writer.Header().Get(name); value != "" {
+				response.Header[name] = value
+			}
+		}
+
+		if err := store.Complete(storeCtx, scopedKey, response); err != nil {
+			log.Failure(fmt.Sprintf("Failed to store idempotent response: %v", err))
+		}
+	}
+}
+
+// replayIdempotentResponse answers a request whose key is already in use with the
+// stored response, or with an error when it cannot be replayed
+func replayIdempotentResponse(ctx *gin.Context, record *service.IdempotencyRecord, fingerprint string) {
+	defer ctx.Abort()
+
+	switch {
+	case record.Fingerprint != fingerprint:
+		ctx.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse("IDEMPOTENCY_KEY_MISMATCH",
+			"Idempotency key was already used with a different request", nil))
+	case record.Response == nil:
+		ctx.JSON(http.StatusConflict, dto.ErrorResponse("IDEMPOTENCY_KEY_IN_USE",
+			"A request with this idempotency key is still being processed", nil))
+	default:
+		for name, value := range record.Response.Header {
+			ctx.Header(name, value)
+		}
+		ctx.Header(IdempotentReplayedHeader, "true")
+		ctx.Status(record.Response.StatusCode)
+		_, _ = ctx.Writer.Write(record.Response.Body)
+	}
+}
+
+// requestFingerprint hashes the method, URI and body of a request
+func requestFingerprint(request *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(request.Method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(request.URL.RequestURI()))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// acceptsIdempotencyKey checks if the HTTP method accepts idempotency keys
+func acceptsIdempotencyKey(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordingWriter keeps a copy of the response body written to the client
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write implements gin.ResponseWriter.
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString implements gin.ResponseWriter.
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
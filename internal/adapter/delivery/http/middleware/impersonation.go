@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+	"todolist/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ForbidImpersonation rejects requests authenticated with an impersonation token.
+// It protects actions that must only be performed by the account owner, such as
+// changing the password or issuing new tokens.
+func ForbidImpersonation() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.GetInt64("impersonatorID") != 0 {
+			ctx.JSON(http.StatusForbidden, dto.ErrorResponse("IMPERSONATION_FORBIDDEN", "Action not allowed while impersonating", nil))
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// AuditImpersonation records every write performed with an impersonation token in the audit log
+func AuditImpersonation(impersonationService service.ImpersonationService, log logger.ExtendedLog) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		actorID := ctx.GetInt64("impersonatorID")
+		if actorID == 0 || isReadOnlyMethod(ctx.Request.Method) {
+			return
+		}
+
+		request := service.ImpersonatedRequest{
+			Method:     ctx.Request.Method,
+			Path:       ctx.Request.URL.Path,
+			StatusCode: ctx.Writer.Status(),
+			IPAddress:  ctx.ClientIP(),
+			UserAgent:  ctx.Request.UserAgent(),
+		}
+
+		// The request context may already be cancelled once the response is written
+		recordCtx := context.WithoutCancel(ctx.Request.Context())
+		if err := impersonationService.RecordImpersonatedRequest(recordCtx, actorID, ctx.GetInt64("userID"), request); err != nil {
+			log.Failure(fmt.Sprintf("Failed to record impersonated request: %v", err))
+		}
+	}
+}
+
+// isReadOnlyMethod checks if the HTTP method does not change state
+func isReadOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
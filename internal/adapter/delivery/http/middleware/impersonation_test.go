@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestForbidImpersonation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// authenticate stands for the auth middleware, impersonating when the header is set
+	authenticate := func(ctx *gin.Context) {
+		ctx.Set("userID", int64(10))
+		if ctx.GetHeader("X-Impersonator") != "" {
+			ctx.Set("impersonatorID", int64(1))
+		}
+		ctx.Next()
+	}
+	ok := func(ctx *gin.Context) { ctx.Status(http.StatusOK) }
+
+	router := gin.New()
+	auth := router.Group("/api/v1/auth")
+	auth.PUT("/change-password", authenticate, ForbidImpersonation(), ok)
+	sessions := auth.Group("/sessions", authenticate, ForbidImpersonation())
+	sessions.GET("", ok)
+	sessions.DELETE("/:id", ok)
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		impersonating bool
+		wantStatus    int
+	}{
+		{name: "change password", method: http.MethodPut, path: "/api/v1/auth/change-password", wantStatus: http.StatusOK},
+		{name: "impersonated change password", method: http.MethodPut, path: "/api/v1/auth/change-password", impersonating: true, wantStatus: http.StatusForbidden},
+		{name: "sessions", method: http.MethodGet, path: "/api/v1/auth/sessions", wantStatus: http.StatusOK},
+		{name: "impersonated sessions", method: http.MethodGet, path: "/api/v1/auth/sessions", impersonating: true, wantStatus: http.StatusForbidden},
+		{name: "impersonated session revocation", method: http.MethodDelete, path: "/api/v1/auth/sessions/3", impersonating: true, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.impersonating {
+				request.Header.Set("X-Impersonator", "1")
+			}
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
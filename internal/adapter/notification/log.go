@@ -0,0 +1,38 @@
+package notification
+
+/*
+ * log.go
+ *
+ * This file provides an implementation of the notification service that
+ * writes notifications to the application log.
+ *
+ * It is used while no delivery channel (email, SMS or push) is configured,
+ * so account events are still traceable.
+ */
+
+import (
+	"context"
+	"todolist/internal/service"
+	"todolist/pkg/logger"
+)
+
+// LogNotifier delivers notifications to the application log
+type LogNotifier struct {
+	log logger.ExtendedLog
+}
+
+// NewLogNotifier creates a new log notifier
+func NewLogNotifier(log logger.ExtendedLog) service.NotificationService {
+	return &LogNotifier{log: log}
+}
+
+// NotifyUser writes the notification to the log
+func (n *LogNotifier) NotifyUser(ctx context.Context, userID int64, notification service.Notification) error {
+	n.log.
+		WithField("user_id", userID).
+		WithField("type", notification.Type).
+		WithFields(notification.Data).
+		Infof("Notification: %s - %s", notification.Subject, notification.Message)
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/audit/entity"
+	"todolist/internal/domain/audit/repository"
+	vo "todolist/internal/domain/audit/valueobject"
+	"todolist/internal/domain/shared"
+	"todolist/internal/infrastructure/database"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+)
+
+// auditLogRepository implements repository.AuditLogRepository
+type auditLogRepository struct {
+	db     *gorm.DB
+	mapper *mapper.AuditLogMapper
+}
+
+// NewAuditLogRepository creates a new audit log repository
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &auditLogRepository{
+		db:     db,
+		mapper: mapper.NewAuditLogMapper(),
+	}
+}
+
+// Save records an audit entry, assigning the generated ID
+func (r *auditLogRepository) Save(ctx context.Context, auditLog *entity.AuditLog) error {
+	auditModel, err := r.mapper.ToModel(auditLog)
+	if err != nil {
+		return err
+	}
+
+	if err := conn(ctx, r.db).Create(auditModel).Error; err != nil {
+		return err
+	}
+
+	auditLog.SetID(auditModel.ID)
+	return nil
+}
+
+// FindByUser finds the entries performed by the user or affecting the user, newest first
+func (r *auditLogRepository) FindByUser(
+	ctx context.Context,
+	userID int64,
+	options shared.QueryOptions,
+) ([]*entity.AuditLog, error) {
+	auditLogs := []*model.AuditLog{}
+
+	query := r.userQuery(ctx, userID).Order("created_at DESC")
+	query = database.ApplyQueryOptions(query, options)
+
+	if err := query.Find(&auditLogs).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(auditLogs)
+}
+
+// CountByUser counts the entries performed by the user or affecting the user
+func (r *auditLogRepository) CountByUser(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+
+	if err := r.userQuery(ctx, userID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// FindByEntity finds every entry of an entity, oldest first
+func (r *auditLogRepository) FindByEntity(ctx context.Context, entityType string, entityID int64) ([]*entity.AuditLog, error) {
+	auditLogs := []*model.AuditLog{}
+
+	if err := conn(ctx, r.db).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&auditLogs).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(auditLogs)
+}
+
+// FindByFilters finds the entries matching the filters, newest first
+func (r *auditLogRepository) FindByFilters(
+	ctx context.Context,
+	filters vo.AuditFilterCriteria,
+	options shared.QueryOptions,
+) ([]*entity.AuditLog, error) {
+	auditLogs := []*model.AuditLog{}
+
+	query := r.filteredQuery(ctx, filters).Order("created_at DESC").Order("id DESC")
+	query = database.ApplyQueryOptions(query, options)
+
+	if err := query.Find(&auditLogs).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(auditLogs)
+}
+
+// CountByFilters counts the entries matching the filters
+func (r *auditLogRepository) CountByFilters(ctx context.Context, filters vo.AuditFilterCriteria) (int64, error) {
+	var count int64
+
+	if err := r.filteredQuery(ctx, filters).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// filteredQuery builds the query for the entries matching the filters
+func (r *auditLogRepository) filteredQuery(ctx context.Context, filters vo.AuditFilterCriteria) *gorm.DB {
+	query := conn(ctx, r.db).Model(&model.AuditLog{})
+
+	if filters.EntityType != "" {
+		query = query.Where("entity_type = ?", filters.EntityType)
+	}
+
+	if filters.EntityID != 0 {
+		query = query.Where("entity_id = ?", filters.EntityID)
+	}
+
+	if filters.HasActorFilter() {
+		query = query.Where("(user_id = ? OR impersonator_id = ?)", filters.ActorID, filters.ActorID)
+	}
+
+	if filters.HasActionFilter() {
+		query = query.Where("action IN ?", filters.Action)
+	}
+
+	if filters.From != nil {
+		query = query.Where("created_at >= ?", *filters.From)
+	}
+
+	if filters.To != nil {
+		query = query.Where("created_at <= ?", *filters.To)
+	}
+
+	return query
+}
+
+// userQuery builds the query for the audit trail of a user
+func (r *auditLogRepository) userQuery(ctx context.Context, userID int64) *gorm.DB {
+	return conn(ctx, r.db).
+		Model(&model.AuditLog{}).
+		Where(
+			"user_id = ? OR impersonator_id = ? OR (entity_type = ? AND entity_id = ?)",
+			userID, userID, entity.EntityTypeUser, userID,
+		)
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/audit/entity"
+	"todolist/internal/domain/audit/repository"
+	vo "todolist/internal/domain/audit/valueobject"
+	"todolist/internal/domain/shared"
+)
+
+// auditRecorder records the changes made through the audited repositories.
+//
+// The actor of each change is taken from the context, see vo.ContextWithActor.
+type auditRecorder struct {
+	auditLogRepository repository.AuditLogRepository
+	transactionManager shared.TransactionManager
+}
+
+// within runs fn in a transaction, so a change is only persisted along with its audit entry
+func (r auditRecorder) within(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.transactionManager.WithinTransaction(ctx, fn)
+}
+
+// record stores the change between two snapshots of an entity.
+//
+// A nil before snapshot records a creation and a nil after snapshot a deletion.
+// Updates that did not change any field are not recorded.
+func (r auditRecorder) record(
+	ctx context.Context,
+	entityType string,
+	entityID int64,
+	before, after map[string]any,
+	sensitive ...string,
+) error {
+	change := vo.NewChange(before, after, sensitive...)
+	if change.IsEmpty() {
+		return nil
+	}
+
+	action := entity.ActionUpdated
+	switch {
+	case before == nil:
+		action = entity.ActionCreated
+	case after == nil:
+		action = entity.ActionDeleted
+	}
+
+	return r.recordAction(ctx, entityType, entityID, action, change)
+}
+
+// recordAction stores an action performed on an entity with the affected values
+func (r auditRecorder) recordAction(
+	ctx context.Context,
+	entityType string,
+	entityID int64,
+	action string,
+	change vo.Change,
+) error {
+	actor := vo.ActorFromContext(ctx)
+
+	auditLog, err := entity.NewAuditLog(0, actor.UserID, entityType, entityID, action, change.OldValues, change.NewValues)
+	if err != nil {
+		return err
+	}
+
+	auditLog.SetImpersonator(actor.ImpersonatorID)
+	auditLog.SetClient(actor.IPAddress, actor.UserAgent)
+
+	return r.auditLogRepository.Save(ctx, auditLog)
+}
+
+// auditTime formats a timestamp for snapshots, nil when unset
+func auditTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/person/entity"
+	"todolist/internal/domain/person/repository"
+	"todolist/internal/domain/shared"
+)
+
+// auditedPersonRepository decorates a repository.PersonRepository recording every change in the audit log
+type auditedPersonRepository struct {
+	repository.PersonRepository
+	recorder auditRecorder
+}
+
+// NewAuditedPersonRepository wraps a person repository so that every create, update and delete is audited
+func NewAuditedPersonRepository(
+	personRepository repository.PersonRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	transactionManager shared.TransactionManager,
+) repository.PersonRepository {
+	return &auditedPersonRepository{
+		PersonRepository: personRepository,
+		recorder:         auditRecorder{auditLogRepository: auditLogRepository, transactionManager: transactionManager},
+	}
+}
+
+// Save saves or updates a person, recording the changed fields
+func (r *auditedPersonRepository) Save(ctx context.Context, person *entity.Person) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, person.ID())
+		if err != nil {
+			return err
+		}
+
+		if err := r.PersonRepository.Save(ctx, person); err != nil {
+			return err
+		}
+
+		return r.recorder.record(ctx, entAudit.EntityTypePerson, person.ID(), before, personAuditValues(person), "tax_id")
+	})
+}
+
+// Delete deletes a person, recording its last values
+func (r *auditedPersonRepository) Delete(ctx context.Context, id int64) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := r.PersonRepository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return r.recorder.record(ctx, entAudit.EntityTypePerson, id, before, nil, "tax_id")
+	})
+}
+
+// snapshot returns the persisted values of a person, nil when it does not exist yet
+func (r *auditedPersonRepository) snapshot(ctx context.Context, id int64) (map[string]any, error) {
+	if id == 0 {
+		return nil, nil
+	}
+
+	person, err := r.PersonRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return personAuditValues(person), nil
+}
+
+// personAuditValues returns the audited fields of a person, the tax ID is redacted when recorded
+func personAuditValues(person *entity.Person) map[string]any {
+	return map[string]any{
+		"name":       person.Name(),
+		"tax_id":     person.TaxID().Number(),
+		"email":      person.Email().Value(),
+		"phone":      person.Phone(),
+		"birth_date": person.BirthDate().String(),
+	}
+}
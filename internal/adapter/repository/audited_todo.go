@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	voAudit "todolist/internal/domain/audit/valueobject"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+)
+
+// auditedTodoRepository decorates a repository.TodoRepository recording every change in the audit log
+type auditedTodoRepository struct {
+	repository.TodoRepository
+	recorder auditRecorder
+}
+
+// NewAuditedTodoRepository wraps a todo repository so that every create, update and delete is audited
+func NewAuditedTodoRepository(
+	todoRepository repository.TodoRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	transactionManager shared.TransactionManager,
+) repository.TodoRepository {
+	return &auditedTodoRepository{
+		TodoRepository: todoRepository,
+		recorder:       auditRecorder{auditLogRepository: auditLogRepository, transactionManager: transactionManager},
+	}
+}
+
+// Save saves or updates a todo, recording the changed fields
+func (r *auditedTodoRepository) Save(ctx context.Context, todo *entity.Todo) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, todo.ID())
+		if err != nil {
+			return err
+		}
+
+		if err := r.TodoRepository.Save(ctx, todo); err != nil {
+			return err
+		}
+
+		return r.recorder.record(ctx, entAudit.EntityTypeTodo, todo.ID(), before, todoAuditValues(todo))
+	})
+}
+
+// Delete deletes a todo, recording its last values
+func (r *auditedTodoRepository) Delete(ctx context.Context, id int64) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := r.TodoRepository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return r.recorder.record(ctx, entAudit.EntityTypeTodo, id, before, nil)
+	})
+}
+
+// DeleteVersioned deletes a todo if it still has the version, recording its last values
+func (r *auditedTodoRepository) DeleteVersioned(ctx context.Context, id, version int64) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := r.TodoRepository.DeleteVersioned(ctx, id, version); err != nil {
+			return err
+		}
+
+		return r.recorder.record(ctx, entAudit.EntityTypeTodo, id, before, nil)
+	})
+}
+
+// DeleteByUserID deletes all todos for a user, recording each deleted todo
+func (r *auditedTodoRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		todos, err := r.TodoRepository.FindByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := r.TodoRepository.DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+
+		for _, todo := range todos {
+			if err := r.recorder.record(ctx, entAudit.EntityTypeTodo, todo.ID(), todoAuditValues(todo), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Restore moves a todo out of the trash, recording its restored values
+func (r *auditedTodoRepository) Restore(ctx context.Context, id int64) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		if err := r.TodoRepository.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		todo, err := r.TodoRepository.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		change := voAudit.NewChange(nil, todoAuditValues(todo))
+		return r.recorder.recordAction(ctx, entAudit.EntityTypeTodo, id, entAudit.ActionRestored, change)
+	})
+}
+
+// PurgeDeletedByUserID permanently deletes the todos in the trash of a user, recording each purged todo
+func (r *auditedTodoRepository) PurgeDeletedByUserID(ctx context.Context, userID int64) (int64, error) {
+	return r.purge(ctx, func(ctx context.Context) ([]*entity.Todo, error) {
+		return r.TodoRepository.FindDeletedByUserID(ctx, userID)
+	})
+}
+
+// PurgeDeletedBefore permanently deletes the todos moved to the trash before the given time, recording
+// each purged todo
+func (r *auditedTodoRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.purge(ctx, func(ctx context.Context) ([]*entity.Todo, error) {
+		return r.TodoRepository.FindDeletedBefore(ctx, before)
+	})
+}
+
+// purge permanently deletes the todos in the trash it finds, recording their last values.
+//
+// Only the todos found are deleted, so a todo entering the selection meanwhile is left for the
+// next purge rather than deleted without an audit entry.
+func (r *auditedTodoRepository) purge(
+	ctx context.Context,
+	find func(ctx context.Context) ([]*entity.Todo, error),
+) (int64, error) {
+	var purged int64
+
+	err := r.recorder.within(ctx, func(ctx context.Context) error {
+		todos, err := find(ctx)
+		if err != nil || len(todos) == 0 {
+			return err
+		}
+
+		ids := make([]int64, len(todos))
+		for i, todo := range todos {
+			ids[i] = todo.ID()
+		}
+
+		if purged, err = r.TodoRepository.PurgeDeletedByIDs(ctx, ids); err != nil {
+			return err
+		}
+
+		for _, todo := range todos {
+			change := voAudit.NewChange(todoAuditValues(todo), nil)
+			if err := r.recorder.recordAction(ctx, entAudit.EntityTypeTodo, todo.ID(), entAudit.ActionPurged, change); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return purged, err
+}
+
+// snapshot returns the persisted values of a todo, nil when it does not exist yet
+func (r *auditedTodoRepository) snapshot(ctx context.Context, id int64) (map[string]any, error) {
+	if id == 0 {
+		return nil, nil
+	}
+
+	todo, err := r.TodoRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return todoAuditValues(todo), nil
+}
+
+// todoAuditValues returns the audited fields of a todo
+func todoAuditValues(todo *entity.Todo) map[string]any {
+	tags := todo.Tags()
+	slices.Sort(tags)
+
+	var projectID any
+	if todo.ProjectID() != 0 {
+		projectID = todo.ProjectID()
+	}
+
+	return map[string]any{
+		vo.TodoFieldUserID:      todo.UserID(),
+		vo.TodoFieldTitle:       todo.Title().Value(),
+		vo.TodoFieldDescription: todo.Description().Value(),
+		vo.TodoFieldStatus:      string(todo.Status()),
+		vo.TodoFieldPriority:    todo.Priority().String(),
+		vo.TodoFieldDueDate:     auditTime(todo.DueDate()),
+		vo.TodoFieldCompletedAt: auditTime(todo.CompletedAt()),
+		vo.TodoFieldTags:        tags,
+		vo.TodoFieldProjectID:   projectID,
+		vo.TodoFieldState:       todo.State(),
+		vo.TodoFieldResolution:  todo.Resolution(),
+		vo.TodoFieldEstimate:    int64(todo.Estimate() / time.Second),
+	}
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// failingAuditLogRepository fails to record any entry
+type failingAuditLogRepository struct {
+	rptAudit.AuditLogRepository
+}
+
+var errAuditUnavailable = errors.New("audit log unavailable")
+
+func (failingAuditLogRepository) Save(ctx context.Context, auditLog *entAudit.AuditLog) error {
+	return errAuditUnavailable
+}
+
+func TestAuditedTodoRepository_Save(t *testing.T) {
+	ctx := context.Background()
+
+	newTodo := func(t *testing.T) *entity.Todo {
+		title, _ := vo.NewTodoTitle("Write report")
+		todo, err := entity.NewTodo(0, 1, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+		if err != nil {
+			t.Fatalf("NewTodo() error = %v", err)
+		}
+		return todo
+	}
+
+	t.Run("should record the change", func(t *testing.T) {
+		db := newTestDB(t)
+		if err := db.AutoMigrate(&model.AuditLog{}); err != nil {
+			t.Fatalf("Failed to migrate: %v", err)
+		}
+		auditLogs := NewAuditLogRepository(db)
+		repo := NewAuditedTodoRepository(NewTodoRepository(db), auditLogs, NewTransactionManager(db))
+
+		todo := newTodo(t)
+		if err := repo.Save(ctx, todo); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		entries, err := auditLogs.FindByEntity(ctx, entAudit.EntityTypeTodo, todo.ID())
+		if err != nil {
+			t.Fatalf("FindByEntity() error = %v", err)
+		}
+		if len(entries) != 1 || entries[0].Action() != entAudit.ActionCreated {
+			t.Errorf("Expected one creation entry, got %v", entries)
+		}
+	})
+
+	t.Run("should roll back the change when it cannot be recorded", func(t *testing.T) {
+		db := newTestDB(t)
+		repo := NewAuditedTodoRepository(NewTodoRepository(db), failingAuditLogRepository{}, NewTransactionManager(db))
+
+		todo := newTodo(t)
+		if err := repo.Save(ctx, todo); !errors.Is(err, errAuditUnavailable) {
+			t.Fatalf("Expected the audit error, got %v", err)
+		}
+
+		if _, err := NewTodoRepository(db).FindByID(ctx, todo.ID()); !errors.Is(err, shared.ErrNotFound) {
+			t.Errorf("Expected the todo not to be stored, got %v", err)
+		}
+	})
+}
+
+// lateTrashTodoRepository leaves a todo out of the trash it finds, as if it was
+// moved to the trash after the selection
+type lateTrashTodoRepository struct {
+	repository.TodoRepository
+	late int64
+}
+
+func (r lateTrashTodoRepository) FindDeletedByUserID(ctx context.Context, userID int64) ([]*entity.Todo, error) {
+	todos, err := r.TodoRepository.FindDeletedByUserID(ctx, userID)
+	return slices.DeleteFunc(todos, func(todo *entity.Todo) bool { return todo.ID() == r.late }), err
+}
+
+func TestAuditedTodoRepository_PurgeDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	// trash stores and deletes a todo of each of the users
+	trash := func(t *testing.T, repo repository.TodoRepository, userIDs ...int64) []int64 {
+		t.Helper()
+
+		ids := make([]int64, len(userIDs))
+		for i, userID := range userIDs {
+			title, _ := vo.NewTodoTitle("Write report")
+			todo, err := entity.NewTodo(0, userID, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+			if err != nil {
+				t.Fatalf("NewTodo() error = %v", err)
+			}
+			if err := repo.Save(ctx, todo); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			if err := repo.Delete(ctx, todo.ID()); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			ids[i] = todo.ID()
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name  string
+		purge func(repo repository.TodoRepository) (int64, error)
+	}{
+		{
+			name: "empty the trash of a user",
+			purge: func(repo repository.TodoRepository) (int64, error) {
+				return repo.PurgeDeletedByUserID(ctx, 1)
+			},
+		},
+		{
+			name: "enforce the retention",
+			purge: func(repo repository.TodoRepository) (int64, error) {
+				return repo.PurgeDeletedBefore(ctx, time.Now().Add(time.Hour))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			if err := db.AutoMigrate(&model.AuditLog{}); err != nil {
+				t.Fatalf("Failed to migrate: %v", err)
+			}
+			todos := NewTodoRepository(db)
+			auditLogs := NewAuditLogRepository(db)
+			repo := NewAuditedTodoRepository(todos, auditLogs, NewTransactionManager(db))
+
+			ids := trash(t, todos, 1, 1)
+
+			purged, err := tt.purge(repo)
+			if err != nil {
+				t.Fatalf("purge error = %v", err)
+			}
+			if purged != 2 {
+				t.Errorf("Expected 2 todos to be purged, got %d", purged)
+			}
+
+			for _, id := range ids {
+				entries, err := auditLogs.FindByEntity(ctx, entAudit.EntityTypeTodo, id)
+				if err != nil {
+					t.Fatalf("FindByEntity() error = %v", err)
+				}
+				if len(entries) != 1 || entries[0].Action() != entAudit.ActionPurged {
+					t.Errorf("Expected todo %d to be recorded as purged, got %v", id, entries)
+				}
+			}
+		})
+	}
+
+	t.Run("should only purge the todos it records", func(t *testing.T) {
+		db := newTestDB(t)
+		if err := db.AutoMigrate(&model.AuditLog{}); err != nil {
+			t.Fatalf("Failed to migrate: %v", err)
+		}
+		todos := NewTodoRepository(db)
+		ids := trash(t, todos, 1, 1)
+
+		// The second todo enters the trash after the selection
+		late := lateTrashTodoRepository{TodoRepository: todos, late: ids[1]}
+		repo := NewAuditedTodoRepository(late, NewAuditLogRepository(db), NewTransactionManager(db))
+
+		purged, err := repo.PurgeDeletedByUserID(ctx, 1)
+		if err != nil {
+			t.Fatalf("PurgeDeletedByUserID() error = %v", err)
+		}
+		if purged != 1 {
+			t.Errorf("Expected 1 todo to be purged, got %d", purged)
+		}
+		if _, err := todos.FindDeletedByID(ctx, ids[1]); err != nil {
+			t.Errorf("Expected the late todo to stay in the trash, got %v", err)
+		}
+	})
+
+	t.Run("should keep the trash when the purge cannot be recorded", func(t *testing.T) {
+		db := newTestDB(t)
+		todos := NewTodoRepository(db)
+		repo := NewAuditedTodoRepository(todos, failingAuditLogRepository{}, NewTransactionManager(db))
+
+		ids := trash(t, todos, 1)
+
+		if _, err := repo.PurgeDeletedByUserID(ctx, 1); !errors.Is(err, errAuditUnavailable) {
+			t.Fatalf("Expected the audit error, got %v", err)
+		}
+		if _, err := todos.FindDeletedByID(ctx, ids[0]); err != nil {
+			t.Errorf("Expected the todo to stay in the trash, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+)
+
+// auditedUserRepository decorates a repository.UserRepository recording every change in the audit log
+type auditedUserRepository struct {
+	repository.UserRepository
+	recorder auditRecorder
+}
+
+// NewAuditedUserRepository wraps a user repository so that every create, update and delete is audited
+func NewAuditedUserRepository(
+	userRepository repository.UserRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	transactionManager shared.TransactionManager,
+) repository.UserRepository {
+	return &auditedUserRepository{
+		UserRepository: userRepository,
+		recorder:       auditRecorder{auditLogRepository: auditLogRepository, transactionManager: transactionManager},
+	}
+}
+
+// Save saves or updates a user, recording the changed fields
+func (r *auditedUserRepository) Save(ctx context.Context, user *entity.User) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+
+		if err := r.UserRepository.Save(ctx, user); err != nil {
+			return err
+		}
+
+		return r.recorder.record(ctx, entAudit.EntityTypeUser, user.ID(), before, userAuditValues(user), "password")
+	})
+}
+
+// Delete deletes a user, recording its last values
+func (r *auditedUserRepository) Delete(ctx context.Context, id int64) error {
+	return r.recorder.within(ctx, func(ctx context.Context) error {
+		before, err := r.snapshot(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := r.UserRepository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return r.recorder.record(ctx, entAudit.EntityTypeUser, id, before, nil, "password")
+	})
+}
+
+// snapshot returns the persisted values of a user, nil when it does not exist yet
+func (r *auditedUserRepository) snapshot(ctx context.Context, id int64) (map[string]any, error) {
+	if id == 0 {
+		return nil, nil
+	}
+
+	user, err := r.UserRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return userAuditValues(user), nil
+}
+
+// userAuditValues returns the audited fields of a user, the password hash is redacted when recorded
+func userAuditValues(user *entity.User) map[string]any {
+	return map[string]any{
+		"person_id":           user.PersonID(),
+		"username":            user.Username(),
+		"password":            user.Password().Hash(),
+		"status":              string(user.Status()),
+		"role":                string(user.Role()),
+		"must_reset_password": user.MustResetPassword(),
+		"search_language":     string(user.SearchLanguage()),
+	}
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// boardRepository implements repository.BoardRepository
+type boardRepository struct {
+	db     *gorm.DB
+	mapper *mapper.BoardMapper
+}
+
+// NewBoardRepository creates a new board repository
+func NewBoardRepository(db *gorm.DB) repository.BoardRepository {
+	return &boardRepository{
+		db:     db,
+		mapper: mapper.NewBoardMapper(),
+	}
+}
+
+// Save saves or updates a board, replacing its members
+func (r *boardRepository) Save(ctx context.Context, board *entity.Board) error {
+	boardModel, err := r.mapper.ToModel(board)
+	if err != nil {
+		return err
+	}
+
+	err = conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(boardModel).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("board_id = ?", boardModel.ID).Delete(&model.BoardMember{}).Error; err != nil {
+			return err
+		}
+
+		if len(boardModel.Members) == 0 {
+			return nil
+		}
+		return tx.Omit(clause.Associations).Create(boardModel.Members).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return shared.ErrDuplicateEntry
+	}
+
+	return err
+}
+
+// Delete removes a board by ID, with its members
+func (r *boardRepository) Delete(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("board_id = ?", id).Delete(&model.BoardMember{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.Board{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return shared.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// Lock locks the row of a board until the end of the transaction in ctx. Drivers
+// without row locks, as SQLite, serialize the write transactions instead.
+func (r *boardRepository) Lock(ctx context.Context, id int64) error {
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&model.Board{}, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return shared.ErrNotFound
+	}
+
+	return err
+}
+
+// FindByID finds a board by ID
+func (r *boardRepository) FindByID(ctx context.Context, id int64) (*entity.Board, error) {
+	boardModel := &model.Board{}
+
+	if err := conn(ctx, r.db).
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("user_id ASC") }).
+		First(boardModel, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(boardModel)
+}
+
+// FindByUser returns the boards owned by the user or having them as member ordered by name
+func (r *boardRepository) FindByUser(ctx context.Context, userID int64) ([]*entity.Board, error) {
+	var boardModels []*model.Board
+
+	if err := conn(ctx, r.db).
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("user_id ASC") }).
+		Where("user_id = ? OR id IN (?)", userID,
+			r.db.Model(&model.BoardMember{}).Select("board_id").Where("user_id = ?", userID)).
+		Order("name ASC").
+		Order("id ASC").
+		Find(&boardModels).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(boardModels)
+}
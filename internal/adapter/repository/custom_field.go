@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/customfield/entity"
+	"todolist/internal/domain/customfield/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// customFieldRepository implements repository.CustomFieldRepository
+type customFieldRepository struct {
+	db     *gorm.DB
+	mapper *mapper.CustomFieldMapper
+}
+
+// NewCustomFieldRepository creates a new custom field repository
+func NewCustomFieldRepository(db *gorm.DB) repository.CustomFieldRepository {
+	return &customFieldRepository{
+		db:     db,
+		mapper: mapper.NewCustomFieldMapper(),
+	}
+}
+
+// Save saves or updates a custom field
+func (r *customFieldRepository) Save(ctx context.Context, field *entity.CustomField) error {
+	fieldModel, err := r.mapper.ToModel(field)
+	if err != nil {
+		return err
+	}
+
+	err = conn(ctx, r.db).Omit(clause.Associations).Save(fieldModel).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return shared.ErrDuplicateEntry
+	}
+
+	return err
+}
+
+// Delete removes a custom field by ID along with its values on todos
+func (r *customFieldRepository) Delete(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("field_id = ?", id).Delete(&model.TodoFieldValue{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.CustomField{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return shared.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// FindByID finds a custom field by ID
+func (r *customFieldRepository) FindByID(ctx context.Context, id int64) (*entity.CustomField, error) {
+	fieldModel := &model.CustomField{}
+
+	if err := conn(ctx, r.db).First(fieldModel, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(fieldModel)
+}
+
+// FindByUser returns the custom fields of the user, user-wide ones first, then by project and key
+func (r *customFieldRepository) FindByUser(ctx context.Context, userID int64) ([]*entity.CustomField, error) {
+	var fieldModels []*model.CustomField
+
+	if err := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("CASE WHEN project_id IS NULL THEN 0 ELSE 1 END ASC").
+		Order("project_id ASC").
+		Order("custom_fields.key ASC").
+		Find(&fieldModels).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(fieldModels)
+}
+
+// FindOptionsInUse returns the options of the field chosen by todos, trashed todos included
+func (r *customFieldRepository) FindOptionsInUse(ctx context.Context, id int64) ([]string, error) {
+	var options []string
+
+	if err := conn(ctx, r.db).
+		Model(&model.TodoFieldValue{}).
+		Where("field_id = ?", id).
+		Distinct("value").
+		Order("value ASC").
+		Pluck("value", &options).Error; err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+	"todolist/internal/infrastructure/database"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+)
+
+// loginAttemptRepository implements repository.LoginAttemptRepository
+type loginAttemptRepository struct {
+	db     *gorm.DB
+	mapper *mapper.LoginAttemptMapper
+}
+
+// NewLoginAttemptRepository creates a new login attempt repository
+func NewLoginAttemptRepository(db *gorm.DB) repository.LoginAttemptRepository {
+	return &loginAttemptRepository{
+		db:     db,
+		mapper: mapper.NewLoginAttemptMapper(),
+	}
+}
+
+// Save records a login attempt, assigning the generated ID
+func (r *loginAttemptRepository) Save(ctx context.Context, attempt *entity.LoginAttempt) error {
+	attemptModel := r.mapper.ToModel(attempt)
+
+	if err := r.db.WithContext(ctx).Create(attemptModel).Error; err != nil {
+		return err
+	}
+
+	attempt.SetID(attemptModel.ID)
+	return nil
+}
+
+// FindByUserID finds the login attempts of a user, newest first
+func (r *loginAttemptRepository) FindByUserID(
+	ctx context.Context,
+	userID int64,
+	options shared.QueryOptions,
+) ([]*entity.LoginAttempt, error) {
+	attempts := []*model.LoginAttempt{}
+
+	query := r.db.WithContext(ctx).
+		Model(&model.LoginAttempt{}).
+		Where("user_id = ?", userID).
+		Order("created_at DESC")
+	query = database.ApplyQueryOptions(query, options)
+
+	if err := query.Find(&attempts).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(attempts)
+}
+
+// CountByUserID counts the login attempts of a user
+func (r *loginAttemptRepository) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&model.LoginAttempt{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -30,20 +30,22 @@ func NewPersonRepository(db *gorm.DB) repository.PersonRepository {
 func (r *personRepository) Save(ctx context.Context, person *entity.Person) error {
 	personModel := r.mapper.ToModel(person)
 
-	// Use Save to handle both create and update
-	if err := r.db.WithContext(ctx).Save(personModel).Error; err != nil {
+	// Create or update the person, rejecting stale versions
+	if err := saveVersioned(conn(ctx, r.db), personModel, personModel.ID, &personModel.Version); err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return shared.ErrDuplicateEntry
 		}
 		return err
 	}
 
+	person.SetID(personModel.ID)
+	person.SetLockVersion(personModel.Version)
 	return nil
 }
 
 // Delete deletes a person (soft delete)
 func (r *personRepository) Delete(ctx context.Context, id int64) error {
-	result := r.db.WithContext(ctx).Delete(&model.Person{}, "id = ?", id)
+	result := conn(ctx, r.db).Delete(&model.Person{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -59,7 +61,7 @@ func (r *personRepository) Delete(ctx context.Context, id int64) error {
 func (r *personRepository) FindByID(ctx context.Context, id int64) (*entity.Person, error) {
 	person := &model.Person{}
 
-	if err := r.db.WithContext(ctx).First(person, "id = ?", id).Error; err != nil {
+	if err := conn(ctx, r.db).First(person, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, shared.ErrNotFound
 		}
@@ -73,7 +75,7 @@ func (r *personRepository) FindByID(ctx context.Context, id int64) (*entity.Pers
 func (r *personRepository) FindByEmail(ctx context.Context, email string) (*entity.Person, error) {
 	person := &model.Person{}
 
-	if err := r.db.WithContext(ctx).Where("email = ?", email).First(person).Error; err != nil {
+	if err := conn(ctx, r.db).Where("email = ?", email).First(person).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, shared.ErrNotFound
 		}
@@ -87,7 +89,7 @@ func (r *personRepository) FindByEmail(ctx context.Context, email string) (*enti
 func (r *personRepository) ExistsByTaxID(ctx context.Context, taxID string) (bool, error) {
 	var count int64
 
-	if err := r.db.WithContext(ctx).Model(&model.Person{}).
+	if err := conn(ctx, r.db).Model(&model.Person{}).
 		Where("tax_id = ?", taxID).
 		Count(&count).Error; err != nil {
 		return false, err
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/project/entity"
+	"todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// projectRepository implements repository.ProjectRepository
+type projectRepository struct {
+	db     *gorm.DB
+	mapper *mapper.ProjectMapper
+}
+
+// NewProjectRepository creates a new project repository
+func NewProjectRepository(db *gorm.DB) repository.ProjectRepository {
+	return &projectRepository{
+		db:     db,
+		mapper: mapper.NewProjectMapper(),
+	}
+}
+
+// Save saves or updates a project
+func (r *projectRepository) Save(ctx context.Context, project *entity.Project) error {
+	projectModel, err := r.mapper.ToModel(project)
+	if err != nil {
+		return err
+	}
+
+	err = conn(ctx, r.db).Omit(clause.Associations).Save(projectModel).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return shared.ErrDuplicateEntry
+	}
+
+	return err
+}
+
+// Delete removes a project by ID, its todos, trashed ones included, moving to the
+// default workflow state of their status
+func (r *projectRepository) Delete(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Model(&model.Todo{}).
+			Where("project_id = ?", id).
+			UpdateColumns(map[string]any{
+				"project_id": nil,
+				"state":      gorm.Expr("status"),
+			}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.Project{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return shared.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// FindByID finds a project by ID
+func (r *projectRepository) FindByID(ctx context.Context, id int64) (*entity.Project, error) {
+	projectModel := &model.Project{}
+
+	if err := conn(ctx, r.db).First(projectModel, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(projectModel)
+}
+
+// FindByUser returns the projects of the user ordered by name
+func (r *projectRepository) FindByUser(ctx context.Context, userID int64) ([]*entity.Project, error) {
+	var projectModels []*model.Project
+
+	if err := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Order("id ASC").
+		Find(&projectModels).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(projectModels)
+}
+
+// FindStatesInUse returns the states the todos of the project are in, trashed todos included
+func (r *projectRepository) FindStatesInUse(ctx context.Context, id int64) ([]string, error) {
+	var states []string
+
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Model(&model.Todo{}).
+		Where("project_id = ?", id).
+		Distinct("state").
+		Order("state ASC").
+		Pluck("state", &states).Error; err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+	"todolist/internal/domain/user/valueobject"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+)
+
+// roleRepository implements repository.RoleRepository
+type roleRepository struct {
+	db     *gorm.DB
+	mapper *mapper.RoleMapper
+}
+
+// NewRoleRepository creates a new role repository
+func NewRoleRepository(db *gorm.DB) repository.RoleRepository {
+	return &roleRepository{
+		db:     db,
+		mapper: mapper.NewRoleMapper(),
+	}
+}
+
+// Save saves or updates a role, assigning the generated ID on creation
+func (r *roleRepository) Save(ctx context.Context, role *entity.Role) error {
+	roleModel, err := r.mapper.ToModel(role)
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Save(roleModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return shared.ErrDuplicateEntry
+		}
+		return err
+	}
+
+	role.SetID(roleModel.ID)
+	return nil
+}
+
+// Delete removes a role by name
+func (r *roleRepository) Delete(ctx context.Context, name valueobject.UserRole) error {
+	result := r.db.WithContext(ctx).Delete(&model.Role{}, "name = ?", name.String())
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindByName finds a role by name
+func (r *roleRepository) FindByName(ctx context.Context, name valueobject.UserRole) (*entity.Role, error) {
+	roleModel := &model.Role{}
+
+	err := r.db.WithContext(ctx).First(roleModel, "name = ?", name.String()).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(roleModel)
+}
+
+// FindAll returns every custom role ordered by name
+func (r *roleRepository) FindAll(ctx context.Context) ([]*entity.Role, error) {
+	var roleModels []*model.Role
+
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&roleModels).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(roleModels)
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+)
+
+// sessionRepository implements repository.SessionRepository
+type sessionRepository struct {
+	db     *gorm.DB
+	mapper *mapper.SessionMapper
+}
+
+// NewSessionRepository creates a new session repository
+func NewSessionRepository(db *gorm.DB) repository.SessionRepository {
+	return &sessionRepository{
+		db:     db,
+		mapper: mapper.NewSessionMapper(),
+	}
+}
+
+// Save saves or updates a session, assigning the generated ID on creation
+func (r *sessionRepository) Save(ctx context.Context, session *entity.Session) error {
+	sessionModel := r.mapper.ToModel(session)
+
+	if err := conn(ctx, r.db).Save(sessionModel).Error; err != nil {
+		return err
+	}
+
+	session.SetID(sessionModel.ID)
+	return nil
+}
+
+// RevokeAllByUserID revokes every active session of a user except the given one
+func (r *sessionRepository) RevokeAllByUserID(
+	ctx context.Context,
+	userID int64,
+	exceptSessionID int64,
+) (int64, error) {
+	now := time.Now()
+
+	result := conn(ctx, r.db).
+		Model(&model.UserSession{}).
+		Where("user_id = ? AND id <> ? AND revoked_at IS NULL", userID, exceptSessionID).
+		Updates(map[string]any{
+			"revoked_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// RotateRefreshToken updates the session bound to its new refresh token, comparing the
+// stored refresh token with the previous one in the same statement
+func (r *sessionRepository) RotateRefreshToken(
+	ctx context.Context,
+	session *entity.Session,
+	previousTokenID string,
+) (bool, error) {
+	sessionModel := r.mapper.ToModel(session)
+
+	result := conn(ctx, r.db).
+		Model(&model.UserSession{}).
+		Where("id = ? AND refresh_token_id = ?", session.ID(), previousTokenID).
+		Updates(map[string]any{
+			"refresh_token_id": sessionModel.RefreshTokenID,
+			"ip_address":       sessionModel.IPAddress,
+			"user_agent":       sessionModel.UserAgent,
+			"last_seen_at":     sessionModel.LastSeenAt,
+			"expires_at":       sessionModel.ExpiresAt,
+			"updated_at":       time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// FindByID finds a session by ID
+func (r *sessionRepository) FindByID(ctx context.Context, id int64) (*entity.Session, error) {
+	sessionModel := &model.UserSession{}
+
+	if err := conn(ctx, r.db).First(sessionModel, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(sessionModel)
+}
+
+// FindActiveByUserID finds all non revoked and non expired sessions of a user
+func (r *sessionRepository) FindActiveByUserID(ctx context.Context, userID int64) ([]*entity.Session, error) {
+	sessions := []*model.UserSession{}
+
+	if err := conn(ctx, r.db).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(sessions)
+}
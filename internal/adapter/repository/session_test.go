@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todolist/internal/domain/user/entity"
+)
+
+func TestSessionRepository_RotateRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSessionRepository(newTestDB(t))
+
+	session, err := entity.NewSession(0, 10, "Laptop", "10.0.0.1", "Firefox")
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+	session.BindRefreshToken("token-1", time.Now().Add(time.Hour))
+	if err := repo.Save(ctx, session); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// Two refreshes presenting token-1 at once, both loaded the session bound to it
+	first, _ := repo.FindByID(ctx, session.ID())
+	second, _ := repo.FindByID(ctx, session.ID())
+
+	first.BindRefreshToken("token-2", time.Now().Add(2*time.Hour))
+	first.Touch("10.0.0.2", "Firefox")
+	rotated, err := repo.RotateRefreshToken(ctx, first, "token-1")
+	if err != nil || !rotated {
+		t.Fatalf("Expected the first rotation to succeed, got %v, %v", rotated, err)
+	}
+
+	second.BindRefreshToken("token-3", time.Now().Add(2*time.Hour))
+	rotated, err = repo.RotateRefreshToken(ctx, second, "token-1")
+	if err != nil || rotated {
+		t.Fatalf("Expected the second rotation to be refused, got %v, %v", rotated, err)
+	}
+
+	stored, err := repo.FindByID(ctx, session.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if stored.RefreshTokenID() != "token-2" || stored.IPAddress() != "10.0.0.2" {
+		t.Errorf("Expected the session bound to token-2 from 10.0.0.2, got %q from %q", stored.RefreshTokenID(), stored.IPAddress())
+	}
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/timetracking/entity"
+	"todolist/internal/domain/timetracking/repository"
+	vo "todolist/internal/domain/timetracking/valueobject"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// timeEntryRepository implements repository.TimeEntryRepository
+type timeEntryRepository struct {
+	db     *gorm.DB
+	mapper *mapper.TimeEntryMapper
+}
+
+// NewTimeEntryRepository creates a new time entry repository
+func NewTimeEntryRepository(db *gorm.DB) repository.TimeEntryRepository {
+	return &timeEntryRepository{
+		db:     db,
+		mapper: mapper.NewTimeEntryMapper(),
+	}
+}
+
+// Save saves or updates a time entry, summing the tracked time of its todo again
+func (r *timeEntryRepository) Save(ctx context.Context, entry *entity.TimeEntry) error {
+	entryModel := r.mapper.ToModel(entry)
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(entryModel).Error; err != nil {
+			return err
+		}
+		return sumTrackedTime(tx, entryModel.TodoID)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return shared.ErrDuplicateEntry
+	}
+
+	return err
+}
+
+// Delete removes a time entry by ID, summing the tracked time of its todo again
+func (r *timeEntryRepository) Delete(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		entryModel := &model.TimeEntry{}
+		if err := tx.Select("todo_id").First(entryModel, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return shared.ErrNotFound
+			}
+			return err
+		}
+
+		if err := tx.Delete(&model.TimeEntry{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		return sumTrackedTime(tx, entryModel.TodoID)
+	})
+}
+
+// sumTrackedTime sets the tracked time of a todo to the duration of its time
+// entries. The todo version is left alone, tracking time does not edit the todo.
+func sumTrackedTime(tx *gorm.DB, todoID int64) error {
+	return tx.Unscoped().
+		Model(&model.Todo{}).
+		Where("id = ?", todoID).
+		UpdateColumn("tracked_seconds", gorm.Expr(
+			"(SELECT COALESCE(SUM(duration_seconds), 0) FROM time_entries WHERE todo_id = ?)", todoID,
+		)).Error
+}
+
+// FindByID finds a time entry by ID
+func (r *timeEntryRepository) FindByID(ctx context.Context, id int64) (*entity.TimeEntry, error) {
+	entryModel := &model.TimeEntry{}
+
+	if err := conn(ctx, r.db).First(entryModel, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(entryModel)
+}
+
+// FindRunning returns the running timer of the user
+func (r *timeEntryRepository) FindRunning(ctx context.Context, userID int64) (*entity.TimeEntry, error) {
+	entryModel := &model.TimeEntry{}
+
+	if err := conn(ctx, r.db).
+		Where("user_id = ? AND ended_at IS NULL", userID).
+		First(entryModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(entryModel)
+}
+
+// FindByTodo returns the time entries of a todo, the latest first
+func (r *timeEntryRepository) FindByTodo(ctx context.Context, todoID int64) ([]*entity.TimeEntry, error) {
+	var entryModels []*model.TimeEntry
+
+	if err := conn(ctx, r.db).
+		Where("todo_id = ?", todoID).
+		Order("started_at DESC").
+		Order("id DESC").
+		Find(&entryModels).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(entryModels)
+}
+
+// FindTracked returns the finished time entries of the user started in the period,
+// with the tags and project of their todos, trashed todos included
+func (r *timeEntryRepository) FindTracked(ctx context.Context, userID int64, from, to time.Time) ([]vo.TrackedTime, error) {
+	var rows []struct {
+		TodoID      int64
+		StartedAt   time.Time
+		Duration    int64
+		ProjectID   *int64
+		ProjectName *string
+	}
+
+	if err := conn(ctx, r.db).
+		Table("time_entries").
+		Select("time_entries.todo_id, time_entries.started_at, time_entries.duration_seconds AS duration, "+
+			"todos.project_id, projects.name AS project_name").
+		Joins("JOIN todos ON todos.id = time_entries.todo_id").
+		Joins("LEFT JOIN projects ON projects.id = todos.project_id").
+		Where("time_entries.user_id = ? AND time_entries.ended_at IS NOT NULL", userID).
+		Where("time_entries.started_at >= ? AND time_entries.started_at < ?", from, to).
+		Order("time_entries.started_at ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return []vo.TrackedTime{}, nil
+	}
+
+	todoIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		todoIDs = append(todoIDs, row.TodoID)
+	}
+
+	var tagRows []struct {
+		TodoID int64
+		Name   string
+	}
+
+	if err := conn(ctx, r.db).
+		Table("todo_tags").
+		Select("todo_tags.todo_id, tags.name").
+		Joins("JOIN tags ON tags.id = todo_tags.tag_id").
+		Where("todo_tags.todo_id IN ?", todoIDs).
+		Order("tags.name ASC").
+		Scan(&tagRows).Error; err != nil {
+		return nil, err
+	}
+
+	tags := map[int64][]string{}
+	for _, tagRow := range tagRows {
+		tags[tagRow.TodoID] = append(tags[tagRow.TodoID], tagRow.Name)
+	}
+
+	tracked := make([]vo.TrackedTime, len(rows))
+	for i, row := range rows {
+		tracked[i] = vo.TrackedTime{
+			StartedAt: row.StartedAt,
+			Duration:  time.Duration(row.Duration) * time.Second,
+			Tags:      tags[row.TodoID],
+		}
+		if row.ProjectID != nil {
+			tracked[i].ProjectID = *row.ProjectID
+		}
+		if row.ProjectName != nil {
+			tracked[i].ProjectName = *row.ProjectName
+		}
+	}
+
+	return tracked, nil
+}
@@ -9,8 +9,10 @@ import (
 	"todolist/internal/domain/todo/repository"
 	"todolist/internal/infrastructure/database/mapper"
 	"todolist/internal/infrastructure/database/model"
+	"todolist/pkg/rank"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // todoRepository implements repository.TodoRepository
@@ -29,11 +31,12 @@ func NewTodoRepository(db *gorm.DB) repository.TodoRepository {
 
 // Save saves or updates a todo
 func (r *todoRepository) Save(ctx context.Context, todo *entity.Todo) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		todoModel := r.mapper.ToModel(todo)
 
-		// Create or first todo
-		if err := tx.FirstOrCreate(todoModel).Error; err != nil {
+		// Create or update the todo, tags and custom field values are associated below,
+		// moves update the position and time entries the tracked time
+		if err := saveVersioned(tx, todoModel, todoModel.ID, &todoModel.Version, "position", "tracked_seconds"); err != nil {
 			return err
 		}
 
@@ -66,13 +69,31 @@ func (r *todoRepository) Save(ctx context.Context, todo *entity.Todo) error {
 			}
 		}
 
+		// Replace the custom field values
+		if err := tx.
+			Where("todo_id = ?", todoModel.ID).
+			Delete(&model.TodoFieldValue{}).Error; err != nil {
+			return err
+		}
+
+		for _, fieldValue := range todoModel.FieldValues {
+			fieldValue.TodoID = todoModel.ID
+		}
+		if len(todoModel.FieldValues) > 0 {
+			if err := tx.Omit(clause.Associations).Create(todoModel.FieldValues).Error; err != nil {
+				return err
+			}
+		}
+
+		todo.SetID(todoModel.ID)
+		todo.SetLockVersion(todoModel.Version)
 		return nil
 	})
 }
 
 // Delete deletes a todo (soft delete)
 func (r *todoRepository) Delete(ctx context.Context, id int64) error {
-	result := r.db.WithContext(ctx).Delete(&model.Todo{}, "id = ?", id)
+	result := conn(ctx, r.db).Delete(&model.Todo{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -84,12 +105,38 @@ func (r *todoRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// DeleteVersioned deletes a todo if it still has the version
+func (r *todoRepository) DeleteVersioned(ctx context.Context, id, version int64) error {
+	db := conn(ctx, r.db)
+
+	result := db.Where("version = ?", version).Delete(&model.Todo{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	// Nothing was deleted, either the todo is gone or it was changed meanwhile
+	var count int64
+	if err := db.Model(&model.Todo{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return shared.ErrNotFound
+	}
+
+	return shared.ErrOptimisticLock
+}
+
 // FindByID finds a todo by ID
 func (r *todoRepository) FindByID(ctx context.Context, id int64) (*entity.Todo, error) {
 	var model model.Todo
 
-	if err := r.db.WithContext(ctx).
-		Preload("Tags").
+	if err := conn(ctx, r.db).
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		First(&model, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, shared.ErrNotFound
@@ -104,8 +151,8 @@ func (r *todoRepository) FindByID(ctx context.Context, id int64) (*entity.Todo,
 func (r *todoRepository) FindByUserID(ctx context.Context, userID int64) ([]*entity.Todo, error) {
 	var model []*model.Todo
 
-	if err := r.db.WithContext(ctx).
-		Preload("Tags").
+	if err := conn(ctx, r.db).
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Where("user_id = ?", userID).
 		Find(&model).Error; err != nil {
 		return nil, err
@@ -116,7 +163,225 @@ func (r *todoRepository) FindByUserID(ctx context.Context, userID int64) ([]*ent
 
 // DeleteByUserID deletes all todos for a user
 func (r *todoRepository) DeleteByUserID(ctx context.Context, userID int64) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Where("user_id = ?", userID).
 		Delete(&model.Todo{}).Error
 }
+
+// FindDeletedByID finds a todo in the trash
+func (r *todoRepository) FindDeletedByID(ctx context.Context, id int64) (*entity.Todo, error) {
+	var model model.Todo
+
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
+		Where("deleted_at IS NOT NULL").
+		First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(&model)
+}
+
+// Restore moves a todo out of the trash
+func (r *todoRepository) Restore(ctx context.Context, id int64) error {
+	result := conn(ctx, r.db).
+		Unscoped().
+		Model(&model.Todo{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindDeletedByUserID finds the todos in the trash of a user
+func (r *todoRepository) FindDeletedByUserID(ctx context.Context, userID int64) ([]*entity.Todo, error) {
+	return r.findDeleted(ctx, "user_id = ?", userID)
+}
+
+// FindDeletedBefore finds the todos moved to the trash before the given time
+func (r *todoRepository) FindDeletedBefore(ctx context.Context, before time.Time) ([]*entity.Todo, error) {
+	return r.findDeleted(ctx, "deleted_at < ?", before)
+}
+
+// findDeleted finds the todos in the trash matching the condition
+func (r *todoRepository) findDeleted(ctx context.Context, condition string, args ...any) ([]*entity.Todo, error) {
+	var model []*model.Todo
+
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
+		Where("deleted_at IS NOT NULL").
+		Where(condition, args...).
+		Find(&model).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(model)
+}
+
+// PurgeDeletedByUserID permanently deletes the todos in the trash of a user
+func (r *todoRepository) PurgeDeletedByUserID(ctx context.Context, userID int64) (int64, error) {
+	return r.purge(ctx, "user_id = ?", userID)
+}
+
+// PurgeDeletedBefore permanently deletes the todos moved to the trash before the given time
+func (r *todoRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.purge(ctx, "deleted_at < ?", before)
+}
+
+// PurgeDeletedByIDs permanently deletes the given todos still in the trash
+func (r *todoRepository) PurgeDeletedByIDs(ctx context.Context, ids []int64) (int64, error) {
+	return r.purge(ctx, "id IN ?", ids)
+}
+
+// purge permanently deletes the todos in the trash matching the condition along with their tag links
+// and custom field values
+func (r *todoRepository) purge(ctx context.Context, condition string, args ...any) (int64, error) {
+	var purged int64
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		trashed := tx.Unscoped().
+			Model(&model.Todo{}).
+			Select("id").
+			Where("deleted_at IS NOT NULL").
+			Where(condition, args...)
+
+		if err := tx.Where("todo_id IN (?)", trashed).Delete(&model.TodoTag{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("todo_id IN (?)", trashed).Delete(&model.TodoFieldValue{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().
+			Where("deleted_at IS NOT NULL").
+			Where(condition, args...).
+			Delete(&model.Todo{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		purged = result.RowsAffected
+		return nil
+	})
+
+	return purged, err
+}
+
+// LastPosition returns the last position of the manual order of a user
+func (r *todoRepository) LastPosition(ctx context.Context, userID int64) (string, error) {
+	var position string
+
+	if err := conn(ctx, r.db).
+		Model(&model.Todo{}).
+		Select("COALESCE(MAX(position), '')").
+		Where("user_id = ?", userID).
+		Scan(&position).Error; err != nil {
+		return "", err
+	}
+
+	return position, nil
+}
+
+// AdjacentPosition returns the position next to the given one in the manual order of a user
+func (r *todoRepository) AdjacentPosition(ctx context.Context, userID int64, position string, next bool) (string, error) {
+	var adjacent string
+
+	query := conn(ctx, r.db).Model(&model.Todo{}).Where("user_id = ?", userID)
+	if next {
+		query = query.Select("COALESCE(MIN(position), '')").Where("position > ?", position)
+	} else {
+		query = query.Select("COALESCE(MAX(position), '')").Where("position < ?", position)
+	}
+
+	if err := query.Scan(&adjacent).Error; err != nil {
+		return "", err
+	}
+
+	return adjacent, nil
+}
+
+// UpdatePosition moves a todo to a position, leaving its version and update time
+// alone as the order is not part of the todo itself
+func (r *todoRepository) UpdatePosition(ctx context.Context, id int64, position string) error {
+	result := conn(ctx, r.db).
+		Model(&model.Todo{}).
+		Where("id = ?", id).
+		UpdateColumn("position", position)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+
+	return nil
+}
+
+// RebalancePositions spreads the positions of the todos of a user evenly, those
+// in the trash included so they get back to their place when restored
+func (r *todoRepository) RebalancePositions(ctx context.Context, userID int64) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var todos []struct {
+			ID       int64
+			Position string
+		}
+
+		if err := tx.Unscoped().
+			Model(&model.Todo{}).
+			Select("id, position").
+			Where("user_id = ?", userID).
+			Order("position ASC").
+			Order("id ASC").
+			Scan(&todos).Error; err != nil {
+			return err
+		}
+
+		for i, position := range rank.Spread(len(todos)) {
+			if todos[i].Position == position {
+				continue
+			}
+
+			if err := tx.Unscoped().
+				Model(&model.Todo{}).
+				Where("id = ?", todos[i].ID).
+				UpdateColumn("position", position).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FindUsersToRebalance finds the users having todos never placed or placed at long positions
+func (r *todoRepository) FindUsersToRebalance(ctx context.Context, maxLength int) ([]int64, error) {
+	var userIDs []int64
+
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Model(&model.Todo{}).
+		Distinct("user_id").
+		Where("position = '' OR LENGTH(position) > ?", maxLength).
+		Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"todolist/internal/domain/shared"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+)
+
+// customSortPrefix starts the sort keys of custom fields
+const customSortPrefix = "cf."
+
+// todoCustomField is the definition of a custom field filtered or sorted by. Without
+// a user the key may name the fields of several users, which must share their type.
+type todoCustomField struct {
+	ids       []int64
+	fieldType vo.CustomFieldType
+}
+
+// todoCustomFields are the custom fields of a query by key
+type todoCustomFields map[string]todoCustomField
+
+// findTodoCustomFields loads the custom fields with the keys, of the user unless 0
+func findTodoCustomFields(db *gorm.DB, userID int64, keys []string) (todoCustomFields, error) {
+	fields := todoCustomFields{}
+	if len(keys) == 0 {
+		return fields, nil
+	}
+
+	query := db.Model(&model.CustomField{}).Where("custom_fields.key IN ?", keys)
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	var fieldModels []*model.CustomField
+	if err := query.Order("id ASC").Find(&fieldModels).Error; err != nil {
+		return nil, err
+	}
+
+	for _, fieldModel := range fieldModels {
+		fieldType, err := vo.NewCustomFieldType(fieldModel.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		field, found := fields[fieldModel.Key]
+		if found && field.fieldType != fieldType {
+			return nil, fmt.Errorf("%w: custom field %s has several types", vo.ErrInvalidFilterExpression, fieldModel.Key)
+		}
+		field.ids = append(field.ids, fieldModel.ID)
+		field.fieldType = fieldType
+		fields[fieldModel.Key] = field
+	}
+
+	return fields, nil
+}
+
+// customFilterKeys collects the keys of the custom fields compared by a filter expression
+func customFilterKeys(expression vo.TodoFilterExpression, keys []string) []string {
+	switch node := expression.(type) {
+	case vo.FilterAnd:
+		for _, operand := range node.Operands {
+			keys = customFilterKeys(operand, keys)
+		}
+	case vo.FilterOr:
+		for _, operand := range node.Operands {
+			keys = customFilterKeys(operand, keys)
+		}
+	case vo.FilterNot:
+		keys = customFilterKeys(node.Operand, keys)
+	case vo.FilterCondition:
+		if node.Field == vo.FilterFieldCustom && !slices.Contains(keys, node.Key) {
+			keys = append(keys, node.Key)
+		}
+	}
+	return keys
+}
+
+// customSortKeys collects the keys of the custom fields sorted by
+func customSortKeys(sort shared.Sort) []string {
+	var keys []string
+	for _, field := range sort {
+		if key, ok := strings.CutPrefix(field.Key, customSortPrefix); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// todoSortsWith returns the todo sorts along with the custom fields sorted by, which
+// sort by their smallest value, numerically for numbers, the todos without value last
+func todoSortsWith(fields todoCustomFields, sort shared.Sort) (*database.SortRegistry, error) {
+	columns := map[string]database.SortColumn{}
+
+	for _, key := range customSortKeys(sort) {
+		field, ok := fields[key]
+		if !ok {
+			return nil, &shared.InvalidSortError{Key: customSortPrefix + key, Allowed: vo.TodoSortKeys()}
+		}
+
+		column := "todo_field_values.value"
+		if field.fieldType == vo.CustomFieldNumber {
+			column = "todo_field_values.number_value"
+		}
+
+		// IDs are integers, so they are safe to inline
+		ids := make([]string, len(field.ids))
+		for i, id := range field.ids {
+			ids[i] = strconv.FormatInt(id, 10)
+		}
+
+		columns[customSortPrefix+key] = database.SortColumn{
+			Expression: fmt.Sprintf(
+				"(SELECT MIN(%s) FROM todo_field_values WHERE todo_field_values.todo_id = todos.id AND todo_field_values.field_id IN (%s))",
+				column, strings.Join(ids, ", "),
+			),
+			Nullable: true,
+		}
+	}
+
+	if len(columns) == 0 {
+		return todoSorts, nil
+	}
+	return todoSorts.With(columns), nil
+}
+
+// compileCustomFilterMatch compiles a condition on a custom field according to its
+// type, taking inequalities as equalities. Text and URLs contain the value, options
+// equal it case insensitively, and numbers and dates compare to it.
+func compileCustomFilterMatch(condition vo.FilterCondition, fields todoCustomFields) (string, []any, error) {
+	field, ok := fields[condition.Key]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: unknown custom field %s", vo.ErrInvalidFilterExpression, condition.Key)
+	}
+
+	if condition.Value == nil {
+		return "todos.id NOT IN (SELECT todo_field_values.todo_id FROM todo_field_values WHERE todo_field_values.field_id IN ?)",
+			[]any{field.ids}, nil
+	}
+
+	value, ok := condition.Value.(string)
+	if !ok {
+		return "", nil, fmt.Errorf("invalid value %v for custom field %s", condition.Value, condition.Key)
+	}
+
+	operator := condition.Operator
+	if operator == vo.FilterOperatorNotEqual {
+		operator = vo.FilterOperatorEqual
+	}
+	if operator != vo.FilterOperatorEqual && !field.fieldType.IsOrdered() {
+		return "", nil, fmt.Errorf("%w: operator '%s' is not supported by custom field %s of type %s",
+			vo.ErrInvalidFilterExpression, condition.Operator, condition.Key, field.fieldType)
+	}
+
+	match := func(sql string, args ...any) (string, []any, error) {
+		return "todos.id IN (SELECT todo_field_values.todo_id FROM todo_field_values WHERE todo_field_values.field_id IN ? AND " + sql + ")",
+			append([]any{field.ids}, args...), nil
+	}
+
+	switch field.fieldType {
+	case vo.CustomFieldText, vo.CustomFieldURL:
+		pattern, err := containsPattern(value)
+		if err != nil {
+			return "", nil, err
+		}
+		return match("LOWER(todo_field_values.value) LIKE ? ESCAPE '!'", pattern)
+
+	case vo.CustomFieldSelect, vo.CustomFieldMultiSelect:
+		return match("LOWER(todo_field_values.value) = ?", strings.ToLower(value))
+
+	case vo.CustomFieldCheckbox:
+		checked, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: expected true or false for custom field %s", vo.ErrInvalidFilterExpression, condition.Key)
+		}
+		sql, args, _ := match("todo_field_values.value = ?", "true")
+		if !checked {
+			return "NOT (" + sql + ")", args, nil
+		}
+		return sql, args, nil
+
+	case vo.CustomFieldNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: expected a number for custom field %s", vo.ErrInvalidFilterExpression, condition.Key)
+		}
+		return match(fmt.Sprintf("todo_field_values.number_value %s ?", operator), number)
+
+	case vo.CustomFieldDate:
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return "", nil, fmt.Errorf("%w: expected a date as YYYY-MM-DD for custom field %s", vo.ErrInvalidFilterExpression, condition.Key)
+		}
+		return match(fmt.Sprintf("todo_field_values.value %s ?", operator), value)
+
+	default:
+		return "", nil, fmt.Errorf("unsupported custom field type %s", field.fieldType)
+	}
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"todolist/internal/domain/shared"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+)
+
+// testCustomFields are custom fields of every type, some defined by several users
+var testCustomFields = todoCustomFields{
+	"notes":    {ids: []int64{1}, fieldType: vo.CustomFieldText},
+	"link":     {ids: []int64{2}, fieldType: vo.CustomFieldURL},
+	"team":     {ids: []int64{3, 13}, fieldType: vo.CustomFieldSelect},
+	"labels":   {ids: []int64{4}, fieldType: vo.CustomFieldMultiSelect},
+	"billable": {ids: []int64{5}, fieldType: vo.CustomFieldCheckbox},
+	"points":   {ids: []int64{6, 16}, fieldType: vo.CustomFieldNumber},
+	"deadline": {ids: []int64{7}, fieldType: vo.CustomFieldDate},
+}
+
+func TestCompileCustomFilterMatch(t *testing.T) {
+	const (
+		matching = "todos.id IN (SELECT todo_field_values.todo_id FROM todo_field_values WHERE todo_field_values.field_id IN ? AND "
+		without  = "todos.id NOT IN (SELECT todo_field_values.todo_id FROM todo_field_values WHERE todo_field_values.field_id IN ?)"
+	)
+
+	condition := func(key string, operator vo.FilterOperator, value any) vo.FilterCondition {
+		return vo.FilterCondition{Field: vo.FilterFieldCustom, Key: key, Operator: operator, Value: value}
+	}
+
+	tests := []struct {
+		name      string
+		condition vo.FilterCondition
+		wantSQL   string
+		wantArgs  []any
+		wantErr   error
+	}{
+		{
+			name:      "text contains the value",
+			condition: condition("notes", vo.FilterOperatorEqual, "50%_Off"),
+			wantSQL:   matching + "LOWER(todo_field_values.value) LIKE ? ESCAPE '!')",
+			wantArgs:  []any{[]int64{1}, "%50!%!_off%"},
+		},
+		{
+			name:      "url contains the value",
+			condition: condition("link", vo.FilterOperatorEqual, "Example.com"),
+			wantSQL:   matching + "LOWER(todo_field_values.value) LIKE ? ESCAPE '!')",
+			wantArgs:  []any{[]int64{2}, "%example.com%"},
+		},
+		{
+			name:      "select of several users",
+			condition: condition("team", vo.FilterOperatorEqual, "Backend"),
+			wantSQL:   matching + "LOWER(todo_field_values.value) = ?)",
+			wantArgs:  []any{[]int64{3, 13}, "backend"},
+		},
+		{
+			name:      "multi select",
+			condition: condition("labels", vo.FilterOperatorEqual, "Bug"),
+			wantSQL:   matching + "LOWER(todo_field_values.value) = ?)",
+			wantArgs:  []any{[]int64{4}, "bug"},
+		},
+		{
+			name:      "checked checkbox",
+			condition: condition("billable", vo.FilterOperatorEqual, "true"),
+			wantSQL:   matching + "todo_field_values.value = ?)",
+			wantArgs:  []any{[]int64{5}, "true"},
+		},
+		{
+			name:      "unchecked checkbox",
+			condition: condition("billable", vo.FilterOperatorEqual, "false"),
+			wantSQL:   "NOT (" + matching + "todo_field_values.value = ?))",
+			wantArgs:  []any{[]int64{5}, "true"},
+		},
+		{
+			name:      "number",
+			condition: condition("points", vo.FilterOperatorGreaterOrEqual, "2.5"),
+			wantSQL:   matching + "todo_field_values.number_value >= ?)",
+			wantArgs:  []any{[]int64{6, 16}, 2.5},
+		},
+		{
+			name:      "date",
+			condition: condition("deadline", vo.FilterOperatorLess, "2024-06-01"),
+			wantSQL:   matching + "todo_field_values.value < ?)",
+			wantArgs:  []any{[]int64{7}, "2024-06-01"},
+		},
+		{
+			name:      "inequality matched as equality",
+			condition: condition("points", vo.FilterOperatorNotEqual, "3"),
+			wantSQL:   matching + "todo_field_values.number_value = ?)",
+			wantArgs:  []any{[]int64{6, 16}, 3.0},
+		},
+		{
+			name:      "none",
+			condition: condition("team", vo.FilterOperatorEqual, nil),
+			wantSQL:   without,
+			wantArgs:  []any{[]int64{3, 13}},
+		},
+		{
+			name:      "unknown key",
+			condition: condition("unknown", vo.FilterOperatorEqual, "x"),
+			wantErr:   vo.ErrInvalidFilterExpression,
+		},
+		{
+			name:      "order of an unordered type",
+			condition: condition("team", vo.FilterOperatorGreater, "backend"),
+			wantErr:   vo.ErrInvalidFilterExpression,
+		},
+		{
+			name:      "checkbox not a boolean",
+			condition: condition("billable", vo.FilterOperatorEqual, "maybe"),
+			wantErr:   vo.ErrInvalidFilterExpression,
+		},
+		{
+			name:      "number not a number",
+			condition: condition("points", vo.FilterOperatorEqual, "many"),
+			wantErr:   vo.ErrInvalidFilterExpression,
+		},
+		{
+			name:      "date not a date",
+			condition: condition("deadline", vo.FilterOperatorEqual, "01/06/2024"),
+			wantErr:   vo.ErrInvalidFilterExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := compileCustomFilterMatch(tt.condition, testCustomFields)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("compileCustomFilterMatch() error = %v, want %v", err, tt.wantErr)
+			}
+			if sql != tt.wantSQL {
+				t.Errorf("Expected SQL\n%s\ngot\n%s", tt.wantSQL, sql)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Expected args %v, got %v", tt.wantArgs, args)
+			}
+		})
+	}
+
+	t.Run("inequality negates the match", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			condition vo.FilterCondition
+			wantSQL   string
+		}{
+			{
+				name:      "!= text",
+				condition: condition("notes", vo.FilterOperatorNotEqual, "draft"),
+				wantSQL:   "NOT (" + matching + "LOWER(todo_field_values.value) LIKE ? ESCAPE '!'))",
+			},
+			{
+				name:      "!= none",
+				condition: condition("team", vo.FilterOperatorNotEqual, nil),
+				wantSQL:   "NOT (" + without + ")",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				sql, _, err := compileTodoFilterCondition(tt.condition, testCustomFields)
+				if err != nil {
+					t.Fatalf("compileTodoFilterCondition() error = %v", err)
+				}
+				if sql != tt.wantSQL {
+					t.Errorf("Expected SQL\n%s\ngot\n%s", tt.wantSQL, sql)
+				}
+			})
+		}
+	})
+}
+
+func TestTodoSortsWith(t *testing.T) {
+	db := newTestDB(t).Session(&gorm.Session{DryRun: true})
+
+	// orderOf returns the ORDER BY clause of a query sorted with the registry
+	orderOf := func(t *testing.T, sort shared.Sort) (string, error) {
+		t.Helper()
+
+		sorts, err := todoSortsWith(testCustomFields, sort)
+		if err != nil {
+			return "", err
+		}
+		query, err := sorts.Apply(db.Model(&model.Todo{}), sort)
+		if err != nil {
+			return "", err
+		}
+
+		sql := query.Find(&[]*model.Todo{}).Statement.SQL.String()
+		_, order, _ := strings.Cut(sql, "ORDER BY ")
+		return order, nil
+	}
+
+	tests := []struct {
+		name      string
+		sort      shared.Sort
+		wantOrder string
+	}{
+		{
+			name:      "text field",
+			sort:      shared.Sort{{Key: "cf.notes"}},
+			wantOrder: "(SELECT MIN(todo_field_values.value) FROM todo_field_values WHERE todo_field_values.todo_id = todos.id AND todo_field_values.field_id IN (1)) IS NULL,(SELECT MIN(todo_field_values.value) FROM todo_field_values WHERE todo_field_values.todo_id = todos.id AND todo_field_values.field_id IN (1)) ASC,todos.id ASC",
+		},
+		{
+			name:      "number field of several users",
+			sort:      shared.Sort{{Key: "cf.points", Desc: true}},
+			wantOrder: "(SELECT MIN(todo_field_values.number_value) FROM todo_field_values WHERE todo_field_values.todo_id = todos.id AND todo_field_values.field_id IN (6, 16)) IS NULL,(SELECT MIN(todo_field_values.number_value) FROM todo_field_values WHERE todo_field_values.todo_id = todos.id AND todo_field_values.field_id IN (6, 16)) DESC,todos.id DESC",
+		},
+		{
+			name:      "date field",
+			sort:      shared.Sort{{Key: "cf.deadline"}},
+			wantOrder: "(SELECT MIN(todo_field_values.value) FROM todo_field_values WHERE todo_field_values.todo_id = todos.id AND todo_field_values.field_id IN (7)) IS NULL,(SELECT MIN(todo_field_values.value) FROM todo_field_values WHERE todo_field_values.todo_id = todos.id AND todo_field_values.field_id IN (7)) ASC,todos.id ASC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, err := orderOf(t, tt.sort)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if order != tt.wantOrder {
+				t.Errorf("Expected ORDER BY\n%s\ngot\n%s", tt.wantOrder, order)
+			}
+		})
+	}
+
+	t.Run("without custom fields", func(t *testing.T) {
+		sorts, err := todoSortsWith(testCustomFields, shared.Sort{{Key: "id"}})
+		if err != nil {
+			t.Fatalf("todoSortsWith() error = %v", err)
+		}
+		if sorts != todoSorts {
+			t.Error("Expected the todo sorts to be returned as is")
+		}
+	})
+
+	t.Run("unknown custom field", func(t *testing.T) {
+		_, err := todoSortsWith(testCustomFields, shared.Sort{{Key: "cf.unknown"}})
+
+		var sortErr *shared.InvalidSortError
+		if !errors.As(err, &sortErr) || sortErr.Key != "cf.unknown" {
+			t.Errorf("Expected an invalid sort error for cf.unknown, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	vo "todolist/internal/domain/todo/valueobject"
+)
+
+// todoFilterColumns are the columns compared by the fields of filter expressions
+var todoFilterColumns = map[vo.FilterField]string{
+	vo.FilterFieldStatus:   "todos.status",
+	vo.FilterFieldPriority: "todos.priority",
+	vo.FilterFieldDue:      "todos.due_date",
+	vo.FilterFieldCreated:  "todos.created_at",
+	vo.FilterFieldUpdated:  "todos.updated_at",
+	vo.FilterFieldTitle:    "todos.title",
+}
+
+// todoTagSubquery selects the IDs of the todos with a tag
+const todoTagSubquery = "SELECT todo_tags.todo_id FROM todo_tags JOIN tags ON tags.id = todo_tags.tag_id WHERE tags.name = ?"
+
+// likeEscaper escapes the wildcards of LIKE patterns, with ! as the escape character
+// as backslashes are not portable across databases
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// compileTodoFilter compiles a filter expression into a SQL condition and its
+// arguments, with the definitions of the custom fields it compares. Conditions
+// never evaluate to NULL, so negations match the todos without due date.
+func compileTodoFilter(expression vo.TodoFilterExpression, fields todoCustomFields) (string, []any, error) {
+	switch node := expression.(type) {
+	case vo.FilterAnd:
+		return compileTodoFilterOperands(node.Operands, " AND ", fields)
+	case vo.FilterOr:
+		return compileTodoFilterOperands(node.Operands, " OR ", fields)
+	case vo.FilterNot:
+		sql, args, err := compileTodoFilter(node.Operand, fields)
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT (" + sql + ")", args, nil
+	case vo.FilterCondition:
+		return compileTodoFilterCondition(node, fields)
+	default:
+		return "", nil, fmt.Errorf("unsupported filter expression %T", expression)
+	}
+}
+
+// compileTodoFilterOperands compiles the operands of AND and OR
+func compileTodoFilterOperands(operands []vo.TodoFilterExpression, separator string, fields todoCustomFields) (string, []any, error) {
+	conditions := make([]string, len(operands))
+	args := []any{}
+
+	for i, operand := range operands {
+		sql, operandArgs, err := compileTodoFilter(operand, fields)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions[i] = sql
+		args = append(args, operandArgs...)
+	}
+
+	return "(" + strings.Join(conditions, separator) + ")", args, nil
+}
+
+// compileTodoFilterCondition compiles a condition comparing a field to a value
+func compileTodoFilterCondition(condition vo.FilterCondition, fields todoCustomFields) (string, []any, error) {
+	sql, args, err := compileTodoFilterMatch(condition, fields)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Inequalities are negated matches, so they hold for NULL columns
+	if condition.Operator == vo.FilterOperatorNotEqual {
+		return "NOT (" + sql + ")", args, nil
+	}
+	return sql, args, nil
+}
+
+// compileTodoFilterMatch compiles a condition, taking inequalities as equalities
+func compileTodoFilterMatch(condition vo.FilterCondition, fields todoCustomFields) (string, []any, error) {
+	column := todoFilterColumns[condition.Field]
+
+	switch condition.Field {
+	case vo.FilterFieldStatus:
+		status, ok := condition.Value.(vo.TodoStatus)
+		if !ok {
+			return "", nil, fmt.Errorf("invalid value %v for filter field %s", condition.Value, condition.Field)
+		}
+		return column + " = ?", []any{status.String()}, nil
+
+	case vo.FilterFieldPriority:
+		priority, ok := condition.Value.(sharedvo.Priority)
+		if !ok {
+			return "", nil, fmt.Errorf("invalid value %v for filter field %s", condition.Value, condition.Field)
+		}
+		operator := condition.Operator
+		if operator == vo.FilterOperatorNotEqual {
+			operator = vo.FilterOperatorEqual
+		}
+		return fmt.Sprintf("%s %s ?", column, operator), []any{int(priority)}, nil
+
+	case vo.FilterFieldTag:
+		return "todos.id IN (" + todoTagSubquery + ")", []any{condition.Value}, nil
+
+	case vo.FilterFieldTitle:
+		pattern, err := containsPattern(condition.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		return "LOWER(todos.title) LIKE ? ESCAPE '!'", []any{pattern}, nil
+
+	case vo.FilterFieldText:
+		pattern, err := containsPattern(condition.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		return "(LOWER(todos.title) LIKE ? ESCAPE '!' OR LOWER(COALESCE(todos.description, '')) LIKE ? ESCAPE '!')",
+			[]any{pattern, pattern}, nil
+
+	case vo.FilterFieldDue, vo.FilterFieldCreated, vo.FilterFieldUpdated:
+		if condition.Value == nil {
+			return column + " IS NULL", nil, nil
+		}
+		day, ok := condition.Value.(time.Time)
+		if !ok {
+			return "", nil, fmt.Errorf("invalid value %v for filter field %s", condition.Value, condition.Field)
+		}
+		nextDay := day.AddDate(0, 0, 1)
+
+		// Dates are whole days
+		switch condition.Operator {
+		case vo.FilterOperatorLess:
+			return fmt.Sprintf("(%[1]s IS NOT NULL AND %[1]s < ?)", column), []any{day}, nil
+		case vo.FilterOperatorLessOrEqual:
+			return fmt.Sprintf("(%[1]s IS NOT NULL AND %[1]s < ?)", column), []any{nextDay}, nil
+		case vo.FilterOperatorGreater:
+			return fmt.Sprintf("(%[1]s IS NOT NULL AND %[1]s >= ?)", column), []any{nextDay}, nil
+		case vo.FilterOperatorGreaterOrEqual:
+			return fmt.Sprintf("(%[1]s IS NOT NULL AND %[1]s >= ?)", column), []any{day}, nil
+		default:
+			return fmt.Sprintf("(%[1]s IS NOT NULL AND %[1]s >= ? AND %[1]s < ?)", column), []any{day, nextDay}, nil
+		}
+
+	case vo.FilterFieldCustom:
+		return compileCustomFilterMatch(condition, fields)
+
+	default:
+		return "", nil, fmt.Errorf("unsupported filter field %s", condition.Field)
+	}
+}
+
+// containsPattern builds the LIKE pattern matching the lowercase text containing the value
+func containsPattern(value any) (string, error) {
+	text, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid text filter value %v", value)
+	}
+	return "%" + likeEscaper.Replace(strings.ToLower(text)) + "%", nil
+}
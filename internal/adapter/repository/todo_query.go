@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"time"
 	"todolist/internal/domain/shared"
 	sharedvo "todolist/internal/domain/shared/valueobject"
@@ -11,14 +13,27 @@ import (
 	"todolist/internal/infrastructure/database"
 	"todolist/internal/infrastructure/database/mapper"
 	"todolist/internal/infrastructure/database/model"
+	"todolist/pkg/trigram"
 
 	"gorm.io/gorm"
 )
 
+// todoSorts maps the keys todos can be sorted by to their columns
+var todoSorts = database.NewSortRegistry("todos.id", map[string]database.SortColumn{
+	"created_at": {Expression: "todos.created_at"},
+	"updated_at": {Expression: "todos.updated_at"},
+	"due_date":   {Expression: "todos.due_date", Nullable: true},
+	"priority":   {Expression: "todos.priority"},
+	"title":      {Expression: "todos.title"},
+	"status":     {Expression: "todos.status"},
+	"position":   {Expression: "todos.position"},
+})
+
 // todoQueryRepository implements repository.TodoQueryRepository
 type todoQueryRepository struct {
 	db     *gorm.DB
 	mapper *mapper.TodoMapper
+	fuzzy  *fuzzyMatchCache
 }
 
 // NewTodoQueryRepository creates a new todo query repository
@@ -26,6 +41,7 @@ func NewTodoQueryRepository(db *gorm.DB) repository.TodoQueryRepository {
 	return &todoQueryRepository{
 		db:     db,
 		mapper: mapper.NewTodoMapper(),
+		fuzzy:  newFuzzyMatchCache(),
 	}
 }
 
@@ -33,8 +49,11 @@ func NewTodoQueryRepository(db *gorm.DB) repository.TodoQueryRepository {
 func (r *todoQueryRepository) FindAll(ctx context.Context, options shared.QueryOptions) ([]*entity.Todo, error) {
 	users := []*model.Todo{}
 
-	query := r.db.WithContext(ctx).Model(&model.Todo{}).Preload("Tags")
-	query = database.ApplyQueryOptions(query, options)
+	query := r.db.WithContext(ctx).Model(&model.Todo{}).Preload("Tags").Preload("Project").Preload("FieldValues.Field")
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -43,7 +62,7 @@ func (r *todoQueryRepository) FindAll(ctx context.Context, options shared.QueryO
 	return r.mapper.ToDomainList(users)
 }
 
-// FindByFilters finds todos by multiple filters
+// FindByFilters finds todos by multiple filters, paginated by offset or from the cursor of the options
 func (r *todoQueryRepository) FindByFilters(
 	ctx context.Context,
 	filters vo.TodoFilterCriteria,
@@ -51,15 +70,157 @@ func (r *todoQueryRepository) FindByFilters(
 ) ([]*entity.Todo, error) {
 	users := []*model.Todo{}
 
-	query := r.db.WithContext(ctx).Model(&model.Todo{}).Preload("Tags")
+	fields, err := findTodoCustomFields(r.db.WithContext(ctx), filters.UserID, customSortKeys(options.Sort))
+	if err != nil {
+		return nil, err
+	}
+	sorts, err := todoSortsWith(fields, options.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := r.prepareSearch(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.filteredQuery(ctx, filters, search).Preload("Tags").Preload("Project").Preload("FieldValues.Field")
+	query, err = sorts.ApplyKeysetOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := query.Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	if options.Cursor != nil && options.Cursor.Backward {
+		slices.Reverse(users)
+	}
+
+	return r.mapper.ToDomainList(users)
+}
+
+// CountByFilters counts the todos matching the filters
+func (r *todoQueryRepository) CountByFilters(ctx context.Context, filters vo.TodoFilterCriteria) (int64, error) {
+	var count int64
+
+	search, err := r.prepareSearch(ctx, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := r.filteredQuery(ctx, filters, search).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// FacetsByFilters counts the todos matching the filters by status, priority, due
+// date bucket and tag, listing at most tagLimit tags, the most used first
+func (r *todoQueryRepository) FacetsByFilters(
+	ctx context.Context,
+	filters vo.TodoFilterCriteria,
+	tagLimit int,
+) (*vo.TodoFacets, error) {
+	// Due dates are bucketed by the days of the server time zone
+	now := time.Now()
+	today := sharedvo.StartOfDay(now)
+	tomorrow := today.AddDate(0, 0, 1)
+	weekEnd := today.AddDate(0, 0, 7)
+
+	// The search is prepared once for both queries
+	search, err := r.prepareSearch(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single grouped query gives the status, priority and due date counts
+	var groups []struct {
+		Status    string
+		Priority  int
+		DueBucket *string
+		Count     int64
+	}
+
+	if err := r.filteredQuery(ctx, filters, search).
+		Select(`todos.status AS status, todos.priority AS priority,
+			CASE
+				WHEN todos.due_date IS NULL THEN ?
+				WHEN todos.due_date < ? AND todos.status IN ? THEN ?
+				WHEN todos.due_date < ? THEN NULL
+				WHEN todos.due_date < ? THEN ?
+				WHEN todos.due_date < ? THEN ?
+				ELSE ?
+			END AS due_bucket,
+			COUNT(*) AS count`,
+			vo.DueBucketNoDueDate,
+			now, []string{"pending", "in_progress"}, vo.DueBucketOverdue,
+			today,
+			tomorrow, vo.DueBucketToday,
+			weekEnd, vo.DueBucketThisWeek,
+			vo.DueBucketLater,
+		).
+		Group("status, priority, due_bucket").
+		Scan(&groups).Error; err != nil {
+		return nil, err
+	}
+
+	facets := &vo.TodoFacets{
+		ByStatus:   map[string]int64{},
+		ByPriority: map[string]int64{},
+		ByTag:      []vo.TagCount{},
+		ByDueDate:  map[string]int64{},
+	}
+
+	for _, group := range groups {
+		facets.Total += group.Count
+		facets.ByStatus[group.Status] += group.Count
+		facets.ByPriority[sharedvo.Priority(group.Priority).String()] += group.Count
+		if group.DueBucket != nil {
+			facets.ByDueDate[*group.DueBucket] += group.Count
+		}
+	}
+
+	if facets.Total == 0 || tagLimit <= 0 {
+		return facets, nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Table("todo_tags").
+		Select("tags.name AS tag, COUNT(*) AS count").
+		Joins("JOIN tags ON tags.id = todo_tags.tag_id").
+		Where("todo_tags.todo_id IN (?)", r.filteredQuery(ctx, filters, search).Select("todos.id")).
+		Group("tags.name").
+		Order("count DESC, tags.name ASC").
+		Limit(tagLimit).
+		Scan(&facets.ByTag).Error; err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// prepareSearch prepares the search of the term of the filters, nil without term
+func (r *todoQueryRepository) prepareSearch(ctx context.Context, filters vo.TodoFilterCriteria) (*todoSearch, error) {
+	if !filters.HasSearchTerm() {
+		return nil, nil
+	}
+	return newTodoSearch(ctx, r.db, filters, r.fuzzy)
+}
+
+// filteredQuery builds the query for the todos matching the filters, searching
+// with the search prepared for the term of the filters
+func (r *todoQueryRepository) filteredQuery(ctx context.Context, filters vo.TodoFilterCriteria, search *todoSearch) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&model.Todo{})
 
-	// Apply filters
 	if filters.UserID != 0 {
-		query = query.Where("user_id = ?", filters.UserID)
+		query = query.Where("todos.user_id = ?", filters.UserID)
 	}
 
 	if len(filters.Status) > 0 {
-		query = query.Where("status IN ?", filters.Status)
+		query = query.Where("todos.status IN ?", filters.Status)
 	}
 
 	if len(filters.Priority) > 0 {
@@ -69,49 +230,55 @@ func (r *todoQueryRepository) FindByFilters(
 				priorities[i] = int(priority)
 			}
 		}
-		query = query.Where("priority IN ?", priorities)
+		query = query.Where("todos.priority IN ?", priorities)
 	}
 
 	if filters.IsOverdue != nil && *filters.IsOverdue {
 		query = query.Where(
-			"due_date < ? AND status IN ?",
+			"todos.due_date < ? AND todos.status IN ?",
 			time.Now(),
 			[]string{"pending", "in_progress"},
 		)
 	}
 
 	if filters.DueDateFrom != nil {
-		query = query.Where("due_date >= ?", *filters.DueDateFrom)
+		query = query.Where("todos.due_date >= ?", *filters.DueDateFrom)
 	}
 
 	if filters.DueDateTo != nil {
-		query = query.Where("due_date <= ?", *filters.DueDateTo)
+		query = query.Where("todos.due_date <= ?", *filters.DueDateTo)
 	}
 
-	if filters.SearchTerm != "" {
-		query = database.BuildSearchQuery(
-			query,
-			filters.SearchTerm,
-			"title",
-			"description",
-		)
+	if search != nil {
+		sql, args := search.condition()
+		query = query.Where(sql, args...)
 	}
 
-	// Handle tag filters
+	// Todos with any of the tags
 	if len(filters.Tags) > 0 {
-		query = query.Joins("JOIN todo_tags ON todo_tags.todo_id = todos.id").
+		query = query.Where("todos.id IN (?)", r.db.
+			Table("todo_tags").
+			Select("todo_tags.todo_id").
 			Joins("JOIN tags ON tags.id = todo_tags.tag_id").
-			Where("tags.name IN ?", filters.Tags).
-			Group("todos.id")
+			Where("tags.name IN ?", filters.Tags))
 	}
 
-	query = database.ApplyQueryOptions(query, options)
+	if filters.Expression != nil {
+		fields, err := findTodoCustomFields(r.db.WithContext(ctx), filters.UserID, customFilterKeys(filters.Expression, nil))
+		if err != nil {
+			_ = query.AddError(err)
+			return query
+		}
 
-	if err := query.Find(&users).Error; err != nil {
-		return nil, err
+		sql, args, err := compileTodoFilter(filters.Expression, fields)
+		if err != nil {
+			_ = query.AddError(err)
+			return query
+		}
+		query = query.Where(sql, args...)
 	}
 
-	return r.mapper.ToDomainList(users)
+	return query
 }
 
 // FindByUserAndStatus finds todos by user and status
@@ -125,9 +292,12 @@ func (r *todoQueryRepository) FindByUserAndStatus(
 
 	query := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Where("user_id = ? AND status = ?", userID, string(status))
-	query = database.ApplyQueryOptions(query, options)
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -136,6 +306,53 @@ func (r *todoQueryRepository) FindByUserAndStatus(
 	return r.mapper.ToDomainList(users)
 }
 
+// FindByUsersInColumn finds the todos of the users in a board column
+func (r *todoQueryRepository) FindByUsersInColumn(
+	ctx context.Context,
+	userIDs []int64,
+	column vo.TodoColumnFilter,
+	options shared.QueryOptions,
+) ([]*entity.Todo, error) {
+	todos := []*model.Todo{}
+
+	// Empty lists are left out, as IN (NULL) matches no todo and NOT IN (NULL) neither
+	var inColumn *gorm.DB
+	if len(column.States) > 0 {
+		inColumn = r.db.Where("state IN ?", column.States)
+	}
+	if len(column.Statuses) > 0 {
+		inStatuses := r.db.Where("status IN ?", column.Statuses)
+		if len(column.ExcludedStates) > 0 {
+			inStatuses = inStatuses.Where("state NOT IN ?", column.ExcludedStates)
+		}
+
+		if inColumn == nil {
+			inColumn = inStatuses
+		} else {
+			inColumn = inColumn.Or(inStatuses)
+		}
+	}
+	if inColumn == nil {
+		return []*entity.Todo{}, nil
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&model.Todo{}).
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
+		Where("user_id IN ?", userIDs).
+		Where(inColumn)
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := query.Find(&todos).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(todos)
+}
+
 // FindByUserAndPriority finds todos by user and priority
 func (r *todoQueryRepository) FindByUserAndPriority(
 	ctx context.Context,
@@ -147,9 +364,12 @@ func (r *todoQueryRepository) FindByUserAndPriority(
 
 	query := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Where("user_id = ? AND priority = ?", userID, int(priority))
-	query = database.ApplyQueryOptions(query, options)
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -168,10 +388,13 @@ func (r *todoQueryRepository) FindOverdue(
 
 	query := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Where("user_id = ? AND due_date < ? AND status IN ?",
 			userID, time.Now(), []string{"pending", "in_progress"})
-	query = database.ApplyQueryOptions(query, options)
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -189,7 +412,7 @@ func (r *todoQueryRepository) FindDueToday(ctx context.Context, userID int64) ([
 
 	if err := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Where("user_id = ? AND due_date >= ? AND due_date < ?", userID, today, tomorrow).
 		Find(&users).Error; err != nil {
 		return nil, err
@@ -209,9 +432,12 @@ func (r *todoQueryRepository) FindDueBetween(
 
 	query := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Where("user_id = ? AND due_date >= ? AND due_date <= ?", userID, start, end)
-	query = database.ApplyQueryOptions(query, options)
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -231,11 +457,14 @@ func (r *todoQueryRepository) FindByTag(
 
 	query := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Joins("JOIN todo_tags ON todo_tags.todo_id = todos.id").
 		Joins("JOIN tags ON tags.id = todo_tags.tag_id").
 		Where("todos.user_id = ? AND tags.name = ?", userID, tag)
-	query = database.ApplyQueryOptions(query, options)
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -255,14 +484,17 @@ func (r *todoQueryRepository) FindByTags(
 
 	query := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
+		Preload("Tags").Preload("Project").Preload("FieldValues.Field").
 		Joins("JOIN todo_tags ON todo_tags.todo_id = todos.id").
 		Joins("JOIN tags ON tags.id = todo_tags.tag_id").
 		Where("todos.user_id = ? AND tags.name IN ?", userID, tags).
 		Group("todos.id").
 		Having("COUNT(DISTINCT tags.name) = ?", len(tags))
 
-	query = database.ApplyQueryOptions(query, options)
+	query, err := todoSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -271,27 +503,213 @@ func (r *todoQueryRepository) FindByTags(
 	return r.mapper.ToDomainList(users)
 }
 
-// Search searches todos by title or description
+// Search finds the todos matching the filters by full-text search of their title
+// and description, or by the similarity of their title for misspelled searches,
+// the most relevant first, with their highlights. The sort order of the options
+// is ignored.
 func (r *todoQueryRepository) Search(
 	ctx context.Context,
-	userID int64,
-	searchQuery string,
+	filters vo.TodoFilterCriteria,
 	options shared.QueryOptions,
-) ([]*entity.Todo, error) {
-	users := []*model.Todo{}
+) ([]*entity.TodoSearchResult, error) {
+	if !filters.HasSearchTerm() {
+		return []*entity.TodoSearchResult{}, nil
+	}
+
+	search, err := r.prepareSearch(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	columns, args := search.columns()
+
+	hits := []todoSearchHit{}
+
+	query := r.filteredQuery(ctx, filters, search).
+		Select("todos.id AS id, "+columns, args...).
+		Order("search_rank DESC").
+		Order("todos.id DESC")
+	query = database.ApplyQueryOptions(query, options)
+
+	if err := query.Scan(&hits).Error; err != nil {
+		return nil, err
+	}
+
+	if len(hits) == 0 {
+		return []*entity.TodoSearchResult{}, nil
+	}
+
+	ids := make([]int64, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+
+	todosByID, err := r.findByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*entity.TodoSearchResult, 0, len(hits))
+	for _, hit := range hits {
+		todoModel, ok := todosByID[hit.ID]
+		if !ok {
+			continue
+		}
+
+		todo, err := r.mapper.ToDomain(todoModel)
+		if err != nil {
+			return nil, err
+		}
+
+		titleHighlight, descriptionHighlight := search.highlight(hit, todoModel.Title, todoModel.Description)
+
+		results = append(results, &entity.TodoSearchResult{
+			Todo:                 todo,
+			Rank:                 hit.SearchRank,
+			TitleHighlight:       titleHighlight,
+			DescriptionHighlight: descriptionHighlight,
+		})
+	}
+
+	return results, nil
+}
+
+// FindSimilarOpen finds the pending and in progress todos of a user whose title
+// is similar to the title, at least by minSimilarity, the most similar first.
+//
+// PostgreSQL measures the similarity with pg_trgm, using the trigram index of the
+// titles, so todos less similar than pg_trgm.similarity_threshold (0.3 by
+// default) are never found. Other databases compare the titles in process.
+func (r *todoQueryRepository) FindSimilarOpen(
+	ctx context.Context,
+	userID int64,
+	title string,
+	minSimilarity float64,
+	limit int,
+) ([]*entity.SimilarTodo, error) {
+	var matches []todoSimilarity
 
 	query := r.db.WithContext(ctx).
 		Model(&model.Todo{}).
-		Preload("Tags").
-		Where("user_id = ?", userID)
-	query = database.BuildSearchQuery(query, searchQuery, "title", "description")
+		Where("todos.user_id = ? AND todos.status IN ?", userID, []string{"pending", "in_progress"})
+
+	if r.db.Dialector.Name() == "postgres" {
+		if err := query.
+			Select("todos.id AS id, similarity(todos.title, ?) AS similarity", title).
+			Where("todos.title % ? AND similarity(todos.title, ?) >= ?", title, title, minSimilarity).
+			Order("similarity DESC").
+			Order("todos.id DESC").
+			Limit(limit).
+			Scan(&matches).Error; err != nil {
+			return nil, err
+		}
+	} else {
+		var titles []struct {
+			ID    int64
+			Title string
+		}
+		if err := query.Select("todos.id AS id, todos.title AS title").Scan(&titles).Error; err != nil {
+			return nil, err
+		}
+
+		for _, todo := range titles {
+			if similarity := trigram.Similarity(title, todo.Title); similarity >= minSimilarity {
+				matches = append(matches, todoSimilarity{ID: todo.ID, Similarity: similarity})
+			}
+		}
+
+		slices.SortFunc(matches, func(a, b todoSimilarity) int {
+			if a.Similarity != b.Similarity {
+				return cmp.Compare(b.Similarity, a.Similarity)
+			}
+			return cmp.Compare(b.ID, a.ID)
+		})
+		if len(matches) > limit {
+			matches = matches[:limit]
+		}
+	}
+
+	if len(matches) == 0 {
+		return []*entity.SimilarTodo{}, nil
+	}
+
+	ids := make([]int64, len(matches))
+	for i, match := range matches {
+		ids[i] = match.ID
+	}
+
+	todosByID, err := r.findByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	similar := make([]*entity.SimilarTodo, 0, len(matches))
+	for _, match := range matches {
+		todoModel, ok := todosByID[match.ID]
+		if !ok {
+			continue
+		}
+
+		todo, err := r.mapper.ToDomain(todoModel)
+		if err != nil {
+			return nil, err
+		}
+
+		similar = append(similar, &entity.SimilarTodo{Todo: todo, Similarity: match.Similarity})
+	}
+
+	return similar, nil
+}
+
+// findByIDs finds the todos with the IDs, with their tags, by ID
+func (r *todoQueryRepository) findByIDs(ctx context.Context, ids []int64) (map[int64]*model.Todo, error) {
+	todos := []*model.Todo{}
+	if err := r.db.WithContext(ctx).Preload("Tags").Preload("Project").Preload("FieldValues.Field").Where("id IN ?", ids).Find(&todos).Error; err != nil {
+		return nil, err
+	}
+
+	todosByID := make(map[int64]*model.Todo, len(todos))
+	for _, todo := range todos {
+		todosByID[todo.ID] = todo
+	}
+
+	return todosByID, nil
+}
+
+// FindDeleted finds the todos in the trash of a user, most recently deleted first
+func (r *todoQueryRepository) FindDeleted(
+	ctx context.Context,
+	userID int64,
+	options shared.QueryOptions,
+) ([]*entity.Todo, error) {
+	todos := []*model.Todo{}
+
+	query := r.deletedQuery(ctx, userID).Preload("Tags").Preload("Project").Preload("FieldValues.Field").Order("deleted_at DESC")
 	query = database.ApplyQueryOptions(query, options)
 
-	if err := query.Find(&users).Error; err != nil {
+	if err := query.Find(&todos).Error; err != nil {
 		return nil, err
 	}
 
-	return r.mapper.ToDomainList(users)
+	return r.mapper.ToDomainList(todos)
+}
+
+// CountDeleted counts the todos in the trash of a user
+func (r *todoQueryRepository) CountDeleted(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+
+	if err := r.deletedQuery(ctx, userID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// deletedQuery builds the query for the todos in the trash of a user
+func (r *todoQueryRepository) deletedQuery(ctx context.Context, userID int64) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Unscoped().
+		Model(&model.Todo{}).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID)
 }
 
 // Count counts todos with filters
@@ -335,6 +753,36 @@ func (r *todoQueryRepository) CountByStatus(ctx context.Context, userID int64) (
 	return counts, nil
 }
 
+// CountByUsersAndState counts the todos of the users by status and workflow state.
+// The count runs in the transaction of ctx, if any, so moves into board columns
+// count the todos under the lock of the board.
+func (r *todoQueryRepository) CountByUsersAndState(ctx context.Context, userIDs []int64) (map[vo.TodoStateKey]int64, error) {
+	var results []struct {
+		Status string
+		State  string
+		Count  int64
+	}
+
+	if err := conn(ctx, r.db).
+		Model(&model.Todo{}).
+		Select("status, state, COUNT(*) as count").
+		Where("user_id IN ?", userIDs).
+		Group("status, state").
+		Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[vo.TodoStateKey]int64)
+	for _, result := range results {
+		status := vo.TodoStatus(result.Status)
+		if status.IsValid() {
+			counts[vo.TodoStateKey{Status: status, State: result.State}] = result.Count
+		}
+	}
+
+	return counts, nil
+}
+
 // CountByPriority counts todos by priority for a user
 func (r *todoQueryRepository) CountByPriority(ctx context.Context, userID int64) (map[sharedvo.Priority]int64, error) {
 	var results []struct {
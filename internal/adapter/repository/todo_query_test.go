@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"todolist/internal/domain/shared"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// newTestDB opens an in-memory database with the tables of the todos and sessions
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{TranslateError: true})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(
+		&model.User{}, &model.Project{}, &model.Tag{}, &model.CustomField{}, &model.Todo{}, &model.TodoTag{}, &model.TodoFieldValue{},
+		&model.UserSession{},
+	); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	return db
+}
+
+// insertTestTodos inserts the todos, creating their tags first
+func insertTestTodos(t *testing.T, db *gorm.DB, todos ...*model.Todo) {
+	t.Helper()
+
+	tags := map[string]*model.Tag{}
+	for _, todo := range todos {
+		if err := db.Omit(clause.Associations).Create(todo).Error; err != nil {
+			t.Fatalf("Failed to insert todo: %v", err)
+		}
+
+		for i, tag := range todo.Tags {
+			if _, ok := tags[tag.Name]; !ok {
+				tags[tag.Name] = &model.Tag{ID: int64(len(tags) + 1), Name: tag.Name}
+				if err := db.Create(tags[tag.Name]).Error; err != nil {
+					t.Fatalf("Failed to insert tag: %v", err)
+				}
+			}
+			todo.Tags[i] = tags[tag.Name]
+
+			todoTag := &model.TodoTag{TodoID: todo.ID, TagID: tags[tag.Name].ID, CreatedAt: time.Now()}
+			if err := db.Omit(clause.Associations).Create(todoTag).Error; err != nil {
+				t.Fatalf("Failed to tag todo: %v", err)
+			}
+		}
+	}
+}
+
+func tagged(names ...string) []*model.Tag {
+	tags := make([]*model.Tag, len(names))
+	for i, name := range names {
+		tags[i] = &model.Tag{Name: name}
+	}
+	return tags
+}
+
+func TestTodoQueryRepository_CountByFilters(t *testing.T) {
+	db := newTestDB(t)
+
+	past := time.Now().Add(-48 * time.Hour)
+	insertTestTodos(t, db,
+		&model.Todo{ID: 1, UserID: 1, Title: "Write report", Status: "pending", Priority: 2, Tags: tagged("work", "urgent")},
+		&model.Todo{ID: 2, UserID: 1, Title: "Call plumber", Status: "in_progress", Priority: 1, DueDate: &past, Tags: tagged("home")},
+		&model.Todo{ID: 3, UserID: 1, Title: "File taxes", Status: "completed", Priority: 3, DueDate: &past, Tags: tagged("work")},
+		&model.Todo{ID: 4, UserID: 2, Title: "Other user", Status: "pending", Priority: 1, Tags: tagged("work")},
+	)
+
+	overdue := true
+	tests := []struct {
+		name    string
+		filters vo.TodoFilterCriteria
+		want    int64
+	}{
+		{name: "user", filters: vo.TodoFilterCriteria{UserID: 1}, want: 3},
+		{name: "all users", filters: vo.TodoFilterCriteria{}, want: 4},
+		{name: "status", filters: vo.TodoFilterCriteria{UserID: 1, Status: []string{"pending", "completed"}}, want: 2},
+		{name: "priority", filters: vo.TodoFilterCriteria{UserID: 1, Priority: []string{"low"}}, want: 1},
+		{name: "tag", filters: vo.TodoFilterCriteria{UserID: 1, Tags: []string{"work"}}, want: 2},
+		// A todo with several of the tags is counted once
+		{name: "any of the tags", filters: vo.TodoFilterCriteria{UserID: 1, Tags: []string{"work", "urgent"}}, want: 2},
+		{name: "overdue", filters: vo.TodoFilterCriteria{UserID: 1, IsOverdue: &overdue}, want: 1},
+		{name: "no match", filters: vo.TodoFilterCriteria{UserID: 1, Tags: []string{"garden"}}, want: 0},
+	}
+
+	repo := NewTodoQueryRepository(db)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := repo.CountByFilters(context.Background(), tt.filters)
+			if err != nil {
+				t.Fatalf("CountByFilters() error = %v", err)
+			}
+			if count != tt.want {
+				t.Errorf("CountByFilters() = %d, want %d", count, tt.want)
+			}
+		})
+	}
+}
+
+func TestTodoQueryRepository_FacetsByFilters(t *testing.T) {
+	// Days start at local midnight, which is not UTC midnight in this zone
+	local := time.Local
+	time.Local = time.FixedZone("UTC+13", 13*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	db := newTestDB(t)
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	at := func(t time.Time) *time.Time { return &t }
+
+	insertTestTodos(t, db,
+		&model.Todo{ID: 1, UserID: 1, Title: "Overdue", Status: "pending", Priority: 2, DueDate: at(today.AddDate(0, 0, -2)), Tags: tagged("work", "urgent")},
+		&model.Todo{ID: 2, UserID: 1, Title: "Done early today", Status: "completed", Priority: 2, DueDate: at(today.Add(time.Minute)), Tags: tagged("work")},
+		&model.Todo{ID: 3, UserID: 1, Title: "Done yesterday", Status: "completed", Priority: 1, DueDate: at(today.Add(-time.Minute))},
+		&model.Todo{ID: 4, UserID: 1, Title: "Next days", Status: "pending", Priority: 3, DueDate: at(today.AddDate(0, 0, 3)), Tags: tagged("home")},
+		&model.Todo{ID: 5, UserID: 1, Title: "Next month", Status: "in_progress", Priority: 1, DueDate: at(today.AddDate(0, 1, 0))},
+		&model.Todo{ID: 6, UserID: 1, Title: "Someday", Status: "pending", Priority: 1, Tags: tagged("work")},
+		&model.Todo{ID: 7, UserID: 2, Title: "Other user", Status: "pending", Priority: 1, Tags: tagged("work")},
+	)
+
+	repo := NewTodoQueryRepository(db)
+
+	facets, err := repo.FacetsByFilters(context.Background(), vo.TodoFilterCriteria{UserID: 1}, 2)
+	if err != nil {
+		t.Fatalf("FacetsByFilters() error = %v", err)
+	}
+
+	if facets.Total != 6 {
+		t.Errorf("Expected 6 todos, got %d", facets.Total)
+	}
+
+	wantStatus := map[string]int64{"pending": 3, "completed": 2, "in_progress": 1}
+	for status, want := range wantStatus {
+		if facets.ByStatus[status] != want {
+			t.Errorf("Expected %d %s todos, got %d", want, status, facets.ByStatus[status])
+		}
+	}
+
+	wantPriority := map[string]int64{"low": 3, "medium": 2, "high": 1}
+	for priority, want := range wantPriority {
+		if facets.ByPriority[priority] != want {
+			t.Errorf("Expected %d %s todos, got %d", want, priority, facets.ByPriority[priority])
+		}
+	}
+
+	wantDue := map[string]int64{
+		vo.DueBucketOverdue:   1,
+		vo.DueBucketToday:     1,
+		vo.DueBucketThisWeek:  1,
+		vo.DueBucketLater:     1,
+		vo.DueBucketNoDueDate: 1,
+	}
+	if len(facets.ByDueDate) != len(wantDue) {
+		t.Errorf("Expected due date buckets %v, got %v", wantDue, facets.ByDueDate)
+	}
+	for bucket, want := range wantDue {
+		if facets.ByDueDate[bucket] != want {
+			t.Errorf("Expected %d todos %s, got %d", want, bucket, facets.ByDueDate[bucket])
+		}
+	}
+
+	wantTags := []vo.TagCount{{Tag: "work", Count: 3}, {Tag: "home", Count: 1}}
+	if len(facets.ByTag) != len(wantTags) {
+		t.Fatalf("Expected tags %v, got %v", wantTags, facets.ByTag)
+	}
+	for i, want := range wantTags {
+		if facets.ByTag[i] != want {
+			t.Errorf("Expected tag %v at %d, got %v", want, i, facets.ByTag[i])
+		}
+	}
+
+	t.Run("filtered by tag", func(t *testing.T) {
+		facets, err := repo.FacetsByFilters(context.Background(), vo.TodoFilterCriteria{UserID: 1, Tags: []string{"work", "urgent"}}, 10)
+		if err != nil {
+			t.Fatalf("FacetsByFilters() error = %v", err)
+		}
+		if facets.Total != 3 || facets.ByStatus["pending"] != 2 {
+			t.Errorf("Expected 3 todos, 2 pending, once each, got %d and %v", facets.Total, facets.ByStatus)
+		}
+	})
+}
+
+func TestTodoQueryRepository_FindByFilters_Cursor(t *testing.T) {
+	db := newTestDB(t)
+
+	insertTestTodos(t, db,
+		&model.Todo{ID: 1, UserID: 1, Title: "Low one", Status: "pending", Priority: 1},
+		&model.Todo{ID: 2, UserID: 1, Title: "High one", Status: "pending", Priority: 3},
+		&model.Todo{ID: 3, UserID: 1, Title: "Medium one", Status: "pending", Priority: 2},
+		&model.Todo{ID: 4, UserID: 1, Title: "High two", Status: "pending", Priority: 3},
+		&model.Todo{ID: 5, UserID: 1, Title: "Low two", Status: "pending", Priority: 1},
+	)
+
+	// Listed by priority then ID, both descending: 4, 2, 3, 5, 1
+	byPriority := shared.Sort{{Key: "priority", Desc: true}}
+
+	tests := []struct {
+		name    string
+		sort    shared.Sort
+		cursor  shared.Cursor
+		limit   int
+		wantIDs []int64
+	}{
+		{name: "after the position", sort: byPriority, cursor: shared.Cursor{Value: 3, ID: 2}, limit: 2, wantIDs: []int64{3, 5}},
+		{name: "before the position", sort: byPriority, cursor: shared.Cursor{Value: 1, ID: 5, Backward: true}, limit: 2, wantIDs: []int64{2, 3}},
+		{name: "before the position up to the start", sort: byPriority, cursor: shared.Cursor{Value: 2, ID: 3, Backward: true}, limit: 5, wantIDs: []int64{4, 2}},
+		{name: "before the position by id", cursor: shared.Cursor{ID: 4, Backward: true}, limit: 2, wantIDs: []int64{2, 3}},
+	}
+
+	repo := NewTodoQueryRepository(db)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos, err := repo.FindByFilters(context.Background(), vo.TodoFilterCriteria{UserID: 1}, shared.QueryOptions{
+				Sort:   tt.sort,
+				Limit:  tt.limit,
+				Cursor: &tt.cursor,
+			})
+			if err != nil {
+				t.Fatalf("FindByFilters() error = %v", err)
+			}
+
+			ids := make([]int64, len(todos))
+			for i, todo := range todos {
+				ids[i] = todo.ID()
+			}
+			// Backward pages are returned in the listing order
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("Expected todos %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+
+	t.Run("nullable sort key", func(t *testing.T) {
+		_, err := repo.FindByFilters(context.Background(), vo.TodoFilterCriteria{UserID: 1}, shared.QueryOptions{
+			Sort:   shared.Sort{{Key: "due_date"}},
+			Cursor: &shared.Cursor{ID: 1},
+		})
+		if !errors.Is(err, shared.ErrInvalidOperation) {
+			t.Errorf("Expected ErrInvalidOperation, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,511 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database/model"
+	"todolist/pkg/trigram"
+	"unicode"
+
+	"gorm.io/gorm"
+)
+
+// Markers wrapping the matching words in the highlights of PostgreSQL. They are
+// private use characters, so they are left alone by the HTML escaping and are not
+// expected in the todos.
+const (
+	highlightStart = "\uE000"
+	highlightStop  = "\uE001"
+)
+
+// highlightMaxWords is the length of the excerpts of the descriptions in words
+const highlightMaxWords = 35
+
+// highlightLeadingWords is the number of words before the first match in the
+// excerpts of the descriptions
+const highlightLeadingWords = 5
+
+// fuzzySearchThreshold is the word similarity from which the titles match the
+// search despite typos, the default pg_trgm.word_similarity_threshold of the <%
+// operator of PostgreSQL
+const fuzzySearchThreshold = 0.6
+
+// maxFuzzyCandidates bounds the titles compared to the fuzzy term in process on
+// databases other than PostgreSQL, the newest todos being compared
+const maxFuzzyCandidates = 2000
+
+// fuzzyMatchTTL is how long the todos similar to a search are reused, long enough
+// for the page, total and facets of a request to share them
+const fuzzyMatchTTL = 5 * time.Second
+
+// maxFuzzyMatchEntries bounds the searches whose similar todos are kept
+const maxFuzzyMatchEntries = 256
+
+// highlightReplacer turns the highlight markers into HTML
+var highlightReplacer = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// todoSearch is a full-text search of todos by title and description.
+//
+// PostgreSQL searches with websearch_to_tsquery and the text search configuration
+// of the language, which uses the full-text indexes of the todos. Other databases
+// fall back to matching the words of the search with LIKE, with the same syntax:
+// the todos match when they contain all the words and "quoted phrases" of the
+// search, or between words matches either side and a leading - excludes a word.
+//
+// Searches without or nor exclusions also match the todos whose title is similar
+// to the words of the search, so that misspelled words still find them. The
+// similarity of the trigrams of the words is measured with pg_trgm on PostgreSQL,
+// using the trigram index of the titles, and in process on other databases.
+type todoSearch struct {
+	postgres  bool
+	term      string
+	config    string
+	groups    [][]searchTerm
+	fuzzyTerm string
+
+	// Word similarity of the titles similar to the fuzzy term, by todo ID, on
+	// databases other than PostgreSQL
+	fuzzyMatches map[int64]float64
+}
+
+// searchTerm is a word or phrase of a search, lowercase
+type searchTerm struct {
+	text    string
+	exclude bool
+}
+
+// newTodoSearch creates the search of the term of the filters for the database,
+// in the language of the filters or the default language when it is not supported.
+// The todos similar to the search are taken from the cache while they are fresh.
+func newTodoSearch(
+	ctx context.Context,
+	db *gorm.DB,
+	filters vo.TodoFilterCriteria,
+	cache *fuzzyMatchCache,
+) (*todoSearch, error) {
+	language := filters.SearchLanguage
+	if !language.IsValid() {
+		language = sharedvo.DefaultSearchLanguage
+	}
+
+	search := &todoSearch{
+		postgres: db.Dialector.Name() == "postgres",
+		term:     strings.TrimSpace(filters.SearchTerm),
+		// Safe to inline as the language is one of the supported ones. The literal
+		// must match the expressions of the full-text indexes for them to be used.
+		config: "'" + language.String() + "'",
+	}
+	search.groups = parseSearchTerms(search.term)
+
+	// Operators ask for exact words
+	if len(search.groups) == 1 {
+		words := make([]string, 0, len(search.groups[0]))
+		for _, term := range search.groups[0] {
+			if term.exclude {
+				words = nil
+				break
+			}
+			words = append(words, term.text)
+		}
+		search.fuzzyTerm = strings.Join(words, " ")
+	}
+
+	if !search.postgres && search.fuzzyTerm != "" {
+		key := fuzzyMatchKey{userID: filters.UserID, term: search.fuzzyTerm}
+
+		matches, ok := cache.get(key)
+		if !ok {
+			var err error
+			if matches, err = search.matchFuzzy(ctx, db, filters.UserID); err != nil {
+				return nil, err
+			}
+			cache.put(key, matches)
+		}
+		search.fuzzyMatches = matches
+	}
+
+	return search, nil
+}
+
+// matchFuzzy finds the todos of the user whose title is similar to the fuzzy term,
+// among the newest maxFuzzyCandidates todos
+func (s *todoSearch) matchFuzzy(ctx context.Context, db *gorm.DB, userID int64) (map[int64]float64, error) {
+	var titles []struct {
+		ID    int64
+		Title string
+	}
+
+	query := db.WithContext(ctx).
+		Model(&model.Todo{}).
+		Select("todos.id AS id, todos.title AS title").
+		Order("todos.id DESC").
+		Limit(maxFuzzyCandidates)
+	if userID != 0 {
+		query = query.Where("todos.user_id = ?", userID)
+	}
+
+	if err := query.Scan(&titles).Error; err != nil {
+		return nil, err
+	}
+
+	matches := map[int64]float64{}
+	for _, todo := range titles {
+		if similarity := trigram.WordSimilarity(s.fuzzyTerm, todo.Title); similarity >= fuzzySearchThreshold {
+			matches[todo.ID] = similarity
+		}
+	}
+
+	return matches, nil
+}
+
+// fuzzyMatchKey identifies the todos similar to a search
+type fuzzyMatchKey struct {
+	userID int64
+	term   string
+}
+
+// fuzzyMatchEntry is the similarity of the titles similar to a search, by todo ID
+type fuzzyMatchEntry struct {
+	matches map[int64]float64
+	expires time.Time
+}
+
+// fuzzyMatchCache keeps the todos similar to the recent searches, so that the
+// queries of a request compare the titles to the search once
+type fuzzyMatchCache struct {
+	mu      sync.Mutex
+	entries map[fuzzyMatchKey]fuzzyMatchEntry
+}
+
+// newFuzzyMatchCache creates an empty cache
+func newFuzzyMatchCache() *fuzzyMatchCache {
+	return &fuzzyMatchCache{entries: map[fuzzyMatchKey]fuzzyMatchEntry{}}
+}
+
+// get returns the fresh matches of a search. The matches are shared and must not
+// be changed.
+func (c *fuzzyMatchCache) get(key fuzzyMatchKey) (map[int64]float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.matches, true
+}
+
+// put keeps the matches of a search, dropping the stale entries when full
+func (c *fuzzyMatchCache) put(key fuzzyMatchKey, matches map[int64]float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= maxFuzzyMatchEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	if len(c.entries) >= maxFuzzyMatchEntries {
+		return
+	}
+
+	c.entries[key] = fuzzyMatchEntry{matches: matches, expires: now.Add(fuzzyMatchTTL)}
+}
+
+// condition returns the SQL condition matching the todos found by the search
+func (s *todoSearch) condition() (string, []any) {
+	if s.postgres {
+		sql := fmt.Sprintf(
+			"to_tsvector(%[1]s, todos.title) @@ %[2]s OR to_tsvector(%[1]s, todos.description) @@ %[2]s",
+			s.config, s.tsquery(),
+		)
+		args := []any{s.term, s.term}
+
+		if s.fuzzyTerm != "" {
+			sql += " OR ? <% todos.title"
+			args = append(args, s.fuzzyTerm)
+		}
+
+		return "(" + sql + ")", args
+	}
+
+	conditions := []string{}
+	args := []any{}
+
+	for _, group := range s.groups {
+		terms := make([]string, len(group))
+		for i, term := range group {
+			pattern := "%" + likeEscaper.Replace(term.text) + "%"
+			terms[i] = "(LOWER(todos.title) LIKE ? ESCAPE '!' OR LOWER(COALESCE(todos.description, '')) LIKE ? ESCAPE '!')"
+			if term.exclude {
+				terms[i] = "NOT " + terms[i]
+			}
+			args = append(args, pattern, pattern)
+		}
+		conditions = append(conditions, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	if len(s.fuzzyMatches) > 0 {
+		conditions = append(conditions, "todos.id IN ?")
+		args = append(args, s.fuzzyIDs())
+	}
+
+	if len(conditions) == 0 {
+		return "1 = 0", nil
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// columns returns the SQL columns of the rank, title highlight and description
+// highlight of the todos found by the search. The highlights are only selected
+// from PostgreSQL, other databases highlight the todos with highlight.
+func (s *todoSearch) columns() (string, []any) {
+	if s.postgres {
+		rank := fmt.Sprintf(
+			"ts_rank(setweight(to_tsvector(%[1]s, todos.title), 'A') || setweight(to_tsvector(%[1]s, COALESCE(todos.description, '')), 'B'), %[2]s)",
+			s.config, s.tsquery(),
+		)
+		args := []any{s.term}
+
+		if s.fuzzyTerm != "" {
+			rank += " + word_similarity(?, todos.title)"
+			args = append(args, s.fuzzyTerm)
+		}
+
+		return fmt.Sprintf(
+				"%[3]s AS search_rank, "+
+					"ts_headline(%[1]s, todos.title, %[2]s, ?) AS title_highlight, "+
+					"ts_headline(%[1]s, COALESCE(todos.description, ''), %[2]s, ?) AS description_highlight",
+				s.config, s.tsquery(), rank,
+			), append(args,
+				s.term, fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", highlightStart, highlightStop),
+				s.term, fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=%d, MinWords=15", highlightStart, highlightStop, highlightMaxWords),
+			)
+	}
+
+	// Words matching the title weigh more than the ones matching the description
+	ranks := []string{}
+	args := []any{}
+
+	for _, group := range s.groups {
+		for _, term := range group {
+			if term.exclude {
+				continue
+			}
+			pattern := "%" + likeEscaper.Replace(term.text) + "%"
+			ranks = append(ranks,
+				"CASE WHEN LOWER(todos.title) LIKE ? ESCAPE '!' THEN 1.0 ELSE 0.0 END",
+				"CASE WHEN LOWER(COALESCE(todos.description, '')) LIKE ? ESCAPE '!' THEN 0.4 ELSE 0.0 END",
+			)
+			args = append(args, pattern, pattern)
+		}
+	}
+
+	if len(s.fuzzyMatches) > 0 {
+		similarities := []string{}
+		for _, id := range s.fuzzyIDs() {
+			similarities = append(similarities, "WHEN ? THEN ?")
+			args = append(args, id, s.fuzzyMatches[id])
+		}
+		ranks = append(ranks, "CASE todos.id "+strings.Join(similarities, " ")+" ELSE 0.0 END")
+	}
+
+	if len(ranks) == 0 {
+		return "0.0 AS search_rank, '' AS title_highlight, '' AS description_highlight", nil
+	}
+
+	return "(" + strings.Join(ranks, " + ") + ") AS search_rank, '' AS title_highlight, '' AS description_highlight", args
+}
+
+// fuzzyIDs returns the IDs of the todos whose title is similar to the fuzzy term
+func (s *todoSearch) fuzzyIDs() []int64 {
+	ids := make([]int64, 0, len(s.fuzzyMatches))
+	for id := range s.fuzzyMatches {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// highlight returns the HTML highlights of the title and description of a todo,
+// from the ones selected by PostgreSQL or else from the todo
+func (s *todoSearch) highlight(hit todoSearchHit, title, description string) (string, string) {
+	if s.postgres {
+		return highlightReplacer.Replace(html.EscapeString(hit.TitleHighlight)),
+			highlightReplacer.Replace(html.EscapeString(hit.DescriptionHighlight))
+	}
+
+	terms := []string{}
+	for _, group := range s.groups {
+		for _, term := range group {
+			if !term.exclude {
+				terms = append(terms, term.text)
+			}
+		}
+	}
+
+	return highlightText(title, terms, 0), highlightText(description, terms, highlightMaxWords)
+}
+
+// tsquery returns the SQL parsing the search
+func (s *todoSearch) tsquery() string {
+	return fmt.Sprintf("websearch_to_tsquery(%s, ?)", s.config)
+}
+
+// todoSearchHit is a todo found by a search, as selected from the database
+type todoSearchHit struct {
+	ID                   int64
+	SearchRank           float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// todoSimilarity is the similarity of the title of a todo to another title
+type todoSimilarity struct {
+	ID         int64
+	Similarity float64
+}
+
+// parseSearchTerms splits a search into groups of terms, a todo matching a group
+// when it matches all of its terms
+func parseSearchTerms(search string) [][]searchTerm {
+	groups := [][]searchTerm{}
+	group := []searchTerm{}
+	runes := []rune(strings.ToLower(search))
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		exclude := false
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			exclude = true
+			i++
+		}
+
+		start, quoted := i, runes[i] == '"'
+		if quoted {
+			start++
+			for i = start; i < len(runes) && runes[i] != '"'; i++ {
+			}
+		} else {
+			for ; i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '"'; i++ {
+			}
+		}
+
+		text := strings.TrimSpace(string(runes[start:i]))
+		if quoted && i < len(runes) {
+			i++ // closing quote
+		}
+
+		switch {
+		case text == "":
+		case text == "or" && !quoted && !exclude:
+			if len(group) > 0 {
+				groups = append(groups, group)
+				group = []searchTerm{}
+			}
+		default:
+			group = append(group, searchTerm{text: text, exclude: exclude})
+		}
+	}
+
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// highlightText HTML escapes a text wrapping the occurrences of the terms in
+// <mark> elements. When maxWords is positive, the text is cut to an excerpt of as
+// many words around the first occurrence.
+func highlightText(text string, terms []string, maxWords int) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// Runes of the occurrences of the terms
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		termRunes := []rune(term)
+		for i := 0; i+len(termRunes) <= len(lower); i++ {
+			if string(lower[i:i+len(termRunes)]) != term {
+				continue
+			}
+			for j := i; j < i+len(termRunes); j++ {
+				marked[j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+		}
+	}
+
+	start, end := 0, len(runes)
+	if maxWords > 0 {
+		start, end = excerptBounds(runes, first, maxWords)
+	}
+
+	var builder strings.Builder
+	for i := start; i < end; i++ {
+		if marked[i] && (i == start || !marked[i-1]) {
+			builder.WriteString("<mark>")
+		}
+		builder.WriteString(html.EscapeString(string(runes[i])))
+		if marked[i] && (i == end-1 || !marked[i+1]) {
+			builder.WriteString("</mark>")
+		}
+	}
+
+	return builder.String()
+}
+
+// excerptBounds returns the bounds of the excerpt of maxWords words of a text
+// starting a few words before the rune at, or at the start of the text when at
+// is negative
+func excerptBounds(runes []rune, at, maxWords int) (int, int) {
+	words := []int{}
+	for i, r := range runes {
+		if !unicode.IsSpace(r) && (i == 0 || unicode.IsSpace(runes[i-1])) {
+			words = append(words, i)
+		}
+	}
+
+	if len(words) <= maxWords {
+		return 0, len(runes)
+	}
+
+	first := 0
+	for i, word := range words {
+		if at >= 0 && word <= at {
+			first = i
+		}
+	}
+	// The word at stays in the excerpt even when it is shorter than the leading words
+	leading := min(highlightLeadingWords, maxWords-1)
+	first = max(0, min(first-leading, len(words)-maxWords))
+
+	end := len(runes)
+	if last := first + maxWords; last < len(words) {
+		end = words[last]
+	}
+
+	return words[first], len([]rune(strings.TrimRightFunc(string(runes[:end]), unicode.IsSpace)))
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"todolist/internal/domain/shared"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database/model"
+)
+
+func TestTodoQueryRepository_FuzzySearch(t *testing.T) {
+	db := newTestDB(t)
+	insertTestTodos(t, db,
+		&model.Todo{ID: 1, UserID: 1, Title: "Schedule dentist appointment", Status: "pending", Priority: 1},
+		&model.Todo{ID: 2, UserID: 1, Title: "Buy groceries", Status: "pending", Priority: 1},
+		&model.Todo{ID: 3, UserID: 2, Title: "Dentist for the kids", Status: "pending", Priority: 1},
+	)
+
+	repo := NewTodoQueryRepository(db).(*todoQueryRepository)
+	ctx := context.Background()
+	filters := vo.TodoFilterCriteria{UserID: 1, SearchTerm: "dentistt"}
+
+	count, err := repo.CountByFilters(ctx, filters)
+	if err != nil {
+		t.Fatalf("CountByFilters() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected the misspelled search to count 1 todo, got %d", count)
+	}
+
+	matches, ok := repo.fuzzy.get(fuzzyMatchKey{userID: 1, term: "dentistt"})
+	if !ok || len(matches) != 1 || matches[1] == 0 {
+		t.Fatalf("Expected the similar todos of the search to be kept, got %v", matches)
+	}
+
+	// The later queries of the request reuse the similar todos
+	repo.fuzzy.put(fuzzyMatchKey{userID: 1, term: "dentistt"}, map[int64]float64{2: 1})
+	todos, err := repo.FindByFilters(ctx, filters, shared.QueryOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("FindByFilters() error = %v", err)
+	}
+	if len(todos) != 1 || todos[0].ID() != 2 {
+		t.Errorf("Expected the kept similar todos to be used, got %d todos", len(todos))
+	}
+}
+
+func TestFuzzyMatchCache(t *testing.T) {
+	cache := newFuzzyMatchCache()
+	key := fuzzyMatchKey{userID: 1, term: "dentist"}
+
+	if _, ok := cache.get(key); ok {
+		t.Fatal("Expected an empty cache to miss")
+	}
+
+	cache.put(key, map[int64]float64{1: 0.8})
+	if matches, ok := cache.get(key); !ok || matches[1] != 0.8 {
+		t.Errorf("Expected the kept matches, got %v", matches)
+	}
+	if _, ok := cache.get(fuzzyMatchKey{userID: 2, term: "dentist"}); ok {
+		t.Error("Expected the matches of other users to miss")
+	}
+
+	cache.entries[key] = fuzzyMatchEntry{matches: map[int64]float64{1: 0.8}, expires: time.Now().Add(-time.Second)}
+	if _, ok := cache.get(key); ok {
+		t.Error("Expected stale matches to miss")
+	}
+
+	// Stale entries make room for new ones when the cache is full
+	for i := range maxFuzzyMatchEntries - 1 {
+		cache.put(fuzzyMatchKey{userID: int64(100 + i), term: "x"}, nil)
+	}
+	cache.put(fuzzyMatchKey{userID: 3, term: "dentist"}, map[int64]float64{})
+	if _, ok := cache.get(fuzzyMatchKey{userID: 3, term: "dentist"}); !ok || len(cache.entries) != maxFuzzyMatchEntries {
+		t.Errorf("Expected the stale entry to be replaced, got %d entries", len(cache.entries))
+	}
+}
+
+func TestParseSearchTerms(t *testing.T) {
+	term := func(text string) searchTerm { return searchTerm{text: text} }
+	excluded := func(text string) searchTerm { return searchTerm{text: text, exclude: true} }
+
+	tests := []struct {
+		name   string
+		search string
+		want   [][]searchTerm
+	}{
+		{name: "empty", search: "  ", want: [][]searchTerm{}},
+		{name: "words", search: "Annual  Report", want: [][]searchTerm{{term("annual"), term("report")}}},
+		{name: "phrase", search: `"Annual report" 2024`, want: [][]searchTerm{{term("annual report"), term("2024")}}},
+		{name: "excluded word", search: "report -draft", want: [][]searchTerm{{term("report"), excluded("draft")}}},
+		{name: "excluded phrase", search: `report -"first draft"`, want: [][]searchTerm{{term("report"), excluded("first draft")}}},
+		{name: "dash alone", search: "report - draft", want: [][]searchTerm{{term("report"), term("-"), term("draft")}}},
+		{name: "alternatives", search: "report OR memo draft", want: [][]searchTerm{{term("report")}, {term("memo"), term("draft")}}},
+		{name: "leading and trailing or", search: "or report or", want: [][]searchTerm{{term("report")}}},
+		{name: "repeated or", search: "report or or memo", want: [][]searchTerm{{term("report")}, {term("memo")}}},
+		{name: "quoted or", search: `report "or" memo`, want: [][]searchTerm{{term("report"), term("or"), term("memo")}}},
+		{name: "excluded or", search: "report -or", want: [][]searchTerm{{term("report"), excluded("or")}}},
+		{name: "unterminated phrase", search: `"crème brûlée`, want: [][]searchTerm{{term("crème brûlée")}}},
+		{name: "empty phrase", search: `"" report`, want: [][]searchTerm{{term("report")}}},
+		{name: "phrase right after a word", search: `report"q3 memo"`, want: [][]searchTerm{{term("report"), term("q3 memo")}}},
+		{name: "multibyte", search: "ÉTÉ Straße -Œuvre", want: [][]searchTerm{{term("été"), term("straße"), excluded("œuvre")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseSearchTerms(tt.search)
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseSearchTerms(%q) = %v, want %v", tt.search, got, tt.want)
+			}
+			for i := range got {
+				if !slices.Equal(got[i], tt.want[i]) {
+					t.Errorf("parseSearchTerms(%q) = %v, want %v", tt.search, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestHighlightText(t *testing.T) {
+	words := make([]string, 20)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%d", i)
+	}
+	long := strings.Join(words, " ")
+
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		maxWords int
+		want     string
+	}{
+		{name: "no terms", text: "Write report", want: "Write report"},
+		{name: "no match", text: "Write report", terms: []string{"memo"}, want: "Write report"},
+		{name: "match at the start", text: "Report due", terms: []string{"report"}, want: "<mark>Report</mark> due"},
+		{name: "match at the end", text: "Write report", terms: []string{"report"}, want: "Write <mark>report</mark>"},
+		{name: "whole text", text: "Report", terms: []string{"report"}, want: "<mark>Report</mark>"},
+		{name: "every occurrence", text: "todo, todo", terms: []string{"todo"}, want: "<mark>todo</mark>, <mark>todo</mark>"},
+		{name: "overlapping terms", text: "Reporting", terms: []string{"report", "port", "ing"}, want: "<mark>Reporting</mark>"},
+		{name: "adjacent terms", text: "abcd", terms: []string{"ab", "cd"}, want: "<mark>abcd</mark>"},
+		{name: "multibyte", text: "Crème brûlée recipe", terms: []string{"brûlée"}, want: "Crème <mark>brûlée</mark> recipe"},
+		{name: "multibyte case", text: "ÉTÉ plans", terms: []string{"été"}, want: "<mark>ÉTÉ</mark> plans"},
+		{name: "multibyte at the end", text: "Plan für Straße", terms: []string{"straße"}, want: "Plan für <mark>Straße</mark>"},
+		{name: "escaped", text: `<b>Q&A</b> "report"`, terms: []string{"q&a"}, want: "&lt;b&gt;<mark>Q&amp;A</mark>&lt;/b&gt; &#34;report&#34;"},
+		{name: "excerpt around the match", text: long, terms: []string{"w15"}, maxWords: 8, want: "w10 w11 w12 w13 w14 <mark>w15</mark> w16 w17"},
+		{name: "excerpt of the end", text: long, terms: []string{"w19"}, maxWords: 8, want: "w12 w13 w14 w15 w16 w17 w18 <mark>w19</mark>"},
+		{name: "excerpt without match", text: long, terms: []string{"memo"}, maxWords: 3, want: "w0 w1 w2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlightText(tt.text, tt.terms, tt.maxWords); got != tt.want {
+				t.Errorf("highlightText(%q, %v, %d) = %q, want %q", tt.text, tt.terms, tt.maxWords, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExcerptBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		at       int
+		maxWords int
+		want     string
+	}{
+		{name: "short text", text: "  Write the report ", at: 8, maxWords: 3, want: "  Write the report "},
+		{name: "no match", text: "one two three four", at: -1, maxWords: 2, want: "one two"},
+		{name: "match at the start", text: "one two three four", at: 0, maxWords: 2, want: "one two"},
+		{name: "leading words", text: "a b c d e f g h i j k l", at: 16, maxWords: 7, want: "d e f g h i j"},
+		{name: "fewer words than the leading words", text: "a b c d e f g h i j", at: 14, maxWords: 3, want: "f g h"},
+		{name: "match near the end", text: "a b c d e f g h i j", at: 18, maxWords: 3, want: "h i j"},
+		{name: "runs of spaces", text: "a  b\tc\n\nd e f g h", at: 0, maxWords: 4, want: "a  b\tc\n\nd"},
+		{name: "multibyte", text: "été crème brûlée à la française", at: 17, maxWords: 2, want: "brûlée à"},
+		{name: "multibyte after the leading words", text: "à b c d e f g h ñ j ü l", at: 16, maxWords: 3, want: "g h ñ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runes := []rune(tt.text)
+			start, end := excerptBounds(runes, tt.at, tt.maxWords)
+			if got := string(runes[start:end]); got != tt.want {
+				t.Errorf("excerptBounds(%q, %d, %d) = %q, want %q", tt.text, tt.at, tt.maxWords, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	vo "todolist/internal/domain/todo/valueobject"
+)
+
+func TestTodoRepository_Save(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTodoRepository(newTestDB(t))
+
+	title, _ := vo.NewTodoTitle("Write report")
+	todo, err := entity.NewTodo(0, 1, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+	if err != nil {
+		t.Fatalf("NewTodo() error = %v", err)
+	}
+	todo.AddTag("work")
+
+	if err := repo.Save(ctx, todo); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if todo.ID() == 0 || todo.LockVersion() != 1 {
+		t.Fatalf("Expected the todo to be created with version 1, got ID %d and version %d", todo.ID(), todo.LockVersion())
+	}
+
+	t.Run("should update the stored todo", func(t *testing.T) {
+		loaded, err := repo.FindByID(ctx, todo.ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+
+		title, _ := vo.NewTodoTitle("Write the annual report")
+		loaded.UpdateTitle(title)
+		loaded.RemoveTag("work")
+		loaded.AddTag("finance")
+		if err := repo.Save(ctx, loaded); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		saved, err := repo.FindByID(ctx, todo.ID())
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if saved.Title().Value() != "Write the annual report" {
+			t.Errorf("Expected the title to be updated, got %q", saved.Title().Value())
+		}
+		if tags := saved.Tags(); !slices.Equal(tags, []string{"finance"}) {
+			t.Errorf("Expected the tags to be replaced, got %v", tags)
+		}
+		if saved.LockVersion() != 2 {
+			t.Errorf("Expected version 2, got %d", saved.LockVersion())
+		}
+	})
+
+	t.Run("should reject a stale todo", func(t *testing.T) {
+		// todo still holds the version it was created with
+		priority := sharedvo.PriorityHigh
+		todo.UpdatePriority(priority)
+
+		if err := repo.Save(ctx, todo); !errors.Is(err, shared.ErrOptimisticLock) {
+			t.Fatalf("Expected ErrOptimisticLock, got %v", err)
+		}
+
+		saved, _ := repo.FindByID(ctx, todo.ID())
+		if saved.Priority() == priority {
+			t.Error("Expected the stale change not to be stored")
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+// txContextKey is the context key of the transaction started by the transaction manager
+type txContextKey struct{}
+
+// transactionManager implements shared.TransactionManager
+type transactionManager struct {
+	db *gorm.DB
+}
+
+// NewTransactionManager creates a new transaction manager
+func NewTransactionManager(db *gorm.DB) shared.TransactionManager {
+	return &transactionManager{db: db}
+}
+
+// WithinTransaction runs fn in a transaction, committed when fn returns nil and rolled
+// back otherwise. Nested calls join the transaction already in the context.
+func (m *transactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// conn returns the transaction of the context, if any, or the database otherwise
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+
+	return db.WithContext(ctx)
+}
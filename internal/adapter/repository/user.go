@@ -30,20 +30,21 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 func (r *userRepository) Save(ctx context.Context, user *entity.User) error {
 	userModel := r.mapper.ToModel(user)
 
-	err := r.db.WithContext(ctx).Save(userModel).Error
-	if err != nil {
+	if err := saveVersioned(conn(ctx, r.db), userModel, userModel.ID, &userModel.Version); err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return shared.ErrDuplicateEntry
 		}
 		return err
 	}
 
+	user.SetID(userModel.ID)
+	user.SetLockVersion(userModel.Version)
 	return nil
 }
 
 // Delete deletes a user (soft delete)
 func (r *userRepository) Delete(ctx context.Context, id int64) error {
-	result := r.db.WithContext(ctx).Delete(&model.User{}, "id = ?", id)
+	result := conn(ctx, r.db).Delete(&model.User{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -59,7 +60,7 @@ func (r *userRepository) Delete(ctx context.Context, id int64) error {
 func (r *userRepository) FindByID(ctx context.Context, id int64) (*entity.User, error) {
 	userModel := &model.User{}
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Preload("Person").
 		First(userModel, "id = ?", id).Error
 
@@ -97,7 +98,7 @@ func (r *userRepository) FindByUsername(ctx context.Context, username string) (*
 func (r *userRepository) FindByPersonID(ctx context.Context, personID int64) (*entity.User, error) {
 	userModel := &model.User{}
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Preload("Person").
 		Where("person_id = ?", personID).
 		First(userModel).Error
@@ -116,7 +117,7 @@ func (r *userRepository) FindByPersonID(ctx context.Context, personID int64) (*e
 func (r *userRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
 	var count int64
 
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&model.User{}).
 		Where("username = ?", username).
 		Count(&count).Error; err != nil {
@@ -130,7 +131,7 @@ func (r *userRepository) ExistsByUsername(ctx context.Context, username string)
 func (r *userRepository) ExistsByPersonID(ctx context.Context, personID int64) (bool, error) {
 	var count int64
 
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&model.User{}).
 		Where("person_id = ?", personID).
 		Count(&count).Error; err != nil {
@@ -14,6 +14,15 @@ import (
 	"gorm.io/gorm"
 )
 
+// userSorts maps the keys users can be sorted by to their columns
+var userSorts = database.NewSortRegistry("users.id", map[string]database.SortColumn{
+	"username":   {Expression: "users.username"},
+	"status":     {Expression: "users.status"},
+	"role":       {Expression: "users.role"},
+	"created_at": {Expression: "users.created_at"},
+	"updated_at": {Expression: "users.updated_at"},
+})
+
 // userQueryRepository implements repository.UserQueryRepository
 type userQueryRepository struct {
 	db     *gorm.DB
@@ -33,7 +42,31 @@ func (r *userQueryRepository) FindAll(ctx context.Context, options shared.QueryO
 	users := []*model.User{}
 
 	query := r.db.WithContext(ctx).Model(&model.User{}).Preload("Person")
-	query = database.ApplyQueryOptions(query, options)
+	query, err := userSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := query.Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(users)
+}
+
+// FindByFilters finds users by multiple filters
+func (r *userQueryRepository) FindByFilters(
+	ctx context.Context,
+	filters vo.UserFilterCriteria,
+	options shared.QueryOptions,
+) ([]*entity.User, error) {
+	users := []*model.User{}
+
+	query := r.filteredQuery(ctx, filters).Preload("Person")
+	query, err := userSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -52,7 +85,10 @@ func (r *userQueryRepository) FindByStatus(ctx context.Context, status vo.UserSt
 		Preload("Person").
 		Where("status = ?", string(status))
 
-	query = database.ApplyQueryOptions(query, options)
+	query, err := userSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -71,7 +107,10 @@ func (r *userQueryRepository) FindByRole(ctx context.Context, role vo.UserRole,
 		Preload("Person").
 		Where("role = ?", string(role))
 
-	query = database.ApplyQueryOptions(query, options)
+	query, err := userSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 	if err := query.Find(&people).Error; err != nil {
 		return nil, err
 	}
@@ -97,7 +136,10 @@ func (r *userQueryRepository) FindInactiveUsers(ctx context.Context, days int, o
 		Where("last_login_at < ? OR last_login_at IS NULL", threshold).
 		Where("status = ?", string(vo.StatusActive))
 
-	query = database.ApplyQueryOptions(query, options)
+	query, err := userSorts.ApplyQueryOptions(query, options)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := query.Find(&users).Error; err != nil {
 		return nil, err
@@ -125,6 +167,17 @@ func (r *userQueryRepository) Count(ctx context.Context, filters []shared.Filter
 	return count, nil
 }
 
+// CountByFilters counts users matching the filters
+func (r *userQueryRepository) CountByFilters(ctx context.Context, filters vo.UserFilterCriteria) (int64, error) {
+	var count int64
+
+	if err := r.filteredQuery(ctx, filters).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // CountByStatus counts users grouped by status
 func (r *userQueryRepository) CountByStatus(ctx context.Context) (map[vo.UserStatus]int64, error) {
 	var results []struct {
@@ -176,3 +229,29 @@ func (r *userQueryRepository) CountByRole(ctx context.Context) (map[vo.UserRole]
 
 	return counts, nil
 }
+
+// filteredQuery builds the users query for the filter criteria
+func (r *userQueryRepository) filteredQuery(ctx context.Context, filters vo.UserFilterCriteria) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&model.User{})
+
+	if filters.HasStatusFilter() {
+		query = query.Where("users.status IN ?", filters.Status)
+	}
+
+	if filters.HasRoleFilter() {
+		query = query.Where("users.role IN ?", filters.Role)
+	}
+
+	if filters.HasSearchTerm() {
+		query = query.Joins("JOIN people ON people.id = users.person_id")
+		query = database.BuildSearchQuery(
+			query,
+			filters.SearchTerm,
+			"users.username",
+			"people.name",
+			"people.email",
+		)
+	}
+
+	return query
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"todolist/internal/domain/shared"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// saveVersioned creates the row of an entity that was never persisted, or updates
+// it when the stored version still matches the one it was loaded with.
+//
+// version points to the version field of row and is set to the saved version, so
+// concurrent updates of the same row fail with shared.ErrOptimisticLock instead of
+// silently overwriting each other. Associations are left to the caller. The
+// createOnly columns are only written when the row is created, as they are changed
+// by dedicated updates that must not be overwritten with the loaded values.
+func saveVersioned(tx *gorm.DB, row any, id int64, version *int64, createOnly ...string) error {
+	loaded := *version
+	tx = tx.Omit(clause.Associations).Session(&gorm.Session{})
+
+	if loaded == 0 {
+		*version = 1
+		if err := tx.Create(row).Error; err != nil {
+			*version = loaded
+			return err
+		}
+		return nil
+	}
+
+	*version = loaded + 1
+	result := tx.Model(row).
+		Select("*").
+		Omit(append([]string{clause.Associations}, createOnly...)...).
+		Where("version = ?", loaded).
+		Updates(row)
+	if result.Error != nil {
+		*version = loaded
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	*version = loaded
+
+	// Nothing was updated, either the row is gone or it was changed meanwhile
+	var count int64
+	if err := tx.Model(row).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return shared.ErrNotFound
+	}
+
+	return shared.ErrOptimisticLock
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/view/entity"
+	"todolist/internal/domain/view/repository"
+	"todolist/internal/infrastructure/database/mapper"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+)
+
+// viewRepository implements repository.ViewRepository
+type viewRepository struct {
+	db     *gorm.DB
+	mapper *mapper.ViewMapper
+}
+
+// NewViewRepository creates a new view repository
+func NewViewRepository(db *gorm.DB) repository.ViewRepository {
+	return &viewRepository{
+		db:     db,
+		mapper: mapper.NewViewMapper(),
+	}
+}
+
+// Save saves or updates a view
+func (r *viewRepository) Save(ctx context.Context, view *entity.View) error {
+	viewModel, err := r.mapper.ToModel(view)
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Save(viewModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return shared.ErrDuplicateEntry
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Delete removes a view by ID
+func (r *viewRepository) Delete(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Delete(&model.SavedView{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindByID finds a view by ID
+func (r *viewRepository) FindByID(ctx context.Context, id int64) (*entity.View, error) {
+	viewModel := &model.SavedView{}
+
+	if err := r.db.WithContext(ctx).First(viewModel, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.mapper.ToDomain(viewModel)
+}
+
+// FindByUser returns the views saved by a user ordered by name
+func (r *viewRepository) FindByUser(ctx context.Context, userID int64) ([]*entity.View, error) {
+	var viewModels []*model.SavedView
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&viewModels).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(viewModels)
+}
@@ -15,12 +15,19 @@ package config
 var _ ApplicationProvider = (*application)(nil)
 
 type application struct {
-	Name        string     `mapstructure:"name"`
-	Description string     `mapstructure:"description"`
-	Version     string     `mapstructure:"version"`
-	LogLevel    string     `mapstructure:"log_level"`
-	Web         *webConfig `mapstructure:"web"`
-	JWT         *jwtConfig `mapstructure:"jwt"`
+	Name        string             `mapstructure:"name"`
+	Description string             `mapstructure:"description"`
+	Version     string             `mapstructure:"version"`
+	LogLevel    string             `mapstructure:"log_level"`
+	Web         *webConfig         `mapstructure:"web"`
+	JWT         *jwtConfig         `mapstructure:"jwt"`
+	RBAC        *rbacConfig        `mapstructure:"rbac"`
+	Trash       *trashConfig       `mapstructure:"trash"`
+	IDGenerator *idGeneratorConfig `mapstructure:"id_generator"`
+	Idempotency *idempotencyConfig `mapstructure:"idempotency"`
+	Pagination  *paginationConfig  `mapstructure:"pagination"`
+	Search      *searchConfig      `mapstructure:"search"`
+	Ordering    *orderingConfig    `mapstructure:"ordering"`
 }
 
 // GetName returns the name of the application.
@@ -40,3 +47,24 @@ func (a application) GetWeb() WebConfigProvider { return a.Web }
 
 // GetJWT implements ApplicationProvider.
 func (a application) GetJWT() JWTConfigProvider { return a.JWT }
+
+// GetRBAC implements ApplicationProvider.
+func (a application) GetRBAC() RBACConfigProvider { return a.RBAC }
+
+// GetTrash implements ApplicationProvider.
+func (a application) GetTrash() TrashConfigProvider { return a.Trash }
+
+// GetIDGenerator implements ApplicationProvider.
+func (a application) GetIDGenerator() IDGeneratorConfigProvider { return a.IDGenerator }
+
+// GetIdempotency implements ApplicationProvider.
+func (a application) GetIdempotency() IdempotencyConfigProvider { return a.Idempotency }
+
+// GetPagination implements ApplicationProvider.
+func (a application) GetPagination() PaginationConfigProvider { return a.Pagination }
+
+// GetSearch implements ApplicationProvider.
+func (a application) GetSearch() SearchConfigProvider { return a.Search }
+
+// GetOrdering implements ApplicationProvider.
+func (a application) GetOrdering() OrderingConfigProvider { return a.Ordering }
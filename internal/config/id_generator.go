@@ -0,0 +1,48 @@
+package config
+
+/*
+ * id_generator.go
+ *
+ * This file defines configuration settings for the generation of entity IDs.
+ *
+ * The snowflake strategy generates time ordered IDs in memory, each instance
+ * must be given its own node ID. The sequence strategy reserves blocks of IDs
+ * from a database table shared by all instances.
+ */
+
+const (
+	IDStrategySnowflake = "snowflake"
+	IDStrategySequence  = "sequence"
+)
+
+var _ IDGeneratorConfigProvider = (*idGeneratorConfig)(nil)
+
+type idGeneratorConfig struct {
+	Strategy  string `mapstructure:"strategy"`   // snowflake or sequence
+	NodeID    int64  `mapstructure:"node_id"`    // Node ID of this instance for snowflake IDs, 0 to 1023
+	BlockSize int64  `mapstructure:"block_size"` // IDs reserved at once from the sequence
+}
+
+// GetStrategy implements IDGeneratorConfigProvider.
+func (i *idGeneratorConfig) GetStrategy() string {
+	if i == nil || i.Strategy == "" {
+		return IDStrategySnowflake
+	}
+	return i.Strategy
+}
+
+// GetNodeID implements IDGeneratorConfigProvider.
+func (i *idGeneratorConfig) GetNodeID() int64 {
+	if i == nil {
+		return 0
+	}
+	return i.NodeID
+}
+
+// GetBlockSize implements IDGeneratorConfigProvider.
+func (i *idGeneratorConfig) GetBlockSize() int64 {
+	if i == nil {
+		return 0
+	}
+	return i.BlockSize
+}
@@ -0,0 +1,41 @@
+package config
+
+import "time"
+
+/*
+ * idempotency.go
+ *
+ * This file defines configuration settings for idempotency keys.
+ *
+ * Responses of requests sent with an Idempotency-Key header are kept for the
+ * TTL and replayed to retries. The memory store only suits a single instance,
+ * the database store is shared by all instances.
+ */
+
+const (
+	IdempotencyStoreMemory   = "memory"
+	IdempotencyStoreDatabase = "database"
+)
+
+var _ IdempotencyConfigProvider = (*idempotencyConfig)(nil)
+
+type idempotencyConfig struct {
+	Store string        `mapstructure:"store"` // memory or database
+	TTL   time.Duration `mapstructure:"ttl"`   // Time responses are kept for retries
+}
+
+// GetStore implements IdempotencyConfigProvider.
+func (i *idempotencyConfig) GetStore() string {
+	if i == nil || i.Store == "" {
+		return IdempotencyStoreMemory
+	}
+	return i.Store
+}
+
+// GetTTL implements IdempotencyConfigProvider.
+func (i *idempotencyConfig) GetTTL() time.Duration {
+	if i == nil {
+		return 0
+	}
+	return i.TTL
+}
@@ -30,12 +30,19 @@ type ConfigProvider interface {
 
 // ApplicationProvider represents the main application configuration.
 type ApplicationProvider interface {
-	GetName() string           // Name of the application
-	GetDescription() string    // Description of the application
-	GetVersion() string        // Version of the application
-	GetLogLevel() string       // Log level (e.g., "debug", "info", "warn", "error")
-	GetWeb() WebConfigProvider // Web server settings
-	GetJWT() JWTConfigProvider // JWT settings
+	GetName() string                           // Name of the application
+	GetDescription() string                    // Description of the application
+	GetVersion() string                        // Version of the application
+	GetLogLevel() string                       // Log level (e.g., "debug", "info", "warn", "error")
+	GetWeb() WebConfigProvider                 // Web server settings
+	GetJWT() JWTConfigProvider                 // JWT settings
+	GetRBAC() RBACConfigProvider               // Role based access control settings
+	GetTrash() TrashConfigProvider             // Todo trash settings
+	GetIDGenerator() IDGeneratorConfigProvider // Entity ID generation settings
+	GetIdempotency() IdempotencyConfigProvider // Idempotency key settings
+	GetPagination() PaginationConfigProvider   // Listing pagination settings
+	GetSearch() SearchConfigProvider           // Full-text search settings
+	GetOrdering() OrderingConfigProvider       // Manual todo order settings
 	// GetOIDC() OIDCConfigProvider // OIDC settings
 }
 
@@ -55,9 +62,55 @@ type WebConfigProvider interface {
 
 // JWTConfigProvider defines the configuration for the jwt
 type JWTConfigProvider interface {
-	GetSecretKey() string
-	GetExpirationTime() time.Duration
-	GetRefreshExpirationTime() time.Duration
+	GetAlgorithm() string                    // Signing algorithm (HS256, RS256, ES256, EdDSA)
+	GetSecretKey() string                    // Shared secret used by HS256
+	GetKeysDir() string                      // Directory of PEM private keys for asymmetric algorithms
+	GetRotationInterval() time.Duration      // Interval between key rotations (zero disables rotation)
+	GetGracePeriod() time.Duration           // Time rotated keys remain valid for validation
+	GetExpirationTime() time.Duration        // Access token expiration
+	GetRefreshExpirationTime() time.Duration // Refresh token expiration
+
+	GetImpersonationExpirationTime() time.Duration // Maximum lifetime of impersonation tokens
+}
+
+// RBACConfigProvider defines the configuration for role based access control
+type RBACConfigProvider interface {
+	GetRoles() map[string][]string // Permissions granted to each role, empty to use the built-in roles
+}
+
+// TrashConfigProvider defines the configuration for the todo trash
+type TrashConfigProvider interface {
+	GetRetentionDays() int           // Days deleted todos are kept before being purged, zero keeps them forever
+	GetPurgeInterval() time.Duration // Interval between purge runs
+}
+
+// IDGeneratorConfigProvider defines the configuration for the generation of entity IDs
+type IDGeneratorConfigProvider interface {
+	GetStrategy() string // Generation strategy, snowflake or sequence
+	GetNodeID() int64    // Node ID of this instance for snowflake IDs
+	GetBlockSize() int64 // IDs reserved at once from the database sequence
+}
+
+// IdempotencyConfigProvider defines the configuration for idempotency keys
+type IdempotencyConfigProvider interface {
+	GetStore() string      // Store of the keys, memory or database
+	GetTTL() time.Duration // Time responses are kept for retries, zero uses the default
+}
+
+// PaginationConfigProvider defines the configuration for the pagination of listings
+type PaginationConfigProvider interface {
+	GetCursorSecret() string // Secret signing pagination cursors, random when empty
+}
+
+// OrderingConfigProvider defines the configuration for the manual order of todos
+type OrderingConfigProvider interface {
+	GetRebalanceInterval() time.Duration // Interval between rebalancing runs
+	GetMaxPositionLength() int           // Length past which positions are spread evenly again, zero uses the default
+}
+
+// SearchConfigProvider defines the configuration for the full-text search of todos
+type SearchConfigProvider interface {
+	GetDefaultLanguage() string // Search language of users who have not chosen one, english or portuguese
 }
 
 // DatabaseServiceProvider defines the interface for a database service
@@ -7,7 +7,8 @@ import "time"
  *
  * This file defines configuration settings for JWT token provider.
  *
- * Examples include the secret key and expiration time.
+ * Examples include the signing algorithm, the secret key or key files,
+ * key rotation and expiration time.
  *
  * These settings enable your application to generate JWT tokens.
  */
@@ -15,16 +16,39 @@ import "time"
 var _ JWTConfigProvider = (*jwtConfig)(nil)
 
 type jwtConfig struct {
-	SecretKey             string        `mapstructure:"secret_key"`
+	Algorithm             string        `mapstructure:"algorithm"`         // HS256, RS256, ES256 or EdDSA
+	SecretKey             string        `mapstructure:"secret_key"`        // Shared secret used by HS256
+	KeysDir               string        `mapstructure:"keys_dir"`          // Directory of PEM private keys used by asymmetric algorithms
+	RotationInterval      time.Duration `mapstructure:"rotation_interval"` // Interval between key rotations, zero disables rotation
+	GracePeriod           time.Duration `mapstructure:"grace_period"`      // Time rotated keys remain valid for validation
 	ExpirationTime        time.Duration `mapstructure:"expiration_time"`
 	RefreshExpirationTime time.Duration `mapstructure:"refresh_expiration_time"`
+
+	ImpersonationExpirationTime time.Duration `mapstructure:"impersonation_expiration_time"` // Maximum lifetime of impersonation tokens
 }
 
+// GetAlgorithm implements JWTConfigProvider.
+func (j *jwtConfig) GetAlgorithm() string { return j.Algorithm }
+
+// GetKeysDir implements JWTConfigProvider.
+func (j *jwtConfig) GetKeysDir() string { return j.KeysDir }
+
+// GetRotationInterval implements JWTConfigProvider.
+func (j *jwtConfig) GetRotationInterval() time.Duration { return j.RotationInterval }
+
+// GetGracePeriod implements JWTConfigProvider.
+func (j *jwtConfig) GetGracePeriod() time.Duration { return j.GracePeriod }
+
 // GetExpirationTime implements JWTConfigProvider.
 func (j *jwtConfig) GetExpirationTime() time.Duration { return j.ExpirationTime }
 
 // GetRefreshExpiration implements JWTConfigProvider.
 func (j *jwtConfig) GetRefreshExpirationTime() time.Duration { return j.RefreshExpirationTime }
 
+// GetImpersonationExpirationTime implements JWTConfigProvider.
+func (j *jwtConfig) GetImpersonationExpirationTime() time.Duration {
+	return j.ImpersonationExpirationTime
+}
+
 // GetSecretKey implements JWTConfigProvider.
 func (j *jwtConfig) GetSecretKey() string { return j.SecretKey }
@@ -0,0 +1,36 @@
+package config
+
+import "time"
+
+/*
+ * ordering.go
+ *
+ * This file defines configuration settings for the manual order of todos.
+ *
+ * Each todo has a position in the order chosen by its user. Positions get longer
+ * as todos are moved around, so a background job periodically spreads them evenly
+ * again once they grow past the maximum length.
+ */
+
+var _ OrderingConfigProvider = (*orderingConfig)(nil)
+
+type orderingConfig struct {
+	RebalanceInterval time.Duration `mapstructure:"rebalance_interval"`  // Interval between rebalancing runs
+	MaxPositionLength int           `mapstructure:"max_position_length"` // Length past which the positions are rebalanced
+}
+
+// GetRebalanceInterval implements OrderingConfigProvider.
+func (o *orderingConfig) GetRebalanceInterval() time.Duration {
+	if o == nil {
+		return 0
+	}
+	return o.RebalanceInterval
+}
+
+// GetMaxPositionLength implements OrderingConfigProvider.
+func (o *orderingConfig) GetMaxPositionLength() int {
+	if o == nil {
+		return 0
+	}
+	return o.MaxPositionLength
+}
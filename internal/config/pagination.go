@@ -0,0 +1,25 @@
+package config
+
+/*
+ * pagination.go
+ *
+ * This file defines configuration settings for the pagination of listings.
+ *
+ * Cursors returned by listings are signed with the cursor secret. When it is
+ * not set a random secret is used, so cursors are only accepted by the instance
+ * that issued them and until it restarts.
+ */
+
+var _ PaginationConfigProvider = (*paginationConfig)(nil)
+
+type paginationConfig struct {
+	CursorSecret string `mapstructure:"cursor_secret"` // Secret signing the pagination cursors
+}
+
+// GetCursorSecret implements PaginationConfigProvider.
+func (p *paginationConfig) GetCursorSecret() string {
+	if p == nil {
+		return ""
+	}
+	return p.CursorSecret
+}
@@ -0,0 +1,26 @@
+package config
+
+/*
+ * rbac.go
+ *
+ * This file defines configuration settings for role based access control.
+ *
+ * Roles map a role name to its permission expressions in the form
+ * "resource:action[:scope]", e.g. "todo:update:own" or "*".
+ *
+ * Configured roles replace the built-in ones and can not be changed through the API.
+ */
+
+var _ RBACConfigProvider = (*rbacConfig)(nil)
+
+type rbacConfig struct {
+	Roles map[string][]string `mapstructure:"roles"` // Permissions granted to each role
+}
+
+// GetRoles implements RBACConfigProvider.
+func (r *rbacConfig) GetRoles() map[string][]string {
+	if r == nil {
+		return nil
+	}
+	return r.Roles
+}
@@ -0,0 +1,24 @@
+package config
+
+/*
+ * search.go
+ *
+ * This file defines configuration settings for the full-text search of todos.
+ *
+ * Todos are searched with the text search configuration of the language chosen
+ * by each user, or with the default language when the user has not chosen one.
+ */
+
+var _ SearchConfigProvider = (*searchConfig)(nil)
+
+type searchConfig struct {
+	DefaultLanguage string `mapstructure:"default_language"` // Language of users without a search language
+}
+
+// GetDefaultLanguage implements SearchConfigProvider.
+func (s *searchConfig) GetDefaultLanguage() string {
+	if s == nil {
+		return ""
+	}
+	return s.DefaultLanguage
+}
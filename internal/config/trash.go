@@ -0,0 +1,35 @@
+package config
+
+import "time"
+
+/*
+ * trash.go
+ *
+ * This file defines configuration settings for the todo trash.
+ *
+ * Deleted todos stay in the trash, where they can be restored, for the retention
+ * period. A background job permanently purges them once the period is over.
+ */
+
+var _ TrashConfigProvider = (*trashConfig)(nil)
+
+type trashConfig struct {
+	RetentionDays int           `mapstructure:"retention_days"` // Days deleted todos are kept, zero keeps them forever
+	PurgeInterval time.Duration `mapstructure:"purge_interval"` // Interval between purge runs
+}
+
+// GetRetentionDays implements TrashConfigProvider.
+func (t *trashConfig) GetRetentionDays() int {
+	if t == nil {
+		return 0
+	}
+	return t.RetentionDays
+}
+
+// GetPurgeInterval implements TrashConfigProvider.
+func (t *trashConfig) GetPurgeInterval() time.Duration {
+	if t == nil {
+		return 0
+	}
+	return t.PurgeInterval
+}
@@ -1,57 +1,178 @@
 package di
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"go.uber.org/fx"
 
 	"todolist/internal/adapter/auth"
+	"todolist/internal/adapter/notification"
 	"todolist/internal/config"
+	rptAudit "todolist/internal/domain/audit/repository"
 	rptTodo "todolist/internal/domain/user/repository"
 	"todolist/internal/service"
+	pkgAuth "todolist/pkg/auth"
+	"todolist/pkg/logger"
 )
 
+// keyRotationCheckInterval is how often the signing key age is checked
+const keyRotationCheckInterval = time.Hour
+
 // ApplicationServiceParams defines the dependencies required to create services
 type ApplicationServiceParams struct {
 	fx.In
 	UserRepository      rptTodo.UserRepository
 	UserQueryRepository rptTodo.UserQueryRepository
+	SessionRepository   rptTodo.SessionRepository
+	RoleRepository      rptTodo.RoleRepository
+	AuditLogRepository  rptAudit.AuditLogRepository
 	AppConfig           config.ApplicationProvider
+	Log                 logger.ExtendedLog
 }
 
 // ApplicationServiceContainer provides all service implementations
 type ApplicationServiceContainer struct {
 	fx.Out
-	UserSecurityService service.UserSecurityService
-	TokenService        service.TokenService
+	AuthorizationService service.AuthorizationService
+	UserSecurityService  service.UserSecurityService
+	TokenService         service.TokenService
+	SessionService       service.SessionService
+	NotificationService  service.NotificationService
+	ImpersonationService service.ImpersonationService
+	KeyRotator           *pkgAuth.KeyRotator
 }
 
 // NewApplicationServices creates all service implementations
 func NewApplicationServices(p ApplicationServiceParams) (ApplicationServiceContainer, error) {
-	jwtConfig := p.AppConfig.GetJWT()
-	secretKey := jwtConfig.GetSecretKey()
-	accessDuration := jwtConfig.GetExpirationTime()
-	refreshDuration := jwtConfig.GetRefreshExpirationTime()
+	tokenService, keyRotator, err := newTokenService(p.AppConfig.GetJWT())
+	if err != nil {
+		return ApplicationServiceContainer{}, fmt.Errorf("failed to initialize token service: %w", err)
+	}
 
-	// Create JWT token service using the adapter
-	tokenService, err := auth.NewJWTTokenAdapter(
-		secretKey,
-		accessDuration,
-		refreshDuration,
+	authorizationService, err := service.NewAuthorizationService(
+		p.UserRepository,
+		p.RoleRepository,
+		p.AppConfig.GetRBAC().GetRoles(),
 	)
 	if err != nil {
-		return ApplicationServiceContainer{}, fmt.Errorf("failed to initialize token service: %w", err)
+		return ApplicationServiceContainer{}, fmt.Errorf("failed to initialize authorization service: %w", err)
 	}
 
+	notificationService := notification.NewLogNotifier(p.Log)
+
 	return ApplicationServiceContainer{
-		UserSecurityService: service.NewUserSecurityService(p.UserRepository, p.UserQueryRepository),
-		TokenService:        tokenService,
+		AuthorizationService: authorizationService,
+		UserSecurityService:  service.NewUserSecurityService(p.UserRepository, p.UserQueryRepository, authorizationService),
+		TokenService:         tokenService,
+		SessionService:       service.NewSessionService(p.SessionRepository, tokenService, authorizationService),
+		NotificationService:  notificationService,
+		ImpersonationService: service.NewImpersonationService(
+			p.UserRepository,
+			p.AuditLogRepository,
+			tokenService,
+			authorizationService,
+			notificationService,
+			p.AppConfig.GetJWT().GetImpersonationExpirationTime(),
+		),
+		KeyRotator: keyRotator,
 	}, nil
 }
 
+// newTokenService creates the JWT token service for the configured algorithm.
+// Asymmetric algorithms load their keys from the keys directory, generating one on first boot,
+// and return a key rotator when rotation is enabled.
+func newTokenService(jwtConfig config.JWTConfigProvider) (service.TokenService, *pkgAuth.KeyRotator, error) {
+	accessDuration := jwtConfig.GetExpirationTime()
+	refreshDuration := jwtConfig.GetRefreshExpirationTime()
+
+	algorithm, err := pkgAuth.ParseSigningAlgorithm(jwtConfig.GetAlgorithm())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create JWT token service using the adapter
+	if !algorithm.IsAsymmetric() {
+		tokenService, err := auth.NewJWTTokenAdapter(jwtConfig.GetSecretKey(), accessDuration, refreshDuration)
+		return tokenService, nil, err
+	}
+
+	keys, err := pkgAuth.LoadOrCreateKeySet(jwtConfig.GetKeysDir(), algorithm, jwtConfig.GetGracePeriod())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokenService, err := auth.NewJWTTokenAdapterWithKeys(keys, accessDuration, refreshDuration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if jwtConfig.GetRotationInterval() <= 0 {
+		return tokenService, nil, nil
+	}
+
+	keyRotator, err := pkgAuth.NewKeyRotator(keys, algorithm, jwtConfig.GetRotationInterval(), jwtConfig.GetKeysDir())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokenService, keyRotator, nil
+}
+
+// KeyRotationParams defines the dependencies required to run the key rotation
+type KeyRotationParams struct {
+	fx.In
+	Context    context.Context
+	WaitGroup  *sync.WaitGroup
+	KeyRotator *pkgAuth.KeyRotator
+	Log        logger.ExtendedLog
+}
+
+// keyRotationLifecycle starts the signing key rotation when it is enabled
+func keyRotationLifecycle(lc fx.Lifecycle, params KeyRotationParams) {
+	if params.KeyRotator == nil {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			params.WaitGroup.Add(1)
+			go runKeyRotation(params)
+			return nil
+		},
+	})
+}
+
+// runKeyRotation periodically rotates the signing key until the application context is cancelled
+func runKeyRotation(params KeyRotationParams) {
+	defer params.WaitGroup.Done()
+
+	ticker := time.NewTicker(min(keyRotationCheckInterval, params.KeyRotator.Interval()))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-params.Context.Done():
+			return
+		case now := <-ticker.C:
+			key, err := params.KeyRotator.RotateIfDue(now)
+			if err != nil {
+				params.Log.Failure(fmt.Sprintf("JWT signing key rotation failed: %v", err))
+				continue
+			}
+			if key != nil {
+				params.Log.Info(fmt.Sprintf("JWT signing key rotated, new key ID: %s", key.ID()))
+			}
+		}
+	}
+}
+
 // ApplicationServicesModule returns the fx module with all service dependencies
 func ApplicationServicesModule() fx.Option {
 	return fx.Module("domain_services",
 		fx.Provide(NewApplicationServices),
+		fx.Invoke(keyRotationLifecycle),
 	)
 }
@@ -4,7 +4,12 @@ import (
 	"context"
 	"fmt"
 	"todolist/internal/config"
+	"todolist/internal/domain/shared"
 	infraDB "todolist/internal/infrastructure/database"
+	"todolist/internal/infrastructure/database/model"
+	"todolist/internal/infrastructure/idempotency"
+	"todolist/internal/infrastructure/idgen"
+	"todolist/internal/service"
 	"todolist/pkg/database"
 	"todolist/pkg/logger"
 
@@ -105,10 +110,6 @@ func NewDatabases(p DatabaseParams, lc fx.Lifecycle) (DatabaseContainer, error)
 				return fmt.Errorf("default database migration failed: %w", err)
 			}
 
-			if err := infraDB.SeedDefault(container.DefaultDatabase); err != nil {
-				return fmt.Errorf("default database seeding failed: %w", err)
-			}
-
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
@@ -122,9 +123,64 @@ func NewDatabases(p DatabaseParams, lc fx.Lifecycle) (DatabaseContainer, error)
 	return container, nil
 }
 
+// SeedParams defines the dependencies required to seed the databases
+type SeedParams struct {
+	fx.In
+	DefaultDatabase *gorm.DB
+	IDGenerator     shared.IDGenerator
+}
+
+// SeedDatabases seeds the databases once they are migrated.
+//
+// Seeding is registered apart from NewDatabases because the ID generator may
+// itself depend on the database.
+func SeedDatabases(p SeedParams, lc fx.Lifecycle) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := infraDB.SeedDefault(ctx, p.DefaultDatabase, p.IDGenerator); err != nil {
+				return fmt.Errorf("default database seeding failed: %w", err)
+			}
+			return nil
+		},
+	})
+}
+
+// NewIDGenerator creates the entity ID generator of the configured strategy
+func NewIDGenerator(appConfig config.ApplicationProvider, db *gorm.DB) (shared.IDGenerator, error) {
+	idConfig := appConfig.GetIDGenerator()
+
+	switch idConfig.GetStrategy() {
+	case config.IDStrategySnowflake:
+		return idgen.NewSnowflakeGenerator(idConfig.GetNodeID())
+	case config.IDStrategySequence:
+		return idgen.NewSequenceGenerator(db, model.EntityIDSequence, idConfig.GetBlockSize()), nil
+	default:
+		return nil, fmt.Errorf("unknown ID generator strategy: %s", idConfig.GetStrategy())
+	}
+}
+
+// NewIdempotencyStore creates the idempotency key store of the configured kind
+func NewIdempotencyStore(appConfig config.ApplicationProvider, db *gorm.DB) (service.IdempotencyStore, error) {
+	store := appConfig.GetIdempotency().GetStore()
+
+	switch store {
+	case config.IdempotencyStoreMemory:
+		return idempotency.NewMemoryStore(), nil
+	case config.IdempotencyStoreDatabase:
+		return idempotency.NewDatabaseStore(db), nil
+	default:
+		return nil, fmt.Errorf("unknown idempotency store: %s", store)
+	}
+}
+
 // DatabasesModule returns the fx module with all database dependencies
 func DatabasesModule() fx.Option {
 	return fx.Module("databases",
-		fx.Provide(NewDatabases),
+		fx.Provide(
+			NewDatabases,
+			NewIDGenerator,
+			NewIdempotencyStore,
+		),
+		fx.Invoke(SeedDatabases),
 	)
 }
@@ -3,9 +3,16 @@ package di
 import (
 	"todolist/internal/adapter/delivery/http/handler"
 	"todolist/internal/config"
+	"todolist/internal/service"
+	ucAudit "todolist/internal/usecase/audit"
+	ucBoard "todolist/internal/usecase/board"
+	ucCustomField "todolist/internal/usecase/customfield"
 	ucPerson "todolist/internal/usecase/person"
+	ucProject "todolist/internal/usecase/project"
+	ucTimeTracking "todolist/internal/usecase/timetracking"
 	ucTodo "todolist/internal/usecase/todo"
 	ucUser "todolist/internal/usecase/user"
+	ucView "todolist/internal/usecase/view"
 
 	"go.uber.org/fx"
 )
@@ -16,33 +23,120 @@ type HttpHandlerParams struct {
 	// Application configuration
 	AppConfig config.ApplicationProvider
 
+	// Application Services
+	TokenService service.TokenService
+
 	// Person Use Cases
 	CreatePersonUseCase ucPerson.CreatePersonUseCase
 	UpdatePersonUseCase ucPerson.UpdatePersonUseCase
 	GetPersonUseCase    ucPerson.GetPersonUseCase
 
 	// User Use Cases
-	ChangePasswordUseCase ucUser.ChangePasswordUseCase
-	CreateUserUseCase     ucUser.CreateUserUseCase
-	LoginUseCase          ucUser.LoginUseCase
+	ChangePasswordUseCase      ucUser.ChangePasswordUseCase
+	CreateUserUseCase          ucUser.CreateUserUseCase
+	LoginUseCase               ucUser.LoginUseCase
+	RefreshTokenUseCase        ucUser.RefreshTokenUseCase
+	ListSessionsUseCase        ucUser.ListSessionsUseCase
+	RevokeSessionUseCase       ucUser.RevokeSessionUseCase
+	RevokeOtherSessionsUseCase ucUser.RevokeOtherSessionsUseCase
+	GetPreferencesUseCase      ucUser.GetPreferencesUseCase
+	UpdatePreferencesUseCase   ucUser.UpdatePreferencesUseCase
+
+	// Role Use Cases
+	ListRolesUseCase  ucUser.ListRolesUseCase
+	CreateRoleUseCase ucUser.CreateRoleUseCase
+	UpdateRoleUseCase ucUser.UpdateRoleUseCase
+	DeleteRoleUseCase ucUser.DeleteRoleUseCase
+
+	// User Administration Use Cases
+	ListUsersUseCase          ucUser.ListUsersUseCase
+	ChangeUserRoleUseCase     ucUser.ChangeUserRoleUseCase
+	ChangeUserStatusUseCase   ucUser.ChangeUserStatusUseCase
+	ForcePasswordResetUseCase ucUser.ForcePasswordResetUseCase
+	ListLoginAttemptsUseCase  ucUser.ListLoginAttemptsUseCase
+	ListUserAuditTrailUseCase ucUser.ListUserAuditTrailUseCase
+	ImpersonateUserUseCase    ucUser.ImpersonateUserUseCase
+
+	// Audit Use Cases
+	ListAuditLogsUseCase ucAudit.ListAuditLogsUseCase
 
 	// Todo Use Cases
-	CompleteTodoUseCase  ucTodo.CompleteTodoUseCase
-	CreateTodoUseCase    ucTodo.CreateTodoUseCase
-	DeleteTodoUseCase    ucTodo.DeleteTodoUseCase
-	GetStatisticsUseCase ucTodo.GetStatisticsUseCase
-	GetTodoUseCase       ucTodo.GetTodoUseCase
-	ListTodoUseCase      ucTodo.ListTodosUseCase
-	UpdateTodoUseCase    ucTodo.UpdateTodoUseCase
+	CompleteTodoUseCase   ucTodo.CompleteTodoUseCase
+	CreateTodoUseCase     ucTodo.CreateTodoUseCase
+	DeleteTodoUseCase     ucTodo.DeleteTodoUseCase
+	GetStatisticsUseCase  ucTodo.GetStatisticsUseCase
+	GetTodoUseCase        ucTodo.GetTodoUseCase
+	ListTodoUseCase       ucTodo.ListTodosUseCase
+	UpdateTodoUseCase     ucTodo.UpdateTodoUseCase
+	PatchTodoUseCase      ucTodo.PatchTodoUseCase
+	GetTodoHistoryUseCase ucTodo.GetTodoHistoryUseCase
+	RevertTodoUseCase     ucTodo.RevertTodoUseCase
+	UndoTodoUseCase       ucTodo.UndoTodoUseCase
+	ListTrashUseCase      ucTodo.ListTrashUseCase
+	RestoreTodoUseCase    ucTodo.RestoreTodoUseCase
+	EmptyTrashUseCase     ucTodo.EmptyTrashUseCase
+	BulkTodoUseCase       ucTodo.BulkTodoUseCase
+	SearchTodosUseCase    ucTodo.SearchTodosUseCase
+	MoveTodoUseCase       ucTodo.MoveTodoUseCase
+
+	// View Use Cases
+	ListViewsUseCase     ucView.ListViewsUseCase
+	GetViewUseCase       ucView.GetViewUseCase
+	CreateViewUseCase    ucView.CreateViewUseCase
+	UpdateViewUseCase    ucView.UpdateViewUseCase
+	DeleteViewUseCase    ucView.DeleteViewUseCase
+	ListViewTodosUseCase ucView.ListViewTodosUseCase
+
+	// Board Use Cases
+	ListBoardsUseCase    ucBoard.ListBoardsUseCase
+	GetBoardUseCase      ucBoard.GetBoardUseCase
+	CreateBoardUseCase   ucBoard.CreateBoardUseCase
+	UpdateBoardUseCase   ucBoard.UpdateBoardUseCase
+	DeleteBoardUseCase   ucBoard.DeleteBoardUseCase
+	MoveBoardTodoUseCase ucBoard.MoveBoardTodoUseCase
+
+	// Project Use Cases
+	ListProjectsUseCase  ucProject.ListProjectsUseCase
+	GetProjectUseCase    ucProject.GetProjectUseCase
+	CreateProjectUseCase ucProject.CreateProjectUseCase
+	UpdateProjectUseCase ucProject.UpdateProjectUseCase
+	DeleteProjectUseCase ucProject.DeleteProjectUseCase
+
+	// Time Tracking Use Cases
+	StartTimerUseCase      ucTimeTracking.StartTimerUseCase
+	StopTimerUseCase       ucTimeTracking.StopTimerUseCase
+	GetTimerUseCase        ucTimeTracking.GetTimerUseCase
+	CreateTimeEntryUseCase ucTimeTracking.CreateTimeEntryUseCase
+	ListTimeEntriesUseCase ucTimeTracking.ListTimeEntriesUseCase
+	DeleteTimeEntryUseCase ucTimeTracking.DeleteTimeEntryUseCase
+	GetTimeReportUseCase   ucTimeTracking.GetTimeReportUseCase
+
+	// Custom Field Use Cases
+	ListCustomFieldsUseCase  ucCustomField.ListCustomFieldsUseCase
+	GetCustomFieldUseCase    ucCustomField.GetCustomFieldUseCase
+	CreateCustomFieldUseCase ucCustomField.CreateCustomFieldUseCase
+	UpdateCustomFieldUseCase ucCustomField.UpdateCustomFieldUseCase
+	DeleteCustomFieldUseCase ucCustomField.DeleteCustomFieldUseCase
 }
 
 // HttpHandlerContainer groups all http handlers implementations provide from Fx
 type HttpHandlerContainer struct {
 	fx.Out
-	AuthHandler   *handler.AuthHandler
-	PersonHandler *handler.PersonHandler
-	TodoHandler   *handler.TodoHandler
-	HealthHandler *handler.HealthHandler
+	AuthHandler        *handler.AuthHandler
+	SessionHandler     *handler.SessionHandler
+	PreferencesHandler *handler.PreferencesHandler
+	PersonHandler      *handler.PersonHandler
+	TodoHandler        *handler.TodoHandler
+	HealthHandler      *handler.HealthHandler
+	JWKSHandler        *handler.JWKSHandler
+	RoleHandler        *handler.RoleHandler
+	AdminUserHandler   *handler.AdminUserHandler
+	AuditHandler       *handler.AuditHandler
+	ViewHandler        *handler.ViewHandler
+	BoardHandler       *handler.BoardHandler
+	ProjectHandler     *handler.ProjectHandler
+	TimeEntryHandler   *handler.TimeEntryHandler
+	CustomFieldHandler *handler.CustomFieldHandler
 }
 
 // NewHttpHandlers creates all http handlers implementations
@@ -53,6 +147,17 @@ func NewHttpHandlers(p HttpHandlerParams) HttpHandlerContainer {
 			p.CreatePersonUseCase,
 			p.LoginUseCase,
 			p.ChangePasswordUseCase,
+			p.RefreshTokenUseCase,
+			p.RevokeSessionUseCase,
+		),
+		SessionHandler: handler.NewSessionHandler(
+			p.ListSessionsUseCase,
+			p.RevokeSessionUseCase,
+			p.RevokeOtherSessionsUseCase,
+		),
+		PreferencesHandler: handler.NewPreferencesHandler(
+			p.GetPreferencesUseCase,
+			p.UpdatePreferencesUseCase,
 		),
 		PersonHandler: handler.NewPersonHandler(
 			p.CreatePersonUseCase,
@@ -62,13 +167,80 @@ func NewHttpHandlers(p HttpHandlerParams) HttpHandlerContainer {
 		TodoHandler: handler.NewTodoHandler(
 			p.CreateTodoUseCase,
 			p.UpdateTodoUseCase,
+			p.PatchTodoUseCase,
 			p.CompleteTodoUseCase,
 			p.DeleteTodoUseCase,
 			p.GetTodoUseCase,
 			p.ListTodoUseCase,
 			p.GetStatisticsUseCase,
+			p.GetTodoHistoryUseCase,
+			p.RevertTodoUseCase,
+			p.UndoTodoUseCase,
+			p.ListTrashUseCase,
+			p.RestoreTodoUseCase,
+			p.EmptyTrashUseCase,
+			p.BulkTodoUseCase,
+			p.SearchTodosUseCase,
+			p.MoveTodoUseCase,
+		),
+		HealthHandler: handler.NewHealthHandler(p.AppConfig),
+		JWKSHandler:   handler.NewJWKSHandler(p.TokenService),
+		RoleHandler: handler.NewRoleHandler(
+			p.ListRolesUseCase,
+			p.CreateRoleUseCase,
+			p.UpdateRoleUseCase,
+			p.DeleteRoleUseCase,
+		),
+		AdminUserHandler: handler.NewAdminUserHandler(
+			p.ListUsersUseCase,
+			p.ChangeUserRoleUseCase,
+			p.ChangeUserStatusUseCase,
+			p.ForcePasswordResetUseCase,
+			p.ListLoginAttemptsUseCase,
+			p.ListUserAuditTrailUseCase,
+			p.ImpersonateUserUseCase,
+		),
+		AuditHandler: handler.NewAuditHandler(p.ListAuditLogsUseCase),
+		ViewHandler: handler.NewViewHandler(
+			p.ListViewsUseCase,
+			p.GetViewUseCase,
+			p.CreateViewUseCase,
+			p.UpdateViewUseCase,
+			p.DeleteViewUseCase,
+			p.ListViewTodosUseCase,
+		),
+		BoardHandler: handler.NewBoardHandler(
+			p.ListBoardsUseCase,
+			p.GetBoardUseCase,
+			p.CreateBoardUseCase,
+			p.UpdateBoardUseCase,
+			p.DeleteBoardUseCase,
+			p.MoveBoardTodoUseCase,
+		),
+		ProjectHandler: handler.NewProjectHandler(
+			p.ListProjectsUseCase,
+			p.GetProjectUseCase,
+			p.CreateProjectUseCase,
+			p.UpdateProjectUseCase,
+			p.DeleteProjectUseCase,
+		),
+		TimeEntryHandler: handler.NewTimeEntryHandler(
+			p.StartTimerUseCase,
+			p.StopTimerUseCase,
+			p.GetTimerUseCase,
+			p.CreateTimeEntryUseCase,
+			p.ListTimeEntriesUseCase,
+			p.DeleteTimeEntryUseCase,
+			p.GetTimeReportUseCase,
+		),
+		CustomFieldHandler: handler.NewCustomFieldHandler(
+			p.ListCustomFieldsUseCase,
+			p.GetCustomFieldUseCase,
+			p.CreateCustomFieldUseCase,
+			p.UpdateCustomFieldUseCase,
+			p.DeleteCustomFieldUseCase,
 		),
-		HealthHandler: handler.NewHealthHandler(p.AppConfig)}
+	}
 }
 
 // HTTPHandlersModule exports the Fx module that provides all http handlers dependencies
@@ -11,6 +11,7 @@ import (
 	"todolist/internal/adapter/delivery/http/handler"
 	"todolist/internal/adapter/delivery/http/middleware"
 	"todolist/internal/config"
+	voUser "todolist/internal/domain/user/valueobject"
 	"todolist/internal/service"
 	"todolist/pkg/logger"
 	"todolist/pkg/web"
@@ -23,18 +24,36 @@ import (
 	_ "todolist/docs"
 )
 
+// defaultIdempotencyTTL is used when the idempotency key TTL is not configured
+const defaultIdempotencyTTL = 24 * time.Hour
+
 // HTTPServerParams defines the dependencies required to create the HTTP server
 type HTTPServerParams struct {
 	fx.In
-	Context       context.Context
-	WaitGroup     *sync.WaitGroup
-	AuthHandler   *handler.AuthHandler
-	PersonHandler *handler.PersonHandler
-	TodoHandler   *handler.TodoHandler
-	HealthHandler *handler.HealthHandler
-	TokenService  service.TokenService
-	Log           logger.ExtendedLog
-	AppConfig     config.ApplicationProvider
+	Context              context.Context
+	WaitGroup            *sync.WaitGroup
+	AuthHandler          *handler.AuthHandler
+	SessionHandler       *handler.SessionHandler
+	PreferencesHandler   *handler.PreferencesHandler
+	PersonHandler        *handler.PersonHandler
+	TodoHandler          *handler.TodoHandler
+	HealthHandler        *handler.HealthHandler
+	JWKSHandler          *handler.JWKSHandler
+	RoleHandler          *handler.RoleHandler
+	AdminUserHandler     *handler.AdminUserHandler
+	AuditHandler         *handler.AuditHandler
+	ViewHandler          *handler.ViewHandler
+	BoardHandler         *handler.BoardHandler
+	ProjectHandler       *handler.ProjectHandler
+	TimeEntryHandler     *handler.TimeEntryHandler
+	CustomFieldHandler   *handler.CustomFieldHandler
+	TokenService         service.TokenService
+	SessionService       service.SessionService
+	AuthorizationService service.AuthorizationService
+	ImpersonationService service.ImpersonationService
+	IdempotencyStore     service.IdempotencyStore
+	Log                  logger.ExtendedLog
+	AppConfig            config.ApplicationProvider
 }
 
 // HTTPServerContainer provides the HTTP server components
@@ -108,6 +127,9 @@ func setupAppMiddleware(router *gin.Engine, params HTTPServerParams) {
 	// Request logging
 	router.Use(middleware.RequestLogger(params.Log))
 
+	// Client information for the audit log
+	router.Use(middleware.AuditContext())
+
 	// CORS
 	if corsHeaders := webConfig.GetCORS(); len(corsHeaders) > 0 {
 		router.Use(middleware.CORS(corsHeaders))
@@ -127,8 +149,12 @@ func setupAppRoutes(router *gin.Engine, params HTTPServerParams) {
 
 	router.GET("/health", adptHttp.WrapHandler(params.HealthHandler.HealthCheck))
 
+	// Public keys used by other services to validate our tokens
+	router.GET("/.well-known/jwks.json", adptHttp.WrapHandler(params.JWKSHandler.GetJWKS))
+
 	// API v1 routes
-	authMiddleware := middleware.AuthMiddleware(params.TokenService)
+	authMiddleware := middleware.AuthMiddleware(params.TokenService, params.SessionService)
+	forbidImpersonation := middleware.ForbidImpersonation()
 	v1 := router.Group("/api/v1")
 
 	// Authentication routes (public and mixed)
@@ -136,30 +162,147 @@ func setupAppRoutes(router *gin.Engine, params HTTPServerParams) {
 	{
 		auth.POST("/register", adptHttp.WrapHandler(params.AuthHandler.Register))
 		auth.POST("/login", adptHttp.WrapHandler(params.AuthHandler.Login))
-		auth.PUT("/change-password", authMiddleware, adptHttp.WrapHandler(params.AuthHandler.ChangePassword))
+		auth.POST("/refresh", adptHttp.WrapHandler(params.AuthHandler.RefreshToken))
+		auth.POST("/logout", authMiddleware, forbidImpersonation, adptHttp.WrapHandler(params.AuthHandler.Logout))
+		auth.PUT("/change-password", authMiddleware, forbidImpersonation, adptHttp.WrapHandler(params.AuthHandler.ChangePassword))
+
+		// Session and device management
+		sessions := auth.Group("/sessions", authMiddleware, forbidImpersonation)
+		{
+			sessions.GET("", adptHttp.WrapHandler(params.SessionHandler.ListSessions))
+			sessions.DELETE("", adptHttp.WrapHandler(params.SessionHandler.RevokeOtherSessions))
+			sessions.DELETE("/:id", adptHttp.WrapHandler(params.SessionHandler.RevokeSession))
+		}
+
+		// Preferences of the authenticated user
+		auth.GET("/preferences", authMiddleware, adptHttp.WrapHandler(params.PreferencesHandler.GetPreferences))
+		auth.PUT("/preferences", authMiddleware, adptHttp.WrapHandler(params.PreferencesHandler.UpdatePreferences))
 	}
 
 	// Protected routes
-	protected := v1.Group("", authMiddleware)
+	authorize := func(permissions ...voUser.Permission) gin.HandlerFunc {
+		return middleware.RequirePermission(params.AuthorizationService, permissions...)
+	}
+
+	idempotencyTTL := params.AppConfig.GetIdempotency().GetTTL()
+	if idempotencyTTL <= 0 {
+		idempotencyTTL = defaultIdempotencyTTL
+	}
+
+	protected := v1.Group("",
+		authMiddleware,
+		middleware.AuditImpersonation(params.ImpersonationService, params.Log),
+		middleware.Idempotency(params.IdempotencyStore, idempotencyTTL, params.Log),
+	)
 	{
 		// People management
 		people := protected.Group("/people")
 		{
-			people.POST("", adptHttp.WrapHandler(params.PersonHandler.CreatePerson))
-			people.GET("/:id", adptHttp.WrapHandler(params.PersonHandler.GetPerson))
-			people.PUT("/:id", adptHttp.WrapHandler(params.PersonHandler.UpdatePerson))
+			people.POST("", authorize(voUser.PermissionPersonCreate), adptHttp.WrapHandler(params.PersonHandler.CreatePerson))
+			people.GET("/:id", authorize(voUser.PermissionPersonRead), adptHttp.WrapHandler(params.PersonHandler.GetPerson))
+			people.PUT("/:id", authorize(voUser.PermissionPersonUpdate), adptHttp.WrapHandler(params.PersonHandler.UpdatePerson))
 		}
 
 		// Todo management
 		todos := protected.Group("/todos")
 		{
-			todos.POST("", adptHttp.WrapHandler(params.TodoHandler.CreateTodo))
-			todos.GET("", adptHttp.WrapHandler(params.TodoHandler.ListTodos))
-			todos.GET("/statistics", adptHttp.WrapHandler(params.TodoHandler.GetStatistics))
-			todos.GET("/:id", adptHttp.WrapHandler(params.TodoHandler.GetTodo))
-			todos.PUT("/:id", adptHttp.WrapHandler(params.TodoHandler.UpdateTodo))
-			todos.PUT("/:id/complete", adptHttp.WrapHandler(params.TodoHandler.CompleteTodo))
-			todos.DELETE("/:id", adptHttp.WrapHandler(params.TodoHandler.DeleteTodo))
+			todos.POST("", authorize(voUser.PermissionTodoCreate), adptHttp.WrapHandler(params.TodoHandler.CreateTodo))
+			todos.GET("", authorize(voUser.PermissionTodoRead), adptHttp.WrapHandler(params.TodoHandler.ListTodos))
+			todos.GET("/search", authorize(voUser.PermissionTodoRead), adptHttp.WrapHandler(params.TodoHandler.SearchTodos))
+			todos.GET("/statistics", authorize(voUser.PermissionTodoRead), adptHttp.WrapHandler(params.TodoHandler.GetStatistics))
+			todos.GET("/trash", authorize(voUser.PermissionTodoRead), adptHttp.WrapHandler(params.TodoHandler.ListTrash))
+			todos.POST("/bulk", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TodoHandler.BulkTodos))
+			todos.DELETE("/trash", authorize(voUser.PermissionTodoDelete), adptHttp.WrapHandler(params.TodoHandler.EmptyTrash))
+			todos.GET("/:id", authorize(voUser.PermissionTodoRead), adptHttp.WrapHandler(params.TodoHandler.GetTodo))
+			todos.PUT("/:id", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TodoHandler.UpdateTodo))
+			todos.PATCH("/:id", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TodoHandler.PatchTodo))
+			todos.PUT("/:id/complete", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TodoHandler.CompleteTodo))
+			todos.POST("/:id/move", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TodoHandler.MoveTodo))
+			todos.DELETE("/:id", authorize(voUser.PermissionTodoDelete), adptHttp.WrapHandler(params.TodoHandler.DeleteTodo))
+			todos.GET("/:id/history", authorize(voUser.PermissionTodoRead), adptHttp.WrapHandler(params.TodoHandler.GetTodoHistory))
+			todos.POST("/:id/history/:version/revert", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TodoHandler.RevertTodo))
+			todos.POST("/:id/undo", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TodoHandler.UndoTodo))
+			todos.POST("/:id/restore", authorize(voUser.PermissionTodoDelete), adptHttp.WrapHandler(params.TodoHandler.RestoreTodo))
+			todos.GET("/:id/time", authorize(voUser.PermissionTodoRead), adptHttp.WrapHandler(params.TimeEntryHandler.ListTimeEntries))
+			todos.POST("/:id/time", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TimeEntryHandler.CreateTimeEntry))
+			todos.POST("/:id/time/start", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TimeEntryHandler.StartTimer))
+		}
+
+		// Saved views and smart lists
+		views := protected.Group("/views", authorize(voUser.PermissionTodoRead))
+		{
+			views.GET("", adptHttp.WrapHandler(params.ViewHandler.ListViews))
+			views.POST("", adptHttp.WrapHandler(params.ViewHandler.CreateView))
+			views.GET("/:id", adptHttp.WrapHandler(params.ViewHandler.GetView))
+			views.PUT("/:id", adptHttp.WrapHandler(params.ViewHandler.UpdateView))
+			views.DELETE("/:id", adptHttp.WrapHandler(params.ViewHandler.DeleteView))
+			views.GET("/:id/todos", adptHttp.WrapHandler(params.ViewHandler.ListViewTodos))
+		}
+
+		// Kanban boards
+		boards := protected.Group("/boards", authorize(voUser.PermissionTodoRead))
+		{
+			boards.GET("", adptHttp.WrapHandler(params.BoardHandler.ListBoards))
+			boards.POST("", authorize(voUser.PermissionTodoCreate), adptHttp.WrapHandler(params.BoardHandler.CreateBoard))
+			boards.GET("/:id", adptHttp.WrapHandler(params.BoardHandler.GetBoard))
+			boards.PUT("/:id", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.BoardHandler.UpdateBoard))
+			boards.DELETE("/:id", authorize(voUser.PermissionTodoDelete), adptHttp.WrapHandler(params.BoardHandler.DeleteBoard))
+			boards.POST("/:id/move", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.BoardHandler.MoveBoardTodo))
+		}
+
+		// Projects and their workflows
+		projects := protected.Group("/projects", authorize(voUser.PermissionTodoRead))
+		{
+			projects.GET("", adptHttp.WrapHandler(params.ProjectHandler.ListProjects))
+			projects.POST("", authorize(voUser.PermissionTodoCreate), adptHttp.WrapHandler(params.ProjectHandler.CreateProject))
+			projects.GET("/:id", adptHttp.WrapHandler(params.ProjectHandler.GetProject))
+			projects.PUT("/:id", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.ProjectHandler.UpdateProject))
+			projects.DELETE("/:id", authorize(voUser.PermissionTodoDelete), adptHttp.WrapHandler(params.ProjectHandler.DeleteProject))
+		}
+
+		// Time tracking
+		timeTracking := protected.Group("/time", authorize(voUser.PermissionTodoRead))
+		{
+			timeTracking.GET("/current", adptHttp.WrapHandler(params.TimeEntryHandler.GetTimer))
+			timeTracking.POST("/stop", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TimeEntryHandler.StopTimer))
+			timeTracking.DELETE("/entries/:id", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.TimeEntryHandler.DeleteTimeEntry))
+			timeTracking.GET("/report", adptHttp.WrapHandler(params.TimeEntryHandler.GetTimeReport))
+		}
+
+		// Custom fields of todos
+		customFields := protected.Group("/custom-fields", authorize(voUser.PermissionTodoRead))
+		{
+			customFields.GET("", adptHttp.WrapHandler(params.CustomFieldHandler.ListCustomFields))
+			customFields.POST("", authorize(voUser.PermissionTodoCreate), adptHttp.WrapHandler(params.CustomFieldHandler.CreateCustomField))
+			customFields.GET("/:id", adptHttp.WrapHandler(params.CustomFieldHandler.GetCustomField))
+			customFields.PUT("/:id", authorize(voUser.PermissionTodoUpdate), adptHttp.WrapHandler(params.CustomFieldHandler.UpdateCustomField))
+			customFields.DELETE("/:id", authorize(voUser.PermissionTodoDelete), adptHttp.WrapHandler(params.CustomFieldHandler.DeleteCustomField))
+		}
+
+		// Audit log
+		protected.GET("/audit", authorize(voUser.PermissionAuditRead), adptHttp.WrapHandler(params.AuditHandler.ListAuditLogs))
+
+		// Administration
+		admin := protected.Group("/admin")
+		{
+			roles := admin.Group("/roles", authorize(voUser.PermissionRoleManage))
+			{
+				roles.GET("", adptHttp.WrapHandler(params.RoleHandler.ListRoles))
+				roles.POST("", adptHttp.WrapHandler(params.RoleHandler.CreateRole))
+				roles.PUT("/:name", adptHttp.WrapHandler(params.RoleHandler.UpdateRole))
+				roles.DELETE("/:name", adptHttp.WrapHandler(params.RoleHandler.DeleteRole))
+			}
+
+			users := admin.Group("/users")
+			{
+				users.GET("", authorize(voUser.PermissionUserRead), adptHttp.WrapHandler(params.AdminUserHandler.ListUsers))
+				users.GET("/:id/login-attempts", authorize(voUser.PermissionUserRead), adptHttp.WrapHandler(params.AdminUserHandler.ListLoginAttempts))
+				users.GET("/:id/audit", authorize(voUser.PermissionUserRead), adptHttp.WrapHandler(params.AdminUserHandler.ListAuditTrail))
+				users.PUT("/:id/role", authorize(voUser.PermissionUserManage), adptHttp.WrapHandler(params.AdminUserHandler.ChangeUserRole))
+				users.PUT("/:id/status", authorize(voUser.PermissionUserManage), adptHttp.WrapHandler(params.AdminUserHandler.ChangeUserStatus))
+				users.POST("/:id/password-reset", authorize(voUser.PermissionUserManage), adptHttp.WrapHandler(params.AdminUserHandler.ForcePasswordReset))
+				users.POST("/:id/impersonate", forbidImpersonation, authorize(voUser.PermissionUserImpersonate), adptHttp.WrapHandler(params.AdminUserHandler.ImpersonateUser))
+			}
 		}
 	}
 }
@@ -0,0 +1,176 @@
+package di
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"todolist/internal/config"
+	"todolist/internal/service"
+	ucTodo "todolist/internal/usecase/todo"
+	"todolist/pkg/logger"
+
+	"go.uber.org/fx"
+)
+
+// defaultTrashPurgeInterval is used when the trash purge interval is not configured
+const defaultTrashPurgeInterval = time.Hour
+
+// defaultPositionRebalanceInterval is used when the position rebalance interval is not configured
+const defaultPositionRebalanceInterval = time.Hour
+
+// idempotencyPurgeInterval is the interval between purges of expired idempotency keys
+const idempotencyPurgeInterval = time.Hour
+
+// TrashPurgeParams defines the dependencies required to run the trash purge
+type TrashPurgeParams struct {
+	fx.In
+	Context           context.Context
+	WaitGroup         *sync.WaitGroup
+	AppConfig         config.ApplicationProvider
+	PurgeTrashUseCase ucTodo.PurgeTrashUseCase
+	Log               logger.ExtendedLog
+}
+
+// trashPurgeLifecycle starts the trash purge when a retention period is configured
+func trashPurgeLifecycle(lc fx.Lifecycle, params TrashPurgeParams) {
+	if params.AppConfig.GetTrash().GetRetentionDays() <= 0 {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			params.WaitGroup.Add(1)
+			go runTrashPurge(params)
+			return nil
+		},
+	})
+}
+
+// runTrashPurge periodically purges the expired todos from the trash until the application context is cancelled
+func runTrashPurge(params TrashPurgeParams) {
+	defer params.WaitGroup.Done()
+
+	interval := params.AppConfig.GetTrash().GetPurgeInterval()
+	if interval <= 0 {
+		interval = defaultTrashPurgeInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-params.Context.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := params.PurgeTrashUseCase.Execute(params.Context, now)
+			if err != nil {
+				params.Log.Failure(fmt.Sprintf("Trash purge failed: %v", err))
+				continue
+			}
+			if purged > 0 {
+				params.Log.Info(fmt.Sprintf("Trash purge removed %d expired todos", purged))
+			}
+		}
+	}
+}
+
+// PositionRebalanceParams defines the dependencies required to rebalance the manual todo order
+type PositionRebalanceParams struct {
+	fx.In
+	Context                   context.Context
+	WaitGroup                 *sync.WaitGroup
+	AppConfig                 config.ApplicationProvider
+	RebalancePositionsUseCase ucTodo.RebalancePositionsUseCase
+	Log                       logger.ExtendedLog
+}
+
+// positionRebalanceLifecycle starts the rebalance of the manual todo order
+func positionRebalanceLifecycle(lc fx.Lifecycle, params PositionRebalanceParams) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			params.WaitGroup.Add(1)
+			go runPositionRebalance(params)
+			return nil
+		},
+	})
+}
+
+// runPositionRebalance periodically shortens the todo positions grown too long until the application context is cancelled
+func runPositionRebalance(params PositionRebalanceParams) {
+	defer params.WaitGroup.Done()
+
+	interval := params.AppConfig.GetOrdering().GetRebalanceInterval()
+	if interval <= 0 {
+		interval = defaultPositionRebalanceInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-params.Context.Done():
+			return
+		case <-ticker.C:
+			rebalanced, err := params.RebalancePositionsUseCase.Execute(params.Context)
+			if err != nil {
+				params.Log.Failure(fmt.Sprintf("Position rebalance failed: %v", err))
+				continue
+			}
+			if rebalanced > 0 {
+				params.Log.Info(fmt.Sprintf("Position rebalance reordered the todos of %d users", rebalanced))
+			}
+		}
+	}
+}
+
+// IdempotencyPurgeParams defines the dependencies required to run the idempotency key purge
+type IdempotencyPurgeParams struct {
+	fx.In
+	Context          context.Context
+	WaitGroup        *sync.WaitGroup
+	IdempotencyStore service.IdempotencyStore
+	Log              logger.ExtendedLog
+}
+
+// idempotencyPurgeLifecycle starts the purge of expired idempotency keys
+func idempotencyPurgeLifecycle(lc fx.Lifecycle, params IdempotencyPurgeParams) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			params.WaitGroup.Add(1)
+			go runIdempotencyPurge(params)
+			return nil
+		},
+	})
+}
+
+// runIdempotencyPurge periodically removes the expired idempotency keys until the application context is cancelled
+func runIdempotencyPurge(params IdempotencyPurgeParams) {
+	defer params.WaitGroup.Done()
+
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-params.Context.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := params.IdempotencyStore.DeleteExpired(params.Context, now); err != nil {
+				params.Log.Failure(fmt.Sprintf("Idempotency key purge failed: %v", err))
+			}
+		}
+	}
+}
+
+// JobsModule returns the fx module with the background jobs
+func JobsModule() fx.Option {
+	return fx.Module("jobs",
+		fx.Invoke(trashPurgeLifecycle),
+		fx.Invoke(idempotencyPurgeLifecycle),
+		fx.Invoke(positionRebalanceLifecycle),
+	)
+}
@@ -2,9 +2,16 @@ package di
 
 import (
 	"todolist/internal/adapter/repository"
+	rptAudit "todolist/internal/domain/audit/repository"
+	rptBoard "todolist/internal/domain/board/repository"
+	rptCustomField "todolist/internal/domain/customfield/repository"
 	rptPerson "todolist/internal/domain/person/repository"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	rptTimeTracking "todolist/internal/domain/timetracking/repository"
 	rptTodo "todolist/internal/domain/todo/repository"
 	rptUser "todolist/internal/domain/user/repository"
+	rptView "todolist/internal/domain/view/repository"
 
 	"go.uber.org/fx"
 	"gorm.io/gorm"
@@ -19,23 +26,47 @@ type RepositoryParams struct {
 // Repositories groups all repository implementations provided from Fx
 type RepositoryContainer struct {
 	fx.Out
-	UserRepository        rptUser.UserRepository
-	UserQueryRepository   rptUser.UserQueryRepository
-	PersonRepository      rptPerson.PersonRepository
-	PersonQueryRepository rptPerson.PersonQueryRepository
-	TodoRepository        rptTodo.TodoRepository
-	TodoQueryRepository   rptTodo.TodoQueryRepository
+	UserRepository         rptUser.UserRepository
+	UserQueryRepository    rptUser.UserQueryRepository
+	SessionRepository      rptUser.SessionRepository
+	RoleRepository         rptUser.RoleRepository
+	LoginAttemptRepository rptUser.LoginAttemptRepository
+	AuditLogRepository     rptAudit.AuditLogRepository
+	PersonRepository       rptPerson.PersonRepository
+	PersonQueryRepository  rptPerson.PersonQueryRepository
+	TodoRepository         rptTodo.TodoRepository
+	TodoQueryRepository    rptTodo.TodoQueryRepository
+	ViewRepository         rptView.ViewRepository
+	BoardRepository        rptBoard.BoardRepository
+	ProjectRepository      rptProject.ProjectRepository
+	TimeEntryRepository    rptTimeTracking.TimeEntryRepository
+	CustomFieldRepository  rptCustomField.CustomFieldRepository
+	TransactionManager     shared.TransactionManager
 }
 
 // NewRepositories creates all repository implementations
 func NewRepositories(p RepositoryParams) RepositoryContainer {
+	auditLogRepository := repository.NewAuditLogRepository(p.DatabaseProvider)
+	transactionManager := repository.NewTransactionManager(p.DatabaseProvider)
+
+	// Changes to todos, people and users are recorded in the audit log, in the same transaction
 	return RepositoryContainer{
-		UserRepository:        repository.NewUserRepository(p.DatabaseProvider),
-		UserQueryRepository:   repository.NewUserQueryRepository(p.DatabaseProvider),
-		PersonRepository:      repository.NewPersonRepository(p.DatabaseProvider),
-		PersonQueryRepository: repository.NewPersonQueryRepository(p.DatabaseProvider),
-		TodoRepository:        repository.NewTodoRepository(p.DatabaseProvider),
-		TodoQueryRepository:   repository.NewTodoQueryRepository(p.DatabaseProvider),
+		UserRepository:         repository.NewAuditedUserRepository(repository.NewUserRepository(p.DatabaseProvider), auditLogRepository, transactionManager),
+		UserQueryRepository:    repository.NewUserQueryRepository(p.DatabaseProvider),
+		SessionRepository:      repository.NewSessionRepository(p.DatabaseProvider),
+		RoleRepository:         repository.NewRoleRepository(p.DatabaseProvider),
+		LoginAttemptRepository: repository.NewLoginAttemptRepository(p.DatabaseProvider),
+		AuditLogRepository:     auditLogRepository,
+		PersonRepository:       repository.NewAuditedPersonRepository(repository.NewPersonRepository(p.DatabaseProvider), auditLogRepository, transactionManager),
+		PersonQueryRepository:  repository.NewPersonQueryRepository(p.DatabaseProvider),
+		TodoRepository:         repository.NewAuditedTodoRepository(repository.NewTodoRepository(p.DatabaseProvider), auditLogRepository, transactionManager),
+		TodoQueryRepository:    repository.NewTodoQueryRepository(p.DatabaseProvider),
+		ViewRepository:         repository.NewViewRepository(p.DatabaseProvider),
+		BoardRepository:        repository.NewBoardRepository(p.DatabaseProvider),
+		ProjectRepository:      repository.NewProjectRepository(p.DatabaseProvider),
+		TimeEntryRepository:    repository.NewTimeEntryRepository(p.DatabaseProvider),
+		CustomFieldRepository:  repository.NewCustomFieldRepository(p.DatabaseProvider),
+		TransactionManager:     transactionManager,
 	}
 }
 
@@ -1,29 +1,63 @@
 package di
 
 import (
+	"fmt"
+	"time"
+
 	"go.uber.org/fx"
 
 	"todolist/internal/config"
+	rptAudit "todolist/internal/domain/audit/repository"
+	rptBoard "todolist/internal/domain/board/repository"
+	rptCustomField "todolist/internal/domain/customfield/repository"
 	rptPerson "todolist/internal/domain/person/repository"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	rptTimeTracking "todolist/internal/domain/timetracking/repository"
 	rptTodo "todolist/internal/domain/todo/repository"
 	svcTodo "todolist/internal/domain/todo/service"
 	rptUser "todolist/internal/domain/user/repository"
+	rptView "todolist/internal/domain/view/repository"
 	"todolist/internal/service"
+	ucAudit "todolist/internal/usecase/audit"
+	ucBoard "todolist/internal/usecase/board"
+	ucCustomField "todolist/internal/usecase/customfield"
 	ucPerson "todolist/internal/usecase/person"
+	ucProject "todolist/internal/usecase/project"
+	ucTimeTracking "todolist/internal/usecase/timetracking"
 	ucTodo "todolist/internal/usecase/todo"
 	ucUser "todolist/internal/usecase/user"
+	ucView "todolist/internal/usecase/view"
+	"todolist/pkg/cursor"
 )
 
+// defaultMaxPositionLength is used when the maximum length of the manual todo order positions is not configured
+const defaultMaxPositionLength = 12
+
 // UseCaseParams defines the dependencies required to create use cases
 type UseCaseParams struct {
 	fx.In
-	AppConfig           config.ApplicationProvider
-	PersonRepository    rptPerson.PersonRepository
-	UserRepository      rptUser.UserRepository
-	TodoRepository      rptTodo.TodoRepository
-	TodoQueryRepository rptTodo.TodoQueryRepository
-	TodoService         svcTodo.TodoService
-	TokenService        service.TokenService
+	AppConfig              config.ApplicationProvider
+	IDGenerator            shared.IDGenerator
+	PersonRepository       rptPerson.PersonRepository
+	UserRepository         rptUser.UserRepository
+	UserQueryRepository    rptUser.UserQueryRepository
+	RoleRepository         rptUser.RoleRepository
+	LoginAttemptRepository rptUser.LoginAttemptRepository
+	AuditLogRepository     rptAudit.AuditLogRepository
+	TodoRepository         rptTodo.TodoRepository
+	TodoQueryRepository    rptTodo.TodoQueryRepository
+	ViewRepository         rptView.ViewRepository
+	BoardRepository        rptBoard.BoardRepository
+	ProjectRepository      rptProject.ProjectRepository
+	TimeEntryRepository    rptTimeTracking.TimeEntryRepository
+	CustomFieldRepository  rptCustomField.CustomFieldRepository
+	TransactionManager     shared.TransactionManager
+	TodoService            svcTodo.TodoService
+	SessionService         service.SessionService
+	AuthorizationService   service.AuthorizationService
+	ImpersonationService   service.ImpersonationService
 }
 
 // UseCaseContainer provides all use case implementations
@@ -36,41 +70,231 @@ type UseCaseContainer struct {
 	GetPersonUseCase    ucPerson.GetPersonUseCase
 
 	// User Use Cases
-	ChangePasswordUseCase ucUser.ChangePasswordUseCase
-	CreateUserUseCase     ucUser.CreateUserUseCase
-	LoginUseCase          ucUser.LoginUseCase
+	ChangePasswordUseCase      ucUser.ChangePasswordUseCase
+	CreateUserUseCase          ucUser.CreateUserUseCase
+	LoginUseCase               ucUser.LoginUseCase
+	RefreshTokenUseCase        ucUser.RefreshTokenUseCase
+	ListSessionsUseCase        ucUser.ListSessionsUseCase
+	RevokeSessionUseCase       ucUser.RevokeSessionUseCase
+	RevokeOtherSessionsUseCase ucUser.RevokeOtherSessionsUseCase
+	GetPreferencesUseCase      ucUser.GetPreferencesUseCase
+	UpdatePreferencesUseCase   ucUser.UpdatePreferencesUseCase
+
+	// Role Use Cases
+	ListRolesUseCase  ucUser.ListRolesUseCase
+	CreateRoleUseCase ucUser.CreateRoleUseCase
+	UpdateRoleUseCase ucUser.UpdateRoleUseCase
+	DeleteRoleUseCase ucUser.DeleteRoleUseCase
+
+	// User Administration Use Cases
+	ListUsersUseCase          ucUser.ListUsersUseCase
+	ChangeUserRoleUseCase     ucUser.ChangeUserRoleUseCase
+	ChangeUserStatusUseCase   ucUser.ChangeUserStatusUseCase
+	ForcePasswordResetUseCase ucUser.ForcePasswordResetUseCase
+	ListLoginAttemptsUseCase  ucUser.ListLoginAttemptsUseCase
+	ListUserAuditTrailUseCase ucUser.ListUserAuditTrailUseCase
+	ImpersonateUserUseCase    ucUser.ImpersonateUserUseCase
+
+	// Audit Use Cases
+	ListAuditLogsUseCase ucAudit.ListAuditLogsUseCase
 
 	// Todo Use Cases
-	CompleteTodoUseCase  ucTodo.CompleteTodoUseCase
-	CreateTodoUseCase    ucTodo.CreateTodoUseCase
-	DeleteTodoUseCase    ucTodo.DeleteTodoUseCase
-	GetStatisticsUseCase ucTodo.GetStatisticsUseCase
-	GetTodoUseCase       ucTodo.GetTodoUseCase
-	ListTodoUseCase      ucTodo.ListTodosUseCase
-	UpdateTodoUseCase    ucTodo.UpdateTodoUseCase
+	CompleteTodoUseCase   ucTodo.CompleteTodoUseCase
+	CreateTodoUseCase     ucTodo.CreateTodoUseCase
+	DeleteTodoUseCase     ucTodo.DeleteTodoUseCase
+	GetStatisticsUseCase  ucTodo.GetStatisticsUseCase
+	GetTodoUseCase        ucTodo.GetTodoUseCase
+	ListTodoUseCase       ucTodo.ListTodosUseCase
+	UpdateTodoUseCase     ucTodo.UpdateTodoUseCase
+	PatchTodoUseCase      ucTodo.PatchTodoUseCase
+	GetTodoHistoryUseCase ucTodo.GetTodoHistoryUseCase
+	RevertTodoUseCase     ucTodo.RevertTodoUseCase
+	UndoTodoUseCase       ucTodo.UndoTodoUseCase
+	ListTrashUseCase      ucTodo.ListTrashUseCase
+	RestoreTodoUseCase    ucTodo.RestoreTodoUseCase
+	EmptyTrashUseCase     ucTodo.EmptyTrashUseCase
+	PurgeTrashUseCase     ucTodo.PurgeTrashUseCase
+	BulkTodoUseCase       ucTodo.BulkTodoUseCase
+	SearchTodosUseCase    ucTodo.SearchTodosUseCase
+	MoveTodoUseCase       ucTodo.MoveTodoUseCase
+
+	RebalancePositionsUseCase ucTodo.RebalancePositionsUseCase
+
+	// View Use Cases
+	ListViewsUseCase     ucView.ListViewsUseCase
+	GetViewUseCase       ucView.GetViewUseCase
+	CreateViewUseCase    ucView.CreateViewUseCase
+	UpdateViewUseCase    ucView.UpdateViewUseCase
+	DeleteViewUseCase    ucView.DeleteViewUseCase
+	ListViewTodosUseCase ucView.ListViewTodosUseCase
+
+	// Board Use Cases
+	ListBoardsUseCase    ucBoard.ListBoardsUseCase
+	GetBoardUseCase      ucBoard.GetBoardUseCase
+	CreateBoardUseCase   ucBoard.CreateBoardUseCase
+	UpdateBoardUseCase   ucBoard.UpdateBoardUseCase
+	DeleteBoardUseCase   ucBoard.DeleteBoardUseCase
+	MoveBoardTodoUseCase ucBoard.MoveBoardTodoUseCase
+
+	// Project Use Cases
+	ListProjectsUseCase  ucProject.ListProjectsUseCase
+	GetProjectUseCase    ucProject.GetProjectUseCase
+	CreateProjectUseCase ucProject.CreateProjectUseCase
+	UpdateProjectUseCase ucProject.UpdateProjectUseCase
+	DeleteProjectUseCase ucProject.DeleteProjectUseCase
+
+	// Time Tracking Use Cases
+	StartTimerUseCase      ucTimeTracking.StartTimerUseCase
+	StopTimerUseCase       ucTimeTracking.StopTimerUseCase
+	GetTimerUseCase        ucTimeTracking.GetTimerUseCase
+	CreateTimeEntryUseCase ucTimeTracking.CreateTimeEntryUseCase
+	ListTimeEntriesUseCase ucTimeTracking.ListTimeEntriesUseCase
+	DeleteTimeEntryUseCase ucTimeTracking.DeleteTimeEntryUseCase
+	GetTimeReportUseCase   ucTimeTracking.GetTimeReportUseCase
+
+	// Custom Field Use Cases
+	ListCustomFieldsUseCase  ucCustomField.ListCustomFieldsUseCase
+	GetCustomFieldUseCase    ucCustomField.GetCustomFieldUseCase
+	CreateCustomFieldUseCase ucCustomField.CreateCustomFieldUseCase
+	UpdateCustomFieldUseCase ucCustomField.UpdateCustomFieldUseCase
+	DeleteCustomFieldUseCase ucCustomField.DeleteCustomFieldUseCase
 }
 
 // NewUseCases creates all use case implementations
 func NewUseCases(p UseCaseParams) (UseCaseContainer, error) {
+	trashRetention := time.Duration(p.AppConfig.GetTrash().GetRetentionDays()) * 24 * time.Hour
+
+	cursorCodec, err := cursor.NewCodec([]byte(p.AppConfig.GetPagination().GetCursorSecret()))
+	if err != nil {
+		return UseCaseContainer{}, fmt.Errorf("failed to initialize cursor codec: %w", err)
+	}
+
+	defaultSearchLanguage := sharedvo.DefaultSearchLanguage
+	if language := p.AppConfig.GetSearch().GetDefaultLanguage(); language != "" {
+		if defaultSearchLanguage, err = sharedvo.NewSearchLanguage(language); err != nil {
+			return UseCaseContainer{}, fmt.Errorf("invalid default search language %q: %w", language, err)
+		}
+	}
+
+	maxPositionLength := p.AppConfig.GetOrdering().GetMaxPositionLength()
+	if maxPositionLength <= 0 {
+		maxPositionLength = defaultMaxPositionLength
+	}
+
+	// Boards place their todos like the todo move
+	moveTodoUseCase := ucTodo.NewMoveTodoUseCase(p.TodoRepository, p.BoardRepository, p.AuthorizationService, maxPositionLength)
+
+	// Views list their todos like the todo listing
+	listTodosUseCase := ucTodo.NewListTodosUseCase(p.TodoQueryRepository, p.UserRepository, cursorCodec, defaultSearchLanguage)
+
 	return UseCaseContainer{
 		// Person Use Cases
-		CreatePersonUseCase: ucPerson.NewCreatePersonUseCase(p.PersonRepository),
-		UpdatePersonUseCase: ucPerson.NewUpdatePersonUseCase(p.PersonRepository),
-		GetPersonUseCase:    ucPerson.NewGetPersonUseCase(p.PersonRepository),
+		CreatePersonUseCase: ucPerson.NewCreatePersonUseCase(p.PersonRepository, p.IDGenerator),
+		UpdatePersonUseCase: ucPerson.NewUpdatePersonUseCase(p.PersonRepository, p.UserRepository, p.AuthorizationService),
+		GetPersonUseCase:    ucPerson.NewGetPersonUseCase(p.PersonRepository, p.UserRepository, p.AuthorizationService),
 
 		// User Use Cases
-		ChangePasswordUseCase: ucUser.NewChangePasswordUseCase(p.UserRepository),
-		CreateUserUseCase:     ucUser.NewCreateUserUseCase(p.UserRepository, p.PersonRepository),
-		LoginUseCase:          ucUser.NewLoginUseCase(p.UserRepository, p.PersonRepository, p.TokenService, p.AppConfig.GetName()),
+		ChangePasswordUseCase:      ucUser.NewChangePasswordUseCase(p.UserRepository, p.SessionService),
+		CreateUserUseCase:          ucUser.NewCreateUserUseCase(p.UserRepository, p.PersonRepository, p.IDGenerator),
+		LoginUseCase:               ucUser.NewLoginUseCase(p.UserRepository, p.PersonRepository, p.LoginAttemptRepository, p.SessionService, p.AppConfig.GetName()),
+		RefreshTokenUseCase:        ucUser.NewRefreshTokenUseCase(p.UserRepository, p.PersonRepository, p.SessionService),
+		ListSessionsUseCase:        ucUser.NewListSessionsUseCase(p.SessionService),
+		RevokeSessionUseCase:       ucUser.NewRevokeSessionUseCase(p.SessionService),
+		RevokeOtherSessionsUseCase: ucUser.NewRevokeOtherSessionsUseCase(p.SessionService),
+		GetPreferencesUseCase:      ucUser.NewGetPreferencesUseCase(p.UserRepository, defaultSearchLanguage),
+		UpdatePreferencesUseCase:   ucUser.NewUpdatePreferencesUseCase(p.UserRepository, defaultSearchLanguage),
+
+		// Role Use Cases
+		ListRolesUseCase:  ucUser.NewListRolesUseCase(p.AuthorizationService),
+		CreateRoleUseCase: ucUser.NewCreateRoleUseCase(p.RoleRepository, p.AuthorizationService),
+		UpdateRoleUseCase: ucUser.NewUpdateRoleUseCase(p.RoleRepository, p.AuthorizationService),
+		DeleteRoleUseCase: ucUser.NewDeleteRoleUseCase(p.RoleRepository, p.UserQueryRepository, p.AuthorizationService),
+
+		// User Administration Use Cases
+		ListUsersUseCase:          ucUser.NewListUsersUseCase(p.UserQueryRepository),
+		ChangeUserRoleUseCase:     ucUser.NewChangeUserRoleUseCase(p.UserRepository, p.AuditLogRepository, p.AuthorizationService, p.SessionService, p.TransactionManager),
+		ChangeUserStatusUseCase:   ucUser.NewChangeUserStatusUseCase(p.UserRepository, p.AuditLogRepository, p.SessionService, p.TransactionManager),
+		ForcePasswordResetUseCase: ucUser.NewForcePasswordResetUseCase(p.UserRepository, p.AuditLogRepository, p.SessionService),
+		ListLoginAttemptsUseCase:  ucUser.NewListLoginAttemptsUseCase(p.UserRepository, p.LoginAttemptRepository),
+		ListUserAuditTrailUseCase: ucUser.NewListUserAuditTrailUseCase(p.UserRepository, p.AuditLogRepository),
+		ImpersonateUserUseCase:    ucUser.NewImpersonateUserUseCase(p.UserRepository, p.ImpersonationService, p.AppConfig.GetName()),
+
+		// Audit Use Cases
+		ListAuditLogsUseCase: ucAudit.NewListAuditLogsUseCase(p.AuditLogRepository),
 
 		// Todo Use Cases
-		CompleteTodoUseCase:  ucTodo.NewCompleteTodoUseCase(p.TodoRepository, p.TodoService),
-		CreateTodoUseCase:    ucTodo.NewCreateTodoUseCase(p.TodoRepository, p.TodoService),
-		DeleteTodoUseCase:    ucTodo.NewDeleteTodoUseCase(p.TodoRepository, p.TodoService),
-		GetStatisticsUseCase: ucTodo.NewGetStatisticsUseCase(p.TodoQueryRepository),
-		GetTodoUseCase:       ucTodo.NewGetTodoUseCase(p.TodoRepository, p.TodoService),
-		ListTodoUseCase:      ucTodo.NewListTodosUseCase(p.TodoQueryRepository),
-		UpdateTodoUseCase:    ucTodo.NewUpdateTodoUseCase(p.TodoRepository, p.TodoService),
+		CompleteTodoUseCase:   ucTodo.NewCompleteTodoUseCase(p.TodoRepository, p.BoardRepository, p.AuthorizationService),
+		CreateTodoUseCase:     ucTodo.NewCreateTodoUseCase(p.TodoRepository, p.TodoQueryRepository, p.TodoService, p.ProjectRepository, p.CustomFieldRepository, p.IDGenerator),
+		DeleteTodoUseCase:     ucTodo.NewDeleteTodoUseCase(p.TodoRepository, p.BoardRepository, p.AuthorizationService),
+		GetStatisticsUseCase:  ucTodo.NewGetStatisticsUseCase(p.TodoQueryRepository),
+		GetTodoUseCase:        ucTodo.NewGetTodoUseCase(p.TodoRepository, p.BoardRepository, p.AuthorizationService),
+		ListTodoUseCase:       listTodosUseCase,
+		UpdateTodoUseCase:     ucTodo.NewUpdateTodoUseCase(p.TodoRepository, p.ProjectRepository, p.CustomFieldRepository, p.BoardRepository, p.AuthorizationService),
+		PatchTodoUseCase:      ucTodo.NewPatchTodoUseCase(p.TodoRepository, p.ProjectRepository, p.CustomFieldRepository, p.BoardRepository, p.AuthorizationService),
+		GetTodoHistoryUseCase: ucTodo.NewGetTodoHistoryUseCase(p.TodoRepository, p.AuditLogRepository, p.BoardRepository, p.AuthorizationService),
+		RevertTodoUseCase:     ucTodo.NewRevertTodoUseCase(p.TodoRepository, p.AuditLogRepository, p.BoardRepository, p.AuthorizationService),
+		UndoTodoUseCase:       ucTodo.NewUndoTodoUseCase(p.TodoRepository, p.AuditLogRepository, p.BoardRepository, p.AuthorizationService),
+		ListTrashUseCase:      ucTodo.NewListTrashUseCase(p.TodoQueryRepository, trashRetention),
+		RestoreTodoUseCase:    ucTodo.NewRestoreTodoUseCase(p.TodoRepository, p.BoardRepository, p.AuthorizationService),
+		EmptyTrashUseCase:     ucTodo.NewEmptyTrashUseCase(p.TodoRepository),
+		PurgeTrashUseCase:     ucTodo.NewPurgeTrashUseCase(p.TodoRepository, trashRetention),
+		BulkTodoUseCase:       ucTodo.NewBulkTodoUseCase(p.TodoRepository, p.TodoQueryRepository, p.ProjectRepository, p.TransactionManager, p.BoardRepository, p.AuthorizationService),
+		SearchTodosUseCase:    ucTodo.NewSearchTodosUseCase(p.TodoQueryRepository, p.UserRepository, defaultSearchLanguage),
+		MoveTodoUseCase:       moveTodoUseCase,
+
+		RebalancePositionsUseCase: ucTodo.NewRebalancePositionsUseCase(p.TodoRepository, maxPositionLength),
+
+		// View Use Cases
+		ListViewsUseCase:     ucView.NewListViewsUseCase(p.ViewRepository),
+		GetViewUseCase:       ucView.NewGetViewUseCase(p.ViewRepository),
+		CreateViewUseCase:    ucView.NewCreateViewUseCase(p.ViewRepository, p.IDGenerator),
+		UpdateViewUseCase:    ucView.NewUpdateViewUseCase(p.ViewRepository),
+		DeleteViewUseCase:    ucView.NewDeleteViewUseCase(p.ViewRepository),
+		ListViewTodosUseCase: ucView.NewListViewTodosUseCase(p.ViewRepository, listTodosUseCase),
+
+		// Board Use Cases
+		ListBoardsUseCase:  ucBoard.NewListBoardsUseCase(p.BoardRepository),
+		GetBoardUseCase:    ucBoard.NewGetBoardUseCase(p.BoardRepository, p.TodoQueryRepository, p.UserRepository, p.AuthorizationService),
+		CreateBoardUseCase: ucBoard.NewCreateBoardUseCase(p.BoardRepository, p.UserRepository, p.ProjectRepository, p.IDGenerator),
+		UpdateBoardUseCase: ucBoard.NewUpdateBoardUseCase(p.BoardRepository, p.UserRepository, p.ProjectRepository),
+		DeleteBoardUseCase: ucBoard.NewDeleteBoardUseCase(p.BoardRepository),
+		MoveBoardTodoUseCase: ucBoard.NewMoveBoardTodoUseCase(
+			p.BoardRepository,
+			p.TodoRepository,
+			p.TodoQueryRepository,
+			p.AuthorizationService,
+			p.TransactionManager,
+			moveTodoUseCase,
+		),
+
+		// Project Use Cases
+		ListProjectsUseCase:  ucProject.NewListProjectsUseCase(p.ProjectRepository),
+		GetProjectUseCase:    ucProject.NewGetProjectUseCase(p.ProjectRepository),
+		CreateProjectUseCase: ucProject.NewCreateProjectUseCase(p.ProjectRepository, p.IDGenerator),
+		UpdateProjectUseCase: ucProject.NewUpdateProjectUseCase(p.ProjectRepository),
+		DeleteProjectUseCase: ucProject.NewDeleteProjectUseCase(p.ProjectRepository),
+
+		// Time Tracking Use Cases
+		StartTimerUseCase: ucTimeTracking.NewStartTimerUseCase(
+			p.TimeEntryRepository,
+			p.TodoRepository,
+			p.AuthorizationService,
+			p.TransactionManager,
+			p.IDGenerator,
+		),
+		StopTimerUseCase:       ucTimeTracking.NewStopTimerUseCase(p.TimeEntryRepository),
+		GetTimerUseCase:        ucTimeTracking.NewGetTimerUseCase(p.TimeEntryRepository),
+		CreateTimeEntryUseCase: ucTimeTracking.NewCreateTimeEntryUseCase(p.TimeEntryRepository, p.TodoRepository, p.AuthorizationService, p.IDGenerator),
+		ListTimeEntriesUseCase: ucTimeTracking.NewListTimeEntriesUseCase(p.TimeEntryRepository, p.TodoRepository, p.AuthorizationService),
+		DeleteTimeEntryUseCase: ucTimeTracking.NewDeleteTimeEntryUseCase(p.TimeEntryRepository),
+		GetTimeReportUseCase:   ucTimeTracking.NewGetTimeReportUseCase(p.TimeEntryRepository),
+
+		// Custom Field Use Cases
+		ListCustomFieldsUseCase:  ucCustomField.NewListCustomFieldsUseCase(p.CustomFieldRepository),
+		GetCustomFieldUseCase:    ucCustomField.NewGetCustomFieldUseCase(p.CustomFieldRepository),
+		CreateCustomFieldUseCase: ucCustomField.NewCreateCustomFieldUseCase(p.CustomFieldRepository, p.ProjectRepository, p.IDGenerator),
+		UpdateCustomFieldUseCase: ucCustomField.NewUpdateCustomFieldUseCase(p.CustomFieldRepository),
+		DeleteCustomFieldUseCase: ucCustomField.NewDeleteCustomFieldUseCase(p.CustomFieldRepository),
 	}, nil
 }
 
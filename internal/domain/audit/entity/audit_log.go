@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"errors"
+	"todolist/internal/domain/shared"
+)
+
+var (
+	ErrInvalidAuditEntity = errors.New("audit entry requires an entity type and ID")
+	ErrInvalidAuditAction = errors.New("audit entry requires an action")
+)
+
+// Audited entity types
+const (
+	EntityTypeUser   = "user"
+	EntityTypePerson = "person"
+	EntityTypeTodo   = "todo"
+)
+
+// Audited actions
+const (
+	ActionCreated  = "created"
+	ActionUpdated  = "updated"
+	ActionDeleted  = "deleted"
+	ActionRestored = "restored"
+	ActionPurged   = "purged"
+
+	ActionUserRoleChanged          = "role_changed"
+	ActionUserStatusChanged        = "status_changed"
+	ActionUserPasswordResetForced  = "password_reset_forced"
+	ActionUserImpersonationStarted = "impersonation_started"
+	ActionImpersonatedRequest      = "impersonated_request"
+)
+
+// AuditLog records an action performed by an actor on an entity
+type AuditLog struct {
+	shared.Entity
+	actorID        int64
+	impersonatorID int64
+	entityType     string
+	entityID       int64
+	action         string
+	oldValues      map[string]any
+	newValues      map[string]any
+	ipAddress      string
+	userAgent      string
+}
+
+// NewAuditLog creates a new AuditLog entity.
+//
+// actorID is zero for actions performed by the system.
+func NewAuditLog(
+	id int64,
+	actorID int64,
+	entityType string,
+	entityID int64,
+	action string,
+	oldValues map[string]any,
+	newValues map[string]any,
+) (*AuditLog, error) {
+	if entityType == "" || entityID == 0 {
+		return nil, ErrInvalidAuditEntity
+	}
+
+	if action == "" {
+		return nil, ErrInvalidAuditAction
+	}
+
+	return &AuditLog{
+		Entity:     shared.NewEntity(id),
+		actorID:    actorID,
+		entityType: entityType,
+		entityID:   entityID,
+		action:     action,
+		oldValues:  oldValues,
+		newValues:  newValues,
+	}, nil
+}
+
+// Getters
+
+// ActorID returns the ID of the user who performed the action
+func (a AuditLog) ActorID() int64 { return a.actorID }
+
+// ImpersonatorID returns the ID of the user impersonating the actor, zero when not impersonated
+func (a AuditLog) ImpersonatorID() int64 { return a.impersonatorID }
+
+// IsImpersonated checks if the action was performed under impersonation
+func (a AuditLog) IsImpersonated() bool { return a.impersonatorID != 0 }
+
+// EntityType returns the type of the affected entity
+func (a AuditLog) EntityType() string { return a.entityType }
+
+// EntityID returns the ID of the affected entity
+func (a AuditLog) EntityID() int64 { return a.entityID }
+
+// Action returns the performed action
+func (a AuditLog) Action() string { return a.action }
+
+// OldValues returns the values before the action
+func (a AuditLog) OldValues() map[string]any { return a.oldValues }
+
+// NewValues returns the values after the action
+func (a AuditLog) NewValues() map[string]any { return a.newValues }
+
+// IPAddress returns the IP address of the actor
+func (a AuditLog) IPAddress() string { return a.ipAddress }
+
+// UserAgent returns the user agent of the actor
+func (a AuditLog) UserAgent() string { return a.userAgent }
+
+// Update methods
+
+// SetImpersonator tags the entry as performed by the impersonator on behalf of the actor
+func (a *AuditLog) SetImpersonator(impersonatorID int64) {
+	a.impersonatorID = impersonatorID
+}
+
+// SetClient sets the client information of the actor
+func (a *AuditLog) SetClient(ipAddress, userAgent string) {
+	a.ipAddress = ipAddress
+	a.userAgent = userAgent
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/audit/entity"
+	vo "todolist/internal/domain/audit/valueobject"
+	"todolist/internal/domain/shared"
+)
+
+// AuditLogRepository defines persistence operations for audit entries
+type AuditLogRepository interface {
+	// Commands
+	Save(ctx context.Context, auditLog *entity.AuditLog) error
+
+	// Queries
+
+	// FindByUser returns the entries performed by the user, including under impersonation,
+	// or affecting the user, newest first
+	FindByUser(ctx context.Context, userID int64, options shared.QueryOptions) ([]*entity.AuditLog, error)
+
+	// FindByEntity returns every entry of an entity, oldest first
+	FindByEntity(ctx context.Context, entityType string, entityID int64) ([]*entity.AuditLog, error)
+
+	// FindByFilters returns the entries matching the filters, newest first
+	FindByFilters(ctx context.Context, filters vo.AuditFilterCriteria, options shared.QueryOptions) ([]*entity.AuditLog, error)
+
+	// Aggregations
+	CountByUser(ctx context.Context, userID int64) (int64, error)
+	CountByFilters(ctx context.Context, filters vo.AuditFilterCriteria) (int64, error)
+}
@@ -0,0 +1,28 @@
+package valueobject
+
+import "context"
+
+// actorContextKey is the context key holding the actor of the current operation
+type actorContextKey struct{}
+
+// Actor identifies who performs an operation and from where.
+//
+// A zero UserID means the operation is performed by the system or by an
+// anonymous client, such as a self registration.
+type Actor struct {
+	UserID         int64
+	ImpersonatorID int64
+	IPAddress      string
+	UserAgent      string
+}
+
+// ContextWithActor returns a copy of ctx carrying the actor
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, or the system actor when there is none
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorContextKey{}).(Actor)
+	return actor
+}
@@ -0,0 +1,33 @@
+package valueobject
+
+import "time"
+
+// AuditFilterCriteria represents the filtering criteria for audit entry queries
+type AuditFilterCriteria struct {
+	EntityType string
+	EntityID   int64
+	ActorID    int64
+	Action     []string
+	From       *time.Time
+	To         *time.Time
+}
+
+// HasEntityFilter checks if there is an entity filter
+func (f *AuditFilterCriteria) HasEntityFilter() bool {
+	return f.EntityType != "" || f.EntityID != 0
+}
+
+// HasActorFilter checks if there is an actor filter
+func (f *AuditFilterCriteria) HasActorFilter() bool {
+	return f.ActorID != 0
+}
+
+// HasActionFilter checks if there is an action filter
+func (f *AuditFilterCriteria) HasActionFilter() bool {
+	return len(f.Action) > 0
+}
+
+// HasTimeRange checks if there is a time range filter
+func (f *AuditFilterCriteria) HasTimeRange() bool {
+	return f.From != nil || f.To != nil
+}
@@ -0,0 +1,77 @@
+package valueobject
+
+import "reflect"
+
+// RedactedValue replaces the values of sensitive fields in audit entries
+const RedactedValue = "[REDACTED]"
+
+// Change holds the field level difference between two snapshots of an entity
+type Change struct {
+	OldValues map[string]any
+	NewValues map[string]any
+}
+
+// NewChange compares the snapshots of an entity before and after an operation.
+//
+// A nil before snapshot describes a creation and a nil after snapshot a deletion,
+// in which case every field is kept. Otherwise only the fields whose value changed
+// are kept. Values of the sensitive fields are replaced by RedactedValue, so the
+// entry tells that they changed without disclosing them.
+func NewChange(before, after map[string]any, sensitive ...string) Change {
+	change := Change{}
+
+	switch {
+	case before == nil:
+		change.NewValues = copyValues(after)
+	case after == nil:
+		change.OldValues = copyValues(before)
+	default:
+		change.OldValues = map[string]any{}
+		change.NewValues = map[string]any{}
+
+		for field, newValue := range after {
+			oldValue, ok := before[field]
+			if ok && reflect.DeepEqual(oldValue, newValue) {
+				continue
+			}
+			if ok {
+				change.OldValues[field] = oldValue
+			}
+			change.NewValues[field] = newValue
+		}
+
+		for field, oldValue := range before {
+			if _, ok := after[field]; !ok {
+				change.OldValues[field] = oldValue
+			}
+		}
+	}
+
+	for _, field := range sensitive {
+		redact(change.OldValues, field)
+		redact(change.NewValues, field)
+	}
+
+	return change
+}
+
+// IsEmpty checks if no field changed
+func (c Change) IsEmpty() bool {
+	return len(c.OldValues) == 0 && len(c.NewValues) == 0
+}
+
+// copyValues returns a shallow copy of a snapshot
+func copyValues(values map[string]any) map[string]any {
+	copied := make(map[string]any, len(values))
+	for field, value := range values {
+		copied[field] = value
+	}
+	return copied
+}
+
+// redact hides the value of a field when present
+func redact(values map[string]any, field string) {
+	if _, ok := values[field]; ok {
+		values[field] = RedactedValue
+	}
+}
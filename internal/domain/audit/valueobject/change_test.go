@@ -0,0 +1,98 @@
+package valueobject
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestNewChange(t *testing.T) {
+	tests := []struct {
+		name      string
+		before    map[string]any
+		after     map[string]any
+		sensitive []string
+		wantOld   map[string]any
+		wantNew   map[string]any
+	}{
+		{
+			name:    "creation keeps every field",
+			before:  nil,
+			after:   map[string]any{"title": "Buy milk", "status": "pending"},
+			wantOld: nil,
+			wantNew: map[string]any{"title": "Buy milk", "status": "pending"},
+		},
+		{
+			name:    "deletion keeps every field",
+			before:  map[string]any{"title": "Buy milk", "status": "pending"},
+			after:   nil,
+			wantOld: map[string]any{"title": "Buy milk", "status": "pending"},
+			wantNew: nil,
+		},
+		{
+			name:    "update keeps changed fields only",
+			before:  map[string]any{"title": "Buy milk", "status": "pending", "tags": []string{"home"}},
+			after:   map[string]any{"title": "Buy milk", "status": "completed", "tags": []string{"home"}},
+			wantOld: map[string]any{"status": "pending"},
+			wantNew: map[string]any{"status": "completed"},
+		},
+		{
+			name:    "update with added and removed fields",
+			before:  map[string]any{"due_date": "2025-01-01"},
+			after:   map[string]any{"completed_at": "2025-01-02"},
+			wantOld: map[string]any{"due_date": "2025-01-01"},
+			wantNew: map[string]any{"completed_at": "2025-01-02"},
+		},
+		{
+			name:      "sensitive fields are redacted",
+			before:    map[string]any{"username": "john", "password": "hash-1"},
+			after:     map[string]any{"username": "john", "password": "hash-2"},
+			sensitive: []string{"password"},
+			wantOld:   map[string]any{"password": RedactedValue},
+			wantNew:   map[string]any{"password": RedactedValue},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := NewChange(tt.before, tt.after, tt.sensitive...)
+
+			if len(change.OldValues) != 0 || len(tt.wantOld) != 0 {
+				if !reflect.DeepEqual(change.OldValues, tt.wantOld) {
+					t.Errorf("OldValues = %v, want %v", change.OldValues, tt.wantOld)
+				}
+			}
+			if len(change.NewValues) != 0 || len(tt.wantNew) != 0 {
+				if !reflect.DeepEqual(change.NewValues, tt.wantNew) {
+					t.Errorf("NewValues = %v, want %v", change.NewValues, tt.wantNew)
+				}
+			}
+		})
+	}
+}
+
+func TestChange_IsEmpty(t *testing.T) {
+	before := map[string]any{"title": "Buy milk"}
+	after := map[string]any{"title": "Buy milk"}
+
+	if !NewChange(before, after).IsEmpty() {
+		t.Error("expected no change for identical snapshots")
+	}
+
+	if NewChange(before, map[string]any{"title": "Buy bread"}).IsEmpty() {
+		t.Error("expected a change for different snapshots")
+	}
+}
+
+func TestActorFromContext(t *testing.T) {
+	if actor := ActorFromContext(context.Background()); actor != (Actor{}) {
+		t.Errorf("ActorFromContext() = %v, want system actor", actor)
+	}
+
+	want := Actor{UserID: 1, ImpersonatorID: 2, IPAddress: "127.0.0.1", UserAgent: "test"}
+	ctx := ContextWithActor(context.Background(), want)
+
+	if got := ActorFromContext(ctx); got != want {
+		t.Errorf("ActorFromContext() = %v, want %v", got, want)
+	}
+}
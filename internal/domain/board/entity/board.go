@@ -0,0 +1,246 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	vo "todolist/internal/domain/board/valueobject"
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+const (
+	maxBoardNameLength = 100 // Maximum length in characters of board names
+	maxBoardColumns    = 20  // Maximum number of columns of a board
+	maxBoardMembers    = 50  // Maximum number of members of a board, besides its owner
+)
+
+var (
+	ErrInvalidBoardName    = errors.New("board name must have between 1 and 100 characters")
+	ErrInvalidBoardColumns = errors.New("boards must have between 1 and 20 columns with distinct keys, each status and state in at most one of them")
+	ErrInvalidBoardMembers = errors.New("boards can have up to 50 members besides their owner")
+	ErrBoardNotFound       = errors.New("board not found")
+	ErrBoardAlreadyExists  = errors.New("board already exists")
+	ErrBoardOwnerOnly      = errors.New("only the owner of a board can change it")
+	ErrBoardColumnNotFound = errors.New("board column not found")
+	ErrWIPLimitReached     = errors.New("board column reached its WIP limit")
+	ErrColumnUnreachable   = errors.New("the workflow of the todo has no state of the board column")
+)
+
+// Board lays out the todos of its owner and members in columns by status and
+// workflow state, optionally split into swimlanes
+type Board struct {
+	shared.Entity
+	userID    int64
+	name      string
+	columns   []vo.BoardColumn
+	swimlane  vo.BoardSwimlane
+	memberIDs []int64
+}
+
+// NewBoard creates a new Board entity
+func NewBoard(
+	id int64,
+	userID int64,
+	name string,
+	columns []vo.BoardColumn,
+	swimlane vo.BoardSwimlane,
+	memberIDs []int64,
+) (*Board, error) {
+	b := &Board{
+		Entity:   shared.NewEntity(id),
+		userID:   userID,
+		swimlane: swimlane,
+	}
+
+	if err := b.Rename(name); err != nil {
+		return nil, err
+	}
+
+	if err := b.SetColumns(columns); err != nil {
+		return nil, err
+	}
+
+	if err := b.SetMembers(memberIDs); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// DefaultBoardColumns returns a column for each todo status
+func DefaultBoardColumns() []vo.BoardColumn {
+	statuses := []struct {
+		status todovo.TodoStatus
+		name   string
+	}{
+		{todovo.StatusPending, "To Do"},
+		{todovo.StatusInProgress, "In Progress"},
+		{todovo.StatusCompleted, "Done"},
+		{todovo.StatusCancelled, "Cancelled"},
+	}
+
+	columns := make([]vo.BoardColumn, len(statuses))
+	for i, s := range statuses {
+		// The default columns are valid
+		columns[i], _ = vo.NewBoardColumn(s.status.String(), s.name, []string{s.status.String()}, nil, 0)
+	}
+
+	return columns
+}
+
+// Getters
+
+// UserID returns the ID of the user who owns the board
+func (b Board) UserID() int64 { return b.userID }
+
+// Name returns the board name
+func (b Board) Name() string { return b.name }
+
+// Columns returns the columns of the board, in order
+func (b Board) Columns() []vo.BoardColumn { return slices.Clone(b.columns) }
+
+// Swimlane returns the field the todos of the board are split into rows by
+func (b Board) Swimlane() vo.BoardSwimlane { return b.swimlane }
+
+// MemberIDs returns the IDs of the members of the board
+func (b Board) MemberIDs() []int64 { return slices.Clone(b.memberIDs) }
+
+// UserIDs returns the IDs of the users whose todos are on the board, the owner first
+func (b Board) UserIDs() []int64 { return append([]int64{b.userID}, b.memberIDs...) }
+
+// IsOwnedBy checks if the user owns the board
+func (b Board) IsOwnedBy(userID int64) bool { return b.userID == userID }
+
+// IsVisibleTo checks if the user can see the board, being its owner or a member
+func (b Board) IsVisibleTo(userID int64) bool {
+	return b.userID == userID || slices.Contains(b.memberIDs, userID)
+}
+
+// Column returns the column with the key
+func (b Board) Column(key string) (vo.BoardColumn, bool) {
+	for _, column := range b.columns {
+		if column.Key() == key {
+			return column, true
+		}
+	}
+	return vo.BoardColumn{}, false
+}
+
+// ColumnOf returns the column holding the todos in the status and workflow state,
+// if any. Columns holding the state take precedence over those holding the status.
+func (b Board) ColumnOf(status todovo.TodoStatus, state string) (vo.BoardColumn, bool) {
+	for _, column := range b.columns {
+		if column.HoldsState(state) {
+			return column, true
+		}
+	}
+	for _, column := range b.columns {
+		if column.Holds(status) {
+			return column, true
+		}
+	}
+	return vo.BoardColumn{}, false
+}
+
+// ColumnFilter returns the filter selecting the todos of a column of the board
+func (b Board) ColumnFilter(column vo.BoardColumn) todovo.TodoColumnFilter {
+	filter := todovo.TodoColumnFilter{
+		States:         column.States(),
+		Statuses:       column.Statuses(),
+		ExcludedStates: []string{},
+	}
+	for _, other := range b.columns {
+		if other.Key() != column.Key() {
+			filter.ExcludedStates = append(filter.ExcludedStates, other.States()...)
+		}
+	}
+	return filter
+}
+
+// ColumnCount returns the number of todos in a column from the counts by status and state
+func (b Board) ColumnCount(column vo.BoardColumn, counts map[todovo.TodoStateKey]int64) int64 {
+	var count int64
+	for key, n := range counts {
+		if holder, ok := b.ColumnOf(key.Status, key.State); ok && holder.Key() == column.Key() {
+			count += n
+		}
+	}
+	return count
+}
+
+// Update methods
+
+// Rename changes the board name
+func (b *Board) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxBoardNameLength {
+		return ErrInvalidBoardName
+	}
+	b.name = name
+	b.SetAsModified()
+	return nil
+}
+
+// SetColumns replaces the columns of the board. Todos in statuses held by no
+// column are not on the board.
+func (b *Board) SetColumns(columns []vo.BoardColumn) error {
+	if len(columns) == 0 || len(columns) > maxBoardColumns {
+		return ErrInvalidBoardColumns
+	}
+
+	keys := map[string]bool{}
+	statuses := map[todovo.TodoStatus]bool{}
+	states := map[string]bool{}
+	for _, column := range columns {
+		if keys[column.Key()] {
+			return ErrInvalidBoardColumns
+		}
+		keys[column.Key()] = true
+
+		for _, status := range column.Statuses() {
+			if statuses[status] {
+				return ErrInvalidBoardColumns
+			}
+			statuses[status] = true
+		}
+
+		for _, state := range column.States() {
+			if states[state] {
+				return ErrInvalidBoardColumns
+			}
+			states[state] = true
+		}
+	}
+
+	b.columns = slices.Clone(columns)
+	b.SetAsModified()
+	return nil
+}
+
+// SetSwimlane changes the field the todos of the board are split into rows by
+func (b *Board) SetSwimlane(swimlane vo.BoardSwimlane) {
+	b.swimlane = swimlane
+	b.SetAsModified()
+}
+
+// SetMembers replaces the members of the board, ignoring the owner and repeated IDs
+func (b *Board) SetMembers(memberIDs []int64) error {
+	members := []int64{}
+	for _, id := range memberIDs {
+		if id == b.userID || slices.Contains(members, id) {
+			continue
+		}
+		members = append(members, id)
+	}
+
+	if len(members) > maxBoardMembers {
+		return ErrInvalidBoardMembers
+	}
+
+	b.memberIDs = members
+	b.SetAsModified()
+	return nil
+}
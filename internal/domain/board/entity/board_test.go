@@ -0,0 +1,200 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	vo "todolist/internal/domain/board/valueobject"
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+func mustColumn(t *testing.T, key string, statuses []string, wipLimit int) vo.BoardColumn {
+	t.Helper()
+
+	column, err := vo.NewBoardColumn(key, key, statuses, nil, wipLimit)
+	if err != nil {
+		t.Fatalf("NewBoardColumn(%q) error = %v", key, err)
+	}
+	return column
+}
+
+func mustStateColumn(t *testing.T, key string, states []string, wipLimit int) vo.BoardColumn {
+	t.Helper()
+
+	column, err := vo.NewBoardColumn(key, key, nil, states, wipLimit)
+	if err != nil {
+		t.Fatalf("NewBoardColumn(%q) error = %v", key, err)
+	}
+	return column
+}
+
+func TestNewBoard(t *testing.T) {
+	t.Run("should create valid board", func(t *testing.T) {
+		columns := []vo.BoardColumn{
+			mustColumn(t, "todo", []string{"pending"}, 0),
+			mustColumn(t, "doing", []string{"in_progress"}, 3),
+			mustColumn(t, "closed", []string{"completed", "cancelled"}, 0),
+		}
+
+		board, err := NewBoard(1, 10, " Team ", columns, vo.BoardSwimlaneAssignee, []int64{11, 10, 12, 11})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if board.Name() != "Team" {
+			t.Errorf("Expected name to be trimmed, got %q", board.Name())
+		}
+		if want := []int64{10, 11, 12}; !slices.Equal(board.UserIDs(), want) {
+			t.Errorf("Expected users %v, got %v", want, board.UserIDs())
+		}
+		if !board.IsVisibleTo(12) || board.IsVisibleTo(13) {
+			t.Error("Expected the board to be visible to its members only")
+		}
+
+		column, ok := board.ColumnOf(todovo.StatusCancelled, "cancelled")
+		if entry, _ := column.EntryStatus(); !ok || column.Key() != "closed" || entry != todovo.StatusCompleted {
+			t.Errorf("Expected cancelled todos in the closed column, got %v", column.Key())
+		}
+	})
+
+	tests := []struct {
+		name    string
+		columns []vo.BoardColumn
+		members []int64
+		wantErr error
+	}{
+		{name: "no columns", columns: nil, wantErr: ErrInvalidBoardColumns},
+		{
+			name: "repeated key",
+			columns: []vo.BoardColumn{
+				mustColumn(t, "todo", []string{"pending"}, 0),
+				mustColumn(t, "todo", []string{"in_progress"}, 0),
+			},
+			wantErr: ErrInvalidBoardColumns,
+		},
+		{
+			name: "status in two columns",
+			columns: []vo.BoardColumn{
+				mustColumn(t, "todo", []string{"pending"}, 0),
+				mustColumn(t, "next", []string{"pending", "in_progress"}, 0),
+			},
+			wantErr: ErrInvalidBoardColumns,
+		},
+		{
+			name: "state in two columns",
+			columns: []vo.BoardColumn{
+				mustStateColumn(t, "review", []string{"review"}, 0),
+				mustStateColumn(t, "qa", []string{"qa", "review"}, 0),
+			},
+			wantErr: ErrInvalidBoardColumns,
+		},
+		{
+			name:    "too many members",
+			columns: DefaultBoardColumns(),
+			members: func() []int64 {
+				members := make([]int64, maxBoardMembers+1)
+				for i := range members {
+					members[i] = int64(100 + i)
+				}
+				return members
+			}(),
+			wantErr: ErrInvalidBoardMembers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewBoard(1, 10, "Team", tt.columns, vo.BoardSwimlaneNone, tt.members); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBoard_ColumnOf(t *testing.T) {
+	board, err := NewBoard(1, 10, "Team", []vo.BoardColumn{
+		mustColumn(t, "todo", []string{"pending"}, 0),
+		mustColumn(t, "doing", []string{"in_progress"}, 0),
+		mustStateColumn(t, "review", []string{"review", "qa"}, 2),
+	}, vo.BoardSwimlaneNone, nil)
+	if err != nil {
+		t.Fatalf("NewBoard() error = %v", err)
+	}
+
+	tests := []struct {
+		status todovo.TodoStatus
+		state  string
+		want   string
+	}{
+		{status: todovo.StatusPending, state: "pending", want: "todo"},
+		{status: todovo.StatusInProgress, state: "in_progress", want: "doing"},
+		{status: todovo.StatusInProgress, state: "review", want: "review"},
+		{status: todovo.StatusInProgress, state: "qa", want: "review"},
+		{status: todovo.StatusCompleted, state: "completed", want: ""},
+	}
+	for _, tt := range tests {
+		column, ok := board.ColumnOf(tt.status, tt.state)
+		if ok != (tt.want != "") || column.Key() != tt.want {
+			t.Errorf("ColumnOf(%v, %q) = %q, want %q", tt.status, tt.state, column.Key(), tt.want)
+		}
+	}
+
+	doing, _ := board.Column("doing")
+	filter := board.ColumnFilter(doing)
+	if !slices.Equal(filter.Statuses, []todovo.TodoStatus{todovo.StatusInProgress}) ||
+		!slices.Equal(filter.ExcludedStates, []string{"review", "qa"}) || len(filter.States) != 0 {
+		t.Errorf("Expected in progress todos not in review or qa, got %+v", filter)
+	}
+
+	counts := map[todovo.TodoStateKey]int64{
+		{Status: todovo.StatusInProgress, State: "in_progress"}: 3,
+		{Status: todovo.StatusInProgress, State: "review"}:      1,
+		{Status: todovo.StatusInProgress, State: "qa"}:          1,
+		{Status: todovo.StatusCompleted, State: "completed"}:    4,
+	}
+	review, _ := board.Column("review")
+	if got := board.ColumnCount(doing, counts); got != 3 {
+		t.Errorf("Expected 3 todos in doing, got %d", got)
+	}
+	if got := board.ColumnCount(review, counts); got != 2 {
+		t.Errorf("Expected 2 todos in review, got %d", got)
+	}
+}
+
+func TestBoardColumn(t *testing.T) {
+	for _, tt := range []struct {
+		key      string
+		statuses []string
+		states   []string
+		wipLimit int
+	}{
+		{key: "To Do", statuses: []string{"pending"}},
+		{key: "todo", statuses: nil},
+		{key: "todo", statuses: []string{"done"}},
+		{key: "todo", statuses: []string{"pending", "pending"}},
+		{key: "todo", statuses: []string{"pending"}, wipLimit: -1},
+		{key: "review", states: []string{"In Review"}},
+		{key: "review", states: []string{"review", "review"}},
+	} {
+		if _, err := vo.NewBoardColumn(tt.key, "Column", tt.statuses, tt.states, tt.wipLimit); !errors.Is(err, vo.ErrInvalidBoardColumn) {
+			t.Errorf("NewBoardColumn(%q, %v, %v, %d) error = %v, want ErrInvalidBoardColumn", tt.key, tt.statuses, tt.states, tt.wipLimit, err)
+		}
+	}
+
+	review := mustStateColumn(t, "review", []string{"review"}, 0)
+	if _, ok := review.EntryStatus(); ok || !review.HoldsState("review") || review.Holds(todovo.StatusInProgress) {
+		t.Error("Expected a column of states to hold its states only")
+	}
+
+	limited := mustColumn(t, "doing", []string{"in_progress"}, 2)
+	if !limited.HasRoomFor(1) || limited.HasRoomFor(2) {
+		t.Error("Expected a column limited to 2 todos to have room for a second todo only")
+	}
+	if limited.IsOverLimit(2) || !limited.IsOverLimit(3) {
+		t.Error("Expected a column limited to 2 todos to be over its limit with 3 todos")
+	}
+
+	unlimited := mustColumn(t, "todo", []string{"pending"}, 0)
+	if !unlimited.HasRoomFor(1000) || unlimited.IsOverLimit(1000) {
+		t.Error("Expected a column without WIP limit to always have room")
+	}
+}
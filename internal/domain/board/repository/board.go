@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/board/entity"
+)
+
+// BoardRepository defines persistence operations for boards
+type BoardRepository interface {
+	// Commands
+	Save(ctx context.Context, board *entity.Board) error
+	Delete(ctx context.Context, id int64) error
+
+	// Lock locks a board until the end of the transaction in ctx, serializing the
+	// moves into its columns
+	Lock(ctx context.Context, id int64) error
+
+	// Queries
+	FindByID(ctx context.Context, id int64) (*entity.Board, error)
+
+	// FindByUser returns the boards owned by the user or having them as member, ordered by name
+	FindByUser(ctx context.Context, userID int64) ([]*entity.Board, error)
+}
@@ -0,0 +1,147 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+// maxBoardColumnNameLength is the maximum length in characters of column names
+const maxBoardColumnNameLength = 50
+
+var (
+	ErrInvalidBoardColumn   = errors.New("invalid board column")
+	ErrInvalidBoardSwimlane = errors.New("invalid board swimlane")
+)
+
+// boardColumnKeyRegex validates the keys columns are referred to by
+var boardColumnKeyRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,29}$`)
+
+// BoardColumn is a column of a board, holding the todos in its workflow states
+// and the todos in its statuses whose state no column of the board holds. Todos
+// moved into the column take the first of its states their workflow has, or
+// else its first status.
+type BoardColumn struct {
+	key      string
+	name     string
+	statuses []todovo.TodoStatus
+	states   []string
+	wipLimit int
+}
+
+// NewBoardColumn creates a board column, a WIP limit of zero meaning no limit.
+// The states are the keys of workflow states, checked against the workflows of
+// the board users when the board is saved.
+func NewBoardColumn(key, name string, statuses, states []string, wipLimit int) (BoardColumn, error) {
+	if !boardColumnKeyRegex.MatchString(key) {
+		return BoardColumn{}, fmt.Errorf("%w: key %q must be lowercase letters, digits, - or _", ErrInvalidBoardColumn, key)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxBoardColumnNameLength {
+		return BoardColumn{}, fmt.Errorf("%w: name of %q must have between 1 and 50 characters", ErrInvalidBoardColumn, key)
+	}
+
+	if len(statuses) == 0 && len(states) == 0 {
+		return BoardColumn{}, fmt.Errorf("%w: %q must hold at least one status or state", ErrInvalidBoardColumn, key)
+	}
+
+	column := BoardColumn{key: key, name: name, wipLimit: wipLimit}
+	for _, value := range statuses {
+		status, err := todovo.NewTodoStatusFromString(value)
+		if err != nil {
+			return BoardColumn{}, fmt.Errorf("%w: unknown status %q in %q", ErrInvalidBoardColumn, value, key)
+		}
+		if slices.Contains(column.statuses, status) {
+			return BoardColumn{}, fmt.Errorf("%w: status %q repeated in %q", ErrInvalidBoardColumn, value, key)
+		}
+		column.statuses = append(column.statuses, status)
+	}
+
+	for _, state := range states {
+		// State keys have the format of column keys
+		if !boardColumnKeyRegex.MatchString(state) {
+			return BoardColumn{}, fmt.Errorf("%w: invalid state %q in %q", ErrInvalidBoardColumn, state, key)
+		}
+		if slices.Contains(column.states, state) {
+			return BoardColumn{}, fmt.Errorf("%w: state %q repeated in %q", ErrInvalidBoardColumn, state, key)
+		}
+		column.states = append(column.states, state)
+	}
+
+	if wipLimit < 0 {
+		return BoardColumn{}, fmt.Errorf("%w: WIP limit of %q can not be negative", ErrInvalidBoardColumn, key)
+	}
+
+	return column, nil
+}
+
+// Key returns the key of the column, unique in its board
+func (c BoardColumn) Key() string { return c.key }
+
+// Name returns the column name
+func (c BoardColumn) Name() string { return c.name }
+
+// Statuses returns the statuses of the todos in the column
+func (c BoardColumn) Statuses() []todovo.TodoStatus { return slices.Clone(c.statuses) }
+
+// States returns the keys of the workflow states of the todos in the column
+func (c BoardColumn) States() []string { return slices.Clone(c.states) }
+
+// WIPLimit returns the maximum number of todos in the column, zero when unlimited
+func (c BoardColumn) WIPLimit() int { return c.wipLimit }
+
+// EntryStatus returns the status todos whose workflow has none of the column
+// states take when moved into the column, if the column holds statuses
+func (c BoardColumn) EntryStatus() (todovo.TodoStatus, bool) {
+	if len(c.statuses) == 0 {
+		return "", false
+	}
+	return c.statuses[0], true
+}
+
+// Holds checks if todos in the status are in the column, unless another column
+// holds their state
+func (c BoardColumn) Holds(status todovo.TodoStatus) bool { return slices.Contains(c.statuses, status) }
+
+// HoldsState checks if todos in the workflow state are in the column
+func (c BoardColumn) HoldsState(state string) bool { return slices.Contains(c.states, state) }
+
+// HasRoomFor checks if one more todo can be moved into the column, which holds count todos
+func (c BoardColumn) HasRoomFor(count int64) bool {
+	return c.wipLimit == 0 || count < int64(c.wipLimit)
+}
+
+// IsOverLimit checks if the column holds more todos than its WIP limit allows, as
+// todos changing status outside the board are not held back by the limit
+func (c BoardColumn) IsOverLimit(count int64) bool {
+	return c.wipLimit > 0 && count > int64(c.wipLimit)
+}
+
+// BoardSwimlane is the field the todos of a board are split into rows by
+type BoardSwimlane string
+
+const (
+	BoardSwimlaneNone     BoardSwimlane = ""
+	BoardSwimlanePriority BoardSwimlane = "priority"
+	BoardSwimlaneAssignee BoardSwimlane = "assignee"
+)
+
+// NewBoardSwimlane creates a BoardSwimlane from string
+func NewBoardSwimlane(swimlane string) (BoardSwimlane, error) {
+	s := BoardSwimlane(swimlane)
+	switch s {
+	case BoardSwimlaneNone, BoardSwimlanePriority, BoardSwimlaneAssignee:
+		return s, nil
+	default:
+		return "", ErrInvalidBoardSwimlane
+	}
+}
+
+// String returns the string representation
+func (s BoardSwimlane) String() string { return string(s) }
@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+// Custom field limits
+const (
+	// maxCustomFieldNameLength is the maximum length in characters of custom field names
+	maxCustomFieldNameLength = 100
+
+	// maxCustomFieldOptions is the maximum number of options of select fields
+	maxCustomFieldOptions = 50
+
+	// maxCustomFieldOptionLength is the maximum length in characters of options
+	maxCustomFieldOptionLength = 100
+)
+
+var (
+	ErrInvalidCustomField       = errors.New("invalid custom field")
+	ErrCustomFieldNotFound      = errors.New("custom field not found")
+	ErrCustomFieldAlreadyExists = errors.New("custom field already exists")
+	ErrCustomFieldOptionInUse   = errors.New("custom field option is in use by todos")
+	ErrCustomFieldRequired      = errors.New("custom field is required")
+	ErrUnknownCustomField       = errors.New("unknown custom field")
+)
+
+// customFieldKeyRegex validates the keys custom fields are referred to by in
+// requests, filters and sort orders
+var customFieldKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// CustomField is an attribute users add to their todos, or to the todos of one of
+// their projects. The key and type are fixed, as the values of todos depend on them.
+type CustomField struct {
+	shared.Entity
+	userID    int64
+	projectID int64
+	key       string
+	name      string
+	fieldType todovo.CustomFieldType
+	options   []string
+	required  bool
+}
+
+// NewCustomField creates a custom field of the user's todos, or of the todos of a
+// project when projectID is not zero. Select fields need options to choose from.
+func NewCustomField(
+	id, userID, projectID int64,
+	key, name string,
+	fieldType todovo.CustomFieldType,
+	options []string,
+	required bool,
+) (*CustomField, error) {
+	if !customFieldKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("%w: key %q must start with a lowercase letter followed by lowercase letters, digits or _", ErrInvalidCustomField, key)
+	}
+
+	f := &CustomField{
+		Entity:    shared.NewEntity(id),
+		userID:    userID,
+		projectID: projectID,
+		key:       key,
+		fieldType: fieldType,
+		required:  required,
+	}
+
+	if err := f.Rename(name); err != nil {
+		return nil, err
+	}
+
+	if err := f.ChangeOptions(options, nil); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UserID returns the ID of the user the field belongs to
+func (f *CustomField) UserID() int64 { return f.userID }
+
+// ProjectID returns the ID of the project the field is limited to, zero for all the user's todos
+func (f *CustomField) ProjectID() int64 { return f.projectID }
+
+// Key returns the key of the field, unique among the fields of its user
+func (f *CustomField) Key() string { return f.key }
+
+// Name returns the field name
+func (f *CustomField) Name() string { return f.name }
+
+// Type returns the type of the field values
+func (f *CustomField) Type() todovo.CustomFieldType { return f.fieldType }
+
+// Options returns the options of select fields
+func (f *CustomField) Options() []string { return slices.Clone(f.options) }
+
+// IsRequired checks if the todos the field applies to must have a value
+func (f *CustomField) IsRequired() bool { return f.required }
+
+// IsOwnedBy checks if the user owns the field
+func (f *CustomField) IsOwnedBy(userID int64) bool { return f.userID == userID }
+
+// AppliesTo checks if todos in the project, zero for none, have the field
+func (f *CustomField) AppliesTo(projectID int64) bool {
+	return f.projectID == 0 || f.projectID == projectID
+}
+
+// Rename changes the field name
+func (f *CustomField) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCustomFieldNameLength {
+		return fmt.Errorf("%w: name must have between 1 and %d characters", ErrInvalidCustomField, maxCustomFieldNameLength)
+	}
+
+	f.name = name
+	f.SetAsModified()
+	return nil
+}
+
+// SetRequired changes whether the todos the field applies to must have a value.
+// Todos without a value must be given one the next time their custom fields change.
+func (f *CustomField) SetRequired(required bool) {
+	f.required = required
+	f.SetAsModified()
+}
+
+// ChangeOptions replaces the options of select fields. The options todos have
+// chosen must stay, so the values of the todos remain valid.
+func (f *CustomField) ChangeOptions(options []string, optionsInUse []string) error {
+	if !f.fieldType.HasOptions() {
+		if len(options) > 0 {
+			return fmt.Errorf("%w: only select fields have options", ErrInvalidCustomField)
+		}
+		return nil
+	}
+
+	if len(options) == 0 || len(options) > maxCustomFieldOptions {
+		return fmt.Errorf("%w: select fields must have between 1 and %d options", ErrInvalidCustomField, maxCustomFieldOptions)
+	}
+
+	next := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || utf8.RuneCountInString(option) > maxCustomFieldOptionLength {
+			return fmt.Errorf("%w: options must have between 1 and %d characters", ErrInvalidCustomField, maxCustomFieldOptionLength)
+		}
+		if slices.Contains(next, option) {
+			return fmt.Errorf("%w: option %q repeated", ErrInvalidCustomField, option)
+		}
+		next = append(next, option)
+	}
+
+	for _, option := range optionsInUse {
+		if !slices.Contains(next, option) {
+			return fmt.Errorf("%w: %q", ErrCustomFieldOptionInUse, option)
+		}
+	}
+
+	f.options = next
+	f.SetAsModified()
+	return nil
+}
+
+// Parse checks a value given in a request is a value of the field: a string for
+// text, URL, date (as YYYY-MM-DD) and select fields, a number, a bool for
+// checkboxes, and a list of options for multi-selects
+func (f *CustomField) Parse(value any) (todovo.CustomFieldValue, error) {
+	values, err := f.parseStrings(value)
+	if err != nil {
+		return todovo.CustomFieldValue{}, fmt.Errorf("%w: %s %v", todovo.ErrInvalidCustomFieldValue, f.key, err)
+	}
+
+	if f.fieldType.HasOptions() {
+		for _, v := range values {
+			if !slices.Contains(f.options, v) {
+				return todovo.CustomFieldValue{}, fmt.Errorf("%w: %s has no option %q", todovo.ErrInvalidCustomFieldValue, f.key, v)
+			}
+		}
+	}
+
+	return todovo.NewCustomFieldValue(f.ID(), f.key, f.fieldType, values)
+}
+
+// parseStrings converts a value given in a request to the strings values are kept as
+func (f *CustomField) parseStrings(value any) ([]string, error) {
+	switch f.fieldType {
+	case todovo.CustomFieldNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return nil, errors.New("must be a number")
+		}
+		return []string{strconv.FormatFloat(number, 'f', -1, 64)}, nil
+
+	case todovo.CustomFieldCheckbox:
+		checked, ok := value.(bool)
+		if !ok {
+			return nil, errors.New("must be true or false")
+		}
+		return []string{strconv.FormatBool(checked)}, nil
+
+	case todovo.CustomFieldMultiSelect:
+		items, ok := value.([]any)
+		if !ok {
+			return nil, errors.New("must be a list of options")
+		}
+		values := make([]string, len(items))
+		for i, item := range items {
+			if values[i], ok = item.(string); !ok {
+				return nil, errors.New("must be a list of options")
+			}
+		}
+		return values, nil
+
+	case todovo.CustomFieldDate:
+		text, ok := value.(string)
+		if !ok {
+			return nil, errors.New("must be a date as YYYY-MM-DD")
+		}
+		// Timestamps are accepted for their day
+		if date, err := time.Parse(time.RFC3339, text); err == nil {
+			text = date.Format(time.DateOnly)
+		}
+		return []string{text}, nil
+
+	default:
+		text, ok := value.(string)
+		if !ok {
+			return nil, errors.New("must be a string")
+		}
+		return []string{strings.TrimSpace(text)}, nil
+	}
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+func TestNewCustomField(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		fieldType todovo.CustomFieldType
+		options   []string
+		wantErr   bool
+	}{
+		{name: "text", key: "customer", fieldType: todovo.CustomFieldText},
+		{name: "select", key: "size", fieldType: todovo.CustomFieldSelect, options: []string{"S", " M "}},
+		{name: "invalid key", key: "Story Points", fieldType: todovo.CustomFieldNumber, wantErr: true},
+		{name: "select without options", key: "size", fieldType: todovo.CustomFieldSelect, wantErr: true},
+		{name: "options of a text field", key: "customer", fieldType: todovo.CustomFieldText, options: []string{"a"}, wantErr: true},
+		{name: "repeated option", key: "size", fieldType: todovo.CustomFieldMultiSelect, options: []string{"S", "S"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, err := NewCustomField(1, 10, 0, tt.key, "Field", tt.fieldType, tt.options, false)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCustomField) {
+					t.Fatalf("Expected ErrInvalidCustomField, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if tt.options != nil && field.Options()[1] != "M" {
+				t.Errorf("Expected trimmed options, got %v", field.Options())
+			}
+		})
+	}
+}
+
+func TestCustomFieldAppliesTo(t *testing.T) {
+	userWide, _ := NewCustomField(1, 10, 0, "customer", "Customer", todovo.CustomFieldText, nil, false)
+	ofProject, _ := NewCustomField(2, 10, 5, "points", "Points", todovo.CustomFieldNumber, nil, false)
+
+	if !userWide.AppliesTo(0) || !userWide.AppliesTo(5) {
+		t.Error("Expected user-wide fields to apply to all todos")
+	}
+	if ofProject.AppliesTo(0) || ofProject.AppliesTo(6) || !ofProject.AppliesTo(5) {
+		t.Error("Expected project fields to only apply to the todos of the project")
+	}
+}
+
+func TestCustomFieldChangeOptions(t *testing.T) {
+	field, err := NewCustomField(1, 10, 0, "size", "Size", todovo.CustomFieldSelect, []string{"S", "M", "L"}, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if err := field.ChangeOptions([]string{"S", "L"}, []string{"M"}); !errors.Is(err, ErrCustomFieldOptionInUse) {
+		t.Errorf("Expected ErrCustomFieldOptionInUse, got %v", err)
+	}
+
+	if err := field.ChangeOptions([]string{"S", "M", "XL"}, []string{"M"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := []string{"S", "M", "XL"}; !reflect.DeepEqual(field.Options(), want) {
+		t.Errorf("Options() = %v, want %v", field.Options(), want)
+	}
+}
+
+func TestCustomFieldParse(t *testing.T) {
+	newField := func(fieldType todovo.CustomFieldType, options ...string) *CustomField {
+		t.Helper()
+		field, err := NewCustomField(1, 10, 0, "field", "Field", fieldType, options, false)
+		if err != nil {
+			t.Fatalf("NewCustomField() error = %v", err)
+		}
+		return field
+	}
+
+	tests := []struct {
+		name    string
+		field   *CustomField
+		value   any
+		want    []string
+		wantErr bool
+	}{
+		{name: "text trimmed", field: newField(todovo.CustomFieldText), value: " ACME ", want: []string{"ACME"}},
+		{name: "number", field: newField(todovo.CustomFieldNumber), value: 3.0, want: []string{"3"}},
+		{name: "date", field: newField(todovo.CustomFieldDate), value: "2026-11-01", want: []string{"2026-11-01"}},
+		{name: "date and time", field: newField(todovo.CustomFieldDate), value: "2026-11-01T10:00:00Z", want: []string{"2026-11-01"}},
+		{name: "checkbox", field: newField(todovo.CustomFieldCheckbox), value: false, want: []string{"false"}},
+		{name: "select", field: newField(todovo.CustomFieldSelect, "S", "M"), value: "M", want: []string{"M"}},
+		{name: "multi-select", field: newField(todovo.CustomFieldMultiSelect, "a", "b"), value: []any{"b", "a"}, want: []string{"b", "a"}},
+		{name: "number as string", field: newField(todovo.CustomFieldNumber), value: "3", wantErr: true},
+		{name: "checkbox as string", field: newField(todovo.CustomFieldCheckbox), value: "true", wantErr: true},
+		{name: "unknown option", field: newField(todovo.CustomFieldSelect, "S", "M"), value: "XL", wantErr: true},
+		{name: "options not strings", field: newField(todovo.CustomFieldMultiSelect, "a"), value: []any{1.0}, wantErr: true},
+		{name: "invalid url", field: newField(todovo.CustomFieldURL), value: "example", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.field.Parse(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, todovo.ErrInvalidCustomFieldValue) {
+					t.Fatalf("Expected ErrInvalidCustomFieldValue, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(value.Values(), tt.want) {
+				t.Errorf("Values() = %v, want %v", value.Values(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/customfield/entity"
+)
+
+// CustomFieldRepository defines persistence operations for custom fields
+type CustomFieldRepository interface {
+	// Commands
+	Save(ctx context.Context, field *entity.CustomField) error
+
+	// Delete removes a custom field with its values on todos
+	Delete(ctx context.Context, id int64) error
+
+	// Queries
+	FindByID(ctx context.Context, id int64) (*entity.CustomField, error)
+
+	// FindByUser returns the custom fields of the user, those of all the user's
+	// todos first, then by project and key
+	FindByUser(ctx context.Context, userID int64) ([]*entity.CustomField, error)
+
+	// FindOptionsInUse returns the options of a select field chosen by todos, trashed todos included
+	FindOptionsInUse(ctx context.Context, id int64) ([]string, error)
+}
@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+// maxProjectNameLength is the maximum length in characters of project names
+const maxProjectNameLength = 100
+
+var (
+	ErrInvalidProjectName   = errors.New("project name must have between 1 and 100 characters")
+	ErrProjectNotFound      = errors.New("project not found")
+	ErrProjectAlreadyExists = errors.New("project already exists")
+	ErrWorkflowStateInUse   = errors.New("workflow state is in use by todos")
+)
+
+// Project groups todos of a user following the same workflow
+type Project struct {
+	shared.Entity
+	userID   int64
+	name     string
+	workflow todovo.Workflow
+}
+
+// NewProject creates a new Project entity, a zero workflow standing for the default one
+func NewProject(id, userID int64, name string, workflow todovo.Workflow) (*Project, error) {
+	p := &Project{
+		Entity:   shared.NewEntity(id),
+		userID:   userID,
+		workflow: workflow,
+	}
+
+	if err := p.Rename(name); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// UserID returns the ID of the project owner
+func (p *Project) UserID() int64 { return p.userID }
+
+// Name returns the project name
+func (p *Project) Name() string { return p.name }
+
+// Workflow returns the workflow of the project's todos
+func (p *Project) Workflow() todovo.Workflow {
+	if p.workflow.IsZero() {
+		return todovo.DefaultWorkflow()
+	}
+	return p.workflow
+}
+
+// HasDefaultWorkflow checks if the project's todos follow the default workflow
+func (p *Project) HasDefaultWorkflow() bool { return p.workflow.IsZero() }
+
+// IsOwnedBy checks if the user owns the project
+func (p *Project) IsOwnedBy(userID int64) bool { return p.userID == userID }
+
+// Rename changes the project name
+func (p *Project) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxProjectNameLength {
+		return ErrInvalidProjectName
+	}
+
+	p.name = name
+	p.SetAsModified()
+	return nil
+}
+
+// ChangeWorkflow replaces the project's workflow, a zero workflow standing for
+// the default one. The states todos of the project are in must stay in the new
+// workflow with the same category, so the todos keep a valid state.
+func (p *Project) ChangeWorkflow(workflow todovo.Workflow, statesInUse []string) error {
+	next := workflow
+	if next.IsZero() {
+		next = todovo.DefaultWorkflow()
+	}
+
+	current := p.Workflow()
+	for _, key := range statesInUse {
+		state, ok := current.State(key)
+		if !ok {
+			continue
+		}
+
+		if nextState, ok := next.State(key); !ok || nextState.Category() != state.Category() {
+			return fmt.Errorf("%w: %q", ErrWorkflowStateInUse, key)
+		}
+	}
+
+	p.workflow = workflow
+	p.SetAsModified()
+	return nil
+}
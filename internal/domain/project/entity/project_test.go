@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+func mustWorkflow(t *testing.T, keysAndCategories ...string) todovo.Workflow {
+	t.Helper()
+
+	states := make([]todovo.WorkflowState, 0, len(keysAndCategories)/2)
+	for i := 0; i < len(keysAndCategories); i += 2 {
+		state, err := todovo.NewWorkflowState(keysAndCategories[i], keysAndCategories[i], keysAndCategories[i+1], nil)
+		if err != nil {
+			t.Fatalf("NewWorkflowState(%q) error = %v", keysAndCategories[i], err)
+		}
+		states = append(states, state)
+	}
+
+	workflow, err := todovo.NewWorkflow(states, nil)
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+	return workflow
+}
+
+func TestNewProject(t *testing.T) {
+	project, err := NewProject(1, 10, " Website ", todovo.Workflow{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if project.Name() != "Website" {
+		t.Errorf("Expected name to be trimmed, got %q", project.Name())
+	}
+	if !project.HasDefaultWorkflow() || project.Workflow().Initial().Key() != "pending" {
+		t.Error("Expected the default workflow")
+	}
+
+	if _, err := NewProject(1, 10, " ", todovo.Workflow{}); !errors.Is(err, ErrInvalidProjectName) {
+		t.Errorf("Expected ErrInvalidProjectName, got %v", err)
+	}
+}
+
+func TestProjectChangeWorkflow(t *testing.T) {
+	current := mustWorkflow(t, "todo", "open", "review", "in_progress", "shipped", "done")
+
+	tests := []struct {
+		name        string
+		workflow    todovo.Workflow
+		statesInUse []string
+		wantErr     error
+	}{
+		{
+			name:        "keeps the states in use",
+			workflow:    mustWorkflow(t, "todo", "open", "review", "in_progress"),
+			statesInUse: []string{"todo", "review"},
+		},
+		{
+			name:        "removes a state in use",
+			workflow:    mustWorkflow(t, "todo", "open", "shipped", "done"),
+			statesInUse: []string{"review"},
+			wantErr:     ErrWorkflowStateInUse,
+		},
+		{
+			name:        "changes the category of a state in use",
+			workflow:    mustWorkflow(t, "todo", "open", "review", "open"),
+			statesInUse: []string{"review"},
+			wantErr:     ErrWorkflowStateInUse,
+		},
+		{
+			name:        "ignores states unknown to the workflow",
+			workflow:    mustWorkflow(t, "todo", "open"),
+			statesInUse: []string{"pending"},
+		},
+		{
+			name:        "restores the default workflow",
+			workflow:    todovo.Workflow{},
+			statesInUse: []string{"todo"},
+			wantErr:     ErrWorkflowStateInUse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project, _ := NewProject(1, 10, "Website", current)
+
+			err := project.ChangeWorkflow(tt.workflow, tt.statesInUse)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && project.HasDefaultWorkflow() != tt.workflow.IsZero() {
+				t.Error("Expected the workflow to be replaced")
+			}
+		})
+	}
+}
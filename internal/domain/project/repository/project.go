@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/project/entity"
+)
+
+// ProjectRepository defines persistence operations for projects
+type ProjectRepository interface {
+	// Commands
+	Save(ctx context.Context, project *entity.Project) error
+
+	// Delete removes a project, its todos leaving it for the default workflow
+	Delete(ctx context.Context, id int64) error
+
+	// Queries
+	FindByID(ctx context.Context, id int64) (*entity.Project, error)
+
+	// FindByUser returns the projects of the user, ordered by name
+	FindByUser(ctx context.Context, userID int64) ([]*entity.Project, error)
+
+	// FindStatesInUse returns the states the todos of the project are in, trashed todos included
+	FindStatesInUse(ctx context.Context, id int64) ([]string, error)
+}
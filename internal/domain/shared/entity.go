@@ -1,12 +1,16 @@
 package shared
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // Entity is a base structure for all domain entities
 type Entity struct {
-	id        int64
-	createdAt time.Time
-	updateAt  time.Time
+	id          int64
+	createdAt   time.Time
+	updateAt    time.Time
+	lockVersion int64
 }
 
 // NewEntity creates a base entity with a specific ID
@@ -28,6 +32,9 @@ func (e Entity) CreatedAt() time.Time { return e.createdAt }
 // UpdateAt returns the entity's last update timestamp
 func (e Entity) UpdatedAt() time.Time { return e.updateAt }
 
+// LockVersion returns the version the entity was loaded with, zero when it was never persisted
+func (e Entity) LockVersion() int64 { return e.lockVersion }
+
 // IsModifiedAfter checks if the entity was modified after the given time
 func (e Entity) IsModifiedAfter(t time.Time) bool { return e.updateAt.After(t) }
 
@@ -45,3 +52,15 @@ func (e *Entity) SetCreatedAt(t time.Time) { e.createdAt = t }
 
 // SetUpdatedAt sets the entity's last update timestamp
 func (e *Entity) SetUpdatedAt(t time.Time) { e.updateAt = t }
+
+// SetLockVersion sets the version the entity was loaded with
+func (e *Entity) SetLockVersion(version int64) { e.lockVersion = version }
+
+// CheckLockVersion checks that the entity still has one of the versions expected by the
+// caller, nil expecting any version
+func (e Entity) CheckLockVersion(expected []int64) error {
+	if expected != nil && !slices.Contains(expected, e.lockVersion) {
+		return ErrOptimisticLock
+	}
+	return nil
+}
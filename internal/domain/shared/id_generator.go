@@ -0,0 +1,9 @@
+package shared
+
+import "context"
+
+// IDGenerator generates the identifiers of new entities
+type IDGenerator interface {
+	// NextID returns an identifier never returned before
+	NextID(ctx context.Context) (int64, error)
+}
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"context"
 	"errors"
 )
 
@@ -12,12 +13,24 @@ var (
 	ErrOptimisticLock   = errors.New("optimistic lock error")
 )
 
-// QueryOptions represents query options
+// QueryOptions represents query options.
+//
+// When a cursor is given, results are paginated from the cursor position
+// (keyset pagination) and the offset is ignored. Cursors are only supported for
+// results sorted by at most one key.
 type QueryOptions struct {
-	Limit     int
-	Offset    int
-	OrderBy   string
-	OrderDesc bool
+	Limit  int
+	Offset int
+	Sort   Sort
+	Cursor *Cursor
+}
+
+// Cursor is a position in a listing, given by the value of the sort key and the
+// ID of the entity at the position, the ID breaking ties between equal values
+type Cursor struct {
+	Value    any
+	ID       int64
+	Backward bool // Page before the position, in the listing order, instead of after it
 }
 
 // Filter represents a generic filter
@@ -47,3 +60,9 @@ type Transaction interface {
 	Commit() error
 	Rollback() error
 }
+
+// TransactionManager runs units of work in a transaction. Repositories called with
+// the context given to fn take part in the transaction.
+type TransactionManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
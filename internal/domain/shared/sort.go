@@ -0,0 +1,89 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidSort is returned when a sort order has a key the results cannot be sorted by
+var ErrInvalidSort = errors.New("invalid sort")
+
+// SortField is a key results are sorted by
+type SortField struct {
+	Key  string
+	Desc bool
+}
+
+// Sort is the order of results, by the first field and by the next ones to break ties
+type Sort []SortField
+
+// InvalidSortError reports a sort key that is not allowed, with the allowed keys
+type InvalidSortError struct {
+	Key     string
+	Allowed []string
+}
+
+// Error returns the error message
+func (e *InvalidSortError) Error() string {
+	return fmt.Sprintf("invalid sort key %q, allowed keys: %s", e.Key, strings.Join(e.Allowed, ", "))
+}
+
+// Unwrap returns ErrInvalidSort
+func (e *InvalidSortError) Unwrap() error { return ErrInvalidSort }
+
+// ParseSort parses a comma separated list of sort keys, each one descending when
+// prefixed with a minus, such as -priority,due_date. Keys are checked against the
+// allowed ones, where an allowed key ending with * allows the keys starting with what
+// precedes it, such as cf.* for cf.points. Repeated keys are ignored, as they cannot
+// change the order.
+func ParseSort(value string, allowed []string) (Sort, error) {
+	var sort Sort
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		key, desc := strings.CutPrefix(part, "-")
+		if !desc {
+			key = strings.TrimPrefix(key, "+")
+		}
+
+		if !slices.ContainsFunc(allowed, func(pattern string) bool { return sortKeyMatches(pattern, key) }) {
+			return nil, &InvalidSortError{Key: part, Allowed: allowed}
+		}
+		if sort.Has(key) {
+			continue
+		}
+
+		sort = append(sort, SortField{Key: key, Desc: desc})
+	}
+
+	return sort, nil
+}
+
+// Has checks if the results are sorted by the key
+func (s Sort) Has(key string) bool {
+	return slices.ContainsFunc(s, func(field SortField) bool { return field.Key == key })
+}
+
+// String formats the sort order as parsed by ParseSort
+func (s Sort) String() string {
+	keys := make([]string, len(s))
+	for i, field := range s {
+		keys[i] = field.Key
+		if field.Desc {
+			keys[i] = "-" + field.Key
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+// sortKeyMatches checks if the key is the allowed one, or starts with its prefix when
+// it ends with *
+func sortKeyMatches(allowed, key string) bool {
+	prefix, isPrefix := strings.CutSuffix(allowed, "*")
+	if !isPrefix {
+		return key == allowed
+	}
+	return len(key) > len(prefix) && strings.HasPrefix(key, prefix)
+}
@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseSort(t *testing.T) {
+	allowed := []string{"created_at", "due_date", "priority", "cf.*"}
+
+	tests := []struct {
+		input   string
+		want    Sort
+		wantErr string
+	}{
+		{input: "priority", want: Sort{{Key: "priority"}}},
+		{input: "-priority", want: Sort{{Key: "priority", Desc: true}}},
+		{input: "+priority", want: Sort{{Key: "priority"}}},
+		{
+			input: "-priority,due_date",
+			want:  Sort{{Key: "priority", Desc: true}, {Key: "due_date"}},
+		},
+		{
+			input: " -priority , due_date ",
+			want:  Sort{{Key: "priority", Desc: true}, {Key: "due_date"}},
+		},
+		{input: "priority,-priority", want: Sort{{Key: "priority"}}},
+		{input: "title", wantErr: "title"},
+		{input: "priority,", wantErr: ""},
+		{input: "created_at DESC", wantErr: "created_at DESC"},
+		{input: "--priority", wantErr: "--priority"},
+		{input: "-cf.points,cf.customer", want: Sort{{Key: "cf.points", Desc: true}, {Key: "cf.customer"}}},
+		{input: "cf.", wantErr: "cf."},
+		{input: "cf", wantErr: "cf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSort(tt.input, allowed)
+			if tt.want == nil {
+				var sortErr *InvalidSortError
+				if !errors.As(err, &sortErr) {
+					t.Fatalf("ParseSort(%q) error = %v, want an InvalidSortError", tt.input, err)
+				}
+				if !errors.Is(err, ErrInvalidSort) {
+					t.Errorf("ParseSort(%q) error does not wrap ErrInvalidSort", tt.input)
+				}
+				if sortErr.Key != tt.wantErr || !slices.Equal(sortErr.Allowed, allowed) {
+					t.Errorf("ParseSort(%q) error = %+v, want key %q", tt.input, sortErr, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseSort(%q) error = %v", tt.input, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseSort(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortString(t *testing.T) {
+	sort := Sort{{Key: "priority", Desc: true}, {Key: "due_date"}}
+	if got := sort.String(); got != "-priority,due_date" {
+		t.Errorf("String() = %q, want %q", got, "-priority,due_date")
+	}
+
+	parsed, err := ParseSort(sort.String(), []string{"priority", "due_date"})
+	if err != nil || !slices.Equal(parsed, sort) {
+		t.Errorf("ParseSort(String()) = %v, %v, want %v", parsed, err, sort)
+	}
+}
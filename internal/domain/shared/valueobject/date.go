@@ -22,7 +22,7 @@ type Date struct {
 func NewDate(input any) (Date, error) {
 	switch v := input.(type) {
 	case time.Time:
-		return Date{value: truncateTime(v)}, nil
+		return Date{value: StartOfDay(v)}, nil
 	case string:
 		if v == "" {
 			return Date{}, ErrEmptyDate
@@ -32,7 +32,7 @@ func NewDate(input any) (Date, error) {
 		if v == nil {
 			return Date{}, ErrEmptyDate
 		}
-		return Date{value: truncateTime(*v)}, nil
+		return Date{value: StartOfDay(*v)}, nil
 	default:
 		return Date{}, fmt.Errorf("%w: unsupported type %T", ErrInvalidDate, input)
 	}
@@ -45,7 +45,7 @@ func NewDateFromComponents(year, month, day int) Date {
 
 // Today returns the current date
 func Today() Date {
-	return Date{value: truncateTime(time.Now())}
+	return Date{value: StartOfDay(time.Now())}
 }
 
 func (d Date) Year() int {
@@ -160,7 +160,7 @@ func (d *Date) Scan(value any) error {
 
 	switch v := value.(type) {
 	case time.Time:
-		*d = Date{value: truncateTime(v)}
+		*d = Date{value: StartOfDay(v)}
 		return nil
 	case string:
 		parsed, err := parseDate(v)
@@ -174,7 +174,10 @@ func (d *Date) Scan(value any) error {
 	}
 }
 
-func truncateTime(t time.Time) time.Time {
+// StartOfDay returns midnight of the day of t in its location. Unlike
+// t.Truncate(24*time.Hour), which gives UTC midnight, days start when they do
+// in the time zone of t.
+func StartOfDay(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
 
@@ -192,13 +195,13 @@ func parseDate(str string) (Date, error) {
 
 	for _, layout := range layouts {
 		if t, err := time.Parse(layout, str); err == nil {
-			return Date{value: truncateTime(t)}, nil
+			return Date{value: StartOfDay(t)}, nil
 		}
 	}
 
 	// Try parsing with Go's smart date parser
 	if t, err := time.Parse(time.DateOnly, str); err == nil {
-		return Date{value: truncateTime(t)}, nil
+		return Date{value: StartOfDay(t)}, nil
 	}
 
 	return Date{}, fmt.Errorf("%w: unable to parse %q", ErrInvalidDate, str)
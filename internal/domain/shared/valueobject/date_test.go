@@ -241,3 +241,50 @@ func TestDate_Format(t *testing.T) {
 		})
 	}
 }
+
+func TestStartOfDay(t *testing.T) {
+	auckland := time.FixedZone("UTC+13", 13*60*60)
+	honolulu := time.FixedZone("UTC-10", -10*60*60)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{
+			name: "UTC",
+			t:    time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC),
+			want: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// UTC midnight would be 13:00 of the day in this zone
+			name: "ahead of UTC, after UTC midnight",
+			t:    time.Date(2026, 10, 18, 14, 0, 0, 0, auckland),
+			want: time.Date(2026, 10, 18, 0, 0, 0, 0, auckland),
+		},
+		{
+			name: "ahead of UTC, before UTC midnight",
+			t:    time.Date(2026, 10, 18, 9, 0, 0, 0, auckland),
+			want: time.Date(2026, 10, 18, 0, 0, 0, 0, auckland),
+		},
+		{
+			name: "behind UTC, on the next UTC day",
+			t:    time.Date(2026, 10, 18, 20, 0, 0, 0, honolulu),
+			want: time.Date(2026, 10, 18, 0, 0, 0, 0, honolulu),
+		},
+		{
+			name: "midnight",
+			t:    time.Date(2026, 10, 18, 0, 0, 0, 0, honolulu),
+			want: time.Date(2026, 10, 18, 0, 0, 0, 0, honolulu),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StartOfDay(tt.t)
+			if !got.Equal(tt.want) || got.Location() != tt.want.Location() {
+				t.Errorf("StartOfDay(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package valueobject
+
+import "errors"
+
+// SearchLanguage is the language whose text search configuration stems and
+// matches the words of a full-text search
+type SearchLanguage string
+
+const (
+	SearchLanguageEnglish    SearchLanguage = "english"
+	SearchLanguagePortuguese SearchLanguage = "portuguese"
+)
+
+// DefaultSearchLanguage is the language of searches when none is configured
+const DefaultSearchLanguage = SearchLanguageEnglish
+
+var (
+	ErrInvalidSearchLanguage = errors.New("invalid search language")
+)
+
+// SearchLanguages returns the supported search languages
+func SearchLanguages() []SearchLanguage {
+	return []SearchLanguage{SearchLanguageEnglish, SearchLanguagePortuguese}
+}
+
+// String returns the string representation of the search language
+func (l SearchLanguage) String() string {
+	return string(l)
+}
+
+// IsValid checks if the search language is supported
+func (l SearchLanguage) IsValid() bool {
+	switch l {
+	case SearchLanguageEnglish, SearchLanguagePortuguese:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewSearchLanguage creates a SearchLanguage from string
+func NewSearchLanguage(s string) (SearchLanguage, error) {
+	language := SearchLanguage(s)
+	if !language.IsValid() {
+		return "", ErrInvalidSearchLanguage
+	}
+	return language, nil
+}
@@ -0,0 +1,40 @@
+package valueobject
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewSearchLanguage(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    SearchLanguage
+		wantErr error
+	}{
+		{input: "english", want: SearchLanguageEnglish},
+		{input: "portuguese", want: SearchLanguagePortuguese},
+		{input: "", wantErr: ErrInvalidSearchLanguage},
+		{input: "English", wantErr: ErrInvalidSearchLanguage},
+		{input: "simple", wantErr: ErrInvalidSearchLanguage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NewSearchLanguage(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewSearchLanguage(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewSearchLanguage(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchLanguagesAreValid(t *testing.T) {
+	for _, language := range SearchLanguages() {
+		if !language.IsValid() {
+			t.Errorf("%q is listed but not valid", language)
+		}
+	}
+}
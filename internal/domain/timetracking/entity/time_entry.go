@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"todolist/internal/domain/shared"
+)
+
+// maxTimeEntryNoteLength is the maximum length in characters of time entry notes
+const maxTimeEntryNoteLength = 500
+
+var (
+	ErrInvalidTimeEntry     = errors.New("time entries must end after they start and not in the future")
+	ErrTimeEntryNoteTooLong = errors.New("time entry note must not exceed 500 characters")
+	ErrTimeEntryNotFound    = errors.New("time entry not found")
+	ErrTimerNotRunning      = errors.New("no timer is running")
+	ErrTimerAlreadyRunning  = errors.New("a timer is already running")
+)
+
+// TimeEntry is time a user spent on a todo, either tracked with a timer or
+// entered by hand. Running timers have no end yet.
+type TimeEntry struct {
+	shared.Entity
+	userID    int64
+	todoID    int64
+	startedAt time.Time
+	endedAt   *time.Time
+	note      string
+}
+
+// StartTimer creates a running time entry, started at the given time
+func StartTimer(id, userID, todoID int64, startedAt time.Time, note string) (*TimeEntry, error) {
+	e := &TimeEntry{
+		Entity:    shared.NewEntity(id),
+		userID:    userID,
+		todoID:    todoID,
+		startedAt: startedAt,
+	}
+
+	if err := e.UpdateNote(note); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// NewTimeEntry creates a time entry entered by hand
+func NewTimeEntry(id, userID, todoID int64, startedAt, endedAt time.Time, note string) (*TimeEntry, error) {
+	if !endedAt.After(startedAt) || endedAt.After(time.Now()) {
+		return nil, ErrInvalidTimeEntry
+	}
+
+	e, err := StartTimer(id, userID, todoID, startedAt, note)
+	if err != nil {
+		return nil, err
+	}
+
+	e.endedAt = &endedAt
+	return e, nil
+}
+
+// UserID returns the ID of the user who spent the time
+func (e *TimeEntry) UserID() int64 { return e.userID }
+
+// TodoID returns the ID of the todo the time was spent on
+func (e *TimeEntry) TodoID() int64 { return e.todoID }
+
+// StartedAt returns when the time entry started
+func (e *TimeEntry) StartedAt() time.Time { return e.startedAt }
+
+// EndedAt returns a copy of when the time entry ended, nil while its timer runs
+func (e *TimeEntry) EndedAt() *time.Time {
+	if e.endedAt == nil {
+		return nil
+	}
+	endedAtCopy := *e.endedAt
+	return &endedAtCopy
+}
+
+// Note returns the note describing the work done
+func (e *TimeEntry) Note() string { return e.note }
+
+// IsRunning checks if the timer of the time entry is running
+func (e *TimeEntry) IsRunning() bool { return e.endedAt == nil }
+
+// Duration returns the time spent, zero while the timer runs
+func (e *TimeEntry) Duration() time.Duration {
+	if e.endedAt == nil {
+		return 0
+	}
+	return e.endedAt.Sub(e.startedAt)
+}
+
+// Elapsed returns the time spent until now for running timers, the duration otherwise
+func (e *TimeEntry) Elapsed(now time.Time) time.Duration {
+	if e.endedAt == nil {
+		return max(now.Sub(e.startedAt), 0)
+	}
+	return e.Duration()
+}
+
+// Stop stops the timer of the time entry at the given time
+func (e *TimeEntry) Stop(now time.Time) error {
+	if e.endedAt != nil {
+		return ErrTimerNotRunning
+	}
+
+	endedAt := now
+	if endedAt.Before(e.startedAt) {
+		endedAt = e.startedAt
+	}
+
+	e.endedAt = &endedAt
+	e.SetAsModified()
+	return nil
+}
+
+// UpdateNote updates the note describing the work done
+func (e *TimeEntry) UpdateNote(note string) error {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > maxTimeEntryNoteLength {
+		return ErrTimeEntryNoteTooLong
+	}
+
+	e.note = note
+	e.SetAsModified()
+	return nil
+}
+
+// SetPersistedState restores the end loaded from persistence, nil for running timers
+func (e *TimeEntry) SetPersistedState(endedAt *time.Time) {
+	e.endedAt = endedAt
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewTimeEntry(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		start   time.Time
+		end     time.Time
+		note    string
+		wantErr error
+	}{
+		{name: "valid", start: now.Add(-2 * time.Hour), end: now.Add(-time.Hour), note: " Call "},
+		{name: "ends before it starts", start: now.Add(-time.Hour), end: now.Add(-2 * time.Hour), wantErr: ErrInvalidTimeEntry},
+		{name: "empty", start: now.Add(-time.Hour), end: now.Add(-time.Hour), wantErr: ErrInvalidTimeEntry},
+		{name: "ends in the future", start: now.Add(-time.Hour), end: now.Add(time.Hour), wantErr: ErrInvalidTimeEntry},
+		{
+			name:    "note too long",
+			start:   now.Add(-2 * time.Hour),
+			end:     now.Add(-time.Hour),
+			note:    strings.Repeat("a", 501),
+			wantErr: ErrTimeEntryNoteTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := NewTimeEntry(1, 10, 20, tt.start, tt.end, tt.note)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if entry.IsRunning() {
+				t.Error("Expected the entry not to be running")
+			}
+			if entry.Duration() != time.Hour {
+				t.Errorf("Expected a duration of 1h, got %v", entry.Duration())
+			}
+			if entry.Note() != "Call" {
+				t.Errorf("Expected the note to be trimmed, got %q", entry.Note())
+			}
+		})
+	}
+}
+
+func TestTimeEntryTimer(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+
+	entry, err := StartTimer(1, 10, 20, start, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !entry.IsRunning() || entry.EndedAt() != nil {
+		t.Fatal("Expected the timer to be running")
+	}
+	if entry.Duration() != 0 {
+		t.Errorf("Expected no duration while running, got %v", entry.Duration())
+	}
+	if got := entry.Elapsed(start.Add(30 * time.Minute)); got != 30*time.Minute {
+		t.Errorf("Expected 30m elapsed, got %v", got)
+	}
+
+	if err := entry.Stop(start.Add(45 * time.Minute)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if entry.IsRunning() || entry.Duration() != 45*time.Minute {
+		t.Errorf("Expected a stopped timer of 45m, got %v", entry.Duration())
+	}
+	if got := entry.Elapsed(start.Add(2 * time.Hour)); got != 45*time.Minute {
+		t.Errorf("Expected the elapsed time to be the duration, got %v", got)
+	}
+
+	if err := entry.Stop(start.Add(time.Hour)); !errors.Is(err, ErrTimerNotRunning) {
+		t.Errorf("Expected ErrTimerNotRunning, got %v", err)
+	}
+}
+
+func TestTimeEntryStopBeforeStart(t *testing.T) {
+	start := time.Now()
+
+	entry, err := StartTimer(1, 10, 20, start, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Clocks going back stop the timer at its start
+	if err := entry.Stop(start.Add(-time.Second)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if entry.Duration() != 0 {
+		t.Errorf("Expected no duration, got %v", entry.Duration())
+	}
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/timetracking/entity"
+	vo "todolist/internal/domain/timetracking/valueobject"
+)
+
+// TimeEntryRepository defines persistence operations for time entries
+type TimeEntryRepository interface {
+	// Save saves or updates a time entry, keeping the time tracked on its todo in
+	// step. Saving a second running timer of a user fails with shared.ErrDuplicateEntry.
+	Save(ctx context.Context, entry *entity.TimeEntry) error
+
+	// Delete removes a time entry, its time no longer tracked on its todo
+	Delete(ctx context.Context, id int64) error
+
+	// Queries
+	FindByID(ctx context.Context, id int64) (*entity.TimeEntry, error)
+
+	// FindRunning returns the running timer of the user, shared.ErrNotFound when none runs
+	FindRunning(ctx context.Context, userID int64) (*entity.TimeEntry, error)
+
+	// FindByTodo returns the time entries of a todo, the latest first
+	FindByTodo(ctx context.Context, todoID int64) ([]*entity.TimeEntry, error)
+
+	// FindTracked returns the finished time entries of the user started in the
+	// period, from included and to excluded, with the details of their todos
+	FindTracked(ctx context.Context, userID int64, from, to time.Time) ([]vo.TrackedTime, error)
+}
@@ -0,0 +1,105 @@
+package valueobject
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// TimeReportGrouping is what the tracked time of a report is summed by
+type TimeReportGrouping string
+
+const (
+	TimeReportByDay     TimeReportGrouping = "day"
+	TimeReportByTag     TimeReportGrouping = "tag"
+	TimeReportByProject TimeReportGrouping = "project"
+)
+
+var ErrInvalidTimeReportGrouping = errors.New("time reports are grouped by day, tag or project")
+
+// NewTimeReportGrouping creates a TimeReportGrouping from string, by day when empty
+func NewTimeReportGrouping(grouping string) (TimeReportGrouping, error) {
+	switch g := TimeReportGrouping(grouping); g {
+	case "":
+		return TimeReportByDay, nil
+	case TimeReportByDay, TimeReportByTag, TimeReportByProject:
+		return g, nil
+	default:
+		return "", ErrInvalidTimeReportGrouping
+	}
+}
+
+// String returns the string representation
+func (g TimeReportGrouping) String() string { return string(g) }
+
+// TrackedTime is the time of a finished time entry, with the todo details reports group it by
+type TrackedTime struct {
+	StartedAt   time.Time
+	Duration    time.Duration
+	Tags        []string
+	ProjectID   int64
+	ProjectName string
+}
+
+// TimeReportRow is the time tracked in a group of a report. The key and name are
+// empty for the time on todos without tag or project.
+type TimeReportRow struct {
+	Key      string
+	Name     string
+	Duration time.Duration
+	Entries  int
+}
+
+// NewTimeReport sums the tracked time by the grouping, days starting in the
+// location. Days are listed in order and tags and projects by decreasing time.
+// Time on todos with several tags is counted in the row of each of them.
+func NewTimeReport(tracked []TrackedTime, grouping TimeReportGrouping, location *time.Location) []TimeReportRow {
+	rows := []TimeReportRow{}
+	indexes := map[string]int{}
+
+	add := func(key, name string, item TrackedTime) {
+		index, ok := indexes[key]
+		if !ok {
+			index = len(rows)
+			indexes[key] = index
+			rows = append(rows, TimeReportRow{Key: key, Name: name})
+		}
+		rows[index].Duration += item.Duration
+		rows[index].Entries++
+	}
+
+	for _, item := range tracked {
+		switch grouping {
+		case TimeReportByTag:
+			if len(item.Tags) == 0 {
+				add("", "", item)
+			}
+			for _, tag := range item.Tags {
+				add(tag, tag, item)
+			}
+		case TimeReportByProject:
+			if item.ProjectID == 0 {
+				add("", "", item)
+			} else {
+				add(strconv.FormatInt(item.ProjectID, 10), item.ProjectName, item)
+			}
+		default:
+			day := item.StartedAt.In(location).Format(time.DateOnly)
+			add(day, day, item)
+		}
+	}
+
+	slices.SortFunc(rows, func(a, b TimeReportRow) int {
+		if grouping == TimeReportByDay {
+			return cmp.Compare(a.Key, b.Key)
+		}
+		if a.Duration != b.Duration {
+			return cmp.Compare(b.Duration, a.Duration)
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return rows
+}
@@ -0,0 +1,91 @@
+package valueobject
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewTimeReportGrouping(t *testing.T) {
+	if grouping, err := NewTimeReportGrouping(""); err != nil || grouping != TimeReportByDay {
+		t.Errorf("Expected the day grouping by default, got %q, %v", grouping, err)
+	}
+	if grouping, err := NewTimeReportGrouping("tag"); err != nil || grouping != TimeReportByTag {
+		t.Errorf("Expected the tag grouping, got %q, %v", grouping, err)
+	}
+	if _, err := NewTimeReportGrouping("week"); !errors.Is(err, ErrInvalidTimeReportGrouping) {
+		t.Errorf("Expected ErrInvalidTimeReportGrouping, got %v", err)
+	}
+}
+
+func TestNewTimeReport(t *testing.T) {
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC) }
+
+	tracked := []TrackedTime{
+		{StartedAt: at(2, 23), Duration: time.Hour, Tags: []string{"billing", "client"}, ProjectID: 7, ProjectName: "Website"},
+		{StartedAt: at(1, 10), Duration: 30 * time.Minute},
+		{StartedAt: at(2, 9), Duration: 2 * time.Hour, Tags: []string{"client"}, ProjectID: 8, ProjectName: "App"},
+		{StartedAt: at(3, 9), Duration: time.Hour, Tags: []string{"billing"}, ProjectID: 7, ProjectName: "Website"},
+	}
+
+	tests := []struct {
+		name     string
+		grouping TimeReportGrouping
+		location *time.Location
+		want     []TimeReportRow
+	}{
+		{
+			name:     "by day",
+			grouping: TimeReportByDay,
+			location: time.UTC,
+			want: []TimeReportRow{
+				{Key: "2026-03-01", Name: "2026-03-01", Duration: 30 * time.Minute, Entries: 1},
+				{Key: "2026-03-02", Name: "2026-03-02", Duration: 3 * time.Hour, Entries: 2},
+				{Key: "2026-03-03", Name: "2026-03-03", Duration: time.Hour, Entries: 1},
+			},
+		},
+		{
+			name:     "by day in a timezone",
+			grouping: TimeReportByDay,
+			location: time.FixedZone("UTC+2", 2*60*60),
+			want: []TimeReportRow{
+				{Key: "2026-03-01", Name: "2026-03-01", Duration: 30 * time.Minute, Entries: 1},
+				{Key: "2026-03-02", Name: "2026-03-02", Duration: 2 * time.Hour, Entries: 1},
+				{Key: "2026-03-03", Name: "2026-03-03", Duration: 2 * time.Hour, Entries: 2},
+			},
+		},
+		{
+			name:     "by tag",
+			grouping: TimeReportByTag,
+			location: time.UTC,
+			want: []TimeReportRow{
+				{Key: "client", Name: "client", Duration: 3 * time.Hour, Entries: 2},
+				{Key: "billing", Name: "billing", Duration: 2 * time.Hour, Entries: 2},
+				{Key: "", Name: "", Duration: 30 * time.Minute, Entries: 1},
+			},
+		},
+		{
+			name:     "by project",
+			grouping: TimeReportByProject,
+			location: time.UTC,
+			want: []TimeReportRow{
+				{Key: "8", Name: "App", Duration: 2 * time.Hour, Entries: 1},
+				{Key: "7", Name: "Website", Duration: 2 * time.Hour, Entries: 2},
+				{Key: "", Name: "", Duration: 30 * time.Minute, Entries: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewTimeReport(tracked, tt.grouping, tt.location); !slices.Equal(got, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+
+	if got := NewTimeReport(nil, TimeReportByTag, time.UTC); got == nil || len(got) != 0 {
+		t.Errorf("Expected no rows, got %+v", got)
+	}
+}
@@ -2,7 +2,9 @@ package entity
 
 import (
 	"errors"
+	"fmt"
 	"slices"
+	"strings"
 	"time"
 	"todolist/internal/domain/shared"
 	sharedvo "todolist/internal/domain/shared/valueobject"
@@ -17,19 +19,31 @@ var (
 	ErrInvalidDueDate          = errors.New("due date cannot be in the past")
 	ErrTodoNotFound            = errors.New("todo not found")
 	ErrUnauthorizedTodoAccess  = errors.New("unauthorized to access this todo")
+	ErrUnknownState            = errors.New("unknown workflow state")
+	ErrMissingRequiredField    = errors.New("missing field required by the workflow state")
+	ErrInvalidEstimate         = errors.New("estimate cannot be negative")
 )
 
 // Todo represents a todo item
 type Todo struct {
 	shared.Entity
-	userID      int64
-	title       vo.TodoTitle
-	description vo.TodoDescription
-	status      vo.TodoStatus
-	priority    sharedvo.Priority
-	dueDate     *time.Time
-	completedAt *time.Time
-	tags        []string
+	userID       int64
+	title        vo.TodoTitle
+	description  vo.TodoDescription
+	status       vo.TodoStatus
+	priority     sharedvo.Priority
+	dueDate      *time.Time
+	completedAt  *time.Time
+	deletedAt    *time.Time
+	position     string
+	tags         []string
+	projectID    int64
+	workflow     vo.Workflow
+	state        string
+	resolution   string
+	estimate     time.Duration
+	trackedTime  time.Duration
+	customFields []vo.CustomFieldValue
 }
 
 // NewTodo creates a new Todo entity
@@ -55,6 +69,7 @@ func NewTodo(
 		title:       title,
 		description: description,
 		status:      vo.StatusPending,
+		state:       vo.DefaultWorkflow().Initial().Key(),
 		priority:    priority,
 		dueDate:     dueDate,
 		tags:        []string{},
@@ -96,6 +111,18 @@ func (t *Todo) CompletedAt() *time.Time {
 	return &completedAtCopy
 }
 
+// DeletedAt returns a copy of the todo's deletion timestamp, nil when not in the trash
+func (t *Todo) DeletedAt() *time.Time {
+	if t.deletedAt == nil {
+		return nil
+	}
+	deletedAtCopy := *t.deletedAt
+	return &deletedAtCopy
+}
+
+// Position returns the todo's position in the manual order of its user, empty when it was never placed
+func (t *Todo) Position() string { return t.position }
+
 // Tags returns a copy of the todo's tags
 func (t *Todo) Tags() []string {
 	// Return a copy to prevent external modification
@@ -104,6 +131,41 @@ func (t *Todo) Tags() []string {
 	return tagsCopy
 }
 
+// ProjectID returns the ID of the todo's project, zero when it is in none
+func (t *Todo) ProjectID() int64 { return t.projectID }
+
+// Workflow returns the workflow of the todo's project, the default workflow when it has none
+func (t *Todo) Workflow() vo.Workflow {
+	if t.workflow.IsZero() {
+		return vo.DefaultWorkflow()
+	}
+	return t.workflow
+}
+
+// State returns the key of the todo's state in its workflow
+func (t *Todo) State() string { return t.state }
+
+// Resolution returns the resolution given on entering the todo's state
+func (t *Todo) Resolution() string { return t.resolution }
+
+// Estimate returns the time the todo is expected to take, zero when not estimated
+func (t *Todo) Estimate() time.Duration { return t.estimate }
+
+// TrackedTime returns the time tracked on the todo, running timers excluded
+func (t *Todo) TrackedTime() time.Duration { return t.trackedTime }
+
+// CustomFields returns a copy of the values of the todo's custom fields
+func (t *Todo) CustomFields() []vo.CustomFieldValue { return slices.Clone(t.customFields) }
+
+// CustomField returns the value of the custom field with a key
+func (t *Todo) CustomField(key string) (vo.CustomFieldValue, bool) {
+	index := slices.IndexFunc(t.customFields, func(value vo.CustomFieldValue) bool { return value.Key() == key })
+	if index < 0 {
+		return vo.CustomFieldValue{}, false
+	}
+	return t.customFields[index], true
+}
+
 // Business methods
 
 // IsCompleted checks if the todo is completed
@@ -119,6 +181,21 @@ func (t *Todo) IsOverdue() bool {
 	return t.dueDate.Before(time.Now())
 }
 
+// IsDeleted checks if the todo is in the trash
+func (t *Todo) IsDeleted() bool {
+	return t.deletedAt != nil
+}
+
+// PurgeAt returns when a todo in the trash is permanently deleted given the retention
+// period, nil when it is not in the trash or is kept forever
+func (t *Todo) PurgeAt(retention time.Duration) *time.Time {
+	if t.deletedAt == nil || retention <= 0 {
+		return nil
+	}
+	purgeAt := t.deletedAt.Add(retention)
+	return &purgeAt
+}
+
 // IsOverdue checks if the todo is overdue
 func (t *Todo) DaysUntilDue() *int {
 	if t.dueDate == nil {
@@ -130,6 +207,11 @@ func (t *Todo) DaysUntilDue() *int {
 
 // Update methods
 
+// MoveTo places the todo at a position of the manual order of its user
+func (t *Todo) MoveTo(position string) {
+	t.position = position
+}
+
 // UpdateTitle updates the todo's title
 func (t *Todo) UpdateTitle(title vo.TodoTitle) {
 	t.title = title
@@ -158,19 +240,89 @@ func (t *Todo) UpdateDueDate(dueDate *time.Time) error {
 	return nil
 }
 
-// ChangeStatus changes the todo's status with validation
+// UpdateEstimate updates the time the todo is expected to take, zero removing the estimate
+func (t *Todo) UpdateEstimate(estimate time.Duration) error {
+	if estimate < 0 {
+		return ErrInvalidEstimate
+	}
+	t.estimate = estimate
+	t.SetAsModified()
+	return nil
+}
+
+// SetCustomField sets the value of a custom field, replacing its previous value
+func (t *Todo) SetCustomField(value vo.CustomFieldValue) {
+	index := slices.IndexFunc(t.customFields, func(other vo.CustomFieldValue) bool { return other.FieldID() == value.FieldID() })
+	if index < 0 {
+		t.customFields = append(t.customFields, value)
+	} else if !t.customFields[index].Equal(value) {
+		t.customFields[index] = value
+	} else {
+		return
+	}
+	t.SetAsModified()
+}
+
+// RemoveCustomField removes the value of a custom field
+func (t *Todo) RemoveCustomField(fieldID int64) {
+	count := len(t.customFields)
+	t.customFields = slices.DeleteFunc(t.customFields, func(value vo.CustomFieldValue) bool { return value.FieldID() == fieldID })
+	if len(t.customFields) != count {
+		t.SetAsModified()
+	}
+}
+
+// ChangeStatus changes the todo's status, moving it to the first state in the
+// status its workflow allows to move to
 func (t *Todo) ChangeStatus(newStatus vo.TodoStatus) error {
-	if !t.status.CanTransitionTo(newStatus) {
+	state, ok := t.Workflow().NextState(t.state, newStatus)
+	if !ok {
+		return ErrInvalidStatusTransition
+	}
+	return t.TransitionTo(state.Key(), "")
+}
+
+// TransitionTo moves the todo to a state of its workflow, checking the transition
+// is allowed and the fields the state requires are set. The resolution explains
+// the transition and is replaced by each transition.
+func (t *Todo) TransitionTo(stateKey, resolution string) error {
+	workflow := t.Workflow()
+
+	state, ok := workflow.State(stateKey)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownState, stateKey)
+	}
+
+	if !workflow.CanTransition(t.state, stateKey) {
 		return ErrInvalidStatusTransition
 	}
 
-	t.status = newStatus
+	resolution = strings.TrimSpace(resolution)
+	for _, field := range state.Requires() {
+		missing := false
+		switch field {
+		case vo.FieldResolution:
+			missing = resolution == ""
+		case vo.FieldDescription:
+			missing = t.description.Value() == ""
+		case vo.FieldDueDate:
+			missing = t.dueDate == nil
+		}
+		if missing {
+			return fmt.Errorf("%w: %s", ErrMissingRequiredField, field)
+		}
+	}
+
+	previousStatus := t.status
+	t.state = stateKey
+	t.resolution = resolution
+	t.status = state.Status()
 
 	// Set completed time when marking as completed
-	if newStatus == vo.StatusCompleted {
+	if t.status == vo.StatusCompleted && previousStatus != vo.StatusCompleted {
 		now := time.Now()
 		t.completedAt = &now
-	} else if t.status == vo.StatusCompleted {
+	} else if t.status != vo.StatusCompleted {
 		// Clear completed time when moving away from completed
 		t.completedAt = nil
 	}
@@ -179,6 +331,28 @@ func (t *Todo) ChangeStatus(newStatus vo.TodoStatus) error {
 	return nil
 }
 
+// AssignToProject moves the todo to a project, zero for none, following the
+// project's workflow from then on. The todo keeps its state when the workflow
+// has it in the same status, and takes the first state of its status otherwise.
+func (t *Todo) AssignToProject(projectID int64, workflow vo.Workflow) error {
+	if workflow.IsZero() {
+		workflow = vo.DefaultWorkflow()
+	}
+
+	state, ok := workflow.State(t.state)
+	if !ok || state.Status() != t.status {
+		if state, ok = workflow.StateOf(t.status); !ok {
+			return fmt.Errorf("%w: the workflow has no state in status %s", ErrUnknownState, t.status)
+		}
+	}
+
+	t.projectID = projectID
+	t.workflow = workflow
+	t.state = state.Key()
+	t.SetAsModified()
+	return nil
+}
+
 // Complete marks the todo as completed
 func (t *Todo) Complete() error {
 	if t.IsCompleted() {
@@ -204,6 +378,117 @@ func (t *Todo) Reopen() error {
 	return t.ChangeStatus(vo.StatusPending)
 }
 
+// SetPersistedState restores the state loaded from persistence.
+//
+// The status transition and due date rules only apply to changes, so they are not
+// checked: stored todos may be overdue or have been completed long ago.
+func (t *Todo) SetPersistedState(status vo.TodoStatus, dueDate, completedAt, deletedAt *time.Time) {
+	t.status = status
+	t.dueDate = dueDate
+	t.completedAt = completedAt
+	t.deletedAt = deletedAt
+	t.restoreState(t.state)
+}
+
+// SetPersistedWorkflow restores the project, workflow, state and resolution loaded
+// from persistence, a zero workflow standing for the default one. Todos stored
+// without a state, or in a state their workflow no longer has, take the first
+// state of their status.
+func (t *Todo) SetPersistedWorkflow(projectID int64, workflow vo.Workflow, state, resolution string) {
+	t.projectID = projectID
+	t.workflow = workflow
+	t.resolution = resolution
+	t.restoreState(state)
+}
+
+// SetPersistedTime restores the estimate and the tracked time loaded from persistence.
+// The tracked time is kept by the time entries, not changed through the todo.
+func (t *Todo) SetPersistedTime(estimate, trackedTime time.Duration) {
+	t.estimate = estimate
+	t.trackedTime = trackedTime
+}
+
+// SetPersistedCustomFields restores the custom field values loaded from persistence
+func (t *Todo) SetPersistedCustomFields(values []vo.CustomFieldValue) {
+	t.customFields = slices.Clone(values)
+}
+
+// restoreState sets the restored state, or the first state of the status when the
+// workflow has no such state in the status
+func (t *Todo) restoreState(state string) {
+	if current, ok := t.Workflow().State(state); ok && current.Status() == t.status {
+		t.state = state
+	} else if current, ok := t.Workflow().StateOf(t.status); ok {
+		t.state = current.Key()
+	} else {
+		t.state = t.status.String()
+	}
+}
+
+// History management
+
+// Version returns the editable state of the todo
+func (t *Todo) Version() vo.TodoVersion {
+	return vo.TodoVersion{
+		Title:       t.title,
+		Description: t.description,
+		Status:      t.status,
+		Priority:    t.priority,
+		DueDate:     t.DueDate(),
+		Tags:        t.Tags(),
+	}
+}
+
+// ApplyVersion changes the todo to the given version, used to revert to previous
+// versions and to apply patches.
+//
+// Changes are applied through the update methods, so the status transition and
+// due date rules apply as if the user had made the changes one by one. The status
+// changes last, so the fields the new state requires can be set by the same version.
+func (t *Todo) ApplyVersion(version vo.TodoVersion) error {
+	if !sameTime(version.DueDate, t.dueDate) {
+		if err := t.UpdateDueDate(version.DueDate); err != nil {
+			return err
+		}
+	}
+
+	if !version.Title.Equals(t.title) {
+		t.UpdateTitle(version.Title)
+	}
+
+	if version.Description.Value() != t.description.Value() {
+		t.UpdateDescription(version.Description)
+	}
+
+	if version.Priority != t.priority {
+		t.UpdatePriority(version.Priority)
+	}
+
+	for _, tag := range t.Tags() {
+		if !slices.Contains(version.Tags, tag) {
+			t.RemoveTag(tag)
+		}
+	}
+
+	for _, tag := range version.Tags {
+		t.AddTag(tag)
+	}
+
+	if version.Status != t.status {
+		return t.ChangeStatus(version.Status)
+	}
+
+	return nil
+}
+
+// sameTime checks if two optional timestamps are equal
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
 // Tag management
 
 // AddTag adds a tag to the todo, ensuring no duplicates
@@ -0,0 +1,24 @@
+package entity
+
+// TodoSearchResult is a todo found by a full-text search, with its relevance to
+// the search and the excerpts of its title and description matching it
+type TodoSearchResult struct {
+	Todo *Todo
+
+	// Rank is the relevance of the todo, higher being more relevant. Ranks are
+	// only comparable between the results of the same search.
+	Rank float64
+
+	// TitleHighlight and DescriptionHighlight are HTML escaped excerpts of the
+	// title and description with the matching words wrapped in <mark> elements.
+	// DescriptionHighlight is empty when the todo has no description.
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// SimilarTodo is a todo whose title is similar to another title, from 0 when they
+// share no trigram of their words to 1 when they have the same words
+type SimilarTodo struct {
+	Todo       *Todo
+	Similarity float64
+}
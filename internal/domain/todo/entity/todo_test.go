@@ -1,8 +1,10 @@
 package entity
 
 import (
+	"errors"
 	"testing"
 	"time"
+	"todolist/internal/domain/shared"
 	sharedvo "todolist/internal/domain/shared/valueobject"
 	vo "todolist/internal/domain/todo/valueobject"
 )
@@ -541,3 +543,324 @@ func TestTodoEntityIntegration(t *testing.T) {
 		}
 	})
 }
+
+func TestTodoApplyVersion(t *testing.T) {
+	newTodo := func() *Todo {
+		title, _ := vo.NewTodoTitle("Test Todo")
+		description, _ := vo.NewTodoDescription("Test Description")
+		todo, _ := NewTodo(1, 123, title, description, sharedvo.PriorityMedium, nil)
+		todo.AddTag("work")
+		return todo
+	}
+
+	t.Run("should restore edited fields and tags", func(t *testing.T) {
+		todo := newTodo()
+		version := todo.Version()
+
+		newTitle, _ := vo.NewTodoTitle("Changed Title")
+		todo.UpdateTitle(newTitle)
+		todo.UpdatePriority(sharedvo.PriorityHigh)
+		todo.RemoveTag("work")
+		todo.AddTag("home")
+
+		if err := todo.ApplyVersion(version); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if todo.Title().Value() != "Test Todo" {
+			t.Errorf("Expected title to be restored, got %s", todo.Title().Value())
+		}
+		if todo.Priority() != sharedvo.PriorityMedium {
+			t.Errorf("Expected priority to be restored, got %v", todo.Priority())
+		}
+		if !todo.HasTag("work") || todo.HasTag("home") {
+			t.Errorf("Expected tags to be restored, got %v", todo.Tags())
+		}
+	})
+
+	t.Run("should reopen a completed todo clearing completed at", func(t *testing.T) {
+		todo := newTodo()
+		version := todo.Version()
+		todo.Complete()
+
+		if err := todo.ApplyVersion(version); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if todo.Status() != vo.StatusPending {
+			t.Errorf("Expected status pending, got %s", todo.Status())
+		}
+		if todo.CompletedAt() != nil {
+			t.Error("Expected completedAt to be cleared")
+		}
+	})
+
+	t.Run("should apply status transition rules", func(t *testing.T) {
+		todo := newTodo()
+		todo.StartProgress()
+		version := todo.Version()
+		todo.Cancel()
+
+		if err := todo.ApplyVersion(version); err != ErrInvalidStatusTransition {
+			t.Errorf("Expected ErrInvalidStatusTransition, got %v", err)
+		}
+	})
+
+	t.Run("should apply due date rules", func(t *testing.T) {
+		todo := newTodo()
+		version := todo.Version()
+		past := time.Now().Add(-24 * time.Hour)
+		version.DueDate = &past
+
+		if err := todo.ApplyVersion(version); err != ErrInvalidDueDate {
+			t.Errorf("Expected ErrInvalidDueDate, got %v", err)
+		}
+	})
+
+	t.Run("should change the status after the fields its state requires", func(t *testing.T) {
+		open, _ := vo.NewWorkflowState("open", "Open", "open", nil)
+		review, _ := vo.NewWorkflowState("review", "Review", "in_progress", []string{"description", "due_date"})
+		workflow, err := vo.NewWorkflow([]vo.WorkflowState{open, review}, map[string][]string{"open": {"review"}})
+		if err != nil {
+			t.Fatalf("NewWorkflow() error = %v", err)
+		}
+
+		title, _ := vo.NewTodoTitle("Test Todo")
+		todo, _ := NewTodo(1, 123, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+		if err := todo.AssignToProject(7, workflow); err != nil {
+			t.Fatalf("AssignToProject() error = %v", err)
+		}
+
+		version := todo.Version()
+		version.Status = vo.StatusInProgress
+		version.Description, _ = vo.NewTodoDescription("Ready for review")
+		dueDate := time.Now().Add(24 * time.Hour)
+		version.DueDate = &dueDate
+
+		if err := todo.ApplyVersion(version); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if todo.State() != "review" || todo.Description().Value() != "Ready for review" {
+			t.Errorf("Expected the todo in review with its description, got %q", todo.State())
+		}
+	})
+}
+
+func TestTodoPersistedState(t *testing.T) {
+	title, _ := vo.NewTodoTitle("Test Todo")
+	description, _ := vo.NewTodoDescription("Test Description")
+
+	t.Run("should restore overdue todos without validation", func(t *testing.T) {
+		todo, _ := NewTodo(1, 123, title, description, sharedvo.PriorityMedium, nil)
+		pastDate := time.Now().Add(-48 * time.Hour)
+		completedAt := time.Now().Add(-24 * time.Hour)
+
+		todo.SetPersistedState(vo.StatusCompleted, &pastDate, &completedAt, nil)
+
+		if todo.Status() != vo.StatusCompleted {
+			t.Errorf("Expected status completed, got %s", todo.Status())
+		}
+		if !todo.DueDate().Equal(pastDate) {
+			t.Errorf("Expected due date %v, got %v", pastDate, todo.DueDate())
+		}
+		if !todo.CompletedAt().Equal(completedAt) {
+			t.Errorf("Expected completed at %v, got %v", completedAt, todo.CompletedAt())
+		}
+		if todo.IsDeleted() {
+			t.Error("Expected todo not to be deleted")
+		}
+	})
+
+	t.Run("should compute the purge time of deleted todos", func(t *testing.T) {
+		todo, _ := NewTodo(1, 123, title, description, sharedvo.PriorityMedium, nil)
+		deletedAt := time.Now().Add(-24 * time.Hour)
+
+		if todo.PurgeAt(30*24*time.Hour) != nil {
+			t.Error("Expected no purge time for todos not in the trash")
+		}
+
+		todo.SetPersistedState(vo.StatusPending, nil, nil, &deletedAt)
+
+		if !todo.IsDeleted() {
+			t.Error("Expected todo to be deleted")
+		}
+		if purgeAt := todo.PurgeAt(30 * 24 * time.Hour); purgeAt == nil || !purgeAt.Equal(deletedAt.Add(30*24*time.Hour)) {
+			t.Errorf("Expected purge time 30 days after deletion, got %v", purgeAt)
+		}
+		if todo.PurgeAt(0) != nil {
+			t.Error("Expected no purge time when todos are kept forever")
+		}
+	})
+}
+
+func TestTodoCheckLockVersion(t *testing.T) {
+	title, _ := vo.NewTodoTitle("Test Todo")
+	description, _ := vo.NewTodoDescription("Test Description")
+	todo, _ := NewTodo(1, 123, title, description, sharedvo.PriorityMedium, nil)
+	todo.SetLockVersion(3)
+
+	if err := todo.CheckLockVersion(nil); err != nil {
+		t.Errorf("Expected any version to match, got %v", err)
+	}
+	if err := todo.CheckLockVersion([]int64{2, 3}); err != nil {
+		t.Errorf("Expected current version to match, got %v", err)
+	}
+	if err := todo.CheckLockVersion([]int64{2}); !errors.Is(err, shared.ErrOptimisticLock) {
+		t.Errorf("Expected ErrOptimisticLock, got %v", err)
+	}
+	if err := todo.CheckLockVersion([]int64{}); !errors.Is(err, shared.ErrOptimisticLock) {
+		t.Errorf("Expected no version to match an empty list, got %v", err)
+	}
+}
+
+func TestTodoWorkflow(t *testing.T) {
+	title, _ := vo.NewTodoTitle("Test Todo")
+
+	state := func(key, category string, requires ...string) vo.WorkflowState {
+		s, err := vo.NewWorkflowState(key, key, category, requires)
+		if err != nil {
+			t.Fatalf("NewWorkflowState(%q) error = %v", key, err)
+		}
+		return s
+	}
+
+	workflow, err := vo.NewWorkflow(
+		[]vo.WorkflowState{
+			state("backlog", "open"),
+			state("doing", "in_progress"),
+			state("review", "in_progress", "description"),
+			state("fixed", "done", "resolution"),
+			state("wontfix", "cancelled", "resolution"),
+		},
+		map[string][]string{
+			"backlog": {"doing", "wontfix"},
+			"doing":   {"review", "backlog"},
+			"review":  {"fixed", "doing"},
+			"fixed":   {"backlog"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+
+	newTodo := func(t *testing.T) *Todo {
+		todo, _ := NewTodo(1, 123, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+		if err := todo.AssignToProject(7, workflow); err != nil {
+			t.Fatalf("AssignToProject() error = %v", err)
+		}
+		return todo
+	}
+
+	t.Run("should start in the default workflow", func(t *testing.T) {
+		todo, _ := NewTodo(1, 123, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+
+		if todo.State() != "pending" || todo.ProjectID() != 0 {
+			t.Errorf("Expected the pending state outside projects, got %q in %d", todo.State(), todo.ProjectID())
+		}
+	})
+
+	t.Run("should follow the transitions of the workflow", func(t *testing.T) {
+		todo := newTodo(t)
+		if todo.State() != "backlog" || todo.ProjectID() != 7 {
+			t.Fatalf("Expected the backlog state of project 7, got %q in %d", todo.State(), todo.ProjectID())
+		}
+
+		if err := todo.TransitionTo("review", ""); !errors.Is(err, ErrInvalidStatusTransition) {
+			t.Errorf("Expected ErrInvalidStatusTransition, got %v", err)
+		}
+		if err := todo.TransitionTo("closed", ""); !errors.Is(err, ErrUnknownState) {
+			t.Errorf("Expected ErrUnknownState, got %v", err)
+		}
+		if err := todo.Complete(); !errors.Is(err, ErrInvalidStatusTransition) {
+			t.Errorf("Expected no completion from the backlog, got %v", err)
+		}
+
+		if err := todo.StartProgress(); err != nil || todo.State() != "doing" {
+			t.Fatalf("Expected the doing state, got %q and %v", todo.State(), err)
+		}
+		if todo.Status() != vo.StatusInProgress {
+			t.Errorf("Expected status in progress, got %s", todo.Status())
+		}
+	})
+
+	t.Run("should require the fields of the states", func(t *testing.T) {
+		todo := newTodo(t)
+		_ = todo.StartProgress()
+
+		if err := todo.TransitionTo("review", ""); !errors.Is(err, ErrMissingRequiredField) {
+			t.Fatalf("Expected ErrMissingRequiredField, got %v", err)
+		}
+
+		description, _ := vo.NewTodoDescription("Steps to reproduce")
+		todo.UpdateDescription(description)
+		if err := todo.TransitionTo("review", ""); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if err := todo.Complete(); !errors.Is(err, ErrMissingRequiredField) {
+			t.Errorf("Expected ErrMissingRequiredField without resolution, got %v", err)
+		}
+		if err := todo.TransitionTo("fixed", " Patched "); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !todo.IsCompleted() || todo.CompletedAt() == nil || todo.Resolution() != "Patched" {
+			t.Errorf("Expected a completed todo resolved as Patched, got %s and %q", todo.Status(), todo.Resolution())
+		}
+
+		if err := todo.Reopen(); err != nil || todo.State() != "backlog" || todo.CompletedAt() != nil || todo.Resolution() != "" {
+			t.Errorf("Expected the reopened todo in the backlog, got %q and %v", todo.State(), err)
+		}
+	})
+
+	t.Run("should map the state when changing of workflow", func(t *testing.T) {
+		todo := newTodo(t)
+		_ = todo.StartProgress()
+
+		if err := todo.AssignToProject(0, vo.Workflow{}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if todo.State() != "in_progress" || todo.ProjectID() != 0 {
+			t.Errorf("Expected the in progress state outside projects, got %q", todo.State())
+		}
+
+		closed, _ := vo.NewWorkflow([]vo.WorkflowState{state("open", "open")}, nil)
+		if err := todo.AssignToProject(8, closed); !errors.Is(err, ErrUnknownState) {
+			t.Errorf("Expected ErrUnknownState for a workflow without in progress state, got %v", err)
+		}
+	})
+
+	t.Run("should restore unknown states to the first state of the status", func(t *testing.T) {
+		todo, _ := NewTodo(1, 123, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+		todo.SetPersistedState(vo.StatusCompleted, nil, nil, nil)
+		todo.SetPersistedWorkflow(7, workflow, "archived", "Done")
+
+		if todo.State() != "fixed" || todo.Resolution() != "Done" {
+			t.Errorf("Expected the fixed state, got %q", todo.State())
+		}
+	})
+}
+
+func TestTodoCustomFields(t *testing.T) {
+	title, _ := vo.NewTodoTitle("Test Todo")
+	todo, _ := NewTodo(1, 123, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+
+	points, _ := vo.NewCustomFieldValue(7, "points", vo.CustomFieldNumber, []string{"3"})
+	morePoints, _ := vo.NewCustomFieldValue(7, "points", vo.CustomFieldNumber, []string{"5"})
+	customer, _ := vo.NewCustomFieldValue(8, "customer", vo.CustomFieldText, []string{"ACME"})
+
+	todo.SetCustomField(points)
+	todo.SetCustomField(customer)
+	todo.SetCustomField(morePoints)
+
+	if len(todo.CustomFields()) != 2 {
+		t.Fatalf("Expected 2 custom fields, got %d", len(todo.CustomFields()))
+	}
+	if value, ok := todo.CustomField("points"); !ok || value.Value() != 5.0 {
+		t.Errorf("Expected the points to be replaced, got %v", value.Value())
+	}
+
+	todo.RemoveCustomField(8)
+	if _, ok := todo.CustomField("customer"); ok {
+		t.Error("Expected the customer to be removed")
+	}
+}
@@ -15,12 +15,60 @@ type TodoRepository interface {
 	Save(ctx context.Context, todo *entity.Todo) error
 	Delete(ctx context.Context, id int64) error
 
+	// DeleteVersioned deletes a todo if it still has the version, failing with
+	// shared.ErrOptimisticLock when it was changed since
+	DeleteVersioned(ctx context.Context, id, version int64) error
+
 	// Queries
 	FindByID(ctx context.Context, id int64) (*entity.Todo, error)
 	FindByUserID(ctx context.Context, userID int64) ([]*entity.Todo, error)
 
 	// Batch operations
 	DeleteByUserID(ctx context.Context, userID int64) error
+
+	// Trash
+
+	// FindDeletedByID finds a todo in the trash
+	FindDeletedByID(ctx context.Context, id int64) (*entity.Todo, error)
+
+	// Restore moves a todo out of the trash
+	Restore(ctx context.Context, id int64) error
+
+	// FindDeletedByUserID finds the todos in the trash of a user
+	FindDeletedByUserID(ctx context.Context, userID int64) ([]*entity.Todo, error)
+
+	// FindDeletedBefore finds the todos moved to the trash before the given time
+	FindDeletedBefore(ctx context.Context, before time.Time) ([]*entity.Todo, error)
+
+	// PurgeDeletedByUserID permanently deletes the todos in the trash of a user
+	PurgeDeletedByUserID(ctx context.Context, userID int64) (int64, error)
+
+	// PurgeDeletedBefore permanently deletes the todos moved to the trash before the given time
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// PurgeDeletedByIDs permanently deletes the given todos still in the trash
+	PurgeDeletedByIDs(ctx context.Context, ids []int64) (int64, error)
+
+	// Manual order
+
+	// LastPosition returns the last position of the manual order of a user, empty
+	// when none of the todos of the user was placed
+	LastPosition(ctx context.Context, userID int64) (string, error)
+
+	// AdjacentPosition returns the position after the given one in the manual order
+	// of a user, or before it when next is false, empty past the ends of the order
+	AdjacentPosition(ctx context.Context, userID int64, position string, next bool) (string, error)
+
+	// UpdatePosition moves a todo to a position of the manual order of its user
+	UpdatePosition(ctx context.Context, id int64, position string) error
+
+	// RebalancePositions spreads the positions of the todos of a user evenly,
+	// keeping their order, the todos never placed first
+	RebalancePositions(ctx context.Context, userID int64) error
+
+	// FindUsersToRebalance finds the users having todos never placed or placed at
+	// positions longer than maxLength
+	FindUsersToRebalance(ctx context.Context, maxLength int) ([]int64, error)
 }
 
 // TodoQueryRepository defines complex query operations for Todo
@@ -28,6 +76,8 @@ type TodoQueryRepository interface {
 	// List operations
 	FindAll(ctx context.Context, options shared.QueryOptions) ([]*entity.Todo, error)
 	FindByFilters(ctx context.Context, filters vo.TodoFilterCriteria, options shared.QueryOptions) ([]*entity.Todo, error)
+	CountByFilters(ctx context.Context, filters vo.TodoFilterCriteria) (int64, error)
+	FacetsByFilters(ctx context.Context, filters vo.TodoFilterCriteria, tagLimit int) (*vo.TodoFacets, error)
 
 	// User-specific queries
 	FindByUserAndStatus(ctx context.Context, userID int64, status vo.TodoStatus, options shared.QueryOptions) ([]*entity.Todo, error)
@@ -43,7 +93,20 @@ type TodoQueryRepository interface {
 	FindByTags(ctx context.Context, userID int64, tags []string, options shared.QueryOptions) ([]*entity.Todo, error)
 
 	// Search
-	Search(ctx context.Context, userID int64, query string, options shared.QueryOptions) ([]*entity.Todo, error)
+	Search(ctx context.Context, filters vo.TodoFilterCriteria, options shared.QueryOptions) ([]*entity.TodoSearchResult, error)
+	FindSimilarOpen(ctx context.Context, userID int64, title string, minSimilarity float64, limit int) ([]*entity.SimilarTodo, error)
+
+	// Board queries
+
+	// FindByUsersInColumn finds the todos of the users in a board column
+	FindByUsersInColumn(ctx context.Context, userIDs []int64, column vo.TodoColumnFilter, options shared.QueryOptions) ([]*entity.Todo, error)
+
+	// CountByUsersAndState counts the todos of the users by status and workflow state
+	CountByUsersAndState(ctx context.Context, userIDs []int64) (map[vo.TodoStateKey]int64, error)
+
+	// Trash queries
+	FindDeleted(ctx context.Context, userID int64, options shared.QueryOptions) ([]*entity.Todo, error)
+	CountDeleted(ctx context.Context, userID int64) (int64, error)
 
 	// Aggregations
 	Count(ctx context.Context, filters []shared.Filter) (int64, error)
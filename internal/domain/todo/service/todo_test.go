@@ -52,6 +52,11 @@ func (m *mockTodoRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// DeleteVersioned implements repository.TodoRepository.
+func (m *mockTodoRepository) DeleteVersioned(ctx context.Context, id, version int64) error {
+	return m.Delete(ctx, id)
+}
+
 // DeleteByUserID implements repository.TodoRepository.
 func (m *mockTodoRepository) DeleteByUserID(ctx context.Context, userID int64) error {
 	if m.err != nil {
@@ -82,6 +87,67 @@ func (m *mockTodoRepository) FindByUserID(ctx context.Context, userID int64) ([]
 	return result, nil
 }
 
+// FindDeletedByID implements repository.TodoRepository.
+func (m *mockTodoRepository) FindDeletedByID(ctx context.Context, id int64) (*entity.Todo, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return nil, shared.ErrNotFound
+}
+
+// Restore implements repository.TodoRepository.
+func (m *mockTodoRepository) Restore(ctx context.Context, id int64) error {
+	if m.err != nil {
+		return m.err
+	}
+	return shared.ErrNotFound
+}
+
+// FindDeletedByUserID implements repository.TodoRepository.
+func (m *mockTodoRepository) FindDeletedByUserID(ctx context.Context, userID int64) ([]*entity.Todo, error) {
+	return nil, m.err
+}
+
+// FindDeletedBefore implements repository.TodoRepository.
+func (m *mockTodoRepository) FindDeletedBefore(ctx context.Context, before time.Time) ([]*entity.Todo, error) {
+	return nil, m.err
+}
+
+// PurgeDeletedByUserID implements repository.TodoRepository.
+func (m *mockTodoRepository) PurgeDeletedByUserID(ctx context.Context, userID int64) (int64, error) {
+	return 0, m.err
+}
+
+// PurgeDeletedBefore implements repository.TodoRepository.
+func (m *mockTodoRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return 0, m.err
+}
+
+// PurgeDeletedByIDs implements repository.TodoRepository.
+func (m *mockTodoRepository) PurgeDeletedByIDs(ctx context.Context, ids []int64) (int64, error) {
+	return 0, m.err
+}
+
+func (m *mockTodoRepository) LastPosition(ctx context.Context, userID int64) (string, error) {
+	return "", m.err
+}
+
+func (m *mockTodoRepository) AdjacentPosition(ctx context.Context, userID int64, position string, next bool) (string, error) {
+	return "", m.err
+}
+
+func (m *mockTodoRepository) UpdatePosition(ctx context.Context, id int64, position string) error {
+	return m.err
+}
+
+func (m *mockTodoRepository) RebalancePositions(ctx context.Context, userID int64) error {
+	return m.err
+}
+
+func (m *mockTodoRepository) FindUsersToRebalance(ctx context.Context, maxLength int) ([]int64, error) {
+	return nil, m.err
+}
+
 func (m *mockTodoRepository) setError(err error) {
 	m.err = err
 }
@@ -392,25 +458,61 @@ func (m *mockTodoQueryRepository) GetPopularTags(ctx context.Context, userID int
 }
 
 // Search implements repository.TodoQueryRepository.
-func (m *mockTodoQueryRepository) Search(ctx context.Context, userID int64, query string, options shared.QueryOptions) ([]*entity.Todo, error) {
+func (m *mockTodoQueryRepository) Search(ctx context.Context, filters vo.TodoFilterCriteria, options shared.QueryOptions) ([]*entity.TodoSearchResult, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
 
-	var result []*entity.Todo
+	var result []*entity.TodoSearchResult
 	for _, todo := range m.allTodos {
-		if todo.UserID() == userID {
+		if todo.UserID() == filters.UserID {
 			// Simple search in title and description
-			titleMatch := len(query) == 0 || todo.Title().String() == query
-			descMatch := len(query) == 0 || todo.Description().String() == query
+			titleMatch := todo.Title().String() == filters.SearchTerm
+			descMatch := todo.Description().String() == filters.SearchTerm
 			if titleMatch || descMatch {
-				result = append(result, todo)
+				result = append(result, &entity.TodoSearchResult{Todo: todo})
 			}
 		}
 	}
 	return result, nil
 }
 
+// FindSimilarOpen implements repository.TodoQueryRepository.
+func (m *mockTodoQueryRepository) FindSimilarOpen(ctx context.Context, userID int64, title string, minSimilarity float64, limit int) ([]*entity.SimilarTodo, error) {
+	return nil, m.err
+}
+
+// FindDeleted implements repository.TodoQueryRepository.
+func (m *mockTodoQueryRepository) FindDeleted(ctx context.Context, userID int64, options shared.QueryOptions) ([]*entity.Todo, error) {
+	return nil, m.err
+}
+
+// FindByUsersInColumn implements repository.TodoQueryRepository.
+func (m *mockTodoQueryRepository) FindByUsersInColumn(ctx context.Context, userIDs []int64, column vo.TodoColumnFilter, options shared.QueryOptions) ([]*entity.Todo, error) {
+	return nil, m.err
+}
+
+// CountByUsersAndState implements repository.TodoQueryRepository.
+func (m *mockTodoQueryRepository) CountByUsersAndState(ctx context.Context, userIDs []int64) (map[vo.TodoStateKey]int64, error) {
+	return nil, m.err
+}
+
+// CountDeleted implements repository.TodoQueryRepository.
+func (m *mockTodoQueryRepository) CountDeleted(ctx context.Context, userID int64) (int64, error) {
+	return 0, m.err
+}
+
+// CountByFilters implements repository.TodoQueryRepository.
+func (m *mockTodoQueryRepository) CountByFilters(ctx context.Context, filters vo.TodoFilterCriteria) (int64, error) {
+	todos, err := m.FindByFilters(ctx, filters, shared.QueryOptions{})
+	return int64(len(todos)), err
+}
+
+// FacetsByFilters implements repository.TodoQueryRepository.
+func (m *mockTodoQueryRepository) FacetsByFilters(ctx context.Context, filters vo.TodoFilterCriteria, tagLimit int) (*vo.TodoFacets, error) {
+	return nil, m.err
+}
+
 func (m *mockTodoQueryRepository) setStatistics(stats *vo.TodoStatistics) {
 	m.statistics = stats
 }
@@ -0,0 +1,16 @@
+package valueobject
+
+// TodoColumnFilter selects the todos of a board column: the todos in its workflow
+// states, and the todos in its statuses whose state is not excluded, as other
+// columns of the board hold it
+type TodoColumnFilter struct {
+	States         []string
+	Statuses       []TodoStatus
+	ExcludedStates []string
+}
+
+// TodoStateKey is the status and workflow state todos are counted by on boards
+type TodoStateKey struct {
+	Status TodoStatus
+	State  string
+}
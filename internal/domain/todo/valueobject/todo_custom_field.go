@@ -0,0 +1,165 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCustomFieldTextLength is the maximum length in characters of text and URL custom field values
+const MaxCustomFieldTextLength = 500
+
+var (
+	ErrInvalidCustomFieldType  = errors.New("invalid custom field type")
+	ErrInvalidCustomFieldValue = errors.New("invalid custom field value")
+)
+
+// CustomFieldType is the type of the values of a custom field
+type CustomFieldType string
+
+const (
+	CustomFieldText        CustomFieldType = "text"
+	CustomFieldNumber      CustomFieldType = "number"
+	CustomFieldDate        CustomFieldType = "date"
+	CustomFieldSelect      CustomFieldType = "select"
+	CustomFieldMultiSelect CustomFieldType = "multi_select"
+	CustomFieldURL         CustomFieldType = "url"
+	CustomFieldCheckbox    CustomFieldType = "checkbox"
+)
+
+// NewCustomFieldType creates a CustomFieldType from string
+func NewCustomFieldType(fieldType string) (CustomFieldType, error) {
+	switch t := CustomFieldType(fieldType); t {
+	case CustomFieldText, CustomFieldNumber, CustomFieldDate, CustomFieldSelect,
+		CustomFieldMultiSelect, CustomFieldURL, CustomFieldCheckbox:
+		return t, nil
+	default:
+		return "", ErrInvalidCustomFieldType
+	}
+}
+
+// HasOptions checks if the values of the type are chosen among the options of the field
+func (t CustomFieldType) HasOptions() bool {
+	return t == CustomFieldSelect || t == CustomFieldMultiSelect
+}
+
+// IsOrdered checks if the values of the type can be compared with < and >
+func (t CustomFieldType) IsOrdered() bool {
+	return t == CustomFieldNumber || t == CustomFieldDate
+}
+
+// String returns the string representation
+func (t CustomFieldType) String() string { return string(t) }
+
+// CustomFieldValue is the value of a custom field on a todo, kept as strings: a
+// number, a date as YYYY-MM-DD, true or false for checkboxes, and an option per
+// selected option for multi-selects
+type CustomFieldValue struct {
+	fieldID   int64
+	key       string
+	fieldType CustomFieldType
+	values    []string
+}
+
+// NewCustomFieldValue creates the value of a custom field, checking the strings
+// are values of its type
+func NewCustomFieldValue(fieldID int64, key string, fieldType CustomFieldType, values []string) (CustomFieldValue, error) {
+	if fieldType == CustomFieldMultiSelect {
+		if len(values) == 0 {
+			return CustomFieldValue{}, fmt.Errorf("%w: %s must have at least one option", ErrInvalidCustomFieldValue, key)
+		}
+	} else if len(values) != 1 {
+		return CustomFieldValue{}, fmt.Errorf("%w: %s must have a single value", ErrInvalidCustomFieldValue, key)
+	}
+
+	value := CustomFieldValue{fieldID: fieldID, key: key, fieldType: fieldType}
+	for _, v := range values {
+		if err := checkCustomFieldValue(fieldType, v); err != nil {
+			return CustomFieldValue{}, fmt.Errorf("%w: %s %v", ErrInvalidCustomFieldValue, key, err)
+		}
+		if !slices.Contains(value.values, v) {
+			value.values = append(value.values, v)
+		}
+	}
+
+	return value, nil
+}
+
+// checkCustomFieldValue checks a string is a value of the type
+func checkCustomFieldValue(fieldType CustomFieldType, value string) error {
+	switch fieldType {
+	case CustomFieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("must be a number")
+		}
+	case CustomFieldDate:
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return errors.New("must be a date as YYYY-MM-DD")
+		}
+	case CustomFieldCheckbox:
+		if value != "true" && value != "false" {
+			return errors.New("must be true or false")
+		}
+	case CustomFieldURL:
+		if !IsCustomFieldURL(value) {
+			return errors.New("must be an http or https URL")
+		}
+	}
+
+	if value == "" || utf8.RuneCountInString(value) > MaxCustomFieldTextLength {
+		return fmt.Errorf("must have between 1 and %d characters", MaxCustomFieldTextLength)
+	}
+	return nil
+}
+
+// IsCustomFieldURL checks if the string is an absolute http or https URL
+func IsCustomFieldURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// FieldID returns the ID of the custom field
+func (v CustomFieldValue) FieldID() int64 { return v.fieldID }
+
+// Key returns the key of the custom field
+func (v CustomFieldValue) Key() string { return v.key }
+
+// Type returns the type of the custom field
+func (v CustomFieldValue) Type() CustomFieldType { return v.fieldType }
+
+// Values returns the value as strings, a string per option for multi-selects
+func (v CustomFieldValue) Values() []string { return slices.Clone(v.values) }
+
+// Number returns the value of number fields
+func (v CustomFieldValue) Number() (float64, bool) {
+	if v.fieldType != CustomFieldNumber {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(v.values[0], 64)
+	return number, err == nil
+}
+
+// Value returns the value as given in requests: a float64 for numbers, a bool for
+// checkboxes, the options for multi-selects and a string otherwise
+func (v CustomFieldValue) Value() any {
+	switch v.fieldType {
+	case CustomFieldNumber:
+		number, _ := v.Number()
+		return number
+	case CustomFieldCheckbox:
+		return v.values[0] == "true"
+	case CustomFieldMultiSelect:
+		return v.Values()
+	default:
+		return v.values[0]
+	}
+}
+
+// Equal checks if two values are the same
+func (v CustomFieldValue) Equal(other CustomFieldValue) bool {
+	return v.fieldID == other.fieldID && v.fieldType == other.fieldType && slices.Equal(v.values, other.values)
+}
@@ -0,0 +1,61 @@
+package valueobject
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewCustomFieldValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		fieldType CustomFieldType
+		values    []string
+		want      any
+		wantErr   bool
+	}{
+		{name: "text", fieldType: CustomFieldText, values: []string{"ACME"}, want: "ACME"},
+		{name: "number", fieldType: CustomFieldNumber, values: []string{"2.5"}, want: 2.5},
+		{name: "date", fieldType: CustomFieldDate, values: []string{"2026-11-01"}, want: "2026-11-01"},
+		{name: "checkbox", fieldType: CustomFieldCheckbox, values: []string{"true"}, want: true},
+		{name: "url", fieldType: CustomFieldURL, values: []string{"https://example.com/T-1"}, want: "https://example.com/T-1"},
+		{name: "multi-select deduplicated", fieldType: CustomFieldMultiSelect, values: []string{"a", "b", "a"}, want: []string{"a", "b"}},
+		{name: "not a number", fieldType: CustomFieldNumber, values: []string{"many"}, wantErr: true},
+		{name: "not a date", fieldType: CustomFieldDate, values: []string{"01/11/2026"}, wantErr: true},
+		{name: "not a boolean", fieldType: CustomFieldCheckbox, values: []string{"yes"}, wantErr: true},
+		{name: "relative url", fieldType: CustomFieldURL, values: []string{"/tickets/1"}, wantErr: true},
+		{name: "empty text", fieldType: CustomFieldText, values: []string{""}, wantErr: true},
+		{name: "several values", fieldType: CustomFieldSelect, values: []string{"a", "b"}, wantErr: true},
+		{name: "no options", fieldType: CustomFieldMultiSelect, values: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := NewCustomFieldValue(1, "field", tt.fieldType, tt.values)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCustomFieldValue) {
+					t.Fatalf("Expected ErrInvalidCustomFieldValue, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got := value.Value(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCustomFieldType(t *testing.T) {
+	if _, err := NewCustomFieldType("rating"); !errors.Is(err, ErrInvalidCustomFieldType) {
+		t.Errorf("Expected ErrInvalidCustomFieldType, got %v", err)
+	}
+
+	fieldType, err := NewCustomFieldType("multi_select")
+	if err != nil || !fieldType.HasOptions() || fieldType.IsOrdered() {
+		t.Errorf("Expected multi_select to have options and no order, got %v (%v)", fieldType, err)
+	}
+}
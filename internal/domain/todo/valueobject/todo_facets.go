@@ -0,0 +1,52 @@
+package valueobject
+
+import (
+	"time"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+)
+
+// Due date buckets of the todo facets
+const (
+	DueBucketOverdue   = "overdue"
+	DueBucketToday     = "today"
+	DueBucketThisWeek  = "this_week"
+	DueBucketLater     = "later"
+	DueBucketNoDueDate = "no_due_date"
+)
+
+// TodoFacets represents the counts of the todos matching a filter by status,
+// priority, tag and due date bucket.
+//
+// Open todos past their due date are overdue, the others fall in the bucket of
+// their due day: today, the following days of the week or later. Closed todos
+// due before today are in no due date bucket.
+type TodoFacets struct {
+	Total      int64
+	ByStatus   map[string]int64
+	ByPriority map[string]int64
+	ByTag      []TagCount
+	ByDueDate  map[string]int64
+}
+
+// DueBucketOf returns the due date bucket of a todo at the given time, empty for
+// closed todos due before today. Days start at midnight in the location of now.
+func DueBucketOf(dueDate *time.Time, status TodoStatus, now time.Time) string {
+	if dueDate == nil {
+		return DueBucketNoDueDate
+	}
+
+	today := sharedvo.StartOfDay(now)
+
+	switch {
+	case dueDate.Before(now) && !status.IsFinal():
+		return DueBucketOverdue
+	case dueDate.Before(today):
+		return ""
+	case dueDate.Before(today.AddDate(0, 0, 1)):
+		return DueBucketToday
+	case dueDate.Before(today.AddDate(0, 0, 7)):
+		return DueBucketThisWeek
+	default:
+		return DueBucketLater
+	}
+}
@@ -0,0 +1,40 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDueBucketOf(t *testing.T) {
+	// Local midnight is 13:00 UTC of the previous day in this zone
+	zone := time.FixedZone("UTC+13", 13*60*60)
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, zone)
+	at := func(day, hour int) *time.Time {
+		date := time.Date(2026, 10, day, hour, 0, 0, 0, zone)
+		return &date
+	}
+
+	tests := []struct {
+		name    string
+		dueDate *time.Time
+		status  TodoStatus
+		want    string
+	}{
+		{name: "no due date", dueDate: nil, status: StatusPending, want: DueBucketNoDueDate},
+		{name: "open and past due", dueDate: at(18, 1), status: StatusPending, want: DueBucketOverdue},
+		{name: "closed early today", dueDate: at(18, 1), status: StatusCompleted, want: DueBucketToday},
+		{name: "closed late yesterday", dueDate: at(17, 23), status: StatusCompleted, want: ""},
+		{name: "later today", dueDate: at(18, 23), status: StatusPending, want: DueBucketToday},
+		{name: "early tomorrow", dueDate: at(19, 1), status: StatusPending, want: DueBucketThisWeek},
+		{name: "last day of the week", dueDate: at(24, 23), status: StatusPending, want: DueBucketThisWeek},
+		{name: "after the week", dueDate: at(25, 0), status: StatusPending, want: DueBucketLater},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DueBucketOf(tt.dueDate, tt.status, now); got != tt.want {
+				t.Errorf("DueBucketOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,18 +1,24 @@
 package valueobject
 
-import "time"
+import (
+	"strings"
+	"time"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+)
 
 // TodoFilterCriteria representa critérios de filtragem para queries de Todo.
 // Faz parte do domínio, pois define como a entidade pode ser consultada.
 type TodoFilterCriteria struct {
-	UserID      int64
-	Status      []string
-	Priority    []string
-	Tags        []string
-	IsOverdue   *bool
-	DueDateFrom *time.Time
-	DueDateTo   *time.Time
-	SearchTerm  string
+	UserID         int64
+	Status         []string
+	Priority       []string
+	Tags           []string
+	IsOverdue      *bool
+	DueDateFrom    *time.Time
+	DueDateTo      *time.Time
+	SearchTerm     string
+	SearchLanguage sharedvo.SearchLanguage // Idioma do termo de pesquisa, o padrão quando vazio
+	Expression     TodoFilterExpression
 }
 
 // HasStatusFilter indica se há filtro por status
@@ -37,5 +43,10 @@ func (f *TodoFilterCriteria) HasDueDateRange() bool {
 
 // HasSearchTerm indica se há termo de pesquisa
 func (f *TodoFilterCriteria) HasSearchTerm() bool {
-	return f.SearchTerm != ""
+	return strings.TrimSpace(f.SearchTerm) != ""
+}
+
+// HasExpression indica se há expressão de filtro
+func (f *TodoFilterCriteria) HasExpression() bool {
+	return f.Expression != nil
 }
@@ -0,0 +1,201 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sharedvo "todolist/internal/domain/shared/valueobject"
+)
+
+// ErrInvalidFilterExpression is wrapped by the errors of filter expressions that
+// cannot be parsed
+var ErrInvalidFilterExpression = errors.New("invalid filter expression")
+
+// FilterExpressionError reports where and why a filter expression cannot be parsed
+type FilterExpressionError struct {
+	// Position is the position in characters of the error, starting at 1
+	Position int
+	Message  string
+}
+
+// Error implements error.
+func (e *FilterExpressionError) Error() string {
+	return fmt.Sprintf("%s at position %d: %s", ErrInvalidFilterExpression, e.Position, e.Message)
+}
+
+// Unwrap returns ErrInvalidFilterExpression.
+func (e *FilterExpressionError) Unwrap() error {
+	return ErrInvalidFilterExpression
+}
+
+// FilterField is a todo field filter expressions can compare
+type FilterField string
+
+const (
+	FilterFieldStatus   FilterField = "status"
+	FilterFieldPriority FilterField = "priority"
+	FilterFieldTag      FilterField = "tag"
+	FilterFieldDue      FilterField = "due"
+	FilterFieldCreated  FilterField = "created"
+	FilterFieldUpdated  FilterField = "updated"
+	FilterFieldTitle    FilterField = "title"
+	FilterFieldText     FilterField = "text"
+
+	// FilterFieldCustom compares a custom field, written cf.<key>
+	FilterFieldCustom FilterField = "cf"
+)
+
+// customFilterFieldPrefix starts the names of custom fields in filter expressions
+const customFilterFieldPrefix = string(FilterFieldCustom) + "."
+
+// FilterOperator compares a todo field to the value of a condition. Text fields
+// are equal to the values they contain.
+type FilterOperator string
+
+const (
+	FilterOperatorEqual          FilterOperator = "="
+	FilterOperatorNotEqual       FilterOperator = "!="
+	FilterOperatorLess           FilterOperator = "<"
+	FilterOperatorLessOrEqual    FilterOperator = "<="
+	FilterOperatorGreater        FilterOperator = ">"
+	FilterOperatorGreaterOrEqual FilterOperator = ">="
+)
+
+// TodoFilterExpression is a node of the tree of a parsed filter expression:
+// FilterAnd, FilterOr, FilterNot or FilterCondition
+type TodoFilterExpression interface {
+	isTodoFilterExpression()
+}
+
+// FilterAnd matches the todos matched by all its operands
+type FilterAnd struct {
+	Operands []TodoFilterExpression
+}
+
+// FilterOr matches the todos matched by any of its operands
+type FilterOr struct {
+	Operands []TodoFilterExpression
+}
+
+// FilterNot matches the todos not matched by its operand
+type FilterNot struct {
+	Operand TodoFilterExpression
+}
+
+// FilterCondition compares a field of the todos to a value.
+//
+// The value is a TodoStatus for the status, a sharedvo.Priority for the priority,
+// the start of the day in UTC for dates, or nil for todos without due date, and a
+// string for the other fields. Custom fields compare the field of the Key to a
+// string, compiled according to the type of the field, or nil for todos without value.
+type FilterCondition struct {
+	Field    FilterField
+	Key      string
+	Operator FilterOperator
+	Value    any
+}
+
+func (FilterAnd) isTodoFilterExpression()       {}
+func (FilterOr) isTodoFilterExpression()        {}
+func (FilterNot) isTodoFilterExpression()       {}
+func (FilterCondition) isTodoFilterExpression() {}
+
+// filterFieldSpec is the allowlist entry of a field
+type filterFieldSpec struct {
+	operators []FilterOperator
+	parse     func(value string) (any, error)
+}
+
+var (
+	equalityOperators   = []FilterOperator{FilterOperatorEqual, FilterOperatorNotEqual}
+	comparisonOperators = []FilterOperator{
+		FilterOperatorEqual, FilterOperatorNotEqual,
+		FilterOperatorLess, FilterOperatorLessOrEqual,
+		FilterOperatorGreater, FilterOperatorGreaterOrEqual,
+	}
+)
+
+// todoFilterFields is the allowlist of the fields of filter expressions
+var todoFilterFields = map[FilterField]filterFieldSpec{
+	FilterFieldStatus: {
+		operators: equalityOperators,
+		parse: func(value string) (any, error) {
+			status, err := NewTodoStatusFromString(strings.ToLower(value))
+			if err != nil {
+				return nil, errors.New("expected pending, in_progress, completed or cancelled")
+			}
+			return status, nil
+		},
+	},
+	FilterFieldPriority: {
+		operators: comparisonOperators,
+		parse: func(value string) (any, error) {
+			priority, err := sharedvo.NewPriorityFromString(strings.ToLower(value))
+			if err != nil {
+				return nil, errors.New("expected low, medium, high or critical")
+			}
+			return priority, nil
+		},
+	},
+	FilterFieldTag:     {operators: equalityOperators, parse: parseFilterText},
+	FilterFieldDue:     {operators: comparisonOperators, parse: parseFilterDueDate},
+	FilterFieldCreated: {operators: comparisonOperators, parse: parseFilterDate},
+	FilterFieldUpdated: {operators: comparisonOperators, parse: parseFilterDate},
+	FilterFieldTitle:   {operators: equalityOperators, parse: parseFilterText},
+	FilterFieldText:    {operators: equalityOperators, parse: parseFilterText},
+}
+
+// customFilterField is the allowlist entry of custom fields, the values are checked
+// against the type of the field once it is known
+var customFilterField = filterFieldSpec{operators: comparisonOperators, parse: parseFilterCustom}
+
+// FilterFields returns the names of the fields filter expressions can compare
+func FilterFields() []string {
+	return []string{
+		string(FilterFieldStatus), string(FilterFieldPriority), string(FilterFieldTag),
+		string(FilterFieldDue), string(FilterFieldCreated), string(FilterFieldUpdated),
+		string(FilterFieldTitle), string(FilterFieldText), customFilterFieldPrefix + "<key>",
+	}
+}
+
+// parseFilterText parses the value of a text field
+func parseFilterText(value string) (any, error) {
+	if value == "" {
+		return nil, errors.New("expected a non-empty value")
+	}
+	return value, nil
+}
+
+// parseFilterDate parses the value of a date field as the start of the day in local
+// time, the days of the due date facets and smart lists
+func parseFilterDate(value string) (any, error) {
+	date, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return nil, errors.New("expected a date as YYYY-MM-DD")
+	}
+	return date, nil
+}
+
+// parseFilterDueDate parses the value of the due date, which is none for the
+// todos without due date, as parseFilterDate
+func parseFilterDueDate(value string) (any, error) {
+	if strings.EqualFold(value, "none") {
+		return nil, nil
+	}
+	date, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return nil, errors.New("expected a date as YYYY-MM-DD or none")
+	}
+	return date, nil
+}
+
+// parseFilterCustom parses the value of a custom field, which is none for the
+// todos without value
+func parseFilterCustom(value string) (any, error) {
+	if strings.EqualFold(value, "none") {
+		return nil, nil
+	}
+	return parseFilterText(value)
+}
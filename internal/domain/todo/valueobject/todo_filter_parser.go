@@ -0,0 +1,306 @@
+package valueobject
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxFilterExpressionLength is the maximum length in characters of filter expressions
+	MaxFilterExpressionLength = 1000
+
+	// maxFilterExpressionDepth limits the nesting of parentheses and NOT
+	maxFilterExpressionDepth = 32
+)
+
+// ParseTodoFilterExpression parses a filter expression, such as
+//
+//	status:pending AND (tag:work OR priority>=high) AND due<2026-11-01 AND NOT tag:someday
+//
+// Conditions compare a field to a value with :, =, !=, <, <=, > or >=, where : and
+// = are the same. Values with spaces or operators are quoted with ", escaping " and
+// \ with \. Conditions are combined with AND, OR and NOT, case insensitive, and
+// parentheses; AND binds tighter than OR and is implied between conditions.
+//
+// An empty expression gives a nil expression. Failures are *FilterExpressionError.
+func ParseTodoFilterExpression(input string) (TodoFilterExpression, error) {
+	if utf8.RuneCountInString(input) > MaxFilterExpressionLength {
+		return nil, &FilterExpressionError{
+			Position: MaxFilterExpressionLength + 1,
+			Message:  fmt.Sprintf("expression is longer than %d characters", MaxFilterExpressionLength),
+		}
+	}
+
+	tokens, err := lexFilterExpression(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens}
+	if p.peek().kind == filterTokenEnd {
+		return nil, nil
+	}
+
+	expression, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if token := p.peek(); token.kind != filterTokenEnd {
+		return nil, token.errorf("unexpected %s", token)
+	}
+
+	return expression, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEnd filterTokenKind = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenOperator
+	filterTokenOpen
+	filterTokenClose
+)
+
+// filterToken is a token of a filter expression at its position in characters, starting at 1
+type filterToken struct {
+	kind     filterTokenKind
+	text     string
+	position int
+}
+
+// String describes the token in error messages
+func (t filterToken) String() string {
+	switch t.kind {
+	case filterTokenEnd:
+		return "end of expression"
+	case filterTokenString:
+		return fmt.Sprintf("%q", t.text)
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+// isKeyword checks if the token is the keyword, case insensitive
+func (t filterToken) isKeyword(keyword string) bool {
+	return t.kind == filterTokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t filterToken) errorf(format string, args ...any) error {
+	return &FilterExpressionError{Position: t.position, Message: fmt.Sprintf(format, args...)}
+}
+
+// isFilterDelimiter checks if the character ends a word
+func isFilterDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`()<>=!:"`, r)
+}
+
+// lexFilterExpression splits a filter expression into tokens
+func lexFilterExpression(input string) ([]filterToken, error) {
+	runes := []rune(input)
+	tokens := []filterToken{}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: filterTokenOpen, text: "(", position: position})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: filterTokenClose, text: ")", position: position})
+			i++
+		case r == ':' || r == '=':
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, text: "=", position: position})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			operator := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				operator += "="
+			}
+			if operator == "!" {
+				return nil, &FilterExpressionError{Position: position, Message: "expected '!=', use NOT to negate"}
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, text: operator, position: position})
+			i += len(operator)
+		case r == '"':
+			var value strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, &FilterExpressionError{Position: position, Message: "unterminated quoted value"}
+				}
+				if runes[i] == '"' {
+					i++
+					break
+				}
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					i++
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenString, text: value.String(), position: position})
+		default:
+			start := i
+			for i < len(runes) && !isFilterDelimiter(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord, text: string(runes[start:i]), position: position})
+		}
+	}
+
+	return append(tokens, filterToken{kind: filterTokenEnd, position: len(runes) + 1}), nil
+}
+
+// filterParser is a recursive descent parser of filter expressions
+type filterParser struct {
+	tokens  []filterToken
+	current int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.current]
+}
+
+func (p *filterParser) next() filterToken {
+	token := p.tokens[p.current]
+	if token.kind != filterTokenEnd {
+		p.current++
+	}
+	return token
+}
+
+// parseOr parses operands separated by OR
+func (p *filterParser) parseOr(depth int) (TodoFilterExpression, error) {
+	operand, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []TodoFilterExpression{operand}
+	for p.peek().isKeyword("OR") {
+		p.next()
+		if operand, err = p.parseAnd(depth); err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return FilterOr{Operands: operands}, nil
+}
+
+// parseAnd parses operands separated by AND or following each other
+func (p *filterParser) parseAnd(depth int) (TodoFilterExpression, error) {
+	operand, err := p.parseUnary(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []TodoFilterExpression{operand}
+	for {
+		token := p.peek()
+		if token.isKeyword("AND") {
+			p.next()
+		} else if token.kind != filterTokenWord && token.kind != filterTokenOpen || token.isKeyword("OR") {
+			break
+		}
+
+		if operand, err = p.parseUnary(depth); err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return FilterAnd{Operands: operands}, nil
+}
+
+// parseUnary parses a negated operand, a parenthesized expression or a condition
+func (p *filterParser) parseUnary(depth int) (TodoFilterExpression, error) {
+	token := p.peek()
+
+	if depth >= maxFilterExpressionDepth && (token.isKeyword("NOT") || token.kind == filterTokenOpen) {
+		return nil, token.errorf("expression is nested more than %d levels deep", maxFilterExpressionDepth)
+	}
+
+	switch {
+	case token.isKeyword("NOT"):
+		p.next()
+		operand, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return FilterNot{Operand: operand}, nil
+	case token.kind == filterTokenOpen:
+		p.next()
+		expression, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != filterTokenClose {
+			return nil, closing.errorf("expected ')' closing the '(' at position %d, got %s", token.position, closing)
+		}
+		return expression, nil
+	case token.kind == filterTokenWord && !token.isKeyword("AND") && !token.isKeyword("OR"):
+		return p.parseCondition()
+	default:
+		return nil, token.errorf("expected a condition, got %s", token)
+	}
+}
+
+// parseCondition parses a field, an operator and a value, checking them against
+// the allowlist of fields
+func (p *filterParser) parseCondition() (TodoFilterExpression, error) {
+	fieldToken := p.next()
+	field := FilterField(strings.ToLower(fieldToken.text))
+
+	var key string
+	spec, ok := todoFilterFields[field]
+	if custom, found := strings.CutPrefix(string(field), customFilterFieldPrefix); found && custom != "" {
+		field, key, spec, ok = FilterFieldCustom, custom, customFilterField, true
+	}
+	if !ok {
+		return nil, fieldToken.errorf("unknown field '%s', expected one of %s",
+			fieldToken.text, strings.Join(FilterFields(), ", "))
+	}
+
+	operatorToken := p.next()
+	if operatorToken.kind != filterTokenOperator {
+		return nil, operatorToken.errorf("expected an operator after '%s', got %s", fieldToken.text, operatorToken)
+	}
+
+	operator := FilterOperator(operatorToken.text)
+	if !slices.Contains(spec.operators, operator) {
+		return nil, operatorToken.errorf("operator '%s' is not supported by field '%s'", operator, field)
+	}
+
+	valueToken := p.next()
+	if valueToken.kind != filterTokenWord && valueToken.kind != filterTokenString {
+		return nil, valueToken.errorf("expected a value after '%s%s', got %s", fieldToken.text, operator, valueToken)
+	}
+
+	value, err := spec.parse(valueToken.text)
+	if err != nil {
+		return nil, valueToken.errorf("invalid value %s for field '%s': %v", valueToken, field, err)
+	}
+
+	if value == nil && operator != FilterOperatorEqual && operator != FilterOperatorNotEqual {
+		return nil, valueToken.errorf("operator '%s' cannot compare to none", operator)
+	}
+
+	return FilterCondition{Field: field, Key: key, Operator: operator, Value: value}, nil
+}
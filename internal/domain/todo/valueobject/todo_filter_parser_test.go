@@ -0,0 +1,152 @@
+package valueobject
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	sharedvo "todolist/internal/domain/shared/valueobject"
+)
+
+func TestParseTodoFilterExpression(t *testing.T) {
+	due := time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name  string
+		input string
+		want  TodoFilterExpression
+	}{
+		{
+			name:  "empty",
+			input: "  ",
+			want:  nil,
+		},
+		{
+			name:  "condition",
+			input: "status:pending",
+			want:  FilterCondition{Field: FilterFieldStatus, Operator: FilterOperatorEqual, Value: StatusPending},
+		},
+		{
+			name:  "full example",
+			input: "status:pending AND (tag:work OR priority>=high) AND due<2026-11-01 AND NOT tag:someday",
+			want: FilterAnd{Operands: []TodoFilterExpression{
+				FilterCondition{Field: FilterFieldStatus, Operator: FilterOperatorEqual, Value: StatusPending},
+				FilterOr{Operands: []TodoFilterExpression{
+					FilterCondition{Field: FilterFieldTag, Operator: FilterOperatorEqual, Value: "work"},
+					FilterCondition{Field: FilterFieldPriority, Operator: FilterOperatorGreaterOrEqual, Value: sharedvo.PriorityHigh},
+				}},
+				FilterCondition{Field: FilterFieldDue, Operator: FilterOperatorLess, Value: due},
+				FilterNot{Operand: FilterCondition{Field: FilterFieldTag, Operator: FilterOperatorEqual, Value: "someday"}},
+			}},
+		},
+		{
+			name:  "AND binds tighter than OR",
+			input: "tag:a or tag:b tag:c",
+			want: FilterOr{Operands: []TodoFilterExpression{
+				FilterCondition{Field: FilterFieldTag, Operator: FilterOperatorEqual, Value: "a"},
+				FilterAnd{Operands: []TodoFilterExpression{
+					FilterCondition{Field: FilterFieldTag, Operator: FilterOperatorEqual, Value: "b"},
+					FilterCondition{Field: FilterFieldTag, Operator: FilterOperatorEqual, Value: "c"},
+				}},
+			}},
+		},
+		{
+			name:  "quoted value",
+			input: `title:"say \"hi\" now"`,
+			want:  FilterCondition{Field: FilterFieldTitle, Operator: FilterOperatorEqual, Value: `say "hi" now`},
+		},
+		{
+			name:  "no due date",
+			input: "due!=none",
+			want:  FilterCondition{Field: FilterFieldDue, Operator: FilterOperatorNotEqual, Value: nil},
+		},
+		{
+			name:  "custom field",
+			input: "CF.points>=3 cf.customer:none",
+			want: FilterAnd{Operands: []TodoFilterExpression{
+				FilterCondition{Field: FilterFieldCustom, Key: "points", Operator: FilterOperatorGreaterOrEqual, Value: "3"},
+				FilterCondition{Field: FilterFieldCustom, Key: "customer", Operator: FilterOperatorEqual, Value: nil},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTodoFilterExpression(tt.input)
+			if err != nil {
+				t.Fatalf("ParseTodoFilterExpression() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTodoFilterExpression() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTodoFilterExpression_LocalDays(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("UTC-3", -3*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	expression, err := ParseTodoFilterExpression("due<2026-11-01")
+	if err != nil {
+		t.Fatalf("ParseTodoFilterExpression() error = %v", err)
+	}
+	day, _ := expression.(FilterCondition).Value.(time.Time)
+
+	// Late on October 31 in local time, already November 1 in UTC
+	lateEvening := time.Date(2026, 11, 1, 2, 30, 0, 0, time.UTC)
+	if !day.Equal(sharedvo.StartOfDay(lateEvening.In(time.Local)).AddDate(0, 0, 1)) {
+		t.Errorf("Expected the start of November 1 in local time, got %v", day)
+	}
+	if !lateEvening.Before(day) {
+		t.Errorf("Expected a todo due on the evening of October 31 to be due before %v", day)
+	}
+}
+
+func TestParseTodoFilterExpressionErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		position int
+		message  string
+	}{
+		{name: "unknown field", input: "status:pending AND owner:me", position: 20, message: "unknown field 'owner'"},
+		{name: "missing operator", input: "tag work", position: 5, message: "expected an operator"},
+		{name: "missing value", input: "tag:", position: 5, message: "expected a value"},
+		{name: "invalid status", input: "status:done", position: 8, message: "invalid value 'done'"},
+		{name: "invalid date", input: "due<tomorrow", position: 5, message: "YYYY-MM-DD"},
+		{name: "unsupported operator", input: "tag>work", position: 4, message: "operator '>' is not supported"},
+		{name: "unclosed parenthesis", input: "(tag:a OR tag:b", position: 16, message: "expected ')'"},
+		{name: "unexpected parenthesis", input: "tag:a)", position: 6, message: "unexpected ')'"},
+		{name: "dangling AND", input: "tag:a AND", position: 10, message: "expected a condition"},
+		{name: "unterminated quote", input: `title:"abc`, position: 7, message: "unterminated"},
+		{name: "bang", input: "!tag:a", position: 1, message: "use NOT"},
+		{name: "none comparison", input: "due<none", position: 5, message: "cannot compare to none"},
+		{name: "custom field without key", input: "cf.:x", position: 1, message: "unknown field 'cf.'"},
+		{name: "too deep", input: strings.Repeat("(", 40) + "tag:a" + strings.Repeat(")", 40), position: 33, message: "nested"},
+		{name: "too long", input: "title:" + strings.Repeat("a", MaxFilterExpressionLength), position: MaxFilterExpressionLength + 1, message: "longer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTodoFilterExpression(tt.input)
+			if !errors.Is(err, ErrInvalidFilterExpression) {
+				t.Fatalf("Expected ErrInvalidFilterExpression, got %v", err)
+			}
+
+			var expressionErr *FilterExpressionError
+			if !errors.As(err, &expressionErr) {
+				t.Fatalf("Expected *FilterExpressionError, got %T", err)
+			}
+			if expressionErr.Position != tt.position {
+				t.Errorf("Position = %d, want %d (%s)", expressionErr.Position, tt.position, expressionErr.Message)
+			}
+			if !strings.Contains(expressionErr.Message, tt.message) {
+				t.Errorf("Message = %q, want it to contain %q", expressionErr.Message, tt.message)
+			}
+		})
+	}
+}
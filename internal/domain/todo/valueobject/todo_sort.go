@@ -0,0 +1,15 @@
+package valueobject
+
+import (
+	"slices"
+	"todolist/internal/domain/shared"
+)
+
+// todoSortKeys are the keys todos can be sorted by, cf.<key> sorting by a custom field
+var todoSortKeys = []string{"created_at", "updated_at", "due_date", "priority", "title", "status", "position", "id", "cf.*"}
+
+// DefaultTodoSort lists the newest todos first
+var DefaultTodoSort = shared.Sort{{Key: "created_at", Desc: true}}
+
+// TodoSortKeys returns the keys todos can be sorted by
+func TodoSortKeys() []string { return slices.Clone(todoSortKeys) }
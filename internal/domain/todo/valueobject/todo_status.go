@@ -26,22 +26,6 @@ func (s TodoStatus) IsValid() bool {
 	}
 }
 
-// CanTransitionTo checks if status can transition to another status
-func (s TodoStatus) CanTransitionTo(newStatus TodoStatus) bool {
-	switch s {
-	case StatusPending:
-		return newStatus == StatusInProgress || newStatus == StatusCancelled || newStatus == StatusCompleted
-	case StatusInProgress:
-		return newStatus == StatusCompleted || newStatus == StatusCancelled || newStatus == StatusPending
-	case StatusCompleted:
-		return newStatus == StatusPending // Allow reopening
-	case StatusCancelled:
-		return newStatus == StatusPending // Allow reactivating
-	default:
-		return false
-	}
-}
-
 // String returns the string representation
 func (s TodoStatus) String() string { return string(s) }
 
@@ -0,0 +1,32 @@
+package valueobject
+
+import (
+	"time"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+)
+
+// Names of the todo fields recorded in its history
+const (
+	TodoFieldUserID      = "user_id"
+	TodoFieldTitle       = "title"
+	TodoFieldDescription = "description"
+	TodoFieldStatus      = "status"
+	TodoFieldPriority    = "priority"
+	TodoFieldDueDate     = "due_date"
+	TodoFieldCompletedAt = "completed_at"
+	TodoFieldTags        = "tags"
+	TodoFieldProjectID   = "project_id"
+	TodoFieldState       = "state"
+	TodoFieldResolution  = "resolution"
+	TodoFieldEstimate    = "estimate_seconds"
+)
+
+// TodoVersion represents the editable state of a todo at a point of its history
+type TodoVersion struct {
+	Title       TodoTitle
+	Description TodoDescription
+	Status      TodoStatus
+	Priority    sharedvo.Priority
+	DueDate     *time.Time
+	Tags        []string
+}
@@ -0,0 +1,261 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Workflow limits
+const (
+	// maxWorkflowStates is the maximum number of states of a workflow
+	maxWorkflowStates = 30
+
+	// maxWorkflowStateNameLength is the maximum length in characters of state names
+	maxWorkflowStateNameLength = 50
+)
+
+var (
+	ErrInvalidWorkflow      = errors.New("invalid workflow")
+	ErrInvalidStateCategory = errors.New("invalid workflow state category")
+)
+
+// workflowStateKeyRegex validates the keys states are referred to by
+var workflowStateKeyRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,29}$`)
+
+// StateCategory groups the states of workflows by meaning, each category being
+// stored as the todo status filters, statistics and boards work with
+type StateCategory string
+
+const (
+	CategoryOpen       StateCategory = "open"
+	CategoryInProgress StateCategory = "in_progress"
+	CategoryDone       StateCategory = "done"
+	CategoryCancelled  StateCategory = "cancelled"
+)
+
+// categoryStatuses maps the state categories to the todo statuses
+var categoryStatuses = map[StateCategory]TodoStatus{
+	CategoryOpen:       StatusPending,
+	CategoryInProgress: StatusInProgress,
+	CategoryDone:       StatusCompleted,
+	CategoryCancelled:  StatusCancelled,
+}
+
+// NewStateCategory creates a StateCategory from string
+func NewStateCategory(category string) (StateCategory, error) {
+	c := StateCategory(category)
+	if _, ok := categoryStatuses[c]; !ok {
+		return "", ErrInvalidStateCategory
+	}
+	return c, nil
+}
+
+// CategoryOf returns the category of the states in a status
+func CategoryOf(status TodoStatus) StateCategory {
+	for category, categoryStatus := range categoryStatuses {
+		if categoryStatus == status {
+			return category
+		}
+	}
+	return ""
+}
+
+// Status returns the todo status of the states in the category
+func (c StateCategory) Status() TodoStatus { return categoryStatuses[c] }
+
+// String returns the string representation
+func (c StateCategory) String() string { return string(c) }
+
+// WorkflowField is a todo field a state can require to be set on entering it
+type WorkflowField string
+
+const (
+	FieldResolution  WorkflowField = "resolution"
+	FieldDescription WorkflowField = "description"
+	FieldDueDate     WorkflowField = "due_date"
+)
+
+// String returns the string representation
+func (f WorkflowField) String() string { return string(f) }
+
+// WorkflowState is a state of a workflow
+type WorkflowState struct {
+	key      string
+	name     string
+	category StateCategory
+	requires []WorkflowField
+}
+
+// NewWorkflowState creates a workflow state, requiring the fields to be set on entering it
+func NewWorkflowState(key, name, category string, requires []string) (WorkflowState, error) {
+	if !workflowStateKeyRegex.MatchString(key) {
+		return WorkflowState{}, fmt.Errorf("%w: state key %q must be lowercase letters, digits, - or _", ErrInvalidWorkflow, key)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxWorkflowStateNameLength {
+		return WorkflowState{}, fmt.Errorf("%w: name of state %q must have between 1 and 50 characters", ErrInvalidWorkflow, key)
+	}
+
+	stateCategory, err := NewStateCategory(category)
+	if err != nil {
+		return WorkflowState{}, fmt.Errorf("%w: unknown category %q of state %q", ErrInvalidWorkflow, category, key)
+	}
+
+	state := WorkflowState{key: key, name: name, category: stateCategory}
+	for _, value := range requires {
+		field := WorkflowField(value)
+		switch field {
+		case FieldResolution, FieldDescription, FieldDueDate:
+		default:
+			return WorkflowState{}, fmt.Errorf("%w: unknown required field %q of state %q", ErrInvalidWorkflow, value, key)
+		}
+		if !slices.Contains(state.requires, field) {
+			state.requires = append(state.requires, field)
+		}
+	}
+
+	return state, nil
+}
+
+// Key returns the key of the state, unique in its workflow
+func (s WorkflowState) Key() string { return s.key }
+
+// Name returns the state name
+func (s WorkflowState) Name() string { return s.name }
+
+// Category returns the category of the state
+func (s WorkflowState) Category() StateCategory { return s.category }
+
+// Status returns the todo status of the state
+func (s WorkflowState) Status() TodoStatus { return s.category.Status() }
+
+// Requires returns the fields to set on entering the state
+func (s WorkflowState) Requires() []WorkflowField { return slices.Clone(s.requires) }
+
+// Workflow is the set of states todos go through and the transitions allowed
+// between them. The first state is the one new todos start in.
+type Workflow struct {
+	states      []WorkflowState
+	transitions map[string][]string
+}
+
+// NewWorkflow creates a workflow, transitions mapping state keys to the keys of
+// the states todos can move to from them
+func NewWorkflow(states []WorkflowState, transitions map[string][]string) (Workflow, error) {
+	if len(states) == 0 || len(states) > maxWorkflowStates {
+		return Workflow{}, fmt.Errorf("%w: must have between 1 and %d states", ErrInvalidWorkflow, maxWorkflowStates)
+	}
+
+	if states[0].category != CategoryOpen {
+		return Workflow{}, fmt.Errorf("%w: initial state %q must be open", ErrInvalidWorkflow, states[0].key)
+	}
+
+	workflow := Workflow{states: slices.Clone(states), transitions: map[string][]string{}}
+	for i, state := range states {
+		if slices.ContainsFunc(states[:i], func(other WorkflowState) bool { return other.key == state.key }) {
+			return Workflow{}, fmt.Errorf("%w: state %q repeated", ErrInvalidWorkflow, state.key)
+		}
+	}
+
+	for from, targets := range transitions {
+		if _, ok := workflow.State(from); !ok {
+			return Workflow{}, fmt.Errorf("%w: transitions from unknown state %q", ErrInvalidWorkflow, from)
+		}
+
+		for _, to := range targets {
+			if _, ok := workflow.State(to); !ok {
+				return Workflow{}, fmt.Errorf("%w: transition from %q to unknown state %q", ErrInvalidWorkflow, from, to)
+			}
+			if to == from {
+				return Workflow{}, fmt.Errorf("%w: state %q can not transition to itself", ErrInvalidWorkflow, from)
+			}
+			if !slices.Contains(workflow.transitions[from], to) {
+				workflow.transitions[from] = append(workflow.transitions[from], to)
+			}
+		}
+	}
+
+	return workflow, nil
+}
+
+// DefaultWorkflow returns the workflow of todos outside projects with a workflow
+// of their own, having a state per status keyed by the status
+func DefaultWorkflow() Workflow {
+	state := func(status TodoStatus, name string) WorkflowState {
+		return WorkflowState{key: status.String(), name: name, category: CategoryOf(status)}
+	}
+
+	return Workflow{
+		states: []WorkflowState{
+			state(StatusPending, "Pending"),
+			state(StatusInProgress, "In progress"),
+			state(StatusCompleted, "Completed"),
+			state(StatusCancelled, "Cancelled"),
+		},
+		transitions: map[string][]string{
+			StatusPending.String():    {StatusInProgress.String(), StatusCancelled.String(), StatusCompleted.String()},
+			StatusInProgress.String(): {StatusCompleted.String(), StatusCancelled.String(), StatusPending.String()},
+			StatusCompleted.String():  {StatusPending.String()}, // Allow reopening
+			StatusCancelled.String():  {StatusPending.String()}, // Allow reactivating
+		},
+	}
+}
+
+// IsZero checks if the workflow is unset, standing for the default workflow
+func (w Workflow) IsZero() bool { return len(w.states) == 0 }
+
+// States returns the states of the workflow, the initial state first
+func (w Workflow) States() []WorkflowState { return slices.Clone(w.states) }
+
+// Initial returns the state new todos start in
+func (w Workflow) Initial() WorkflowState { return w.states[0] }
+
+// Transitions returns the keys of the states todos can move to, by state key
+func (w Workflow) Transitions() map[string][]string {
+	transitions := maps.Clone(w.transitions)
+	for from, targets := range transitions {
+		transitions[from] = slices.Clone(targets)
+	}
+	return transitions
+}
+
+// State returns the state with a key
+func (w Workflow) State(key string) (WorkflowState, bool) {
+	index := slices.IndexFunc(w.states, func(state WorkflowState) bool { return state.key == key })
+	if index < 0 {
+		return WorkflowState{}, false
+	}
+	return w.states[index], true
+}
+
+// CanTransition checks if todos can move from a state to another
+func (w Workflow) CanTransition(from, to string) bool {
+	return slices.Contains(w.transitions[from], to)
+}
+
+// StateOf returns the first state in a status, the state todos in the status
+// take when they come from another workflow
+func (w Workflow) StateOf(status TodoStatus) (WorkflowState, bool) {
+	index := slices.IndexFunc(w.states, func(state WorkflowState) bool { return state.Status() == status })
+	if index < 0 {
+		return WorkflowState{}, false
+	}
+	return w.states[index], true
+}
+
+// NextState returns the first state in a status todos can move to from a state,
+// used to change the status of todos without naming a state
+func (w Workflow) NextState(from string, status TodoStatus) (WorkflowState, bool) {
+	for _, state := range w.states {
+		if state.Status() == status && w.CanTransition(from, state.key) {
+			return state, true
+		}
+	}
+	return WorkflowState{}, false
+}
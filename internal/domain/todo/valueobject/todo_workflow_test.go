@@ -0,0 +1,159 @@
+package valueobject
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func mustState(t *testing.T, key, category string, requires ...string) WorkflowState {
+	t.Helper()
+
+	state, err := NewWorkflowState(key, key, category, requires)
+	if err != nil {
+		t.Fatalf("NewWorkflowState(%q) error = %v", key, err)
+	}
+	return state
+}
+
+func TestNewWorkflowState(t *testing.T) {
+	state, err := NewWorkflowState("review", " In review ", "in_progress", []string{"description", "description"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if state.Name() != "In review" || state.Status() != StatusInProgress {
+		t.Errorf("Expected trimmed name and in progress status, got %q and %s", state.Name(), state.Status())
+	}
+	if want := []WorkflowField{FieldDescription}; !slices.Equal(state.Requires(), want) {
+		t.Errorf("Expected required fields %v, got %v", want, state.Requires())
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		category string
+		requires []string
+	}{
+		{name: "invalid key", key: "In Review", category: "open"},
+		{name: "unknown category", key: "review", category: "blocked"},
+		{name: "unknown required field", key: "review", category: "open", requires: []string{"assignee"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewWorkflowState(tt.key, "Review", tt.category, tt.requires); !errors.Is(err, ErrInvalidWorkflow) {
+				t.Errorf("Expected ErrInvalidWorkflow, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewWorkflow(t *testing.T) {
+	backlog := mustState(t, "backlog", "open")
+	doing := mustState(t, "doing", "in_progress")
+	done := mustState(t, "done", "done", "resolution")
+
+	tests := []struct {
+		name        string
+		states      []WorkflowState
+		transitions map[string][]string
+		wantErr     bool
+	}{
+		{
+			name:        "valid",
+			states:      []WorkflowState{backlog, doing, done},
+			transitions: map[string][]string{"backlog": {"doing"}, "doing": {"done", "backlog"}},
+		},
+		{name: "no states", states: nil, wantErr: true},
+		{name: "initial state not open", states: []WorkflowState{doing, backlog}, wantErr: true},
+		{name: "repeated state", states: []WorkflowState{backlog, doing, doing}, wantErr: true},
+		{
+			name:        "transition from unknown state",
+			states:      []WorkflowState{backlog, doing},
+			transitions: map[string][]string{"review": {"doing"}},
+			wantErr:     true,
+		},
+		{
+			name:        "transition to unknown state",
+			states:      []WorkflowState{backlog, doing},
+			transitions: map[string][]string{"backlog": {"review"}},
+			wantErr:     true,
+		},
+		{
+			name:        "transition to itself",
+			states:      []WorkflowState{backlog, doing},
+			transitions: map[string][]string{"backlog": {"backlog"}},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWorkflow(tt.states, tt.transitions)
+			if tt.wantErr != errors.Is(err, ErrInvalidWorkflow) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWorkflowStates(t *testing.T) {
+	workflow, err := NewWorkflow(
+		[]WorkflowState{
+			mustState(t, "backlog", "open"),
+			mustState(t, "ready", "open"),
+			mustState(t, "doing", "in_progress"),
+			mustState(t, "done", "done"),
+		},
+		map[string][]string{"backlog": {"ready", "done"}, "ready": {"doing", "backlog"}, "doing": {"done"}},
+	)
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+
+	if workflow.Initial().Key() != "backlog" {
+		t.Errorf("Expected backlog as initial state, got %q", workflow.Initial().Key())
+	}
+	if !workflow.CanTransition("ready", "doing") || workflow.CanTransition("backlog", "doing") {
+		t.Error("Expected only the listed transitions to be allowed")
+	}
+	if state, ok := workflow.StateOf(StatusPending); !ok || state.Key() != "backlog" {
+		t.Errorf("Expected backlog as the first pending state, got %q", state.Key())
+	}
+	if _, ok := workflow.StateOf(StatusCancelled); ok {
+		t.Error("Expected no cancelled state")
+	}
+	if state, ok := workflow.NextState("ready", StatusPending); !ok || state.Key() != "backlog" {
+		t.Errorf("Expected backlog as the next pending state from ready, got %q", state.Key())
+	}
+	if _, ok := workflow.NextState("backlog", StatusInProgress); ok {
+		t.Error("Expected no in progress state reachable from backlog")
+	}
+}
+
+func TestDefaultWorkflow(t *testing.T) {
+	workflow := DefaultWorkflow()
+
+	statuses := []TodoStatus{StatusPending, StatusInProgress, StatusCompleted, StatusCancelled}
+	for _, status := range statuses {
+		state, ok := workflow.State(status.String())
+		if !ok || state.Status() != status {
+			t.Errorf("Expected a state keyed %q in its status, got %v", status, state)
+		}
+	}
+
+	allowed := map[TodoStatus][]TodoStatus{
+		StatusPending:    {StatusInProgress, StatusCompleted, StatusCancelled},
+		StatusInProgress: {StatusPending, StatusCompleted, StatusCancelled},
+		StatusCompleted:  {StatusPending},
+		StatusCancelled:  {StatusPending},
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := slices.Contains(allowed[from], to)
+			if got := workflow.CanTransition(from.String(), to.String()); got != want {
+				t.Errorf("CanTransition(%s, %s) = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"todolist/internal/domain/shared"
+)
+
+// Reasons of failed login attempts
+const (
+	LoginFailUnknownUser     = "unknown_user"
+	LoginFailInvalidPassword = "invalid_password"
+	LoginFailUserNotActive   = "user_not_active"
+)
+
+// LoginAttempt records an authentication attempt made with a username,
+// used for security monitoring
+type LoginAttempt struct {
+	shared.Entity
+	userID     int64
+	username   string
+	success    bool
+	ipAddress  string
+	userAgent  string
+	failReason string
+}
+
+// NewLoginAttempt creates a new LoginAttempt entity.
+//
+// userID is zero when the username does not belong to any user.
+func NewLoginAttempt(
+	id int64,
+	userID int64,
+	username string,
+	success bool,
+	ipAddress string,
+	userAgent string,
+	failReason string,
+) (*LoginAttempt, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+
+	return &LoginAttempt{
+		Entity:     shared.NewEntity(id),
+		userID:     userID,
+		username:   username,
+		success:    success,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+		failReason: failReason,
+	}, nil
+}
+
+// Getters
+
+// UserID returns the ID of the user, zero for unknown usernames
+func (a LoginAttempt) UserID() int64 { return a.userID }
+
+// Username returns the username used in the attempt
+func (a LoginAttempt) Username() string { return a.username }
+
+// Success checks if the attempt authenticated the user
+func (a LoginAttempt) Success() bool { return a.success }
+
+// IPAddress returns the IP address of the client
+func (a LoginAttempt) IPAddress() string { return a.ipAddress }
+
+// UserAgent returns the user agent of the client
+func (a LoginAttempt) UserAgent() string { return a.userAgent }
+
+// FailReason returns why the attempt failed
+func (a LoginAttempt) FailReason() string { return a.failReason }
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"errors"
+	"todolist/internal/domain/shared"
+	vo "todolist/internal/domain/user/valueobject"
+)
+
+var (
+	ErrInvalidRoleName        = errors.New("invalid role name")
+	ErrRoleWithoutPermissions = errors.New("role must have at least one permission")
+	ErrRoleNotFound           = errors.New("role not found")
+	ErrRoleAlreadyExists      = errors.New("role already exists")
+	ErrRoleInUse              = errors.New("role is assigned to users")
+	ErrBuiltInRole            = errors.New("built-in roles can not be changed")
+)
+
+// Role represents a named set of permissions assignable to users
+type Role struct {
+	shared.Entity
+	name        vo.UserRole
+	description string
+	permissions []vo.Permission
+}
+
+// NewRole creates a new Role entity
+func NewRole(
+	id int64,
+	name vo.UserRole,
+	description string,
+	permissions []vo.Permission,
+) (*Role, error) {
+	if !name.IsValid() {
+		return nil, ErrInvalidRoleName
+	}
+
+	r := &Role{
+		Entity:      shared.NewEntity(id),
+		name:        name,
+		description: description,
+	}
+
+	if err := r.setPermissions(permissions); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Getters
+
+// Name returns the role name
+func (r Role) Name() vo.UserRole { return r.name }
+
+// Description returns the role description
+func (r Role) Description() string { return r.description }
+
+// Permissions returns a copy of the role permissions
+func (r Role) Permissions() []vo.Permission {
+	permissions := make([]vo.Permission, len(r.permissions))
+	copy(permissions, r.permissions)
+	return permissions
+}
+
+// Update methods
+
+// UpdateDescription changes the role description
+func (r *Role) UpdateDescription(description string) {
+	r.description = description
+	r.SetAsModified()
+}
+
+// UpdatePermissions replaces the role permissions
+func (r *Role) UpdatePermissions(permissions []vo.Permission) error {
+	if err := r.setPermissions(permissions); err != nil {
+		return err
+	}
+	r.SetAsModified()
+	return nil
+}
+
+// setPermissions validates and stores the permissions without duplicates
+func (r *Role) setPermissions(permissions []vo.Permission) error {
+	unique := make([]vo.Permission, 0, len(permissions))
+	seen := make(map[vo.Permission]bool, len(permissions))
+
+	for _, permission := range permissions {
+		parsed, err := vo.ParsePermission(permission.String())
+		if err != nil {
+			return err
+		}
+		if seen[parsed] {
+			continue
+		}
+		seen[parsed] = true
+		unique = append(unique, parsed)
+	}
+
+	if len(unique) == 0 {
+		return ErrRoleWithoutPermissions
+	}
+
+	r.permissions = unique
+	return nil
+}
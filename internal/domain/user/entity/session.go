@@ -0,0 +1,156 @@
+package entity
+
+import (
+	"errors"
+	"time"
+	"todolist/internal/domain/shared"
+)
+
+var (
+	ErrInvalidSessionUser   = errors.New("session user ID is required")
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrSessionRevoked       = errors.New("session has been revoked")
+	ErrSessionExpired       = errors.New("session has expired")
+	ErrRefreshTokenMismatch = errors.New("refresh token does not belong to the session")
+)
+
+// sessionTouchInterval is the minimum interval between two last seen updates
+const sessionTouchInterval = 5 * time.Minute
+
+// Session represents an authenticated device of a user, bound to the
+// refresh token issued to it
+type Session struct {
+	shared.Entity
+	userID         int64
+	refreshTokenID string
+	deviceName     string
+	ipAddress      string
+	userAgent      string
+	lastSeenAt     time.Time
+	expiresAt      time.Time
+	revokedAt      *time.Time
+}
+
+// NewSession creates a new Session entity
+func NewSession(
+	id int64,
+	userID int64,
+	deviceName string,
+	ipAddress string,
+	userAgent string,
+) (*Session, error) {
+	if userID == 0 {
+		return nil, ErrInvalidSessionUser
+	}
+
+	s := &Session{
+		Entity:     shared.NewEntity(id),
+		userID:     userID,
+		deviceName: deviceName,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+	}
+	s.lastSeenAt = s.CreatedAt()
+
+	return s, nil
+}
+
+// Getters
+
+// UserID returns the ID of the session owner
+func (s Session) UserID() int64 { return s.userID }
+
+// RefreshTokenID returns the ID of the refresh token currently bound to the session
+func (s Session) RefreshTokenID() string { return s.refreshTokenID }
+
+// DeviceName returns the device name informed by the client
+func (s Session) DeviceName() string { return s.deviceName }
+
+// IPAddress returns the last known IP address of the session
+func (s Session) IPAddress() string { return s.ipAddress }
+
+// UserAgent returns the last known user agent of the session
+func (s Session) UserAgent() string { return s.userAgent }
+
+// LastSeenAt returns the last time the session was used
+func (s Session) LastSeenAt() time.Time { return s.lastSeenAt }
+
+// ExpiresAt returns the session expiration, which follows the refresh token
+func (s Session) ExpiresAt() time.Time { return s.expiresAt }
+
+// RevokedAt returns a copy of the revocation timestamp
+func (s Session) RevokedAt() *time.Time {
+	if s.revokedAt == nil {
+		return nil
+	}
+	revokedAtCopy := *s.revokedAt
+	return &revokedAtCopy
+}
+
+// Business methods
+
+// IsRevoked checks if the session was revoked
+func (s Session) IsRevoked() bool { return s.revokedAt != nil }
+
+// IsExpired checks if the session refresh token has expired
+func (s Session) IsExpired() bool {
+	return !s.expiresAt.IsZero() && time.Now().After(s.expiresAt)
+}
+
+// IsActive checks if the session can still be used
+func (s Session) IsActive() bool { return !s.IsRevoked() && !s.IsExpired() }
+
+// CanBeUsed returns an error describing why the session can not be used
+func (s Session) CanBeUsed() error {
+	if s.IsRevoked() {
+		return ErrSessionRevoked
+	}
+	if s.IsExpired() {
+		return ErrSessionExpired
+	}
+	return nil
+}
+
+// NeedsTouch checks if the last seen timestamp is stale enough to be updated
+func (s Session) NeedsTouch() bool {
+	return time.Since(s.lastSeenAt) >= sessionTouchInterval
+}
+
+// Update methods
+
+// BindRefreshToken binds a newly issued refresh token to the session
+func (s *Session) BindRefreshToken(tokenID string, expiresAt time.Time) {
+	s.refreshTokenID = tokenID
+	s.expiresAt = expiresAt
+	s.SetAsModified()
+}
+
+// Touch records a new usage of the session
+func (s *Session) Touch(ipAddress, userAgent string) {
+	if ipAddress != "" {
+		s.ipAddress = ipAddress
+	}
+	if userAgent != "" {
+		s.userAgent = userAgent
+	}
+	s.lastSeenAt = time.Now()
+	s.SetAsModified()
+}
+
+// Revoke terminates the session
+func (s *Session) Revoke() {
+	if s.IsRevoked() {
+		return
+	}
+	now := time.Now()
+	s.revokedAt = &now
+	s.SetAsModified()
+}
+
+// Restore methods (used by persistence mappers)
+
+// SetLastSeenAt sets the last seen timestamp
+func (s *Session) SetLastSeenAt(t time.Time) { s.lastSeenAt = t }
+
+// SetRevokedAt sets the revocation timestamp
+func (s *Session) SetRevokedAt(t *time.Time) { s.revokedAt = t }
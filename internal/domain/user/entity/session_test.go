@@ -0,0 +1,168 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewSession(t *testing.T) {
+	session, err := NewSession(1, 10, "Laptop", "10.0.0.1", "Firefox")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if session.UserID() != 10 || session.DeviceName() != "Laptop" {
+		t.Errorf("Expected the session of user 10 on Laptop, got %d on %q", session.UserID(), session.DeviceName())
+	}
+	if !session.LastSeenAt().Equal(session.CreatedAt()) {
+		t.Errorf("Expected new sessions to be last seen when created, got %v", session.LastSeenAt())
+	}
+	if !session.IsActive() {
+		t.Error("Expected new sessions to be active")
+	}
+
+	if _, err := NewSession(1, 0, "Laptop", "", ""); !errors.Is(err, ErrInvalidSessionUser) {
+		t.Errorf("Expected ErrInvalidSessionUser, got %v", err)
+	}
+}
+
+func TestSession_CanBeUsed(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(s *Session)
+		wantErr error
+		active  bool
+	}{
+		{name: "without refresh token", setup: func(s *Session) {}, active: true},
+		{
+			name:   "refresh token not expired",
+			setup:  func(s *Session) { s.BindRefreshToken("token-1", time.Now().Add(time.Hour)) },
+			active: true,
+		},
+		{
+			name:    "refresh token expired",
+			setup:   func(s *Session) { s.BindRefreshToken("token-1", time.Now().Add(-time.Minute)) },
+			wantErr: ErrSessionExpired,
+		},
+		{
+			name:    "revoked",
+			setup:   func(s *Session) { s.BindRefreshToken("token-1", time.Now().Add(time.Hour)); s.Revoke() },
+			wantErr: ErrSessionRevoked,
+		},
+		{
+			// Revocation is reported before expiration
+			name:    "revoked and expired",
+			setup:   func(s *Session) { s.BindRefreshToken("token-1", time.Now().Add(-time.Minute)); s.Revoke() },
+			wantErr: ErrSessionRevoked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, _ := NewSession(1, 10, "Laptop", "", "")
+			tt.setup(session)
+
+			if err := session.CanBeUsed(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CanBeUsed() error = %v, want %v", err, tt.wantErr)
+			}
+			if session.IsActive() != tt.active {
+				t.Errorf("IsActive() = %v, want %v", session.IsActive(), tt.active)
+			}
+		})
+	}
+}
+
+func TestSession_BindRefreshToken(t *testing.T) {
+	session, _ := NewSession(1, 10, "Laptop", "", "")
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	session.BindRefreshToken("token-1", time.Now().Add(time.Hour))
+	session.BindRefreshToken("token-2", expiresAt)
+
+	if session.RefreshTokenID() != "token-2" {
+		t.Errorf("Expected the rotated token to replace the previous one, got %q", session.RefreshTokenID())
+	}
+	if !session.ExpiresAt().Equal(expiresAt) {
+		t.Errorf("Expected the session to expire with the new token, got %v", session.ExpiresAt())
+	}
+}
+
+func TestSession_Revoke(t *testing.T) {
+	session, _ := NewSession(1, 10, "Laptop", "", "")
+
+	session.Revoke()
+	revokedAt := session.RevokedAt()
+	if revokedAt == nil {
+		t.Fatal("Expected the revocation time to be set")
+	}
+
+	// Revoking again keeps the first revocation time
+	session.Revoke()
+	if !session.RevokedAt().Equal(*revokedAt) {
+		t.Errorf("Expected revocation time %v, got %v", revokedAt, session.RevokedAt())
+	}
+
+	// The returned time is a copy
+	*revokedAt = time.Time{}
+	if session.RevokedAt().IsZero() {
+		t.Error("Expected RevokedAt to return a copy")
+	}
+}
+
+func TestSession_Touch(t *testing.T) {
+	tests := []struct {
+		name          string
+		lastSeen      time.Duration
+		ipAddress     string
+		userAgent     string
+		wantTouch     bool
+		wantIPAddress string
+		wantUserAgent string
+	}{
+		{
+			name:          "recently seen",
+			lastSeen:      time.Minute,
+			wantTouch:     false,
+			wantIPAddress: "10.0.0.1",
+			wantUserAgent: "Firefox",
+		},
+		{
+			name:          "stale with new client details",
+			lastSeen:      sessionTouchInterval,
+			ipAddress:     "10.0.0.2",
+			userAgent:     "Chrome",
+			wantTouch:     true,
+			wantIPAddress: "10.0.0.2",
+			wantUserAgent: "Chrome",
+		},
+		{
+			name:          "stale without client details",
+			lastSeen:      time.Hour,
+			wantTouch:     true,
+			wantIPAddress: "10.0.0.1",
+			wantUserAgent: "Firefox",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, _ := NewSession(1, 10, "Laptop", "10.0.0.1", "Firefox")
+			session.SetLastSeenAt(time.Now().Add(-tt.lastSeen))
+
+			if session.NeedsTouch() != tt.wantTouch {
+				t.Fatalf("NeedsTouch() = %v, want %v", session.NeedsTouch(), tt.wantTouch)
+			}
+
+			before := time.Now()
+			session.Touch(tt.ipAddress, tt.userAgent)
+
+			if session.LastSeenAt().Before(before) || session.NeedsTouch() {
+				t.Errorf("Expected the session to be seen now, got %v", session.LastSeenAt())
+			}
+			if session.IPAddress() != tt.wantIPAddress || session.UserAgent() != tt.wantUserAgent {
+				t.Errorf("Expected %q and %q, got %q and %q",
+					tt.wantIPAddress, tt.wantUserAgent, session.IPAddress(), session.UserAgent())
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
 	vo "todolist/internal/domain/user/valueobject"
 )
 
@@ -25,6 +26,8 @@ type User struct {
 	password           vo.Password
 	status             vo.UserStatus
 	role               vo.UserRole
+	mustResetPassword  bool
+	searchLanguage     sharedvo.SearchLanguage
 }
 
 // NewUser creates a new User entity
@@ -73,6 +76,13 @@ func (u User) Role() vo.UserRole { return u.role }
 // IsActive checks if the user is active
 func (u User) IsActive() bool { return u.status == vo.StatusActive }
 
+// MustResetPassword checks if the user has to change the password before using the application
+func (u User) MustResetPassword() bool { return u.mustResetPassword }
+
+// SearchLanguage returns the language of the searches of the user, empty when the
+// user has not chosen one
+func (u User) SearchLanguage() sharedvo.SearchLanguage { return u.searchLanguage }
+
 // LastLoginAttemptAt returns the last login attemps of user
 func (u User) LastLoginAttemptAt() time.Time { return u.lastLoginAttemptAt }
 
@@ -91,9 +101,23 @@ func (u User) CanPerformAction() error {
 
 // Update methods
 
-// ChangeUsername changes the username of the user
+// ChangePassword changes the password of the user, clearing any pending password reset
 func (u *User) ChangePassword(newPassword vo.Password) {
 	u.password = newPassword
+	u.mustResetPassword = false
+	u.SetAsModified()
+}
+
+// RequirePasswordReset forces the user to change the password on the next login
+func (u *User) RequirePasswordReset() {
+	u.mustResetPassword = true
+	u.SetAsModified()
+}
+
+// ChangeSearchLanguage changes the language of the searches of the user, an empty
+// language restoring the default one
+func (u *User) ChangeSearchLanguage(language sharedvo.SearchLanguage) {
+	u.searchLanguage = language
 	u.SetAsModified()
 }
 
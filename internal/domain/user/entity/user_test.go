@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"testing"
+	vo "todolist/internal/domain/user/valueobject"
+)
+
+func TestUser_PasswordReset(t *testing.T) {
+	user, err := NewUser(1, 10, "john", vo.NewPasswordFromHash("hash"), vo.RoleUser)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	t.Run("should not require reset for new users", func(t *testing.T) {
+		if user.MustResetPassword() {
+			t.Error("Expected new user not to require a password reset")
+		}
+	})
+
+	t.Run("should require reset until the password is changed", func(t *testing.T) {
+		user.RequirePasswordReset()
+		if !user.MustResetPassword() {
+			t.Fatal("Expected user to require a password reset")
+		}
+
+		user.ChangePassword(vo.NewPasswordFromHash("new-hash"))
+		if user.MustResetPassword() {
+			t.Error("Expected password change to clear the reset requirement")
+		}
+	})
+}
+
+func TestUser_StatusChanges(t *testing.T) {
+	user, _ := NewUser(1, 10, "john", vo.NewPasswordFromHash("hash"), vo.RoleUser)
+
+	tests := []struct {
+		name     string
+		change   func(u *User)
+		expected vo.UserStatus
+		active   bool
+	}{
+		{name: "deactivate", change: (*User).Deactivate, expected: vo.StatusInactive, active: false},
+		{name: "block", change: (*User).Block, expected: vo.StatusBlocked, active: false},
+		{name: "activate", change: (*User).Activate, expected: vo.StatusActive, active: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.change(user)
+
+			if user.Status() != tt.expected {
+				t.Errorf("Expected status %v, got %v", tt.expected, user.Status())
+			}
+			if user.IsActive() != tt.active {
+				t.Errorf("Expected active to be %v", tt.active)
+			}
+			if err := user.CanPerformAction(); (err == nil) != tt.active {
+				t.Errorf("Unexpected CanPerformAction result: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+)
+
+// LoginAttemptRepository defines persistence operations for login attempts
+type LoginAttemptRepository interface {
+	// Commands
+	Save(ctx context.Context, attempt *entity.LoginAttempt) error
+
+	// Queries
+	FindByUserID(ctx context.Context, userID int64, options shared.QueryOptions) ([]*entity.LoginAttempt, error)
+
+	// Aggregations
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
+}
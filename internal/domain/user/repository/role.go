@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/valueobject"
+)
+
+// RoleRepository defines persistence operations for custom Role definitions
+type RoleRepository interface {
+	// Commands
+	Save(ctx context.Context, role *entity.Role) error
+	Delete(ctx context.Context, name valueobject.UserRole) error
+
+	// Queries
+	FindByName(ctx context.Context, name valueobject.UserRole) (*entity.Role, error)
+	FindAll(ctx context.Context) ([]*entity.Role, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/user/entity"
+)
+
+// SessionRepository defines persistence operations for user sessions
+type SessionRepository interface {
+	// Commands
+	Save(ctx context.Context, session *entity.Session) error
+	RevokeAllByUserID(ctx context.Context, userID int64, exceptSessionID int64) (int64, error)
+
+	// RotateRefreshToken saves the session bound to its new refresh token, only when the
+	// stored session is still bound to the previous one. Returns false when another
+	// rotation happened in between.
+	RotateRefreshToken(ctx context.Context, session *entity.Session, previousTokenID string) (bool, error)
+
+	// Queries
+	FindByID(ctx context.Context, id int64) (*entity.Session, error)
+	FindActiveByUserID(ctx context.Context, userID int64) ([]*entity.Session, error)
+}
@@ -27,12 +27,14 @@ type UserRepository interface {
 type UserQueryRepository interface {
 	// List operations
 	FindAll(ctx context.Context, options shared.QueryOptions) ([]*entity.User, error)
+	FindByFilters(ctx context.Context, filters valueobject.UserFilterCriteria, options shared.QueryOptions) ([]*entity.User, error)
 	FindByStatus(ctx context.Context, status valueobject.UserStatus, options shared.QueryOptions) ([]*entity.User, error)
 	FindByRole(ctx context.Context, role valueobject.UserRole, options shared.QueryOptions) ([]*entity.User, error)
 	FindInactiveUsers(ctx context.Context, inactiveDays int, options shared.QueryOptions) ([]*entity.User, error)
 
 	// Aggregations
 	Count(ctx context.Context, filters []shared.Filter) (int64, error)
+	CountByFilters(ctx context.Context, filters valueobject.UserFilterCriteria) (int64, error)
 	CountByStatus(ctx context.Context) (map[valueobject.UserStatus]int64, error)
 	CountByRole(ctx context.Context) (map[valueobject.UserRole]int64, error)
 }
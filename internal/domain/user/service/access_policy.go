@@ -0,0 +1,101 @@
+package service
+
+import (
+	"slices"
+	"todolist/internal/domain/user/entity"
+	vo "todolist/internal/domain/user/valueobject"
+)
+
+// Resource describes the relationships of a resource instance used by access checks
+type Resource struct {
+	OwnerID   int64
+	MemberIDs []int64
+}
+
+// RelationOf returns how the user relates to the resource
+func (r Resource) RelationOf(userID int64) vo.ResourceRelation {
+	switch {
+	case userID != 0 && r.OwnerID == userID:
+		return vo.RelationOwner
+	case userID != 0 && slices.Contains(r.MemberIDs, userID):
+		return vo.RelationMember
+	default:
+		return vo.RelationNone
+	}
+}
+
+// AccessPolicy evaluates permissions granted to roles.
+//
+// A policy is immutable, a new one must be built whenever role definitions change.
+type AccessPolicy struct {
+	roles map[vo.UserRole][]vo.Permission
+}
+
+// DefaultRolePermissions returns the permissions of the built-in roles
+func DefaultRolePermissions() map[vo.UserRole][]vo.Permission {
+	return map[vo.UserRole][]vo.Permission{
+		vo.RoleAdmin: {vo.PermissionAll},
+		vo.RoleUser: {
+			"todo:create",
+			"todo:*:own",
+			"todo:read:shared",
+			"todo:update:shared",
+			"person:create",
+			"person:read:own",
+			"person:update:own",
+		},
+	}
+}
+
+// NewAccessPolicy creates a policy from role definitions.
+// Later definitions of the same role replace earlier ones.
+func NewAccessPolicy(roles ...*entity.Role) *AccessPolicy {
+	policy := &AccessPolicy{roles: make(map[vo.UserRole][]vo.Permission, len(roles))}
+	for _, role := range roles {
+		policy.roles[role.Name()] = role.Permissions()
+	}
+	return policy
+}
+
+// Roles returns the names of every defined role
+func (p *AccessPolicy) Roles() []vo.UserRole {
+	roles := make([]vo.UserRole, 0, len(p.roles))
+	for role := range p.roles {
+		roles = append(roles, role)
+	}
+	slices.Sort(roles)
+	return roles
+}
+
+// HasRole checks if the role is defined
+func (p *AccessPolicy) HasRole(role vo.UserRole) bool {
+	_, ok := p.roles[role]
+	return ok
+}
+
+// Permissions returns the permissions granted to the role
+func (p *AccessPolicy) Permissions(role vo.UserRole) []vo.Permission {
+	return slices.Clone(p.roles[role])
+}
+
+// Can checks if the role holds the permission for at least some resources.
+// It is meant for route level checks, before the resource is known.
+func (p *AccessPolicy) Can(role vo.UserRole, required vo.Permission) bool {
+	for _, granted := range p.roles[role] {
+		if granted.Covers(required) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAccess checks if a user with the role holds the permission on a specific resource
+func (p *AccessPolicy) CanAccess(role vo.UserRole, userID int64, required vo.Permission, resource Resource) bool {
+	relation := resource.RelationOf(userID)
+	for _, granted := range p.roles[role] {
+		if granted.Grants(required, relation) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+	"todolist/internal/domain/user/entity"
+	vo "todolist/internal/domain/user/valueobject"
+)
+
+func newTestPolicy(t *testing.T) *AccessPolicy {
+	t.Helper()
+
+	var roles []*entity.Role
+	for name, permissions := range DefaultRolePermissions() {
+		role, err := entity.NewRole(0, name, "", permissions)
+		if err != nil {
+			t.Fatalf("NewRole(%q) error = %v", name, err)
+		}
+		roles = append(roles, role)
+	}
+
+	return NewAccessPolicy(roles...)
+}
+
+func TestAccessPolicy_Can(t *testing.T) {
+	policy := newTestPolicy(t)
+
+	tests := []struct {
+		name       string
+		role       vo.UserRole
+		permission vo.Permission
+		want       bool
+	}{
+		{name: "user creates todos", role: vo.RoleUser, permission: vo.PermissionTodoCreate, want: true},
+		{name: "user deletes own todos", role: vo.RoleUser, permission: vo.PermissionTodoDelete, want: true},
+		{name: "user can not manage users", role: vo.RoleUser, permission: vo.PermissionUserManage, want: false},
+		{name: "admin manages users", role: vo.RoleAdmin, permission: vo.PermissionUserManage, want: true},
+		{name: "undefined role", role: "guest", permission: vo.PermissionTodoRead, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Can(tt.role, tt.permission); got != tt.want {
+				t.Errorf("Can(%q, %q) = %v, want %v", tt.role, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessPolicy_CanAccess(t *testing.T) {
+	policy := newTestPolicy(t)
+	resource := Resource{OwnerID: 1, MemberIDs: []int64{2}}
+
+	tests := []struct {
+		name       string
+		role       vo.UserRole
+		userID     int64
+		permission vo.Permission
+		want       bool
+	}{
+		{name: "owner deletes", role: vo.RoleUser, userID: 1, permission: vo.PermissionTodoDelete, want: true},
+		{name: "member reads", role: vo.RoleUser, userID: 2, permission: vo.PermissionTodoRead, want: true},
+		{name: "member updates", role: vo.RoleUser, userID: 2, permission: vo.PermissionTodoUpdate, want: true},
+		{name: "member can not delete", role: vo.RoleUser, userID: 2, permission: vo.PermissionTodoDelete, want: false},
+		{name: "stranger can not read", role: vo.RoleUser, userID: 3, permission: vo.PermissionTodoRead, want: false},
+		{name: "admin reads any", role: vo.RoleAdmin, userID: 3, permission: vo.PermissionTodoRead, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.CanAccess(tt.role, tt.userID, tt.permission, resource); got != tt.want {
+				t.Errorf("CanAccess(%q, %d, %q) = %v, want %v", tt.role, tt.userID, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAccessPolicy_LaterDefinitionsOverride(t *testing.T) {
+	base, _ := entity.NewRole(0, "auditor", "", []vo.Permission{"todo:read"})
+	override, _ := entity.NewRole(0, "auditor", "", []vo.Permission{"person:read"})
+
+	policy := NewAccessPolicy(base, override)
+
+	if policy.Can("auditor", vo.PermissionTodoRead) {
+		t.Error("expected overridden permission to be removed")
+	}
+	if !policy.Can("auditor", vo.PermissionPersonRead) {
+		t.Error("expected overriding permission to be granted")
+	}
+}
@@ -0,0 +1,150 @@
+package valueobject
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidPermission = errors.New("invalid permission")
+
+// Wildcard matches any resource, action or the whole permission
+const Wildcard = "*"
+
+// permissionSegmentRegex validates the resource and action segments of a permission
+var permissionSegmentRegex = regexp.MustCompile(`^([a-z][a-z_]*|\*)$`)
+
+// Permission represents an authorization grant in the form "resource:action[:scope]",
+// e.g. "todo:update:own". A missing scope means any resource instance.
+type Permission string
+
+// PermissionScope restricts a permission to resources related to the user
+type PermissionScope string
+
+const (
+	ScopeAny    PermissionScope = "any"
+	ScopeOwn    PermissionScope = "own"
+	ScopeShared PermissionScope = "shared"
+)
+
+// ResourceRelation describes how a user relates to a resource instance
+type ResourceRelation int
+
+const (
+	RelationNone ResourceRelation = iota
+	RelationMember
+	RelationOwner
+)
+
+// Known permissions
+const (
+	PermissionAll Permission = Wildcard
+
+	PermissionTodoRead   Permission = "todo:read"
+	PermissionTodoCreate Permission = "todo:create"
+	PermissionTodoUpdate Permission = "todo:update"
+	PermissionTodoDelete Permission = "todo:delete"
+
+	PermissionPersonRead   Permission = "person:read"
+	PermissionPersonCreate Permission = "person:create"
+	PermissionPersonUpdate Permission = "person:update"
+
+	PermissionUserRead        Permission = "user:read"
+	PermissionUserManage      Permission = "user:manage"
+	PermissionUserImpersonate Permission = "user:impersonate"
+	PermissionRoleManage      Permission = "role:manage"
+
+	PermissionAuditRead Permission = "audit:read"
+)
+
+// ParsePermission parses and validates a permission expression
+func ParsePermission(value string) (Permission, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == Wildcard {
+		return PermissionAll, nil
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return "", ErrInvalidPermission
+	}
+
+	for _, part := range parts[:2] {
+		if !permissionSegmentRegex.MatchString(part) {
+			return "", ErrInvalidPermission
+		}
+	}
+
+	if len(parts) == 3 {
+		switch PermissionScope(parts[2]) {
+		case ScopeAny, ScopeOwn, ScopeShared:
+		default:
+			return "", ErrInvalidPermission
+		}
+	}
+
+	return Permission(value), nil
+}
+
+// Resource returns the resource segment of the permission
+func (p Permission) Resource() string { return p.segment(0) }
+
+// Action returns the action segment of the permission
+func (p Permission) Action() string { return p.segment(1) }
+
+// Scope returns the scope of the permission, defaulting to any
+func (p Permission) Scope() PermissionScope {
+	if scope := p.segment(2); scope != "" && scope != Wildcard {
+		return PermissionScope(scope)
+	}
+	return ScopeAny
+}
+
+// String returns the string representation
+func (p Permission) String() string {
+	return string(p)
+}
+
+// Covers checks if the grant includes the required "resource:action" at any scope
+func (p Permission) Covers(required Permission) bool {
+	return matchSegment(p.Resource(), required.Resource()) &&
+		matchSegment(p.Action(), required.Action())
+}
+
+// Grants checks if the grant allows the required "resource:action" on a resource
+// the user has the given relation with
+func (p Permission) Grants(required Permission, relation ResourceRelation) bool {
+	if !p.Covers(required) {
+		return false
+	}
+
+	switch p.Scope() {
+	case ScopeOwn:
+		return relation == RelationOwner
+	case ScopeShared:
+		return relation == RelationOwner || relation == RelationMember
+	default:
+		return true
+	}
+}
+
+// segment returns the segment at the index, the wildcard permission matches every segment
+func (p Permission) segment(index int) string {
+	if p == PermissionAll {
+		if index == 2 {
+			return ""
+		}
+		return Wildcard
+	}
+
+	parts := strings.Split(string(p), ":")
+	if index >= len(parts) {
+		return ""
+	}
+	return parts[index]
+}
+
+// matchSegment checks if a granted segment matches the required one
+func matchSegment(granted, required string) bool {
+	return granted == Wildcard || granted == required
+}
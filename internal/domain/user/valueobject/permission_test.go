@@ -0,0 +1,64 @@
+package valueobject
+
+import "testing"
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Permission
+		wantErr bool
+	}{
+		{name: "resource and action", value: "todo:read", want: "todo:read"},
+		{name: "with scope", value: "todo:update:own", want: "todo:update:own"},
+		{name: "normalized", value: " TODO:Read:Shared ", want: "todo:read:shared"},
+		{name: "wildcard action", value: "todo:*", want: "todo:*"},
+		{name: "global wildcard", value: "*", want: PermissionAll},
+		{name: "missing action", value: "todo", wantErr: true},
+		{name: "unknown scope", value: "todo:read:team", wantErr: true},
+		{name: "too many segments", value: "todo:read:own:extra", wantErr: true},
+		{name: "invalid characters", value: "todo:re-ad", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePermission(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePermission(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePermission(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermission_Grants(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  Permission
+		required Permission
+		relation ResourceRelation
+		want     bool
+	}{
+		{name: "any scope on unrelated resource", granted: "todo:read", required: PermissionTodoRead, relation: RelationNone, want: true},
+		{name: "own scope on owned resource", granted: "todo:update:own", required: PermissionTodoUpdate, relation: RelationOwner, want: true},
+		{name: "own scope on shared resource", granted: "todo:update:own", required: PermissionTodoUpdate, relation: RelationMember, want: false},
+		{name: "shared scope on shared resource", granted: "todo:read:shared", required: PermissionTodoRead, relation: RelationMember, want: true},
+		{name: "shared scope on owned resource", granted: "todo:read:shared", required: PermissionTodoRead, relation: RelationOwner, want: true},
+		{name: "shared scope on unrelated resource", granted: "todo:read:shared", required: PermissionTodoRead, relation: RelationNone, want: false},
+		{name: "wildcard action", granted: "todo:*:own", required: PermissionTodoDelete, relation: RelationOwner, want: true},
+		{name: "global wildcard", granted: PermissionAll, required: PermissionUserManage, relation: RelationNone, want: true},
+		{name: "different action", granted: "todo:read", required: PermissionTodoDelete, relation: RelationOwner, want: false},
+		{name: "different resource", granted: "person:read", required: PermissionTodoRead, relation: RelationOwner, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.granted.Grants(tt.required, tt.relation); got != tt.want {
+				t.Errorf("%q.Grants(%q, %v) = %v, want %v", tt.granted, tt.required, tt.relation, got, tt.want)
+			}
+		})
+	}
+}
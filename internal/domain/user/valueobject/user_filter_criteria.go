@@ -0,0 +1,23 @@
+package valueobject
+
+// UserFilterCriteria represents the filtering criteria for User queries
+type UserFilterCriteria struct {
+	Status     []UserStatus
+	Role       []UserRole
+	SearchTerm string
+}
+
+// HasStatusFilter checks if there is a status filter
+func (f *UserFilterCriteria) HasStatusFilter() bool {
+	return len(f.Status) > 0
+}
+
+// HasRoleFilter checks if there is a role filter
+func (f *UserFilterCriteria) HasRoleFilter() bool {
+	return len(f.Role) > 0
+}
+
+// HasSearchTerm checks if there is a search term
+func (f *UserFilterCriteria) HasSearchTerm() bool {
+	return f.SearchTerm != ""
+}
@@ -1,5 +1,10 @@
 package valueobject
 
+import "regexp"
+
+// userRoleRegex validates role names, allowing custom roles besides the built-in ones
+var userRoleRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,49}$`)
+
 // UserRole represents the role of a user
 type UserRole string
 
@@ -8,35 +13,18 @@ const (
 	RoleAdmin UserRole = "admin"
 )
 
-// IsValid validates if the role is valid
+// IsValid validates if the role name is well formed.
+// Whether the role is defined is decided by the access policy.
 func (r UserRole) IsValid() bool {
-	switch r {
-	case RoleUser, RoleAdmin:
-		return true
-	default:
-		return false
-	}
+	return userRoleRegex.MatchString(string(r))
+}
+
+// IsBuiltIn checks if the role is one of the roles shipped with the application
+func (r UserRole) IsBuiltIn() bool {
+	return r == RoleUser || r == RoleAdmin
 }
 
 // String returns the string representation
 func (r UserRole) String() string {
 	return string(r)
 }
-
-// HasPermission checks if the role has a specific permission
-func (r UserRole) HasPermission(permission string) bool {
-	switch r {
-	case RoleAdmin:
-		return true
-	case RoleUser:
-		userPermissions := map[string]bool{
-			"todo:read":   true,
-			"todo:create": false,
-			"todo:update": false,
-			"todo:delete": false,
-		}
-		return userPermissions[permission]
-	default:
-		return false
-	}
-}
@@ -0,0 +1,15 @@
+package valueobject
+
+import (
+	"slices"
+	"todolist/internal/domain/shared"
+)
+
+// userSortKeys are the keys users can be sorted by
+var userSortKeys = []string{"username", "status", "role", "created_at", "updated_at", "id"}
+
+// DefaultUserSort lists the newest users first
+var DefaultUserSort = shared.Sort{{Key: "created_at", Desc: true}}
+
+// UserSortKeys returns the keys users can be sorted by
+func UserSortKeys() []string { return slices.Clone(userSortKeys) }
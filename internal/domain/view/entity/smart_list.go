@@ -0,0 +1,66 @@
+package entity
+
+import (
+	todovo "todolist/internal/domain/todo/valueobject"
+	vo "todolist/internal/domain/view/valueobject"
+)
+
+// Keys of the built-in smart lists
+const (
+	SmartListToday     = "today"
+	SmartListUpcoming  = "upcoming"
+	SmartListOverdue   = "overdue"
+	SmartListNoDueDate = "no_due_date"
+)
+
+// openStatuses are the statuses of the todos still to be done
+var openStatuses = []string{todovo.StatusPending.String(), todovo.StatusInProgress.String()}
+
+// SmartLists returns the built-in views, available to every user
+func SmartLists() []*View {
+	today, upcomingFrom, upcomingTo := 0, 1, 7
+	overdue := true
+
+	return []*View{
+		newSmartList(SmartListToday, "Today", vo.ViewFilter{
+			Status:        openStatuses,
+			DueInDaysFrom: &today,
+			DueInDaysTo:   &today,
+		}, vo.ViewGroupingPriority),
+		newSmartList(SmartListUpcoming, "Upcoming", vo.ViewFilter{
+			Status:        openStatuses,
+			DueInDaysFrom: &upcomingFrom,
+			DueInDaysTo:   &upcomingTo,
+		}, vo.ViewGroupingNone),
+		newSmartList(SmartListOverdue, "Overdue", vo.ViewFilter{
+			IsOverdue: &overdue,
+		}, vo.ViewGroupingPriority),
+		newSmartList(SmartListNoDueDate, "No Due Date", vo.ViewFilter{
+			Status:     openStatuses,
+			Expression: "due:none",
+		}, vo.ViewGroupingNone),
+	}
+}
+
+// FindSmartList returns the built-in view with the key
+func FindSmartList(key string) (*View, bool) {
+	for _, view := range SmartLists() {
+		if view.key == key {
+			return view, true
+		}
+	}
+	return nil, false
+}
+
+// newSmartList creates a built-in view sorted by due date
+func newSmartList(key, name string, filter vo.ViewFilter, grouping vo.ViewGrouping) *View {
+	sort, _ := vo.NewViewSort("due_date", false)
+
+	return &View{
+		key:      key,
+		name:     name,
+		filter:   filter,
+		sort:     sort,
+		grouping: grouping,
+	}
+}
@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+	vo "todolist/internal/domain/view/valueobject"
+)
+
+// maxViewNameLength is the maximum length in characters of view names
+const maxViewNameLength = 100
+
+var (
+	ErrInvalidViewName   = errors.New("view name must have between 1 and 100 characters")
+	ErrViewNotFound      = errors.New("view not found")
+	ErrViewAlreadyExists = errors.New("view already exists")
+	ErrBuiltInView       = errors.New("built-in views can not be changed")
+)
+
+// View is a named todo filter, with its sort order and grouping, saved by a user
+// or built in
+type View struct {
+	shared.Entity
+	key      string
+	userID   int64
+	name     string
+	filter   vo.ViewFilter
+	sort     vo.ViewSort
+	grouping vo.ViewGrouping
+}
+
+// NewView creates a new View entity
+func NewView(
+	id int64,
+	userID int64,
+	name string,
+	filter vo.ViewFilter,
+	sort vo.ViewSort,
+	grouping vo.ViewGrouping,
+) (*View, error) {
+	v := &View{
+		Entity:   shared.NewEntity(id),
+		userID:   userID,
+		sort:     sort,
+		grouping: grouping,
+	}
+
+	if err := v.Rename(name); err != nil {
+		return nil, err
+	}
+
+	if err := v.SetFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Getters
+
+// Key returns the key of a built-in view, empty for saved views
+func (v View) Key() string { return v.key }
+
+// UserID returns the ID of the user who saved the view, zero for built-in views
+func (v View) UserID() int64 { return v.userID }
+
+// Name returns the view name
+func (v View) Name() string { return v.name }
+
+// Filter returns the view filter
+func (v View) Filter() vo.ViewFilter { return v.filter }
+
+// Sort returns the order of the todos of the view
+func (v View) Sort() vo.ViewSort { return v.sort }
+
+// Grouping returns the field the todos of the view are grouped by
+func (v View) Grouping() vo.ViewGrouping { return v.grouping }
+
+// IsBuiltIn checks if the view is built in
+func (v View) IsBuiltIn() bool { return v.key != "" }
+
+// IsVisibleTo checks if the user can see the view
+func (v View) IsVisibleTo(userID int64) bool { return v.IsBuiltIn() || v.userID == userID }
+
+// Criteria builds the criteria of the todos of the user in the view at the given time
+func (v View) Criteria(userID int64, now time.Time) (todovo.TodoFilterCriteria, error) {
+	return v.filter.Criteria(userID, now)
+}
+
+// Update methods
+
+// Rename changes the view name
+func (v *View) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxViewNameLength {
+		return ErrInvalidViewName
+	}
+	v.name = name
+	v.SetAsModified()
+	return nil
+}
+
+// SetFilter replaces the view filter
+func (v *View) SetFilter(filter vo.ViewFilter) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+	v.filter = filter
+	v.SetAsModified()
+	return nil
+}
+
+// SetSort changes the order of the todos of the view
+func (v *View) SetSort(sort vo.ViewSort) {
+	v.sort = sort
+	v.SetAsModified()
+}
+
+// SetGrouping changes the field the todos of the view are grouped by
+func (v *View) SetGrouping(grouping vo.ViewGrouping) {
+	v.grouping = grouping
+	v.SetAsModified()
+}
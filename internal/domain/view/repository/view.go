@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"todolist/internal/domain/view/entity"
+)
+
+// ViewRepository defines persistence operations for the views saved by users
+type ViewRepository interface {
+	// Commands
+	Save(ctx context.Context, view *entity.View) error
+	Delete(ctx context.Context, id int64) error
+
+	// Queries
+	FindByID(ctx context.Context, id int64) (*entity.View, error)
+	FindByUser(ctx context.Context, userID int64) ([]*entity.View, error)
+}
@@ -0,0 +1,103 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+// maxDueInDays bounds the days relative to today of view filters
+const maxDueInDays = 3650
+
+// ErrInvalidViewFilter is wrapped by the errors of invalid view filters
+var ErrInvalidViewFilter = errors.New("invalid view filter")
+
+// ViewFilter is the stored filter of a view, from which the todo filter criteria
+// are built each time the view is listed.
+//
+// Due dates are bounded by absolute dates or by days relative to the day the view
+// is listed, 0 being today, so views like "due in the next 7 days" stay current.
+// Both bounds are inclusive. Expression is a filter expression, see
+// todovo.ParseTodoFilterExpression.
+type ViewFilter struct {
+	Status        []string
+	Priority      []string
+	Tags          []string
+	IsOverdue     *bool
+	DueDateFrom   *time.Time
+	DueDateTo     *time.Time
+	DueInDaysFrom *int
+	DueInDaysTo   *int
+	SearchTerm    string
+	Expression    string
+}
+
+// Validate checks the statuses, priorities, relative days and expression of the filter.
+// Invalid expressions fail with their *todovo.FilterExpressionError.
+func (f ViewFilter) Validate() error {
+	for _, status := range f.Status {
+		if _, err := todovo.NewTodoStatusFromString(status); err != nil {
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidViewFilter, status)
+		}
+	}
+
+	for _, priority := range f.Priority {
+		if _, err := sharedvo.NewPriorityFromString(priority); err != nil {
+			return fmt.Errorf("%w: unknown priority %q", ErrInvalidViewFilter, priority)
+		}
+	}
+
+	for _, days := range []*int{f.DueInDaysFrom, f.DueInDaysTo} {
+		if days != nil && (*days < -maxDueInDays || *days > maxDueInDays) {
+			return fmt.Errorf("%w: due days must be between %d and %d", ErrInvalidViewFilter, -maxDueInDays, maxDueInDays)
+		}
+	}
+
+	if f.DueInDaysFrom != nil && f.DueInDaysTo != nil && *f.DueInDaysFrom > *f.DueInDaysTo {
+		return fmt.Errorf("%w: due days range ends before it starts", ErrInvalidViewFilter)
+	}
+
+	_, err := todovo.ParseTodoFilterExpression(f.Expression)
+	return err
+}
+
+// Criteria builds the criteria of the todos of the user matching the filter at the given time
+func (f ViewFilter) Criteria(userID int64, now time.Time) (todovo.TodoFilterCriteria, error) {
+	expression, err := todovo.ParseTodoFilterExpression(f.Expression)
+	if err != nil {
+		return todovo.TodoFilterCriteria{}, err
+	}
+
+	criteria := todovo.TodoFilterCriteria{
+		UserID:      userID,
+		Status:      f.Status,
+		Priority:    f.Priority,
+		Tags:        f.Tags,
+		IsOverdue:   f.IsOverdue,
+		DueDateFrom: f.DueDateFrom,
+		DueDateTo:   f.DueDateTo,
+		SearchTerm:  f.SearchTerm,
+		Expression:  expression,
+	}
+
+	today := sharedvo.StartOfDay(now)
+
+	if f.DueInDaysFrom != nil {
+		from := today.AddDate(0, 0, *f.DueInDaysFrom)
+		if criteria.DueDateFrom == nil || from.After(*criteria.DueDateFrom) {
+			criteria.DueDateFrom = &from
+		}
+	}
+
+	if f.DueInDaysTo != nil {
+		to := today.AddDate(0, 0, *f.DueInDaysTo+1).Add(-time.Nanosecond)
+		if criteria.DueDateTo == nil || to.Before(*criteria.DueDateTo) {
+			criteria.DueDateTo = &to
+		}
+	}
+
+	return criteria, nil
+}
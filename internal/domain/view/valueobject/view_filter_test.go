@@ -0,0 +1,120 @@
+package valueobject
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	todovo "todolist/internal/domain/todo/valueobject"
+)
+
+func TestViewFilterValidate(t *testing.T) {
+	days := func(d int) *int { return &d }
+
+	tests := []struct {
+		name    string
+		filter  ViewFilter
+		wantErr error
+	}{
+		{name: "empty", filter: ViewFilter{}},
+		{name: "valid", filter: ViewFilter{
+			Status:        []string{"pending"},
+			Priority:      []string{"high"},
+			DueInDaysFrom: days(0),
+			DueInDaysTo:   days(7),
+			Expression:    "tag:work",
+		}},
+		{name: "unknown status", filter: ViewFilter{Status: []string{"done"}}, wantErr: ErrInvalidViewFilter},
+		{name: "unknown priority", filter: ViewFilter{Priority: []string{"urgent"}}, wantErr: ErrInvalidViewFilter},
+		{name: "reversed days", filter: ViewFilter{DueInDaysFrom: days(3), DueInDaysTo: days(1)}, wantErr: ErrInvalidViewFilter},
+		{name: "days out of range", filter: ViewFilter{DueInDaysTo: days(maxDueInDays + 1)}, wantErr: ErrInvalidViewFilter},
+		{name: "invalid expression", filter: ViewFilter{Expression: "owner:me"}, wantErr: todovo.ErrInvalidFilterExpression},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestViewFilterCriteria(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)
+	from, to := 1, 7
+
+	criteria, err := ViewFilter{
+		Status:        []string{"pending"},
+		DueInDaysFrom: &from,
+		DueInDaysTo:   &to,
+		Expression:    "tag:work",
+	}.Criteria(42, now)
+	if err != nil {
+		t.Fatalf("Criteria() error = %v", err)
+	}
+
+	if criteria.UserID != 42 || len(criteria.Status) != 1 || criteria.Expression == nil {
+		t.Errorf("Criteria() = %+v, want the user, status and expression", criteria)
+	}
+
+	wantFrom := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	if criteria.DueDateFrom == nil || !criteria.DueDateFrom.Equal(wantFrom) {
+		t.Errorf("DueDateFrom = %v, want %v", criteria.DueDateFrom, wantFrom)
+	}
+
+	wantTo := time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	if criteria.DueDateTo == nil || !criteria.DueDateTo.Equal(wantTo) {
+		t.Errorf("DueDateTo = %v, want %v", criteria.DueDateTo, wantTo)
+	}
+}
+
+func TestViewFilterCriteriaKeepsNarrowestBounds(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)
+	today := 0
+	absoluteFrom := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+	absoluteTo := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	criteria, err := ViewFilter{
+		DueDateFrom:   &absoluteFrom,
+		DueDateTo:     &absoluteTo,
+		DueInDaysFrom: &today,
+		DueInDaysTo:   &today,
+	}.Criteria(1, now)
+	if err != nil {
+		t.Fatalf("Criteria() error = %v", err)
+	}
+
+	if !criteria.DueDateFrom.Equal(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("DueDateFrom = %v, want the start of today", criteria.DueDateFrom)
+	}
+	if !criteria.DueDateTo.Equal(absoluteTo) {
+		t.Errorf("DueDateTo = %v, want %v", criteria.DueDateTo, absoluteTo)
+	}
+}
+
+func TestViewFilterCriteriaInLocalDays(t *testing.T) {
+	// 09:00 in this zone is 20:00 UTC of the previous day
+	zone := time.FixedZone("UTC+13", 13*60*60)
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, zone)
+	from, to := 0, 1
+
+	criteria, err := ViewFilter{DueInDaysFrom: &from, DueInDaysTo: &to}.Criteria(1, now)
+	if err != nil {
+		t.Fatalf("Criteria() error = %v", err)
+	}
+
+	wantFrom := time.Date(2026, 10, 18, 0, 0, 0, 0, zone)
+	if criteria.DueDateFrom == nil || !criteria.DueDateFrom.Equal(wantFrom) {
+		t.Errorf("DueDateFrom = %v, want %v", criteria.DueDateFrom, wantFrom)
+	}
+
+	wantTo := time.Date(2026, 10, 20, 0, 0, 0, 0, zone).Add(-time.Nanosecond)
+	if criteria.DueDateTo == nil || !criteria.DueDateTo.Equal(wantTo) {
+		t.Errorf("DueDateTo = %v, want %v", criteria.DueDateTo, wantTo)
+	}
+}
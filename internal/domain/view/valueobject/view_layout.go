@@ -0,0 +1,70 @@
+package valueobject
+
+import (
+	"errors"
+	"slices"
+	"todolist/internal/domain/shared"
+)
+
+var (
+	ErrInvalidViewSort     = errors.New("invalid view sort order")
+	ErrInvalidViewGrouping = errors.New("invalid view grouping")
+)
+
+// viewSortColumns are the todo columns views can be sorted by
+var viewSortColumns = []string{"created_at", "updated_at", "due_date", "priority", "title", "status", "position"}
+
+// ViewSort is the order of the todos of a view
+type ViewSort struct {
+	orderBy   string
+	orderDesc bool
+}
+
+// DefaultViewSort lists the newest todos first
+var DefaultViewSort = ViewSort{orderBy: "created_at", orderDesc: true}
+
+// NewViewSort creates a sort order by a todo column, the default one when the column is empty
+func NewViewSort(orderBy string, orderDesc bool) (ViewSort, error) {
+	if orderBy == "" {
+		return DefaultViewSort, nil
+	}
+	if !slices.Contains(viewSortColumns, orderBy) {
+		return ViewSort{}, ErrInvalidViewSort
+	}
+	return ViewSort{orderBy: orderBy, orderDesc: orderDesc}, nil
+}
+
+// OrderBy returns the column the todos are sorted by
+func (s ViewSort) OrderBy() string { return s.orderBy }
+
+// OrderDesc tells whether the todos are sorted in descending order
+func (s ViewSort) OrderDesc() bool { return s.orderDesc }
+
+// Fields returns the sort order of the todos, as given to the todo listings
+func (s ViewSort) Fields() shared.Sort {
+	return shared.Sort{{Key: s.orderBy, Desc: s.orderDesc}}
+}
+
+// ViewGrouping is the field the todos of a view are grouped by
+type ViewGrouping string
+
+const (
+	ViewGroupingNone     ViewGrouping = ""
+	ViewGroupingStatus   ViewGrouping = "status"
+	ViewGroupingPriority ViewGrouping = "priority"
+	ViewGroupingDueDate  ViewGrouping = "due_date"
+)
+
+// NewViewGrouping creates a ViewGrouping from string
+func NewViewGrouping(grouping string) (ViewGrouping, error) {
+	g := ViewGrouping(grouping)
+	switch g {
+	case ViewGroupingNone, ViewGroupingStatus, ViewGroupingPriority, ViewGroupingDueDate:
+		return g, nil
+	default:
+		return "", ErrInvalidViewGrouping
+	}
+}
+
+// String returns the string representation
+func (g ViewGrouping) String() string { return string(g) }
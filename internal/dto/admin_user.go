@@ -0,0 +1,60 @@
+package dto
+
+import "time"
+
+// UserListResponse represents a paginated list of users
+type UserListResponse struct {
+	Users      []*UserResponse `json:"users"`
+	TotalCount int64           `json:"total_count"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"page_size"`
+}
+
+// ChangeUserRoleRequest represents the request to change the role of a user
+type ChangeUserRoleRequest struct {
+	Role string `json:"role" validate:"required"`
+}
+
+// ChangeUserStatusRequest represents the request to activate, deactivate or block a user
+type ChangeUserStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=active inactive blocked"`
+	Reason string `json:"reason" validate:"max=255"`
+}
+
+// ForcePasswordResetRequest represents the request to force a user to change the password
+type ForcePasswordResetRequest struct {
+	Reason string `json:"reason" validate:"max=255"`
+}
+
+// LoginAttemptResponse represents a login attempt in API responses
+type LoginAttemptResponse struct {
+	ID         int64     `json:"id"`
+	Username   string    `json:"username"`
+	Success    bool      `json:"success"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	FailReason string    `json:"fail_reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// LoginAttemptListResponse represents a paginated list of login attempts
+type LoginAttemptListResponse struct {
+	Attempts   []*LoginAttemptResponse `json:"attempts"`
+	TotalCount int64                   `json:"total_count"`
+	Page       int                     `json:"page"`
+	PageSize   int                     `json:"page_size"`
+}
+
+// ImpersonateUserRequest represents the request to impersonate a user
+type ImpersonateUserRequest struct {
+	Reason          string `json:"reason"           validate:"required,max=255"`
+	DurationMinutes int    `json:"duration_minutes" validate:"min=0"`
+}
+
+// ImpersonationResponse represents an issued impersonation token
+type ImpersonationResponse struct {
+	Token     string        `json:"token"`
+	ExpiresAt time.Time     `json:"expires_at"`
+	ActorID   int64         `json:"actor_id"`
+	Subject   *UserResponse `json:"subject"`
+}
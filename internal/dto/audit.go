@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+// AuditLogResponse represents an audit entry in API responses
+type AuditLogResponse struct {
+	ID             int64          `json:"id"`
+	ActorID        int64          `json:"actor_id,omitempty"`
+	ImpersonatorID int64          `json:"impersonator_id,omitempty"`
+	EntityType     string         `json:"entity_type"`
+	EntityID       int64          `json:"entity_id"`
+	Action         string         `json:"action"`
+	OldValues      map[string]any `json:"old_values,omitempty"`
+	NewValues      map[string]any `json:"new_values,omitempty"`
+	IPAddress      string         `json:"ip_address,omitempty"`
+	UserAgent      string         `json:"user_agent,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// AuditLogListResponse represents a paginated list of audit entries
+type AuditLogListResponse struct {
+	Entries    []*AuditLogResponse `json:"entries"`
+	TotalCount int64               `json:"total_count"`
+	Page       int                 `json:"page"`
+	PageSize   int                 `json:"page_size"`
+}
@@ -4,11 +4,13 @@ import "time"
 
 // AuthRequest represents the login request
 type AuthRequest struct {
-	Username string `json:"username" validate:"required"`
-	Password string `json:"password" validate:"required"`
+	Username   string `json:"username"              validate:"required"`
+	Password   string `json:"password"              validate:"required"`
+	DeviceName string `json:"device_name,omitempty" validate:"max=100"`
 }
 
 type AuthResponse struct {
+	SessionID        int64         `json:"session_id"`
 	Token            string        `json:"token"`
 	ExpiresAt        time.Time     `json:"expires_at"`
 	RefreshToken     string        `json:"refresh_token,omitempty"`
@@ -0,0 +1,91 @@
+package dto
+
+import "time"
+
+// BoardColumnRequest represents a column of a board, holding the todos in its
+// workflow states and in its statuses. Todos moved into the column take the first
+// of its states their workflow has, or else its first status.
+type BoardColumnRequest struct {
+	Key      string   `json:"key"                 validate:"required,max=30"`
+	Name     string   `json:"name"                validate:"required,max=50"`
+	Statuses []string `json:"statuses,omitempty"  validate:"omitempty,dive,oneof=pending in_progress completed cancelled"`
+	States   []string `json:"states,omitempty"    validate:"omitempty,max=30,dive,max=30"`
+	WIPLimit int      `json:"wip_limit,omitempty" validate:"min=0"`
+}
+
+// CreateBoardRequest represents the request to create a board. Boards without
+// columns get a column for each status.
+type CreateBoardRequest struct {
+	Name      string               `json:"name"                 validate:"required,max=100"`
+	Columns   []BoardColumnRequest `json:"columns,omitempty"    validate:"omitempty,max=20,dive"`
+	Swimlane  string               `json:"swimlane,omitempty"   validate:"omitempty,oneof=priority assignee"`
+	MemberIDs []int64              `json:"member_ids,omitempty" validate:"omitempty,max=50"`
+}
+
+// UpdateBoardRequest represents the request to update a board, changing the given fields
+type UpdateBoardRequest struct {
+	Name      *string              `json:"name,omitempty"       validate:"omitempty,max=100"`
+	Columns   []BoardColumnRequest `json:"columns,omitempty"    validate:"omitempty,max=20,dive"`
+	Swimlane  *string              `json:"swimlane,omitempty"   validate:"omitempty,oneof=none priority assignee"`
+	MemberIDs []int64              `json:"member_ids,omitempty" validate:"omitempty,max=50"`
+}
+
+// MoveBoardTodoRequest represents the request to move a todo into a column of a
+// board, optionally placing it between two todos of its user
+type MoveBoardTodoRequest struct {
+	TodoID   int64  `json:"todo_id"            validate:"required"`
+	Column   string `json:"column"             validate:"required"`
+	AfterID  *int64 `json:"after_id,omitempty"`  // Todo the moved todo is placed after
+	BeforeID *int64 `json:"before_id,omitempty"` // Todo the moved todo is placed before
+}
+
+// BoardColumnResponse represents a column of a board
+type BoardColumnResponse struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Statuses []string `json:"statuses"`
+	States   []string `json:"states,omitempty"`
+	WIPLimit int      `json:"wip_limit,omitempty"`
+}
+
+// BoardResponse represents a board
+type BoardResponse struct {
+	ID        int64                  `json:"id"`
+	OwnerID   int64                  `json:"owner_id"`
+	Name      string                 `json:"name"`
+	Columns   []*BoardColumnResponse `json:"columns"`
+	Swimlane  string                 `json:"swimlane,omitempty"`
+	MemberIDs []int64                `json:"member_ids"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// BoardTodosResponse represents a board with the todos of its columns, split into
+// swimlanes when the board has them
+type BoardTodosResponse struct {
+	Board     *BoardResponse              `json:"board"`
+	Columns   []*BoardColumnTodosResponse `json:"columns"`
+	Swimlanes []*BoardSwimlaneResponse    `json:"swimlanes,omitempty"`
+}
+
+// BoardColumnTodosResponse represents the todos of a column in their manual order.
+// Total counts all the todos of the column, not only the listed ones.
+type BoardColumnTodosResponse struct {
+	Key       string          `json:"key"`
+	Total     int64           `json:"total"`
+	OverLimit bool            `json:"over_limit,omitempty"`
+	Todos     []*TodoResponse `json:"todos"`
+}
+
+// BoardSwimlaneResponse represents a row of a board, with the todos of each column in it
+type BoardSwimlaneResponse struct {
+	Key   string               `json:"key"`
+	Name  string               `json:"name"`
+	Cells []*BoardCellResponse `json:"cells"`
+}
+
+// BoardCellResponse represents the todos of a column in a swimlane
+type BoardCellResponse struct {
+	Column  string  `json:"column"`
+	TodoIDs []int64 `json:"todo_ids"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// CreateCustomFieldRequest represents the request to create a custom field, of all
+// the user's todos or of the todos of a project. Options are the choices of select
+// and multi_select fields.
+type CreateCustomFieldRequest struct {
+	Key       string   `json:"key"                  validate:"required,max=50"`
+	Name      string   `json:"name"                 validate:"required,max=100"`
+	Type      string   `json:"type"                 validate:"required,oneof=text number date select multi_select url checkbox"`
+	Options   []string `json:"options,omitempty"    validate:"omitempty,max=50,dive,required,max=100"`
+	Required  bool     `json:"required"`
+	ProjectID *int64   `json:"project_id,omitempty"`
+}
+
+// UpdateCustomFieldRequest represents the request to update a custom field, changing
+// the given fields. Options chosen by todos cannot be removed.
+type UpdateCustomFieldRequest struct {
+	Name     *string  `json:"name,omitempty"     validate:"omitempty,max=100"`
+	Options  []string `json:"options,omitempty"  validate:"omitempty,max=50,dive,required,max=100"`
+	Required *bool    `json:"required,omitempty"`
+}
+
+// CustomFieldResponse represents a custom field
+type CustomFieldResponse struct {
+	ID        int64     `json:"id"`
+	Key       string    `json:"key"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Options   []string  `json:"options,omitempty"`
+	Required  bool      `json:"required"`
+	ProjectID *int64    `json:"project_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -20,14 +20,17 @@ type PaginatedResponse struct {
 	Success    bool       `json:"success" example:"true"`
 	Data       any        `json:"data"`
 	Pagination Pagination `json:"pagination"`
+	Facets     any        `json:"facets,omitempty"`
 }
 
 // Pagination contains pagination metadata
 type Pagination struct {
-	Page       int   `json:"page"        example:"1"`
-	PageSize   int   `json:"page_size"   example:"20"`
-	Total      int64 `json:"total"       example:"100"`
-	TotalPages int   `json:"total_pages" example:"5"`
+	Page       int    `json:"page"        example:"1"`
+	PageSize   int    `json:"page_size"   example:"20"`
+	Total      int64  `json:"total"       example:"100"`
+	TotalPages int    `json:"total_pages" example:"5"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 // SuccessResponse creates a success response
@@ -70,6 +73,19 @@ func PaginatedSuccessResponse(data any, page, pageSize int, total int64) Paginat
 	}
 }
 
+// WithCursors sets the cursors to the next and previous pages of a paginated response
+func (r PaginatedResponse) WithCursors(next, prev string) PaginatedResponse {
+	r.Pagination.NextCursor = next
+	r.Pagination.PrevCursor = prev
+	return r
+}
+
+// WithFacets sets the facets of a paginated response
+func (r PaginatedResponse) WithFacets(facets any) PaginatedResponse {
+	r.Facets = facets
+	return r
+}
+
 // QueryParams common query parameters
 type QueryParams struct {
 	Page     int    `form:"page"      binding:"min=0"`
@@ -25,4 +25,5 @@ type PersonResponse struct {
 	BirthDate string `json:"birth_date,omitempty"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
+	Version   int64  `json:"version"`
 }
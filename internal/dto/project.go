@@ -0,0 +1,57 @@
+package dto
+
+import "time"
+
+// WorkflowStateRequest represents a state of a workflow
+type WorkflowStateRequest struct {
+	Key      string   `json:"key"                validate:"required,max=30"`
+	Name     string   `json:"name"               validate:"required,max=50"`
+	Category string   `json:"category"           validate:"required,oneof=open in_progress done cancelled"`
+	Requires []string `json:"requires,omitempty" validate:"omitempty,dive,oneof=resolution description due_date"`
+}
+
+// WorkflowRequest represents a workflow, the first state being the one new todos
+// start in. Transitions map state keys to the keys of the states todos can move to.
+type WorkflowRequest struct {
+	States      []WorkflowStateRequest `json:"states"      validate:"required,min=1,max=30,dive"`
+	Transitions map[string][]string    `json:"transitions"`
+}
+
+// CreateProjectRequest represents the request to create a project. Projects
+// without a workflow follow the default workflow.
+type CreateProjectRequest struct {
+	Name     string           `json:"name"               validate:"required,max=100"`
+	Workflow *WorkflowRequest `json:"workflow,omitempty"`
+}
+
+// UpdateProjectRequest represents the request to update a project, changing the
+// given fields. A workflow without states restores the default workflow.
+type UpdateProjectRequest struct {
+	Name     *string          `json:"name,omitempty"     validate:"omitempty,max=100"`
+	Workflow *WorkflowRequest `json:"workflow,omitempty"`
+}
+
+// WorkflowStateResponse represents a state of a workflow
+type WorkflowStateResponse struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Status   string   `json:"status"`
+	Requires []string `json:"requires,omitempty"`
+}
+
+// WorkflowResponse represents a workflow
+type WorkflowResponse struct {
+	Default     bool                     `json:"default"`
+	States      []*WorkflowStateResponse `json:"states"`
+	Transitions map[string][]string      `json:"transitions"`
+}
+
+// ProjectResponse represents a project
+type ProjectResponse struct {
+	ID        int64             `json:"id"`
+	Name      string            `json:"name"`
+	Workflow  *WorkflowResponse `json:"workflow"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+// CreateRoleRequest represents the request to create a custom role
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required,min=2,max=50"`
+	Description string   `json:"description" validate:"max=255"`
+	Permissions []string `json:"permissions" validate:"required,min=1"`
+}
+
+// UpdateRoleRequest represents the request to update a custom role
+type UpdateRoleRequest struct {
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=255"`
+	Permissions []string `json:"permissions,omitempty" validate:"omitempty,min=1"`
+}
+
+// RoleResponse represents a role in API responses
+type RoleResponse struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Permissions []string   `json:"permissions"`
+	Configured  bool       `json:"configured"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
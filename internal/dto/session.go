@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// ClientInfo carries information about the HTTP client issuing a request
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+// RefreshTokenRequest represents the request to renew the authentication tokens
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// SessionResponse represents an authenticated session in API responses
+type SessionResponse struct {
+	ID         int64     `json:"id"`
+	DeviceName string    `json:"device_name,omitempty"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	Current    bool      `json:"current"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// RevokeSessionsResponse represents the result of revoking multiple sessions
+type RevokeSessionsResponse struct {
+	Revoked int64 `json:"revoked"`
+}
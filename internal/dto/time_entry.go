@@ -0,0 +1,57 @@
+package dto
+
+import "time"
+
+// StartTimerRequest represents the request to start a timer on a todo
+type StartTimerRequest struct {
+	Note string `json:"note,omitempty" validate:"max=500"`
+}
+
+// CreateTimeEntryRequest represents the request to enter time spent on a todo by hand
+type CreateTimeEntryRequest struct {
+	StartedAt time.Time `json:"started_at"     validate:"required"`
+	EndedAt   time.Time `json:"ended_at"       validate:"required"`
+	Note      string    `json:"note,omitempty" validate:"max=500"`
+}
+
+// TimeEntryResponse represents a time entry, running timers having no end
+type TimeEntryResponse struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	TodoID    int64      `json:"todo_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Duration  int64      `json:"duration_seconds"` // Time elapsed so far for running timers
+	Running   bool       `json:"running"`
+	Note      string     `json:"note,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TimeReportRowResponse represents the time tracked in a group of a report. The
+// key is empty for the time on todos without tag or project.
+type TimeReportRowResponse struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Duration int64  `json:"duration_seconds"`
+	Entries  int    `json:"entries"`
+}
+
+// TimeReportResponse represents the time tracked by a user in a period
+type TimeReportResponse struct {
+	From     time.Time                `json:"from"`
+	To       time.Time                `json:"to"`
+	GroupBy  string                   `json:"group_by"`
+	Timezone string                   `json:"timezone"`
+	Total    int64                    `json:"total_seconds"`
+	Rows     []*TimeReportRowResponse `json:"rows"`
+}
+
+// TimeReportRequest represents the period and grouping of a time report, read from
+// the query string. The period defaults to the last 30 days and days start in UTC
+// unless a timezone is given.
+type TimeReportRequest struct {
+	From     *time.Time
+	To       *time.Time
+	GroupBy  string
+	Timezone string
+}
@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"time"
+	"todolist/pkg/patch"
+)
 
 // CreateTodoRequest represents the request to create a todo
 type CreateTodoRequest struct {
@@ -9,6 +12,16 @@ type CreateTodoRequest struct {
 	Priority    string     `json:"priority" validate:"required,oneof=low medium high critical"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
 	Tags        []string   `json:"tags,omitempty"`
+	ProjectID   *int64     `json:"project_id,omitempty"` // Project whose workflow the todo follows
+	Estimate    *int64     `json:"estimate_seconds,omitempty" validate:"omitempty,min=0"`
+
+	// CustomFields are the values of custom fields by key: a string, a number, a
+	// boolean for checkboxes or a list of options for multi-selects
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+
+	// CheckDuplicates refuses to create the todo when open todos have a similar
+	// title, listing them so the user can confirm it is not a duplicate
+	CheckDuplicates bool `json:"check_duplicates,omitempty"`
 }
 
 // UpdateTodoRequest represents the request to update a todo
@@ -18,28 +31,196 @@ type UpdateTodoRequest struct {
 	Priority    *string    `json:"priority,omitempty" validate:"omitempty,oneof=low medium high critical"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
 	Status      *string    `json:"status,omitempty" validate:"omitempty,oneof=pending in_progress completed cancelled"`
+	ProjectID   *int64     `json:"project_id,omitempty"` // Zero removes the todo from its project
+	State       *string    `json:"state,omitempty" validate:"omitempty,max=30"`
+	Resolution  *string    `json:"resolution,omitempty"`                                  // Explains the status or state change
+	Estimate    *int64     `json:"estimate_seconds,omitempty" validate:"omitempty,min=0"` // Zero removes the estimate
+
+	// CustomFields changes the values of the given custom fields, null removing them
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+}
+
+// MoveTodoRequest represents the request to move a todo in the manual order of its
+// user, between two todos or right after or before one of them
+type MoveTodoRequest struct {
+	AfterID  *int64 `json:"after_id,omitempty"`  // Todo the moved todo is placed after
+	BeforeID *int64 `json:"before_id,omitempty"` // Todo the moved todo is placed before
+}
+
+// PatchTodoRequest represents a partial update of a todo, either a JSON Merge Patch
+// document or JSON Patch operations over the title, description, status, priority,
+// due_date, tags, project_id, state, resolution, estimate_seconds and custom_fields
+// fields
+type PatchTodoRequest struct {
+	MergePatch map[string]any
+	JSONPatch  []patch.Operation
 }
 
 // TodoResponse represents a todo in API responses
 type TodoResponse struct {
-	ID          int64      `json:"id"`
-	UserID      int64      `json:"user_id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	Status      string     `json:"status"`
-	Priority    string     `json:"priority"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
-	CompletedAt *time.Time `json:"completed_at,omitempty"`
-	Tags        []string   `json:"tags"`
-	IsOverdue   bool       `json:"is_overdue"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID           int64          `json:"id"`
+	UserID       int64          `json:"user_id"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	Status       string         `json:"status"`
+	Priority     string         `json:"priority"`
+	DueDate      *time.Time     `json:"due_date,omitempty"`
+	CompletedAt  *time.Time     `json:"completed_at,omitempty"`
+	Tags         []string       `json:"tags"`
+	IsOverdue    bool           `json:"is_overdue"`
+	Position     string         `json:"position,omitempty"`
+	ProjectID    *int64         `json:"project_id,omitempty"`
+	State        string         `json:"state"`
+	Resolution   string         `json:"resolution,omitempty"`
+	Estimate     int64          `json:"estimate_seconds,omitempty"`
+	TrackedTime  int64          `json:"tracked_seconds"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"` // Values of the custom fields by key
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	Version      int64          `json:"version"`
 }
 
 // TodoListResponse represents a list of todos
 type TodoListResponse struct {
-	Todos      []*TodoResponse `json:"todos"`
-	TotalCount int64           `json:"total_count"`
-	Page       int             `json:"page"`
-	PageSize   int             `json:"page_size"`
+	Todos      []*TodoResponse     `json:"todos"`
+	TotalCount int64               `json:"total_count"`
+	Page       int                 `json:"page"`
+	PageSize   int                 `json:"page_size"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+	PrevCursor string              `json:"prev_cursor,omitempty"`
+	Facets     *TodoFacetsResponse `json:"facets,omitempty"`
+}
+
+// SimilarTodoResponse represents an open todo whose title is similar to the title
+// of a todo being created, from 0 when they share no trigram of their words to 1
+type SimilarTodoResponse struct {
+	Todo       *TodoResponse `json:"todo"`
+	Similarity float64       `json:"similarity"`
+}
+
+// TodoSearchResultResponse represents a todo found by a full-text search. Ranks
+// are only comparable between the results of the same search.
+type TodoSearchResultResponse struct {
+	Todo       *TodoResponse          `json:"todo"`
+	Rank       float64                `json:"rank"`
+	Highlights TodoHighlightsResponse `json:"highlights"`
+}
+
+// TodoHighlightsResponse represents the HTML escaped excerpts of a todo matching a
+// search, with the matching words wrapped in <mark> elements
+type TodoHighlightsResponse struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// TodoSearchResponse represents a page of the todos found by a full-text search
+type TodoSearchResponse struct {
+	Results  []*TodoSearchResultResponse `json:"results"`
+	Language string                      `json:"language"`
+
+	// Pagination
+	TotalCount int64 `json:"-"`
+}
+
+// TodoFacetsResponse represents the counts of the todos matching a filter by
+// status, priority, tag and due date bucket
+type TodoFacetsResponse struct {
+	Status   map[string]int64   `json:"status"`
+	Priority map[string]int64   `json:"priority"`
+	Tags     []TagCountResponse `json:"tags"`
+	DueDate  map[string]int64   `json:"due_date"`
+}
+
+// TagCountResponse represents the number of todos with a tag
+type TagCountResponse struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
+
+// TodoFieldChangeResponse represents the change of a todo field in its history
+type TodoFieldChangeResponse struct {
+	Field    string `json:"field"`
+	OldValue any    `json:"old_value"`
+	NewValue any    `json:"new_value"`
+}
+
+// TodoHistoryEntryResponse represents a change in the history of a todo.
+// The version identifies the state of the todo right after the change.
+type TodoHistoryEntryResponse struct {
+	Version        int                        `json:"version"`
+	Action         string                     `json:"action"`
+	ActorID        int64                      `json:"actor_id,omitempty"`
+	ImpersonatorID int64                      `json:"impersonator_id,omitempty"`
+	Changes        []*TodoFieldChangeResponse `json:"changes"`
+	CreatedAt      time.Time                  `json:"created_at"`
+}
+
+// TodoHistoryResponse represents the history timeline of a todo, newest first
+type TodoHistoryResponse struct {
+	TodoID         int64                       `json:"todo_id"`
+	CurrentVersion int                         `json:"current_version"`
+	Entries        []*TodoHistoryEntryResponse `json:"entries"`
+}
+
+// TrashedTodoResponse represents a todo in the trash
+type TrashedTodoResponse struct {
+	TodoResponse
+	DeletedAt time.Time  `json:"deleted_at"`
+	PurgeAt   *time.Time `json:"purge_at,omitempty"`
+}
+
+// TrashListResponse represents a paginated list of todos in the trash
+type TrashListResponse struct {
+	Todos      []*TrashedTodoResponse `json:"todos"`
+	TotalCount int64                  `json:"total_count"`
+	Page       int                    `json:"page"`
+	PageSize   int                    `json:"page_size"`
+}
+
+// EmptyTrashResponse represents the result of emptying the trash
+type EmptyTrashResponse struct {
+	PurgedCount int64 `json:"purged_count"`
+}
+
+// BulkTodoFilter selects the todos of a bulk operation by their fields
+type BulkTodoFilter struct {
+	Status      []string   `json:"status,omitempty"`
+	Priority    []string   `json:"priority,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	IsOverdue   *bool      `json:"is_overdue,omitempty"`
+	DueDateFrom *time.Time `json:"due_date_from,omitempty"`
+	DueDateTo   *time.Time `json:"due_date_to,omitempty"`
+	Search      string     `json:"search,omitempty"`
+}
+
+// BulkTodoRequest represents an action applied to a set of todos, selected either by
+// their IDs or by a filter. In atomic mode, the default, either every todo is changed
+// or none is; in best_effort mode each todo is changed independently.
+type BulkTodoRequest struct {
+	IDs       []int64         `json:"ids,omitempty"`
+	Filter    *BulkTodoFilter `json:"filter,omitempty"`
+	Action    string          `json:"action" validate:"required,oneof=complete cancel reopen start set_priority add_tags remove_tags move_to_project delete"`
+	Priority  string          `json:"priority,omitempty" validate:"omitempty,oneof=low medium high critical"`
+	Tags      []string        `json:"tags,omitempty"`
+	ProjectID *int64          `json:"project_id,omitempty"` // Zero removes the todos from their project
+	Mode      string          `json:"mode,omitempty" validate:"omitempty,oneof=atomic best_effort"`
+}
+
+// BulkTodoItemResult represents the result of a bulk operation for a single todo
+type BulkTodoItemResult struct {
+	ID      int64         `json:"id"`
+	Success bool          `json:"success"`
+	Error   *ErrorInfo    `json:"error,omitempty"`
+	Todo    *TodoResponse `json:"todo,omitempty"`
+}
+
+// BulkTodoResponse represents the result of a bulk operation
+type BulkTodoResponse struct {
+	Action     string                `json:"action"`
+	Mode       string                `json:"mode"`
+	Total      int                   `json:"total"`
+	Succeeded  int                   `json:"succeeded"`
+	Failed     int                   `json:"failed"`
+	RolledBack bool                  `json:"rolled_back"`
+	Results    []*BulkTodoItemResult `json:"results"`
 }
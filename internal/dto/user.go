@@ -9,20 +9,22 @@ type CreateUserRequest struct {
 
 // ChangePasswordRequest represents the password change request
 type ChangePasswordRequest struct {
-	OldPassword string `json:"old_password" validate:"required"`
-	NewPassword string `json:"new_password" validate:"required,min=8"`
+	OldPassword         string `json:"old_password"          validate:"required"`
+	NewPassword         string `json:"new_password"          validate:"required,min=8"`
+	RevokeOtherSessions bool   `json:"revoke_other_sessions"`
 }
 
 // UserResponse represents a user in API responses
 type UserResponse struct {
-	ID        int64       `json:"id"`
-	PersonID  int64       `json:"person_id"`
-	Username  string      `json:"username"`
-	Status    string      `json:"status"`
-	Role      string      `json:"role"`
-	Person    *PersonInfo `json:"person,omitempty"`
-	CreatedAt string      `json:"created_at"`
-	UpdatedAt string      `json:"updated_at"`
+	ID                int64       `json:"id"`
+	PersonID          int64       `json:"person_id"`
+	Username          string      `json:"username"`
+	Status            string      `json:"status"`
+	Role              string      `json:"role"`
+	MustResetPassword bool        `json:"must_reset_password"`
+	Person            *PersonInfo `json:"person,omitempty"`
+	CreatedAt         string      `json:"created_at"`
+	UpdatedAt         string      `json:"updated_at"`
 }
 
 // PersonInfo represents basic person information
@@ -31,3 +33,17 @@ type PersonInfo struct {
 	Name  string `json:"name"`
 	Email string `json:"email"`
 }
+
+// PreferencesResponse represents the preferences of the authenticated user. An
+// empty search language stands for the default search language.
+type PreferencesResponse struct {
+	SearchLanguage        string   `json:"search_language,omitempty"`
+	DefaultSearchLanguage string   `json:"default_search_language"`
+	SearchLanguages       []string `json:"search_languages"`
+}
+
+// UpdatePreferencesRequest represents the request to change the given preferences
+// of the authenticated user. An empty search language restores the default one.
+type UpdatePreferencesRequest struct {
+	SearchLanguage *string `json:"search_language,omitempty" validate:"omitempty,oneof=english portuguese"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+import "time"
+
+// ViewFilter represents the todo filter of a view. Due dates are bounded by dates
+// or by days relative to today, 0 being today, both inclusive.
+type ViewFilter struct {
+	Status        []string   `json:"status,omitempty"           validate:"omitempty,dive,oneof=pending in_progress completed cancelled"`
+	Priority      []string   `json:"priority,omitempty"         validate:"omitempty,dive,oneof=low medium high critical"`
+	Tags          []string   `json:"tags,omitempty"`
+	IsOverdue     *bool      `json:"is_overdue,omitempty"`
+	DueDateFrom   *time.Time `json:"due_date_from,omitempty"`
+	DueDateTo     *time.Time `json:"due_date_to,omitempty"`
+	DueInDaysFrom *int       `json:"due_in_days_from,omitempty"`
+	DueInDaysTo   *int       `json:"due_in_days_to,omitempty"`
+	Search        string     `json:"search,omitempty"`
+	Filter        string     `json:"filter,omitempty"`
+}
+
+// CreateViewRequest represents the request to save a view
+type CreateViewRequest struct {
+	Name     string     `json:"name"      validate:"required,max=100"`
+	Filter   ViewFilter `json:"filter"`
+	OrderBy  string     `json:"order_by"  validate:"omitempty,oneof=created_at updated_at due_date priority title status position"`
+	OrderDir string     `json:"order_dir" validate:"omitempty,oneof=asc desc"`
+	GroupBy  string     `json:"group_by"  validate:"omitempty,oneof=status priority due_date"`
+}
+
+// UpdateViewRequest represents the request to update a saved view, changing the given fields
+type UpdateViewRequest struct {
+	Name     *string     `json:"name,omitempty"      validate:"omitempty,max=100"`
+	Filter   *ViewFilter `json:"filter,omitempty"`
+	OrderBy  *string     `json:"order_by,omitempty"  validate:"omitempty,oneof=created_at updated_at due_date priority title status position"`
+	OrderDir *string     `json:"order_dir,omitempty" validate:"omitempty,oneof=asc desc"`
+	GroupBy  *string     `json:"group_by,omitempty"  validate:"omitempty,oneof=none status priority due_date"`
+}
+
+// ViewResponse represents a saved or built-in view. Built-in views have a key
+// instead of an ID.
+type ViewResponse struct {
+	ID        int64      `json:"id,omitempty"`
+	Key       string     `json:"key,omitempty"`
+	Name      string     `json:"name"`
+	BuiltIn   bool       `json:"built_in"`
+	Filter    ViewFilter `json:"filter"`
+	OrderBy   string     `json:"order_by"`
+	OrderDir  string     `json:"order_dir"`
+	GroupBy   string     `json:"group_by,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ViewTodosResponse represents a page of the todos of a view, with their groups
+// when the view is grouped
+type ViewTodosResponse struct {
+	View   *ViewResponse        `json:"view"`
+	Todos  []*TodoResponse      `json:"todos"`
+	Groups []*TodoGroupResponse `json:"groups,omitempty"`
+
+	// Pagination
+	TotalCount int64  `json:"-"`
+	NextCursor string `json:"-"`
+	PrevCursor string `json:"-"`
+}
+
+// TodoGroupResponse represents a group of the listed todos. Total counts the todos
+// of the group in the whole view, not only in the page.
+type TodoGroupResponse struct {
+	Key     string  `json:"key"`
+	Total   int64   `json:"total"`
+	TodoIDs []int64 `json:"todo_ids"`
+}
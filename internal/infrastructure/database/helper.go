@@ -43,20 +43,9 @@ func applyFilter(query *gorm.DB, filter shared.Filter) *gorm.DB {
 	return query
 }
 
-// ApplyQueryOptions applies pagination and sorting options
+// ApplyQueryOptions applies pagination options. The sort order is applied by the
+// SortRegistry of the resource, when it can be sorted.
 func ApplyQueryOptions(query *gorm.DB, options shared.QueryOptions) *gorm.DB {
-	// Apply sorting
-	if options.OrderBy != "" {
-		order := options.OrderBy
-		if options.OrderDesc {
-			order += " DESC"
-		} else {
-			order += " ASC"
-		}
-		query = query.Order(order)
-	}
-
-	// Apply pagination
 	if options.Limit > 0 {
 		query = query.Limit(options.Limit)
 	}
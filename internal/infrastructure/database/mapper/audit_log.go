@@ -0,0 +1,137 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"todolist/internal/domain/audit/entity"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// AuditLogMapper handles conversion between domain entity and database model
+type AuditLogMapper struct{}
+
+// NewAuditLogMapper creates a new AuditLogMapper
+func NewAuditLogMapper() *AuditLogMapper {
+	return &AuditLogMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *AuditLogMapper) ToModel(auditLog *entity.AuditLog) (*model.AuditLog, error) {
+	oldValues, err := encodeAuditValues(auditLog.OldValues())
+	if err != nil {
+		return nil, err
+	}
+
+	newValues, err := encodeAuditValues(auditLog.NewValues())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AuditLog{
+		ID:             auditLog.ID(),
+		UserID:         optionalID(auditLog.ActorID()),
+		ImpersonatorID: optionalID(auditLog.ImpersonatorID()),
+		EntityID:       auditLog.EntityID(),
+		EntityType:     auditLog.EntityType(),
+		Action:         auditLog.Action(),
+		OldValues:      oldValues,
+		NewValues:      newValues,
+		IPAddress:      auditLog.IPAddress(),
+		UserAgent:      auditLog.UserAgent(),
+		CreatedAt:      auditLog.CreatedAt(),
+	}, nil
+}
+
+// ToDomain converts database model to domain entity
+func (m *AuditLogMapper) ToDomain(model *model.AuditLog) (*entity.AuditLog, error) {
+	oldValues, err := decodeAuditValues(model.OldValues)
+	if err != nil {
+		return nil, err
+	}
+
+	newValues, err := decodeAuditValues(model.NewValues)
+	if err != nil {
+		return nil, err
+	}
+
+	var actorID int64
+	if model.UserID != nil {
+		actorID = *model.UserID
+	}
+
+	auditLog, err := entity.NewAuditLog(
+		model.ID,
+		actorID,
+		model.EntityType,
+		model.EntityID,
+		model.Action,
+		oldValues,
+		newValues,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog.SetClient(model.IPAddress, model.UserAgent)
+	if model.ImpersonatorID != nil {
+		auditLog.SetImpersonator(*model.ImpersonatorID)
+	}
+
+	// Set timestamps from database
+	auditLog.Entity.SetCreatedAt(model.CreatedAt)
+	auditLog.Entity.SetUpdatedAt(model.CreatedAt)
+
+	return auditLog, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *AuditLogMapper) ToDomainList(models []*model.AuditLog) ([]*entity.AuditLog, error) {
+	auditLogs := make([]*entity.AuditLog, 0, len(models))
+
+	for _, model := range models {
+		auditLog, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	return auditLogs, nil
+}
+
+// encodeAuditValues encodes audited values as a JSON object, using NULL when empty
+func encodeAuditValues(values map[string]any) (*string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("encode audit values: %w", err)
+	}
+
+	result := string(encoded)
+	return &result, nil
+}
+
+// decodeAuditValues decodes audited values stored as a JSON object
+func decodeAuditValues(encoded *string) (map[string]any, error) {
+	if encoded == nil || *encoded == "" {
+		return nil, nil
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal([]byte(*encoded), &values); err != nil {
+		return nil, fmt.Errorf("decode audit values: %w", err)
+	}
+
+	return values, nil
+}
+
+// optionalID converts a zero ID to NULL
+func optionalID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,125 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"todolist/internal/domain/board/entity"
+	vo "todolist/internal/domain/board/valueobject"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// boardColumnJSON is the stored representation of board columns
+type boardColumnJSON struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Statuses []string `json:"statuses"`
+	States   []string `json:"states,omitempty"`
+	WIPLimit int      `json:"wip_limit,omitempty"`
+}
+
+// BoardMapper handles conversion between domain entity and database model
+type BoardMapper struct{}
+
+// NewBoardMapper creates a new BoardMapper
+func NewBoardMapper() *BoardMapper {
+	return &BoardMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *BoardMapper) ToModel(board *entity.Board) (*model.Board, error) {
+	columns := []boardColumnJSON{}
+	for _, column := range board.Columns() {
+		statuses := []string{}
+		for _, status := range column.Statuses() {
+			statuses = append(statuses, status.String())
+		}
+
+		columns = append(columns, boardColumnJSON{
+			Key:      column.Key(),
+			Name:     column.Name(),
+			Statuses: statuses,
+			States:   column.States(),
+			WIPLimit: column.WIPLimit(),
+		})
+	}
+
+	encoded, err := json.Marshal(columns)
+	if err != nil {
+		return nil, fmt.Errorf("encode board columns: %w", err)
+	}
+
+	members := []*model.BoardMember{}
+	for _, memberID := range board.MemberIDs() {
+		members = append(members, &model.BoardMember{BoardID: board.ID(), UserID: memberID})
+	}
+
+	return &model.Board{
+		ID:        board.ID(),
+		UserID:    board.UserID(),
+		Name:      board.Name(),
+		Columns:   string(encoded),
+		Swimlane:  board.Swimlane().String(),
+		Members:   members,
+		CreatedAt: board.CreatedAt(),
+		UpdatedAt: board.UpdatedAt(),
+	}, nil
+}
+
+// ToDomain converts database model to domain entity
+func (m *BoardMapper) ToDomain(model *model.Board) (*entity.Board, error) {
+	var stored []boardColumnJSON
+	if err := json.Unmarshal([]byte(model.Columns), &stored); err != nil {
+		return nil, fmt.Errorf("decode board columns: %w", err)
+	}
+
+	columns := make([]vo.BoardColumn, len(stored))
+	for i, column := range stored {
+		var err error
+		if columns[i], err = vo.NewBoardColumn(column.Key, column.Name, column.Statuses, column.States, column.WIPLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	swimlane, err := vo.NewBoardSwimlane(model.Swimlane)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]int64, len(model.Members))
+	for i, member := range model.Members {
+		memberIDs[i] = member.UserID
+	}
+
+	board, err := entity.NewBoard(
+		model.ID,
+		model.UserID,
+		model.Name,
+		columns,
+		swimlane,
+		memberIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set timestamps from database
+	board.Entity.SetCreatedAt(model.CreatedAt)
+	board.Entity.SetUpdatedAt(model.UpdatedAt)
+
+	return board, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *BoardMapper) ToDomainList(models []*model.Board) ([]*entity.Board, error) {
+	boards := make([]*entity.Board, 0, len(models))
+
+	for _, model := range models {
+		board, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, board)
+	}
+
+	return boards, nil
+}
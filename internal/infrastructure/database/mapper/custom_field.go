@@ -0,0 +1,104 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"todolist/internal/domain/customfield/entity"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// CustomFieldMapper handles conversion between domain entity and database model
+type CustomFieldMapper struct{}
+
+// NewCustomFieldMapper creates a new CustomFieldMapper
+func NewCustomFieldMapper() *CustomFieldMapper {
+	return &CustomFieldMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *CustomFieldMapper) ToModel(field *entity.CustomField) (*model.CustomField, error) {
+	mdl := &model.CustomField{
+		ID:        field.ID(),
+		UserID:    field.UserID(),
+		Key:       field.Key(),
+		Name:      field.Name(),
+		Type:      field.Type().String(),
+		Required:  field.IsRequired(),
+		CreatedAt: field.CreatedAt(),
+		UpdatedAt: field.UpdatedAt(),
+	}
+
+	if projectID := field.ProjectID(); projectID != 0 {
+		mdl.ProjectID = &projectID
+	}
+
+	if field.Type().HasOptions() {
+		encoded, err := json.Marshal(field.Options())
+		if err != nil {
+			return nil, fmt.Errorf("encode custom field options: %w", err)
+		}
+		options := string(encoded)
+		mdl.Options = &options
+	}
+
+	return mdl, nil
+}
+
+// ToDomain converts database model to domain entity
+func (m *CustomFieldMapper) ToDomain(model *model.CustomField) (*entity.CustomField, error) {
+	fieldType, err := todovo.NewCustomFieldType(model.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := decodeOptions(model.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectID int64
+	if model.ProjectID != nil {
+		projectID = *model.ProjectID
+	}
+
+	field, err := entity.NewCustomField(model.ID, model.UserID, projectID, model.Key, model.Name, fieldType, options, model.Required)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set timestamps from database
+	field.Entity.SetCreatedAt(model.CreatedAt)
+	field.Entity.SetUpdatedAt(model.UpdatedAt)
+
+	return field, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *CustomFieldMapper) ToDomainList(models []*model.CustomField) ([]*entity.CustomField, error) {
+	fields := make([]*entity.CustomField, 0, len(models))
+
+	for _, model := range models {
+		field, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// decodeOptions decodes the stored options of a custom field, none when null
+func decodeOptions(encoded *string) ([]string, error) {
+	if encoded == nil {
+		return nil, nil
+	}
+
+	var options []string
+	if err := json.Unmarshal([]byte(*encoded), &options); err != nil {
+		return nil, fmt.Errorf("decode custom field options: %w", err)
+	}
+
+	return options, nil
+}
@@ -0,0 +1,70 @@
+package mapper
+
+import (
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// LoginAttemptMapper handles conversion between domain entity and database model
+type LoginAttemptMapper struct{}
+
+// NewLoginAttemptMapper creates a new LoginAttemptMapper
+func NewLoginAttemptMapper() *LoginAttemptMapper {
+	return &LoginAttemptMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *LoginAttemptMapper) ToModel(attempt *entity.LoginAttempt) *model.LoginAttempt {
+	return &model.LoginAttempt{
+		ID:         attempt.ID(),
+		UserID:     optionalID(attempt.UserID()),
+		Username:   attempt.Username(),
+		Success:    attempt.Success(),
+		IPAddress:  attempt.IPAddress(),
+		UserAgent:  attempt.UserAgent(),
+		FailReason: attempt.FailReason(),
+		CreatedAt:  attempt.CreatedAt(),
+	}
+}
+
+// ToDomain converts database model to domain entity
+func (m *LoginAttemptMapper) ToDomain(model *model.LoginAttempt) (*entity.LoginAttempt, error) {
+	var userID int64
+	if model.UserID != nil {
+		userID = *model.UserID
+	}
+
+	attempt, err := entity.NewLoginAttempt(
+		model.ID,
+		userID,
+		model.Username,
+		model.Success,
+		model.IPAddress,
+		model.UserAgent,
+		model.FailReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set timestamps from database
+	attempt.Entity.SetCreatedAt(model.CreatedAt)
+	attempt.Entity.SetUpdatedAt(model.CreatedAt)
+
+	return attempt, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *LoginAttemptMapper) ToDomainList(models []*model.LoginAttempt) ([]*entity.LoginAttempt, error) {
+	attempts := make([]*entity.LoginAttempt, 0, len(models))
+
+	for _, model := range models {
+		attempt, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, nil
+}
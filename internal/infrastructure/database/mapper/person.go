@@ -25,6 +25,7 @@ func (m *PersonMapper) ToModel(person *entity.Person) *model.Person {
 		TaxID:     person.TaxID().Number(),
 		CreatedAt: person.CreatedAt(),
 		UpdatedAt: person.UpdatedAt(),
+		Version:   person.LockVersion(),
 	}
 
 	if !person.BirthDate().IsZero() {
@@ -70,6 +71,7 @@ func (m *PersonMapper) ToDomain(model *model.Person) (*entity.Person, error) {
 	// Set timestamps from database
 	person.Entity.SetCreatedAt(model.CreatedAt)
 	person.Entity.SetUpdatedAt(model.UpdatedAt)
+	person.Entity.SetLockVersion(model.Version)
 
 	return person, nil
 }
@@ -0,0 +1,127 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"todolist/internal/domain/project/entity"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// workflowJSON is the stored representation of workflows
+type workflowJSON struct {
+	States      []workflowStateJSON `json:"states"`
+	Transitions map[string][]string `json:"transitions"`
+}
+
+// workflowStateJSON is the stored representation of workflow states
+type workflowStateJSON struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Requires []string `json:"requires,omitempty"`
+}
+
+// ProjectMapper handles conversion between domain entity and database model
+type ProjectMapper struct{}
+
+// NewProjectMapper creates a new ProjectMapper
+func NewProjectMapper() *ProjectMapper {
+	return &ProjectMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *ProjectMapper) ToModel(project *entity.Project) (*model.Project, error) {
+	mdl := &model.Project{
+		ID:        project.ID(),
+		UserID:    project.UserID(),
+		Name:      project.Name(),
+		CreatedAt: project.CreatedAt(),
+		UpdatedAt: project.UpdatedAt(),
+	}
+
+	if project.HasDefaultWorkflow() {
+		return mdl, nil
+	}
+
+	workflow := project.Workflow()
+	stored := workflowJSON{Transitions: workflow.Transitions()}
+	for _, state := range workflow.States() {
+		requires := []string{}
+		for _, field := range state.Requires() {
+			requires = append(requires, field.String())
+		}
+
+		stored.States = append(stored.States, workflowStateJSON{
+			Key:      state.Key(),
+			Name:     state.Name(),
+			Category: state.Category().String(),
+			Requires: requires,
+		})
+	}
+
+	encoded, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("encode project workflow: %w", err)
+	}
+
+	value := string(encoded)
+	mdl.Workflow = &value
+	return mdl, nil
+}
+
+// ToDomain converts database model to domain entity
+func (m *ProjectMapper) ToDomain(model *model.Project) (*entity.Project, error) {
+	workflow, err := decodeWorkflow(model.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := entity.NewProject(model.ID, model.UserID, model.Name, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set timestamps from database
+	project.Entity.SetCreatedAt(model.CreatedAt)
+	project.Entity.SetUpdatedAt(model.UpdatedAt)
+
+	return project, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *ProjectMapper) ToDomainList(models []*model.Project) ([]*entity.Project, error) {
+	projects := make([]*entity.Project, 0, len(models))
+
+	for _, model := range models {
+		project, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// decodeWorkflow decodes a stored workflow, the zero workflow when none is stored
+func decodeWorkflow(encoded *string) (todovo.Workflow, error) {
+	if encoded == nil {
+		return todovo.Workflow{}, nil
+	}
+
+	var stored workflowJSON
+	if err := json.Unmarshal([]byte(*encoded), &stored); err != nil {
+		return todovo.Workflow{}, fmt.Errorf("decode project workflow: %w", err)
+	}
+
+	states := make([]todovo.WorkflowState, len(stored.States))
+	for i, state := range stored.States {
+		var err error
+		if states[i], err = todovo.NewWorkflowState(state.Key, state.Name, state.Category, state.Requires); err != nil {
+			return todovo.Workflow{}, err
+		}
+	}
+
+	return todovo.NewWorkflow(states, stored.Transitions)
+}
@@ -0,0 +1,73 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"todolist/internal/domain/user/entity"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// RoleMapper handles conversion between domain entity and database model
+type RoleMapper struct{}
+
+// NewRoleMapper creates a new RoleMapper
+func NewRoleMapper() *RoleMapper {
+	return &RoleMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *RoleMapper) ToModel(role *entity.Role) (*model.Role, error) {
+	permissions, err := json.Marshal(role.Permissions())
+	if err != nil {
+		return nil, fmt.Errorf("encode role permissions: %w", err)
+	}
+
+	return &model.Role{
+		ID:          role.ID(),
+		Name:        role.Name().String(),
+		Description: role.Description(),
+		Permissions: string(permissions),
+		CreatedAt:   role.CreatedAt(),
+		UpdatedAt:   role.UpdatedAt(),
+	}, nil
+}
+
+// ToDomain converts database model to domain entity
+func (m *RoleMapper) ToDomain(model *model.Role) (*entity.Role, error) {
+	var permissions []vo.Permission
+	if err := json.Unmarshal([]byte(model.Permissions), &permissions); err != nil {
+		return nil, fmt.Errorf("decode role permissions: %w", err)
+	}
+
+	role, err := entity.NewRole(
+		model.ID,
+		vo.UserRole(model.Name),
+		model.Description,
+		permissions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set timestamps from database
+	role.Entity.SetCreatedAt(model.CreatedAt)
+	role.Entity.SetUpdatedAt(model.UpdatedAt)
+
+	return role, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *RoleMapper) ToDomainList(models []*model.Role) ([]*entity.Role, error) {
+	roles := make([]*entity.Role, 0, len(models))
+
+	for _, model := range models {
+		role, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
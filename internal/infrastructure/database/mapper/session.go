@@ -0,0 +1,70 @@
+package mapper
+
+import (
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// SessionMapper handles conversion between domain entity and database model
+type SessionMapper struct{}
+
+// NewSessionMapper creates a new SessionMapper
+func NewSessionMapper() *SessionMapper {
+	return &SessionMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *SessionMapper) ToModel(session *entity.Session) *model.UserSession {
+	return &model.UserSession{
+		ID:             session.ID(),
+		UserID:         session.UserID(),
+		RefreshTokenID: session.RefreshTokenID(),
+		DeviceName:     session.DeviceName(),
+		IPAddress:      session.IPAddress(),
+		UserAgent:      session.UserAgent(),
+		LastSeenAt:     session.LastSeenAt(),
+		ExpiresAt:      session.ExpiresAt(),
+		RevokedAt:      session.RevokedAt(),
+		CreatedAt:      session.CreatedAt(),
+		UpdatedAt:      session.UpdatedAt(),
+	}
+}
+
+// ToDomain converts database model to domain entity
+func (m *SessionMapper) ToDomain(model *model.UserSession) (*entity.Session, error) {
+	session, err := entity.NewSession(
+		model.ID,
+		model.UserID,
+		model.DeviceName,
+		model.IPAddress,
+		model.UserAgent,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session.BindRefreshToken(model.RefreshTokenID, model.ExpiresAt)
+	session.SetLastSeenAt(model.LastSeenAt)
+	session.SetRevokedAt(model.RevokedAt)
+
+	// Set timestamps from database
+	session.Entity.SetCreatedAt(model.CreatedAt)
+	session.Entity.SetUpdatedAt(model.UpdatedAt)
+
+	return session, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *SessionMapper) ToDomainList(models []*model.UserSession) ([]*entity.Session, error) {
+	sessions := make([]*entity.Session, 0, len(models))
+
+	for _, model := range models {
+		session, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"time"
+	"todolist/internal/domain/timetracking/entity"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// TimeEntryMapper handles conversion between domain entity and database model
+type TimeEntryMapper struct{}
+
+// NewTimeEntryMapper creates a new TimeEntryMapper
+func NewTimeEntryMapper() *TimeEntryMapper {
+	return &TimeEntryMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *TimeEntryMapper) ToModel(entry *entity.TimeEntry) *model.TimeEntry {
+	return &model.TimeEntry{
+		ID:        entry.ID(),
+		UserID:    entry.UserID(),
+		TodoID:    entry.TodoID(),
+		StartedAt: entry.StartedAt(),
+		EndedAt:   entry.EndedAt(),
+		Duration:  int64(entry.Duration() / time.Second),
+		Note:      entry.Note(),
+		CreatedAt: entry.CreatedAt(),
+		UpdatedAt: entry.UpdatedAt(),
+	}
+}
+
+// ToDomain converts database model to domain entity
+func (m *TimeEntryMapper) ToDomain(model *model.TimeEntry) (*entity.TimeEntry, error) {
+	entry, err := entity.StartTimer(model.ID, model.UserID, model.TodoID, model.StartedAt, model.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the end as stored
+	entry.SetPersistedState(model.EndedAt)
+
+	// Set timestamps from database
+	entry.Entity.SetCreatedAt(model.CreatedAt)
+	entry.Entity.SetUpdatedAt(model.UpdatedAt)
+
+	return entry, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *TimeEntryMapper) ToDomainList(models []*model.TimeEntry) ([]*entity.TimeEntry, error) {
+	entries := make([]*entity.TimeEntry, 0, len(models))
+
+	for _, model := range models {
+		entry, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -1,11 +1,15 @@
 package mapper
 
 import (
-	"fmt"
+	"cmp"
+	"slices"
+	"time"
 	sharedvo "todolist/internal/domain/shared/valueobject"
 	"todolist/internal/domain/todo/entity"
 	vo "todolist/internal/domain/todo/valueobject"
 	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
 )
 
 // TodoMapper handles conversion between domain entity and database model
@@ -27,8 +31,22 @@ func (m *TodoMapper) ToModel(todo *entity.Todo) *model.Todo {
 		Priority:    int8(todo.Priority()),
 		DueDate:     todo.DueDate(),
 		CompletedAt: todo.CompletedAt(),
+		Position:    todo.Position(),
+		State:       todo.State(),
+		Resolution:  todo.Resolution(),
+		Estimate:    int64(todo.Estimate() / time.Second),
+		TrackedTime: int64(todo.TrackedTime() / time.Second),
 		CreatedAt:   todo.CreatedAt(),
 		UpdatedAt:   todo.UpdatedAt(),
+		Version:     todo.LockVersion(),
+	}
+
+	if projectID := todo.ProjectID(); projectID != 0 {
+		mdl.ProjectID = &projectID
+	}
+
+	if deletedAt := todo.DeletedAt(); deletedAt != nil {
+		mdl.DeletedAt = gorm.DeletedAt{Time: *deletedAt, Valid: true}
 	}
 
 	// Convert tags
@@ -40,6 +58,18 @@ func (m *TodoMapper) ToModel(todo *entity.Todo) *model.Todo {
 		}
 	}
 
+	// Convert custom field values, a row per value
+	for _, value := range todo.CustomFields() {
+		number, isNumber := value.Number()
+		for _, v := range value.Values() {
+			row := &model.TodoFieldValue{TodoID: todo.ID(), FieldID: value.FieldID(), Value: v}
+			if isNumber {
+				row.Number = &number
+			}
+			mdl.FieldValues = append(mdl.FieldValues, row)
+		}
+	}
+
 	return mdl
 }
 
@@ -66,25 +96,41 @@ func (m *TodoMapper) ToDomain(model *model.Todo) (*entity.Todo, error) {
 		title,
 		description,
 		priority,
-		model.DueDate,
+		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set status
+	// Set status and dates as stored
 	status := vo.TodoStatus(model.Status)
-	if status != vo.StatusPending && status.IsValid() {
-		if err := todo.ChangeStatus(status); err != nil {
-			return nil, fmt.Errorf("change todo status failed: %w", err)
-		}
+	if !status.IsValid() {
+		status = vo.StatusPending
 	}
 
-	// Set completed time if exists
-	if model.CompletedAt != nil && todo.Status() == vo.StatusCompleted && !todo.IsCompleted() {
-		todo.Complete()
+	var deletedAt *time.Time
+	if model.DeletedAt.Valid {
+		deletedAt = &model.DeletedAt.Time
 	}
 
+	todo.SetPersistedState(status, model.DueDate, model.CompletedAt, deletedAt)
+	todo.MoveTo(model.Position)
+
+	// Set the project and the state in its workflow, the default workflow when the
+	// project is not loaded
+	var projectID int64
+	var workflow vo.Workflow
+	if model.ProjectID != nil {
+		projectID = *model.ProjectID
+	}
+	if model.Project != nil {
+		if workflow, err = decodeWorkflow(model.Project.Workflow); err != nil {
+			return nil, err
+		}
+	}
+	todo.SetPersistedWorkflow(projectID, workflow, model.State, model.Resolution)
+	todo.SetPersistedTime(time.Duration(model.Estimate)*time.Second, time.Duration(model.TrackedTime)*time.Second)
+
 	// Set tags
 	if len(model.Tags) > 0 {
 		for _, tag := range model.Tags {
@@ -92,9 +138,17 @@ func (m *TodoMapper) ToDomain(model *model.Todo) (*entity.Todo, error) {
 		}
 	}
 
+	// Set custom field values
+	customFields, err := toCustomFieldValues(model.FieldValues)
+	if err != nil {
+		return nil, err
+	}
+	todo.SetPersistedCustomFields(customFields)
+
 	// Set timestamps from database
 	todo.Entity.SetCreatedAt(model.CreatedAt)
 	todo.Entity.SetUpdatedAt(model.UpdatedAt)
+	todo.Entity.SetLockVersion(model.Version)
 
 	return todo, nil
 }
@@ -113,3 +167,43 @@ func (m *TodoMapper) ToDomainList(model []*model.Todo) ([]*entity.Todo, error) {
 
 	return todos, nil
 }
+
+// toCustomFieldValues converts the rows of custom field values, loaded with their
+// fields, to the values of each field by key, the options of multi-selects in the
+// order of the field
+func toCustomFieldValues(rows []*model.TodoFieldValue) ([]vo.CustomFieldValue, error) {
+	fields := map[int64]*model.CustomField{}
+	values := map[int64][]string{}
+	for _, row := range rows {
+		if row.Field.ID == 0 {
+			continue
+		}
+		fields[row.FieldID] = &row.Field
+		values[row.FieldID] = append(values[row.FieldID], row.Value)
+	}
+
+	customFields := make([]vo.CustomFieldValue, 0, len(fields))
+	for fieldID, field := range fields {
+		fieldType, err := vo.NewCustomFieldType(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		options, err := decodeOptions(field.Options)
+		if err != nil {
+			return nil, err
+		}
+		slices.SortStableFunc(values[fieldID], func(a, b string) int {
+			return cmp.Compare(slices.Index(options, a), slices.Index(options, b))
+		})
+
+		value, err := vo.NewCustomFieldValue(fieldID, field.Key, fieldType, values[fieldID])
+		if err != nil {
+			return nil, err
+		}
+		customFields = append(customFields, value)
+	}
+
+	slices.SortFunc(customFields, func(a, b vo.CustomFieldValue) int { return cmp.Compare(a.Key(), b.Key()) })
+	return customFields, nil
+}
@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	sharedvo "todolist/internal/domain/shared/valueobject"
 	"todolist/internal/domain/user/entity"
 	vo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/infrastructure/database/model"
@@ -17,14 +18,17 @@ func NewUserMapper() *UserMapper {
 // ToModel converts domain entity to database model
 func (m *UserMapper) ToModel(user *entity.User) *model.User {
 	return &model.User{
-		ID:           user.ID(),
-		PersonID:     user.PersonID(),
-		Username:     user.Username(),
-		PasswordHash: user.Password().Hash(),
-		Status:       string(user.Status()),
-		Role:         string(user.Role()),
-		CreatedAt:    user.CreatedAt(),
-		UpdatedAt:    user.UpdatedAt(),
+		ID:                user.ID(),
+		PersonID:          user.PersonID(),
+		Username:          user.Username(),
+		PasswordHash:      user.Password().Hash(),
+		Status:            string(user.Status()),
+		Role:              string(user.Role()),
+		MustResetPassword: user.MustResetPassword(),
+		SearchLanguage:    string(user.SearchLanguage()),
+		CreatedAt:         user.CreatedAt(),
+		UpdatedAt:         user.UpdatedAt(),
+		Version:           user.LockVersion(),
 	}
 }
 
@@ -63,8 +67,17 @@ func (m *UserMapper) ToDomain(model *model.User) (*entity.User, error) {
 		}
 	}
 
+	if model.MustResetPassword {
+		user.RequirePasswordReset()
+	}
+
+	if language := sharedvo.SearchLanguage(model.SearchLanguage); language.IsValid() {
+		user.ChangeSearchLanguage(language)
+	}
+
 	user.Entity.SetCreatedAt(model.CreatedAt)
 	user.Entity.SetUpdatedAt(model.UpdatedAt)
+	user.Entity.SetLockVersion(model.Version)
 
 	return user, nil
 }
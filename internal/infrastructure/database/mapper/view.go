@@ -0,0 +1,103 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+	"todolist/internal/domain/view/entity"
+	vo "todolist/internal/domain/view/valueobject"
+	"todolist/internal/infrastructure/database/model"
+)
+
+// viewFilterJSON is the stored representation of view filters
+type viewFilterJSON struct {
+	Status        []string   `json:"status,omitempty"`
+	Priority      []string   `json:"priority,omitempty"`
+	Tags          []string   `json:"tags,omitempty"`
+	IsOverdue     *bool      `json:"is_overdue,omitempty"`
+	DueDateFrom   *time.Time `json:"due_date_from,omitempty"`
+	DueDateTo     *time.Time `json:"due_date_to,omitempty"`
+	DueInDaysFrom *int       `json:"due_in_days_from,omitempty"`
+	DueInDaysTo   *int       `json:"due_in_days_to,omitempty"`
+	SearchTerm    string     `json:"search,omitempty"`
+	Expression    string     `json:"filter,omitempty"`
+}
+
+// ViewMapper handles conversion between domain entity and database model
+type ViewMapper struct{}
+
+// NewViewMapper creates a new ViewMapper
+func NewViewMapper() *ViewMapper {
+	return &ViewMapper{}
+}
+
+// ToModel converts domain entity to database model
+func (m *ViewMapper) ToModel(view *entity.View) (*model.SavedView, error) {
+	filter, err := json.Marshal(viewFilterJSON(view.Filter()))
+	if err != nil {
+		return nil, fmt.Errorf("encode view filter: %w", err)
+	}
+
+	return &model.SavedView{
+		ID:        view.ID(),
+		UserID:    view.UserID(),
+		Name:      view.Name(),
+		Filter:    string(filter),
+		OrderBy:   view.Sort().OrderBy(),
+		OrderDesc: view.Sort().OrderDesc(),
+		GroupBy:   view.Grouping().String(),
+		CreatedAt: view.CreatedAt(),
+		UpdatedAt: view.UpdatedAt(),
+	}, nil
+}
+
+// ToDomain converts database model to domain entity
+func (m *ViewMapper) ToDomain(model *model.SavedView) (*entity.View, error) {
+	var filter viewFilterJSON
+	if err := json.Unmarshal([]byte(model.Filter), &filter); err != nil {
+		return nil, fmt.Errorf("decode view filter: %w", err)
+	}
+
+	sort, err := vo.NewViewSort(model.OrderBy, model.OrderDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	grouping, err := vo.NewViewGrouping(model.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := entity.NewView(
+		model.ID,
+		model.UserID,
+		model.Name,
+		vo.ViewFilter(filter),
+		sort,
+		grouping,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set timestamps from database
+	view.Entity.SetCreatedAt(model.CreatedAt)
+	view.Entity.SetUpdatedAt(model.UpdatedAt)
+
+	return view, nil
+}
+
+// ToDomainList converts a list of models to domain entities
+func (m *ViewMapper) ToDomainList(models []*model.SavedView) ([]*entity.View, error) {
+	views := make([]*entity.View, 0, len(models))
+
+	for _, model := range models {
+		view, err := m.ToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+
+	return views, nil
+}
@@ -12,6 +12,7 @@ package database
 
 import (
 	"fmt"
+	sharedvo "todolist/internal/domain/shared/valueobject"
 	"todolist/internal/infrastructure/database/model"
 
 	"gorm.io/gorm"
@@ -21,13 +22,24 @@ import (
 func MigrateDefault(db *gorm.DB) error {
 	models := []any{
 		model.AuditLog{},
+		model.Board{},
+		model.BoardMember{},
+		model.CustomField{},
+		model.IDSequence{},
+		model.IdempotencyKey{},
 		model.LoginAttempt{},
 		model.Person{},
+		model.Project{},
+		model.Role{},
+		model.SavedView{},
 		model.Tag{},
+		model.TimeEntry{},
 		model.Todo{},
 		model.TodoDailyStatistics{},
+		model.TodoFieldValue{},
 		model.TodoTag{},
 		model.User{},
+		model.UserSession{},
 	}
 
 	// Auto migrate all models
@@ -50,6 +62,11 @@ func MigrateDefault(db *gorm.DB) error {
 		return fmt.Errorf("failed to create triggers: %w", err)
 	}
 
+	// Move the ID sequence past the IDs in use
+	if err := syncIDSequence(db); err != nil {
+		return fmt.Errorf("failed to sync ID sequence: %w", err)
+	}
+
 	return nil
 }
 
@@ -59,16 +76,37 @@ func createIndexes(db *gorm.DB) error {
 		// Composite indexes for common queries
 		`CREATE INDEX IF NOT EXISTS idx_todos_user_status ON todos(user_id, status) WHERE deleted_at IS NULL`,
 		`CREATE INDEX IF NOT EXISTS idx_todos_user_priority ON todos(user_id, priority) WHERE deleted_at IS NULL`,
+		`CREATE INDEX IF NOT EXISTS idx_todos_user_position ON todos(user_id, position) WHERE deleted_at IS NULL`,
 		`CREATE INDEX IF NOT EXISTS idx_todos_due_date_status ON todos(due_date, status) WHERE deleted_at IS NULL AND due_date IS NOT NULL`,
 
 		// Partial indexes for active records
 		`CREATE INDEX IF NOT EXISTS idx_users_active ON users(username) WHERE deleted_at IS NULL AND status = 'active'`,
+		`CREATE INDEX IF NOT EXISTS idx_user_sessions_active ON user_sessions(user_id, last_seen_at) WHERE revoked_at IS NULL`,
+
 		// Removi a condição com NOW()
 		`CREATE INDEX IF NOT EXISTS idx_todos_overdue ON todos(user_id, due_date) WHERE deleted_at IS NULL AND status IN ('pending', 'in_progress')`,
 
+		// A single running timer per user
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries(user_id) WHERE ended_at IS NULL`,
+
 		// Full-text search indexes
 		`CREATE INDEX IF NOT EXISTS idx_todos_title_fts ON todos USING gin(to_tsvector('english', title))`,
 		`CREATE INDEX IF NOT EXISTS idx_todos_description_fts ON todos USING gin(to_tsvector('english', description))`,
+
+		// Trigram index for typo tolerant searches and similar titles
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`CREATE INDEX IF NOT EXISTS idx_todos_title_trgm ON todos USING gin(title gin_trgm_ops) WHERE deleted_at IS NULL`,
+	}
+
+	// Full-text search indexes of the other search languages
+	for _, language := range sharedvo.SearchLanguages() {
+		if language == sharedvo.SearchLanguageEnglish {
+			continue
+		}
+		indexes = append(indexes,
+			fmt.Sprintf(`CREATE INDEX IF NOT EXISTS idx_todos_title_fts_%[1]s ON todos USING gin(to_tsvector('%[1]s', title))`, language),
+			fmt.Sprintf(`CREATE INDEX IF NOT EXISTS idx_todos_description_fts_%[1]s ON todos USING gin(to_tsvector('%[1]s', description))`, language),
+		)
 	}
 
 	for _, index := range indexes {
@@ -221,3 +259,29 @@ func createTriggers(db *gorm.DB) error {
 
 	return nil
 }
+
+// syncIDSequence moves the entity ID sequence past the IDs already in use, so IDs
+// generated by another strategy are never reused when switching to the sequence
+func syncIDSequence(db *gorm.DB) error {
+	var maxID int64
+	for _, table := range []string{"people", "users", "todos", "saved_views", "boards", "projects", "time_entries", "custom_fields"} {
+		var tableMaxID int64
+		if err := db.Table(table).Select("COALESCE(MAX(id), 0)").Scan(&tableMaxID).Error; err != nil {
+			return err
+		}
+		maxID = max(maxID, tableMaxID)
+	}
+
+	sequence := model.IDSequence{Name: model.EntityIDSequence}
+	if err := db.FirstOrCreate(&sequence, "name = ?", sequence.Name).Error; err != nil {
+		return err
+	}
+
+	if sequence.Value >= maxID {
+		return nil
+	}
+
+	return db.Model(&sequence).
+		Where("name = ? AND value < ?", sequence.Name, maxID).
+		Update("value", maxID).Error
+}
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// Board is the table of the kanban boards of users
+type Board struct {
+	ID        int64     `gorm:"column:id;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+	UserID    int64     `gorm:"column:user_id;not null;uniqueIndex:idx_boards_user_name"`
+	Name      string    `gorm:"column:name;type:varchar(100);not null;uniqueIndex:idx_boards_user_name"`
+	Columns   string    `gorm:"column:columns;type:jsonb;not null"`
+	Swimlane  string    `gorm:"column:swimlane;type:varchar(20);not null;default:''"`
+
+	// Relationships
+	User    User           `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Members []*BoardMember `gorm:"foreignKey:BoardID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name
+func (Board) TableName() string {
+	return "boards"
+}
+
+// BoardMember is the table of the users whose todos are on the boards of others
+type BoardMember struct {
+	BoardID int64 `gorm:"column:board_id;primaryKey"`
+	UserID  int64 `gorm:"column:user_id;primaryKey;index"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name
+func (BoardMember) TableName() string {
+	return "board_members"
+}
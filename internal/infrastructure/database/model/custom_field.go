@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// CustomField is the table of the custom fields users add to their todos
+type CustomField struct {
+	ID        int64     `gorm:"column:id;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+	UserID    int64     `gorm:"column:user_id;not null;uniqueIndex:idx_custom_fields_user_key"`
+	ProjectID *int64    `gorm:"column:project_id;index"` // Null for the fields of all the user's todos
+	Key       string    `gorm:"column:key;type:varchar(50);not null;uniqueIndex:idx_custom_fields_user_key"`
+	Name      string    `gorm:"column:name;type:varchar(100);not null"`
+	Type      string    `gorm:"column:type;type:varchar(20);not null"`
+	Options   *string   `gorm:"column:options;type:jsonb"` // Null for fields without options
+	Required  bool      `gorm:"column:required;not null;default:false"`
+
+	// Relationships
+	User    User     `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Project *Project `gorm:"foreignKey:ProjectID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name
+func (CustomField) TableName() string {
+	return "custom_fields"
+}
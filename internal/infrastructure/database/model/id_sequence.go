@@ -0,0 +1,14 @@
+package model
+
+// EntityIDSequence is the sequence the IDs of people, users and todos are reserved from
+const EntityIDSequence = "entities"
+
+// IDSequence is the table of the sequences entity IDs are reserved from
+type IDSequence struct {
+	Name  string `gorm:"column:name;type:varchar(50);primaryKey"`
+	Value int64  `gorm:"column:value;not null;default:0"`
+}
+
+func (IDSequence) TableName() string {
+	return "id_sequences"
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// IdempotencyKey is the table of the idempotency keys with the responses replayed to retries
+type IdempotencyKey struct {
+	Key         string    `gorm:"column:idempotency_key;type:varchar(300);primaryKey"`
+	Fingerprint string    `gorm:"column:fingerprint;type:varchar(64);not null"`
+	StatusCode  *int      `gorm:"column:status_code"` // Null while the request is being processed
+	Header      *string   `gorm:"column:header;type:text"`
+	Body        []byte    `gorm:"column:body"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null"`
+	ExpiresAt   time.Time `gorm:"column:expires_at;not null;index"`
+}
+
+// TableName specifies the table name
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
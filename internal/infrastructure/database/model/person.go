@@ -12,6 +12,7 @@ type Person struct {
 	CreatedAt time.Time      `gorm:"column:created_at;not null"`
 	UpdatedAt time.Time      `gorm:"column:updated_at;not null"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	Version   int64          `gorm:"column:version;not null;default:1"`
 	Name      string         `gorm:"column:name;type:varchar(100);not null"`
 	Email     string         `gorm:"column:email;type:varchar(255);not null;uniqueIndex"`
 	Phone     string         `gorm:"column:phone;type:varchar(20);not null"`
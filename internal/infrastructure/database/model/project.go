@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Project is the table of the projects of users
+type Project struct {
+	ID        int64     `gorm:"column:id;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+	UserID    int64     `gorm:"column:user_id;not null;uniqueIndex:idx_projects_user_name"`
+	Name      string    `gorm:"column:name;type:varchar(100);not null;uniqueIndex:idx_projects_user_name"`
+	Workflow  *string   `gorm:"column:workflow;type:jsonb"` // Null for the default workflow
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name
+func (Project) TableName() string {
+	return "projects"
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Role is the custom roles table
+type Role struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;not null"`
+	Name        string    `gorm:"column:name;type:varchar(50);not null;uniqueIndex"`
+	Description string    `gorm:"column:description;type:varchar(255)"`
+	Permissions string    `gorm:"column:permissions;type:jsonb;not null"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
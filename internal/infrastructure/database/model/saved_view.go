@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// SavedView is the table of the todo views saved by users
+type SavedView struct {
+	ID        int64     `gorm:"column:id;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+	UserID    int64     `gorm:"column:user_id;not null;uniqueIndex:idx_saved_views_user_name"`
+	Name      string    `gorm:"column:name;type:varchar(100);not null;uniqueIndex:idx_saved_views_user_name"`
+	Filter    string    `gorm:"column:filter;type:jsonb;not null"`
+	OrderBy   string    `gorm:"column:order_by;type:varchar(30);not null"`
+	OrderDesc bool      `gorm:"column:order_desc;not null;default:false"`
+	GroupBy   string    `gorm:"column:group_by;type:varchar(20);not null;default:''"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name
+func (SavedView) TableName() string {
+	return "saved_views"
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// TimeEntry is the table of the time users spent on todos
+type TimeEntry struct {
+	ID        int64      `gorm:"column:id;primaryKey"`
+	CreatedAt time.Time  `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time  `gorm:"column:updated_at;not null"`
+	UserID    int64      `gorm:"column:user_id;not null;index:idx_time_entries_user_started"`
+	TodoID    int64      `gorm:"column:todo_id;not null;index"`
+	StartedAt time.Time  `gorm:"column:started_at;type:timestamp;not null;index:idx_time_entries_user_started"`
+	EndedAt   *time.Time `gorm:"column:ended_at;type:timestamp"` // Null while the timer runs
+	Duration  int64      `gorm:"column:duration_seconds;not null;default:0"`
+	Note      string     `gorm:"column:note;type:text"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Todo Todo `gorm:"foreignKey:TodoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name
+func (TimeEntry) TableName() string {
+	return "time_entries"
+}
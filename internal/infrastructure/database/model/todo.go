@@ -12,6 +12,7 @@ type Todo struct {
 	CreatedAt   time.Time      `gorm:"column:created_at;not null"`
 	UpdatedAt   time.Time      `gorm:"column:updated_at;not null"`
 	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	Version     int64          `gorm:"column:version;not null;default:1"`
 	UserID      int64          `gorm:"column:user_id;not null;index"`
 	Title       string         `gorm:"column:title;type:varchar(200);not null"`
 	Description string         `gorm:"column:description;type:text"`
@@ -19,10 +20,19 @@ type Todo struct {
 	Priority    int8           `gorm:"column:priority;type:int8;not null;default:1;index"`
 	DueDate     *time.Time     `gorm:"column:due_date;type:timestamp;index"`
 	CompletedAt *time.Time     `gorm:"column:completed_at;type:timestamp"`
+	Position    string         `gorm:"column:position;type:varchar(64);not null;default:''"`
+	ProjectID   *int64         `gorm:"column:project_id;index"`
+	State       string         `gorm:"column:state;type:varchar(30);not null;default:''"`
+	Resolution  string         `gorm:"column:resolution;type:text"`
+	Estimate    int64          `gorm:"column:estimate_seconds;not null;default:0"`
+	TrackedTime int64          `gorm:"column:tracked_seconds;not null;default:0"` // Kept by the time entries
 
 	// Relationships
-	User User   `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	Tags []*Tag `gorm:"many2many:todo_tags;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User    User     `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Project *Project `gorm:"foreignKey:ProjectID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Tags    []*Tag   `gorm:"many2many:todo_tags;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	FieldValues []*TodoFieldValue `gorm:"foreignKey:TodoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
 
 func (Todo) TableName() string {
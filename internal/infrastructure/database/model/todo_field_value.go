@@ -0,0 +1,18 @@
+package model
+
+// TodoFieldValue is the table of the custom field values of todos, with a row per
+// option of multi-select fields
+type TodoFieldValue struct {
+	TodoID  int64    `gorm:"column:todo_id;primaryKey"`
+	FieldID int64    `gorm:"column:field_id;primaryKey;index"`
+	Value   string   `gorm:"column:value;type:varchar(500);primaryKey"`
+	Number  *float64 `gorm:"column:number_value"` // Set for number fields, which sort by it
+
+	// Relationships
+	Field CustomField `gorm:"foreignKey:FieldID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name
+func (TodoFieldValue) TableName() string {
+	return "todo_field_values"
+}
@@ -12,6 +12,7 @@ type User struct {
 	CreatedAt    time.Time      `gorm:"column:created_at;not null"`
 	UpdatedAt    time.Time      `gorm:"column:updated_at;not null"`
 	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	Version      int64          `gorm:"column:version;not null;default:1"`
 	PersonID     int64          `gorm:"column:person_id;not null;uniqueIndex"`
 	Username     string         `gorm:"column:username;type:varchar(50);not null;uniqueIndex"`
 	PasswordHash string         `gorm:"column:password_hash;type:varchar(255);not null"`
@@ -19,6 +20,9 @@ type User struct {
 	Role         string         `gorm:"column:role;type:varchar(20);not null;default:'user'"`
 	LastLoginAt  *time.Time     `gorm:"column:last_login_at;type:timestamp"`
 
+	// Preferences
+	SearchLanguage string `gorm:"column:search_language;type:varchar(20);not null;default:''"`
+
 	// Relationships
 	Person Person  `gorm:"foreignKey:PersonID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 	Todos  []*Todo `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
@@ -26,6 +30,7 @@ type User struct {
 	// Additional fields for security
 	FailedLoginAttempts int        `gorm:"column:failed_login_attempts;default:0"`
 	LockedUntil         *time.Time `gorm:"column:locked_until;type:timestamp"`
+	MustResetPassword   bool       `gorm:"column:must_reset_password;not null;default:false"`
 }
 
 func (User) TableName() string {
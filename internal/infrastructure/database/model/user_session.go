@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// UserSession is the table tracking the devices authenticated by each user
+type UserSession struct {
+	ID             int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt      time.Time  `gorm:"column:created_at;not null"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;not null"`
+	UserID         int64      `gorm:"column:user_id;not null;index"`
+	RefreshTokenID string     `gorm:"column:refresh_token_id;type:varchar(64);index"`
+	DeviceName     string     `gorm:"column:device_name;type:varchar(100)"`
+	IPAddress      string     `gorm:"column:ip_address;type:varchar(45)"`
+	UserAgent      string     `gorm:"column:user_agent;type:varchar(255)"`
+	LastSeenAt     time.Time  `gorm:"column:last_seen_at;not null"`
+	ExpiresAt      time.Time  `gorm:"column:expires_at;not null;index"`
+	RevokedAt      *time.Time `gorm:"column:revoked_at;type:timestamp"`
+
+	// Relationships
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
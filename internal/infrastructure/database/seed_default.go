@@ -12,8 +12,9 @@ package database
  */
 
 import (
+	"context"
 	"fmt"
-	"time"
+	"todolist/internal/domain/shared"
 	"todolist/internal/infrastructure/database/model"
 
 	"golang.org/x/crypto/bcrypt"
@@ -21,13 +22,13 @@ import (
 )
 
 // Seed runs all seeders
-func SeedDefault(db *gorm.DB) error {
+func SeedDefault(ctx context.Context, db *gorm.DB, ids shared.IDGenerator) error {
 	// Seed in order of dependencies
 	if err := seedTags(db); err != nil {
 		return fmt.Errorf("failed to seed tags: %w", err)
 	}
 
-	if err := seedPeopleAndUsers(db); err != nil {
+	if err := seedPeopleAndUsers(ctx, db, ids); err != nil {
 		return fmt.Errorf("failed to seed people and users: %w", err)
 	}
 
@@ -59,20 +60,18 @@ func seedTags(db *gorm.DB) error {
 }
 
 // seedPeopleAndUsers seeds test people and users
-func seedPeopleAndUsers(db *gorm.DB) error {
+func seedPeopleAndUsers(ctx context.Context, db *gorm.DB, ids shared.IDGenerator) error {
 	testData := []struct {
 		person model.Person
 		user   model.User
 	}{
 		{
 			person: model.Person{
-				ID:    time.Now().Unix(),
 				Name:  "Administrador",
 				Email: "soge@fibralink.net.br",
 				Phone: "94999990001",
 			},
 			user: model.User{
-				ID:       time.Now().Unix(),
 				Username: "admin",
 				Role:     "admin",
 				Status:   "active",
@@ -93,11 +92,23 @@ func seedPeopleAndUsers(db *gorm.DB) error {
 			continue
 		}
 
+		personID, err := ids.NextID(ctx)
+		if err != nil {
+			return err
+		}
+
+		data.person.ID = personID
 		if err := db.Create(&data.person).Error; err != nil {
 			return err
 		}
 
 		// Create user
+		userID, err := ids.NextID(ctx)
+		if err != nil {
+			return err
+		}
+
+		data.user.ID = userID
 		data.user.PersonID = data.person.ID
 		data.user.PasswordHash = string(hashedPassword)
 
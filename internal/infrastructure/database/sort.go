@@ -0,0 +1,153 @@
+package database
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"todolist/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+// SortColumn is the SQL expression a sort key orders by
+type SortColumn struct {
+	Expression string
+	Nullable   bool // Nulls are sorted last in both directions
+}
+
+// SortRegistry maps the sort keys of a resource to the SQL expressions they order by,
+// so only registered expressions ever reach ORDER BY. Results are sorted by ID after
+// the sort keys, in the direction of the last one, so their order is stable.
+type SortRegistry struct {
+	idColumn string
+	columns  map[string]SortColumn
+}
+
+// NewSortRegistry creates the sort registry of a resource, the id key sorting by its ID column
+func NewSortRegistry(idColumn string, columns map[string]SortColumn) *SortRegistry {
+	registered := maps.Clone(columns)
+	registered["id"] = SortColumn{Expression: idColumn}
+
+	return &SortRegistry{
+		idColumn: idColumn,
+		columns:  registered,
+	}
+}
+
+// With returns a copy of the registry with more sort keys, such as keys only known
+// at query time
+func (r *SortRegistry) With(columns map[string]SortColumn) *SortRegistry {
+	registered := maps.Clone(r.columns)
+	maps.Copy(registered, columns)
+
+	return &SortRegistry{
+		idColumn: r.idColumn,
+		columns:  registered,
+	}
+}
+
+// Keys returns the registered sort keys, in alphabetical order
+func (r *SortRegistry) Keys() []string {
+	return slices.Sorted(maps.Keys(r.columns))
+}
+
+// Apply sorts the query, failing with a shared.InvalidSortError for keys not registered
+func (r *SortRegistry) Apply(query *gorm.DB, sort shared.Sort) (*gorm.DB, error) {
+	return r.apply(query, sort, false)
+}
+
+// ApplyQueryOptions applies the sort and pagination options
+func (r *SortRegistry) ApplyQueryOptions(query *gorm.DB, options shared.QueryOptions) (*gorm.DB, error) {
+	query, err := r.Apply(query, options.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	return ApplyQueryOptions(query, options), nil
+}
+
+// ApplyKeysetOptions applies the sort and pagination options.
+//
+// When the options have a cursor, only the rows after it are selected, or before
+// it for backward cursors. Backward pages are selected in reverse order, so
+// callers must reverse the rows to restore the listing order. Cursors are only
+// supported when sorting by at most one key that is not nullable.
+func (r *SortRegistry) ApplyKeysetOptions(query *gorm.DB, options shared.QueryOptions) (*gorm.DB, error) {
+	cursor := options.Cursor
+	if cursor == nil {
+		return r.ApplyQueryOptions(query, options)
+	}
+
+	if len(options.Sort) > 1 {
+		return nil, fmt.Errorf("%w: cursors cannot paginate results sorted by several keys", shared.ErrInvalidOperation)
+	}
+
+	desc, column := false, SortColumn{Expression: r.idColumn}
+	if len(options.Sort) == 1 {
+		var ok bool
+		if column, ok = r.columns[options.Sort[0].Key]; !ok {
+			return nil, &shared.InvalidSortError{Key: options.Sort[0].Key, Allowed: r.Keys()}
+		}
+		if column.Nullable {
+			return nil, fmt.Errorf("%w: cursors cannot paginate results sorted by a nullable key", shared.ErrInvalidOperation)
+		}
+		desc = options.Sort[0].Desc
+	}
+
+	operator := ">"
+	if desc != cursor.Backward {
+		operator = "<"
+	}
+
+	if column.Expression == r.idColumn {
+		query = query.Where(fmt.Sprintf("%s %s ?", r.idColumn, operator), cursor.ID)
+	} else {
+		query = query.Where(
+			fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND %[3]s %[2]s ?))", column.Expression, operator, r.idColumn),
+			cursor.Value, cursor.Value, cursor.ID,
+		)
+	}
+
+	query, err := r.apply(query, options.Sort, cursor.Backward)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(options.Limit)
+	}
+
+	return query, nil
+}
+
+// apply sorts the query, in the reverse order when reverse is set
+func (r *SortRegistry) apply(query *gorm.DB, sort shared.Sort, reverse bool) (*gorm.DB, error) {
+	desc := reverse
+	for _, field := range sort {
+		column, ok := r.columns[field.Key]
+		if !ok {
+			return nil, &shared.InvalidSortError{Key: field.Key, Allowed: r.Keys()}
+		}
+
+		if column.Nullable {
+			query = query.Order(column.Expression + " IS NULL")
+		}
+
+		desc = field.Desc != reverse
+		query = query.Order(column.Expression + " " + sortDirection(desc))
+	}
+
+	if !sort.Has("id") {
+		query = query.Order(r.idColumn + " " + sortDirection(desc))
+	}
+
+	return query, nil
+}
+
+// sortDirection returns the SQL keyword of a sort direction
+func sortDirection(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
@@ -0,0 +1,189 @@
+package database
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"todolist/internal/domain/shared"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type sortedItem struct {
+	ID       int64
+	Priority int
+	DueDate  *time.Time
+}
+
+var sortedItems = NewSortRegistry("id", map[string]SortColumn{
+	"priority": {Expression: "priority"},
+	"due_date": {Expression: "due_date", Nullable: true},
+})
+
+func newSortTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(&sortedItem{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	day := func(d int) *time.Time {
+		date := time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	items := []sortedItem{
+		{ID: 1, Priority: 1, DueDate: day(3)},
+		{ID: 2, Priority: 2, DueDate: nil},
+		{ID: 3, Priority: 2, DueDate: day(1)},
+		{ID: 4, Priority: 1, DueDate: nil},
+		{ID: 5, Priority: 2, DueDate: day(2)},
+	}
+	if err := db.Create(&items).Error; err != nil {
+		t.Fatalf("Failed to insert: %v", err)
+	}
+
+	return db
+}
+
+func sortedIDs(t *testing.T, query *gorm.DB, err error) []int64 {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var items []sortedItem
+	if err := query.Find(&items).Error; err != nil {
+		t.Fatalf("Failed to query: %v", err)
+	}
+
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestSortRegistryApply(t *testing.T) {
+	db := newSortTestDB(t)
+
+	tests := []struct {
+		name string
+		sort shared.Sort
+		want []int64
+	}{
+		{name: "no sort", sort: nil, want: []int64{1, 2, 3, 4, 5}},
+		{name: "nulls last ascending", sort: shared.Sort{{Key: "due_date"}}, want: []int64{3, 5, 1, 2, 4}},
+		{name: "nulls last descending", sort: shared.Sort{{Key: "due_date", Desc: true}}, want: []int64{1, 5, 3, 4, 2}},
+		{
+			name: "several keys",
+			sort: shared.Sort{{Key: "priority", Desc: true}, {Key: "due_date"}},
+			want: []int64{3, 5, 2, 1, 4},
+		},
+		{name: "ties broken by ID", sort: shared.Sort{{Key: "priority"}}, want: []int64{1, 4, 2, 3, 5}},
+		{name: "by ID", sort: shared.Sort{{Key: "id", Desc: true}}, want: []int64{5, 4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := sortedItems.Apply(db.Model(&sortedItem{}), tt.sort)
+			if got := sortedIDs(t, query, err); !slices.Equal(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSortRegistryApplyUnknownKey(t *testing.T) {
+	db := newSortTestDB(t)
+
+	_, err := sortedItems.Apply(db.Model(&sortedItem{}), shared.Sort{{Key: "priority; DROP TABLE sorted_items"}})
+
+	var sortErr *shared.InvalidSortError
+	if !errors.As(err, &sortErr) {
+		t.Fatalf("Expected an InvalidSortError, got %v", err)
+	}
+	if want := []string{"due_date", "id", "priority"}; !slices.Equal(sortErr.Allowed, want) {
+		t.Errorf("Expected allowed keys %v, got %v", want, sortErr.Allowed)
+	}
+}
+
+func TestSortRegistryWith(t *testing.T) {
+	db := newSortTestDB(t)
+
+	extended := sortedItems.With(map[string]SortColumn{"urgency": {Expression: "priority * 10 - id"}})
+
+	query, err := extended.Apply(db.Model(&sortedItem{}), shared.Sort{{Key: "urgency"}})
+	if got, want := sortedIDs(t, query, err), []int64{4, 1, 5, 3, 2}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if _, err := sortedItems.Apply(db.Model(&sortedItem{}), shared.Sort{{Key: "urgency"}}); !errors.Is(err, shared.ErrInvalidSort) {
+		t.Errorf("Expected the registry to be unchanged, got %v", err)
+	}
+}
+
+func TestSortRegistryApplyKeysetOptions(t *testing.T) {
+	db := newSortTestDB(t)
+
+	tests := []struct {
+		name    string
+		options shared.QueryOptions
+		want    []int64
+	}{
+		{
+			name: "after the cursor",
+			options: shared.QueryOptions{
+				Limit:  2,
+				Sort:   shared.Sort{{Key: "priority"}},
+				Cursor: &shared.Cursor{Value: 1, ID: 4},
+			},
+			want: []int64{2, 3},
+		},
+		{
+			name: "before the cursor",
+			options: shared.QueryOptions{
+				Limit:  2,
+				Sort:   shared.Sort{{Key: "priority", Desc: true}},
+				Cursor: &shared.Cursor{Value: 1, ID: 4, Backward: true},
+			},
+			want: []int64{2, 3},
+		},
+		{
+			name: "by ID",
+			options: shared.QueryOptions{
+				Cursor: &shared.Cursor{ID: 3},
+			},
+			want: []int64{4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := sortedItems.ApplyKeysetOptions(db.Model(&sortedItem{}), tt.options)
+			if got := sortedIDs(t, query, err); !slices.Equal(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSortRegistryApplyKeysetOptionsUnsupportedSort(t *testing.T) {
+	db := newSortTestDB(t)
+
+	for _, sort := range []shared.Sort{
+		{{Key: "due_date"}},
+		{{Key: "priority"}, {Key: "due_date"}},
+	} {
+		options := shared.QueryOptions{Sort: sort, Cursor: &shared.Cursor{Value: 1, ID: 1}}
+		if _, err := sortedItems.ApplyKeysetOptions(db.Model(&sortedItem{}), options); !errors.Is(err, shared.ErrInvalidOperation) {
+			t.Errorf("Expected ErrInvalidOperation for %v, got %v", sort, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package idempotency
+
+/*
+ * database.go
+ *
+ * This file provides an idempotency key store backed by the idempotency_keys table.
+ *
+ * The primary key of the table makes reservations atomic, so the keys are shared
+ * by every instance of the application.
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	"todolist/internal/infrastructure/database/model"
+	"todolist/internal/service"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ service.IdempotencyStore = (*databaseStore)(nil)
+
+type databaseStore struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// NewDatabaseStore creates an idempotency key store backed by the database
+func NewDatabaseStore(db *gorm.DB) service.IdempotencyStore {
+	return &databaseStore{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// Reserve implements service.IdempotencyStore.
+func (s *databaseStore) Reserve(ctx context.Context, key, fingerprint string, expiresAt time.Time) (*service.IdempotencyRecord, error) {
+	now := s.now()
+	db := s.db.WithContext(ctx)
+
+	// An expired key is free to be reserved again
+	if err := db.
+		Where("idempotency_key = ? AND expires_at <= ?", key, now).
+		Delete(&model.IdempotencyKey{}).Error; err != nil {
+		return nil, err
+	}
+
+	result := db.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.IdempotencyKey{
+			Key:         key,
+			Fingerprint: fingerprint,
+			CreatedAt:   now,
+			ExpiresAt:   expiresAt,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var row model.IdempotencyKey
+	if err := db.First(&row, "idempotency_key = ?", key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Released meanwhile, the retry may reserve it
+			return s.Reserve(ctx, key, fingerprint, expiresAt)
+		}
+		return nil, err
+	}
+
+	return toRecord(&row)
+}
+
+// Complete implements service.IdempotencyStore.
+func (s *databaseStore) Complete(ctx context.Context, key string, response service.IdempotentResponse) error {
+	header, err := json.Marshal(response.Header)
+	if err != nil {
+		return err
+	}
+
+	return s.db.WithContext(ctx).
+		Model(&model.IdempotencyKey{}).
+		Where("idempotency_key = ?", key).
+		Updates(map[string]any{
+			"status_code": response.StatusCode,
+			"header":      string(header),
+			"body":        response.Body,
+		}).Error
+}
+
+// Release implements service.IdempotencyStore.
+func (s *databaseStore) Release(ctx context.Context, key string) error {
+	return s.db.WithContext(ctx).
+		Where("idempotency_key = ?", key).
+		Delete(&model.IdempotencyKey{}).Error
+}
+
+// DeleteExpired implements service.IdempotencyStore.
+func (s *databaseStore) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&model.IdempotencyKey{})
+
+	return result.RowsAffected, result.Error
+}
+
+// toRecord converts a row of the idempotency_keys table to a record
+func toRecord(row *model.IdempotencyKey) (*service.IdempotencyRecord, error) {
+	record := &service.IdempotencyRecord{
+		Key:         row.Key,
+		Fingerprint: row.Fingerprint,
+		ExpiresAt:   row.ExpiresAt,
+	}
+
+	if row.StatusCode == nil {
+		return record, nil
+	}
+
+	record.Response = &service.IdempotentResponse{
+		StatusCode: *row.StatusCode,
+		Body:       row.Body,
+	}
+
+	if row.Header != nil {
+		if err := json.Unmarshal([]byte(*row.Header), &record.Response.Header); err != nil {
+			return nil, err
+		}
+	}
+
+	return record, nil
+}
@@ -0,0 +1,98 @@
+package idempotency
+
+/*
+ * memory.go
+ *
+ * This file provides an idempotency key store kept in memory.
+ *
+ * Keys are lost on restart and not shared between instances, so it only suits
+ * single instance deployments.
+ */
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+	"todolist/internal/service"
+)
+
+var _ service.IdempotencyStore = (*memoryStore)(nil)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*service.IdempotencyRecord
+	now     func() time.Time
+}
+
+// NewMemoryStore creates an idempotency key store kept in memory
+func NewMemoryStore() service.IdempotencyStore {
+	return &memoryStore{
+		records: map[string]*service.IdempotencyRecord{},
+		now:     time.Now,
+	}
+}
+
+// Reserve implements service.IdempotencyStore.
+func (s *memoryStore) Reserve(ctx context.Context, key, fingerprint string, expiresAt time.Time) (*service.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok && record.ExpiresAt.After(s.now()) {
+		return copyRecord(record), nil
+	}
+
+	s.records[key] = &service.IdempotencyRecord{
+		Key:         key,
+		Fingerprint: fingerprint,
+		ExpiresAt:   expiresAt,
+	}
+	return nil, nil
+}
+
+// Complete implements service.IdempotencyStore.
+func (s *memoryStore) Complete(ctx context.Context, key string, response service.IdempotentResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		response.Header = maps.Clone(response.Header)
+		response.Body = append([]byte(nil), response.Body...)
+		record.Response = &response
+	}
+	return nil
+}
+
+// Release implements service.IdempotencyStore.
+func (s *memoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+// DeleteExpired implements service.IdempotencyStore.
+func (s *memoryStore) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for key, record := range s.records {
+		if record.ExpiresAt.Before(before) {
+			delete(s.records, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// copyRecord copies a record so callers cannot change the stored one
+func copyRecord(record *service.IdempotencyRecord) *service.IdempotencyRecord {
+	copied := *record
+	if record.Response != nil {
+		response := *record.Response
+		copied.Response = &response
+	}
+	return &copied
+}
@@ -0,0 +1,121 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todolist/internal/infrastructure/database/model"
+	"todolist/internal/service"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestStores(t *testing.T) map[string]service.IdempotencyStore {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(&model.IdempotencyKey{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	return map[string]service.IdempotencyStore{
+		"memory":   NewMemoryStore(),
+		"database": NewDatabaseStore(db),
+	}
+}
+
+func TestStoreReserve(t *testing.T) {
+	for name, store := range newTestStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			expiresAt := time.Now().Add(time.Hour)
+
+			record, err := store.Reserve(ctx, "1:key", "fingerprint", expiresAt)
+			if err != nil || record != nil {
+				t.Fatalf("Expected the key to be reserved, got %v, %v", record, err)
+			}
+
+			record, err = store.Reserve(ctx, "1:key", "other", expiresAt)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if record == nil || record.Fingerprint != "fingerprint" || record.Response != nil {
+				t.Fatalf("Expected the key in use by the first request, got %+v", record)
+			}
+
+			response := service.IdempotentResponse{
+				StatusCode: 201,
+				Header:     map[string]string{"ETag": `"1"`},
+				Body:       []byte(`{"id":1}`),
+			}
+			if err := store.Complete(ctx, "1:key", response); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			record, err = store.Reserve(ctx, "1:key", "fingerprint", expiresAt)
+			if err != nil || record == nil || record.Response == nil {
+				t.Fatalf("Expected the stored response, got %+v, %v", record, err)
+			}
+			if record.Response.StatusCode != 201 || string(record.Response.Body) != `{"id":1}` ||
+				record.Response.Header["ETag"] != `"1"` {
+				t.Errorf("Expected the completed response, got %+v", record.Response)
+			}
+
+			if record, _ := store.Reserve(ctx, "2:key", "fingerprint", expiresAt); record != nil {
+				t.Errorf("Expected keys of other scopes to be free, got %+v", record)
+			}
+		})
+	}
+}
+
+func TestStoreRelease(t *testing.T) {
+	for name, store := range newTestStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			expiresAt := time.Now().Add(time.Hour)
+
+			if _, err := store.Reserve(ctx, "1:key", "fingerprint", expiresAt); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if err := store.Release(ctx, "1:key"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if record, err := store.Reserve(ctx, "1:key", "other", expiresAt); err != nil || record != nil {
+				t.Errorf("Expected a released key to be reserved again, got %+v, %v", record, err)
+			}
+		})
+	}
+}
+
+func TestStoreExpiration(t *testing.T) {
+	for name, store := range newTestStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			now := time.Now()
+
+			if _, err := store.Reserve(ctx, "1:expired", "fingerprint", now.Add(-time.Minute)); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if _, err := store.Reserve(ctx, "1:live", "fingerprint", now.Add(time.Hour)); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if record, err := store.Reserve(ctx, "1:expired", "other", now.Add(time.Hour)); err != nil || record != nil {
+				t.Errorf("Expected an expired key to be reserved again, got %+v, %v", record, err)
+			}
+
+			deleted, err := store.DeleteExpired(ctx, now.Add(2*time.Hour))
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if deleted != 2 {
+				t.Errorf("Expected 2 expired keys deleted, got %d", deleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package idgen
+
+/*
+ * sequence.go
+ *
+ * This file provides an ID generator backed by a database sequence.
+ *
+ * Sequences are rows of the id_sequences table, so they work with every supported
+ * dialect. Blocks of IDs are reserved at once to avoid a round trip per ID, which
+ * keeps IDs unique across instances but only ordered within an instance.
+ */
+
+import (
+	"context"
+	"sync"
+	"todolist/internal/domain/shared"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const defaultBlockSize = 100
+
+var _ shared.IDGenerator = (*sequenceGenerator)(nil)
+
+type sequenceGenerator struct {
+	mu        sync.Mutex
+	db        *gorm.DB
+	name      string
+	blockSize int64
+	next      int64
+	last      int64
+}
+
+// NewSequenceGenerator creates an ID generator reserving blocks of IDs from the named sequence
+func NewSequenceGenerator(db *gorm.DB, name string, blockSize int64) shared.IDGenerator {
+	if blockSize <= 0 {
+		blockSize = defaultBlockSize
+	}
+
+	return &sequenceGenerator{
+		db:        db,
+		name:      name,
+		blockSize: blockSize,
+	}
+}
+
+// NextID returns the next ID of the reserved block, reserving a new block when it is used up
+func (g *sequenceGenerator) NextID(ctx context.Context) (int64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.next == 0 || g.next > g.last {
+		if err := g.reserve(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	id := g.next
+	g.next++
+	return id, nil
+}
+
+// reserve advances the sequence by a block, the update locks the row so concurrent
+// instances never reserve the same block
+func (g *sequenceGenerator) reserve(ctx context.Context) error {
+	var sequence model.IDSequence
+
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.IDSequence{Name: g.name}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.
+			Model(&model.IDSequence{}).
+			Where("name = ?", g.name).
+			Update("value", gorm.Expr("value + ?", g.blockSize)).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("name = ?", g.name).First(&sequence).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	g.next = sequence.Value - g.blockSize + 1
+	g.last = sequence.Value
+	return nil
+}
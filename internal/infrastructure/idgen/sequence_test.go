@@ -0,0 +1,48 @@
+package idgen
+
+import (
+	"context"
+	"testing"
+	"todolist/internal/domain/shared"
+	"todolist/internal/infrastructure/database/model"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestSequenceGeneratorNextID(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(&model.IDSequence{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	ctx := context.Background()
+	first := NewSequenceGenerator(db, model.EntityIDSequence, 2)
+	second := NewSequenceGenerator(db, model.EntityIDSequence, 2)
+
+	seen := map[int64]bool{}
+	for range 5 {
+		for _, generator := range []shared.IDGenerator{first, second} {
+			id, err := generator.NextID(ctx)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if seen[id] {
+				t.Fatalf("Expected unique IDs, got %d twice", id)
+			}
+			seen[id] = true
+		}
+	}
+
+	var sequence model.IDSequence
+	if err := db.First(&sequence, "name = ?", model.EntityIDSequence).Error; err != nil {
+		t.Fatalf("Failed to load sequence: %v", err)
+	}
+	// Each generator used 5 IDs out of 3 blocks of 2
+	if sequence.Value != 12 {
+		t.Errorf("Expected sequence at 12, got %d", sequence.Value)
+	}
+}
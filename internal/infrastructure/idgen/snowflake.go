@@ -0,0 +1,87 @@
+package idgen
+
+/*
+ * snowflake.go
+ *
+ * This file provides a Snowflake style ID generator.
+ *
+ * IDs are made of the milliseconds since a custom epoch (41 bits), the node ID
+ * of the instance (10 bits) and a per millisecond sequence (12 bits), so they are
+ * unique across instances with distinct node IDs and ordered by creation time.
+ */
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"todolist/internal/domain/shared"
+)
+
+const (
+	nodeBits     = 10
+	sequenceBits = 12
+
+	maxNodeID    = 1<<nodeBits - 1
+	maxSequence  = 1<<sequenceBits - 1
+	maxTimestamp = 1<<(63-nodeBits-sequenceBits) - 1
+)
+
+// snowflakeEpoch is the start of the snowflake timestamps
+var snowflakeEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	ErrInvalidNodeID    = fmt.Errorf("node ID must be between 0 and %d", maxNodeID)
+	ErrIDSpaceExhausted = errors.New("snowflake timestamp space exhausted")
+)
+
+var _ shared.IDGenerator = (*snowflakeGenerator)(nil)
+
+type snowflakeGenerator struct {
+	mu        sync.Mutex
+	nodeID    int64
+	timestamp int64
+	sequence  int64
+	now       func() time.Time
+}
+
+// NewSnowflakeGenerator creates a Snowflake style ID generator for the given node
+func NewSnowflakeGenerator(nodeID int64) (shared.IDGenerator, error) {
+	if nodeID < 0 || nodeID > maxNodeID {
+		return nil, ErrInvalidNodeID
+	}
+
+	return &snowflakeGenerator{
+		nodeID: nodeID,
+		now:    time.Now,
+	}, nil
+}
+
+// NextID returns the next ID of the node.
+//
+// IDs never decrease: when the clock moves backwards, or the sequence of the current
+// millisecond is exhausted, the generator keeps counting from the last timestamp it
+// used instead of waiting for the clock to catch up.
+func (g *snowflakeGenerator) NextID(_ context.Context) (int64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now().Sub(snowflakeEpoch).Milliseconds()
+
+	if now > g.timestamp {
+		g.timestamp = now
+		g.sequence = 0
+	} else {
+		g.sequence = (g.sequence + 1) & maxSequence
+		if g.sequence == 0 {
+			g.timestamp++
+		}
+	}
+
+	if g.timestamp > maxTimestamp {
+		return 0, ErrIDSpaceExhausted
+	}
+
+	return g.timestamp<<(nodeBits+sequenceBits) | g.nodeID<<sequenceBits | g.sequence, nil
+}
@@ -0,0 +1,71 @@
+package idgen
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewSnowflakeGenerator(t *testing.T) {
+	for _, nodeID := range []int64{-1, maxNodeID + 1} {
+		if _, err := NewSnowflakeGenerator(nodeID); !errors.Is(err, ErrInvalidNodeID) {
+			t.Errorf("Expected ErrInvalidNodeID for node %d, got %v", nodeID, err)
+		}
+	}
+
+	if _, err := NewSnowflakeGenerator(maxNodeID); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestSnowflakeGeneratorNextID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should generate increasing IDs within the same millisecond", func(t *testing.T) {
+		generator, _ := NewSnowflakeGenerator(1)
+		now := time.Now()
+		generator.(*snowflakeGenerator).now = func() time.Time { return now }
+
+		previous := int64(0)
+		for range 2 * (maxSequence + 1) {
+			id, err := generator.NextID(ctx)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if id <= previous {
+				t.Fatalf("Expected ID greater than %d, got %d", previous, id)
+			}
+			previous = id
+		}
+	})
+
+	t.Run("should keep increasing when the clock moves backwards", func(t *testing.T) {
+		generator, _ := NewSnowflakeGenerator(1)
+		now := time.Now()
+		generator.(*snowflakeGenerator).now = func() time.Time { return now }
+
+		first, _ := generator.NextID(ctx)
+		now = now.Add(-time.Minute)
+		second, _ := generator.NextID(ctx)
+
+		if second <= first {
+			t.Errorf("Expected ID greater than %d, got %d", first, second)
+		}
+	})
+
+	t.Run("should not collide across nodes", func(t *testing.T) {
+		now := time.Now()
+		first, _ := NewSnowflakeGenerator(1)
+		second, _ := NewSnowflakeGenerator(2)
+		first.(*snowflakeGenerator).now = func() time.Time { return now }
+		second.(*snowflakeGenerator).now = func() time.Time { return now }
+
+		firstID, _ := first.NextID(ctx)
+		secondID, _ := second.NextID(ctx)
+
+		if firstID == secondID {
+			t.Errorf("Expected distinct IDs, got %d twice", firstID)
+		}
+	})
+}
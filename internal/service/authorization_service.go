@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+	domainService "todolist/internal/domain/user/service"
+	vo "todolist/internal/domain/user/valueobject"
+)
+
+// Custom token claims describing the authenticated user
+const (
+	ClaimUsername          = "username"
+	ClaimRole              = "role"
+	ClaimPermissions       = "permissions"
+	ClaimMustResetPassword = "must_reset_password"
+)
+
+// policyTTL bounds how long a loaded policy is used before role definitions are read again,
+// so changes made by other instances are picked up
+const policyTTL = time.Minute
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// ClaimsProvider supplies the custom claims embedded in the tokens issued to a user
+type ClaimsProvider interface {
+	// UserClaims returns the username, role, permissions and password reset claims of the user.
+	UserClaims(ctx context.Context, userID int64) (map[string]any, error)
+}
+
+// AuthorizationService defines operations for evaluating role based access control.
+//
+// Roles are defined in the application configuration, falling back to the built-in roles,
+// and custom roles can be stored in the database. Configured roles take precedence.
+type AuthorizationService interface {
+	ClaimsProvider
+
+	// Authorize checks if the role holds the permission for at least some resources.
+	//
+	// Returns ErrPermissionDenied when the permission is not granted.
+	Authorize(ctx context.Context, role vo.UserRole, permission vo.Permission) error
+
+	// AuthorizeUser checks if the user can perform the permission on the resource.
+	//
+	// The user must be active. Returns ErrPermissionDenied when the permission is not granted.
+	AuthorizeUser(ctx context.Context, userID int64, permission vo.Permission, resource domainService.Resource) error
+
+	// RolePermissions returns the permissions granted to the role.
+	RolePermissions(ctx context.Context, role vo.UserRole) ([]vo.Permission, error)
+
+	// ListRoles returns every defined role, configured roles first.
+	ListRoles(ctx context.Context) ([]*entity.Role, error)
+
+	// IsConfiguredRole checks if the role is defined by configuration and therefore read only.
+	IsConfiguredRole(role vo.UserRole) bool
+
+	// ReloadPolicy discards the cached policy so the next check reads the role definitions again.
+	ReloadPolicy()
+}
+
+type authorizationService struct {
+	userRepository  repository.UserRepository
+	roleRepository  repository.RoleRepository
+	configuredRoles []*entity.Role
+
+	mu       sync.RWMutex
+	policy   *domainService.AccessPolicy
+	loadedAt time.Time
+}
+
+// NewAuthorizationService creates a new instance.
+//
+// configuredRoles maps role names to permission expressions; when empty the built-in roles are used.
+func NewAuthorizationService(
+	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
+	configuredRoles map[string][]string,
+) (AuthorizationService, error) {
+	roles, err := buildConfiguredRoles(configuredRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authorizationService{
+		userRepository:  userRepository,
+		roleRepository:  roleRepository,
+		configuredRoles: roles,
+	}, nil
+}
+
+func (s *authorizationService) UserClaims(ctx context.Context, userID int64) (map[string]any, error) {
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.RolePermissions(ctx, user.Role())
+	if err != nil {
+		return nil, err
+	}
+
+	permissionNames := make([]string, len(permissions))
+	for i, permission := range permissions {
+		permissionNames[i] = permission.String()
+	}
+
+	return map[string]any{
+		ClaimUsername:          user.Username(),
+		ClaimRole:              user.Role().String(),
+		ClaimPermissions:       permissionNames,
+		ClaimMustResetPassword: user.MustResetPassword(),
+	}, nil
+}
+
+func (s *authorizationService) Authorize(ctx context.Context, role vo.UserRole, permission vo.Permission) error {
+	policy, err := s.currentPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !policy.Can(role, permission) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func (s *authorizationService) AuthorizeUser(
+	ctx context.Context,
+	userID int64,
+	permission vo.Permission,
+	resource domainService.Resource,
+) error {
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := user.CanPerformAction(); err != nil {
+		return err
+	}
+
+	policy, err := s.currentPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !policy.CanAccess(user.Role(), userID, permission, resource) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func (s *authorizationService) RolePermissions(ctx context.Context, role vo.UserRole) ([]vo.Permission, error) {
+	policy, err := s.currentPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !policy.HasRole(role) {
+		return nil, entity.ErrRoleNotFound
+	}
+
+	return policy.Permissions(role), nil
+}
+
+func (s *authorizationService) ListRoles(ctx context.Context) ([]*entity.Role, error) {
+	customRoles, err := s.roleRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*entity.Role, 0, len(s.configuredRoles)+len(customRoles))
+	roles = append(roles, s.configuredRoles...)
+	for _, role := range customRoles {
+		if !s.IsConfiguredRole(role.Name()) {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles, nil
+}
+
+func (s *authorizationService) IsConfiguredRole(role vo.UserRole) bool {
+	for _, configured := range s.configuredRoles {
+		if configured.Name() == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *authorizationService) ReloadPolicy() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy = nil
+}
+
+// currentPolicy returns the cached policy, loading the role definitions when stale
+func (s *authorizationService) currentPolicy(ctx context.Context) (*domainService.AccessPolicy, error) {
+	s.mu.RLock()
+	policy, loadedAt := s.policy, s.loadedAt
+	s.mu.RUnlock()
+
+	if policy != nil && time.Since(loadedAt) < policyTTL {
+		return policy, nil
+	}
+
+	customRoles, err := s.roleRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Configured roles are applied last so they can not be overridden from the database
+	roles := append(customRoles, s.configuredRoles...)
+	policy = domainService.NewAccessPolicy(roles...)
+
+	s.mu.Lock()
+	s.policy, s.loadedAt = policy, time.Now()
+	s.mu.Unlock()
+
+	return policy, nil
+}
+
+// buildConfiguredRoles parses the configured role definitions, using the built-in roles when empty
+func buildConfiguredRoles(configured map[string][]string) ([]*entity.Role, error) {
+	definitions := domainService.DefaultRolePermissions()
+
+	if len(configured) > 0 {
+		definitions = make(map[vo.UserRole][]vo.Permission, len(configured))
+		for name, expressions := range configured {
+			permissions := make([]vo.Permission, len(expressions))
+			for i, expression := range expressions {
+				permissions[i] = vo.Permission(expression)
+			}
+			definitions[vo.UserRole(name)] = permissions
+		}
+	}
+
+	roles := make([]*entity.Role, 0, len(definitions))
+	for name, permissions := range definitions {
+		role, err := entity.NewRole(0, name, "", permissions)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	// Keep a stable order for listings
+	slices.SortFunc(roles, func(a, b *entity.Role) int {
+		return strings.Compare(a.Name().String(), b.Name().String())
+	})
+
+	return roles, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotentResponse is the response of a request made with an idempotency key,
+// replayed to the retries of the request
+type IdempotentResponse struct {
+	StatusCode int
+	Header     map[string]string
+	Body       []byte
+}
+
+// IdempotencyRecord is the state of an idempotency key
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string              // Hash of the request the key was first used with
+	Response    *IdempotentResponse // Nil while the request is being processed
+	ExpiresAt   time.Time
+}
+
+// IdempotencyStore keeps the responses of requests made with an idempotency key.
+//
+// Keys are reserved before the request is processed, so concurrent retries find the
+// key in use instead of processing the request twice. Expired keys are treated as
+// if they did not exist.
+type IdempotencyStore interface {
+	// Reserve claims the key for a request with the given fingerprint.
+	//
+	// Returns nil when the key was reserved, or the record of the key when it is
+	// already in use, leaving it unchanged.
+	Reserve(ctx context.Context, key, fingerprint string, expiresAt time.Time) (*IdempotencyRecord, error)
+
+	// Complete stores the response of the request that reserved the key.
+	Complete(ctx context.Context, key string, response IdempotentResponse) error
+
+	// Release frees a reserved key so the request can be retried.
+	Release(ctx context.Context, key string) error
+
+	// DeleteExpired removes the keys that expired before the given time.
+	//
+	// Returns the number of removed keys.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+)
+
+var (
+	ErrImpersonationNotAllowed = errors.New("user can not be impersonated")
+	ErrImpersonationForbidden  = errors.New("action is not allowed while impersonating")
+)
+
+// ImpersonationGrant is a time-limited token issued to an actor to act as the subject user
+type ImpersonationGrant struct {
+	ActorID   int64
+	SubjectID int64
+	Token     string
+	Metadata  *TokenMetadata
+}
+
+// ImpersonatedRequest describes a request performed under impersonation
+type ImpersonatedRequest struct {
+	Method     string
+	Path       string
+	StatusCode int
+	IPAddress  string
+	UserAgent  string
+}
+
+// ImpersonationService defines operations for administrators acting on behalf of other users.
+//
+// Impersonation tokens are short lived access tokens of the subject carrying the actor
+// in a dedicated claim. They can not be refreshed, every write performed with them is
+// recorded in the audit log and the subject is notified when an impersonation starts.
+type ImpersonationService interface {
+	// StartImpersonation issues an impersonation token of the subject for the actor.
+	//
+	// The duration is capped by the configured maximum; zero uses the maximum.
+	// Users allowed to manage other users can not be impersonated.
+	StartImpersonation(
+		ctx context.Context,
+		issuerName string,
+		actorID, subjectID int64,
+		duration time.Duration,
+		reason string,
+		info SessionInfo,
+	) (*ImpersonationGrant, error)
+
+	// RecordImpersonatedRequest records a write performed by the actor on behalf of the subject.
+	RecordImpersonatedRequest(ctx context.Context, actorID, subjectID int64, request ImpersonatedRequest) error
+}
+
+type impersonationService struct {
+	userRepository       repository.UserRepository
+	auditLogRepository   rptAudit.AuditLogRepository
+	tokenService         TokenService
+	authorizationService AuthorizationService
+	notificationService  NotificationService
+	maxDuration          time.Duration
+}
+
+// NewImpersonationService creates a new instance.
+//
+// maxDuration bounds the lifetime of the issued tokens.
+func NewImpersonationService(
+	userRepository repository.UserRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	tokenService TokenService,
+	authorizationService AuthorizationService,
+	notificationService NotificationService,
+	maxDuration time.Duration,
+) ImpersonationService {
+	return &impersonationService{
+		userRepository:       userRepository,
+		auditLogRepository:   auditLogRepository,
+		tokenService:         tokenService,
+		authorizationService: authorizationService,
+		notificationService:  notificationService,
+		maxDuration:          maxDuration,
+	}
+}
+
+func (s *impersonationService) StartImpersonation(
+	ctx context.Context,
+	issuerName string,
+	actorID, subjectID int64,
+	duration time.Duration,
+	reason string,
+	info SessionInfo,
+) (*ImpersonationGrant, error) {
+	if actorID == subjectID || s.maxDuration <= 0 {
+		return nil, ErrImpersonationNotAllowed
+	}
+
+	subject, err := s.userRepository.FindByID(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := subject.CanPerformAction(); err != nil {
+		return nil, err
+	}
+
+	// Impersonating other administrators would allow an escalation of privileges
+	err = s.authorizationService.Authorize(ctx, subject.Role(), vo.PermissionUserManage)
+	if err == nil {
+		return nil, ErrImpersonationNotAllowed
+	}
+	if !errors.Is(err, ErrPermissionDenied) {
+		return nil, err
+	}
+
+	if duration <= 0 || duration > s.maxDuration {
+		duration = s.maxDuration
+	}
+
+	claims, err := s.authorizationService.UserClaims(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, metadata, err := s.tokenService.GenerateImpersonationToken(ctx, issuerName, actorID, subjectID, duration, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	newValues := map[string]any{
+		"token_id":   metadata.TokenID,
+		"expires_at": metadata.ExpiresAt,
+	}
+	if reason != "" {
+		newValues["reason"] = reason
+	}
+
+	if err := s.recordAudit(ctx, actorID, 0, subjectID, entAudit.ActionUserImpersonationStarted, newValues,
+		info.IPAddress, info.UserAgent,
+	); err != nil {
+		return nil, fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	if err := s.notificationService.NotifyUser(ctx, subjectID, Notification{
+		Type:    NotificationImpersonationStarted,
+		Subject: "Support access to your account",
+		Message: "A support administrator started a session on your account to investigate an issue.",
+		Data: map[string]any{
+			"actor_id":   actorID,
+			"expires_at": metadata.ExpiresAt,
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to notify user: %w", err)
+	}
+
+	return &ImpersonationGrant{
+		ActorID:   actorID,
+		SubjectID: subjectID,
+		Token:     token,
+		Metadata:  metadata,
+	}, nil
+}
+
+func (s *impersonationService) RecordImpersonatedRequest(
+	ctx context.Context,
+	actorID, subjectID int64,
+	request ImpersonatedRequest,
+) error {
+	return s.recordAudit(ctx, subjectID, actorID, subjectID, entAudit.ActionImpersonatedRequest,
+		map[string]any{
+			"method": request.Method,
+			"path":   request.Path,
+			"status": request.StatusCode,
+		},
+		request.IPAddress, request.UserAgent,
+	)
+}
+
+// recordAudit records an audit entry on the subject user, tagged with the impersonator when set
+func (s *impersonationService) recordAudit(
+	ctx context.Context,
+	actorID, impersonatorID, subjectID int64,
+	action string,
+	newValues map[string]any,
+	ipAddress, userAgent string,
+) error {
+	auditLog, err := entAudit.NewAuditLog(0, actorID, entAudit.EntityTypeUser, subjectID, action, nil, newValues)
+	if err != nil {
+		return err
+	}
+
+	auditLog.SetImpersonator(impersonatorID)
+	auditLog.SetClient(ipAddress, userAgent)
+
+	return s.auditLogRepository.Save(ctx, auditLog)
+}
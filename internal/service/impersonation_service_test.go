@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+)
+
+type fakeImpersonationUserRepository struct {
+	repository.UserRepository
+	users map[int64]*entity.User
+}
+
+func (f fakeImpersonationUserRepository) FindByID(ctx context.Context, id int64) (*entity.User, error) {
+	user, ok := f.users[id]
+	if !ok {
+		return nil, shared.ErrNotFound
+	}
+	return user, nil
+}
+
+type fakeAuditLogRepository struct {
+	rptAudit.AuditLogRepository
+	saved []*entAudit.AuditLog
+}
+
+func (f *fakeAuditLogRepository) Save(ctx context.Context, auditLog *entAudit.AuditLog) error {
+	f.saved = append(f.saved, auditLog)
+	return nil
+}
+
+// fakeImpersonationTokenService issues impersonation tokens, remembering their duration
+type fakeImpersonationTokenService struct {
+	TokenService
+	duration time.Duration
+}
+
+func (f *fakeImpersonationTokenService) GenerateImpersonationToken(
+	ctx context.Context,
+	issuerName string,
+	actorID, subjectID int64,
+	duration time.Duration,
+	claims map[string]any,
+) (string, *TokenMetadata, error) {
+	f.duration = duration
+	return "impersonation", &TokenMetadata{TokenID: "id-1", ExpiresAt: time.Now().Add(duration), TokenType: TypeAccess}, nil
+}
+
+// fakeImpersonationAuthorizationService grants every permission to administrators only
+type fakeImpersonationAuthorizationService struct {
+	AuthorizationService
+}
+
+func (fakeImpersonationAuthorizationService) Authorize(ctx context.Context, role vo.UserRole, permission vo.Permission) error {
+	if role != vo.RoleAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func (fakeImpersonationAuthorizationService) UserClaims(ctx context.Context, userID int64) (map[string]any, error) {
+	return map[string]any{"role": "user"}, nil
+}
+
+type fakeNotificationService struct {
+	notified map[int64][]Notification
+}
+
+func (f *fakeNotificationService) NotifyUser(ctx context.Context, userID int64, notification Notification) error {
+	f.notified[userID] = append(f.notified[userID], notification)
+	return nil
+}
+
+type impersonationTestDeps struct {
+	tokens        *fakeImpersonationTokenService
+	auditLogs     *fakeAuditLogRepository
+	notifications *fakeNotificationService
+}
+
+// newTestImpersonationService returns a service impersonating for at most an hour,
+// with the administrators 1 and 2, the user 10 and the inactive user 11
+func newTestImpersonationService(t *testing.T) (ImpersonationService, impersonationTestDeps) {
+	t.Helper()
+
+	users := make(map[int64]*entity.User)
+	for id, role := range map[int64]vo.UserRole{1: vo.RoleAdmin, 2: vo.RoleAdmin, 10: vo.RoleUser, 11: vo.RoleUser} {
+		user, err := entity.NewUser(id, id, "user", vo.Password{}, role)
+		if err != nil {
+			t.Fatalf("NewUser() error = %v", err)
+		}
+		users[id] = user
+	}
+	users[11].Deactivate()
+
+	deps := impersonationTestDeps{
+		tokens:        &fakeImpersonationTokenService{},
+		auditLogs:     &fakeAuditLogRepository{},
+		notifications: &fakeNotificationService{notified: make(map[int64][]Notification)},
+	}
+	svc := NewImpersonationService(
+		fakeImpersonationUserRepository{users: users},
+		deps.auditLogs,
+		deps.tokens,
+		fakeImpersonationAuthorizationService{},
+		deps.notifications,
+		time.Hour,
+	)
+	return svc, deps
+}
+
+func TestImpersonationService_StartImpersonation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should issue a token, notify the subject and record an audit entry", func(t *testing.T) {
+		svc, deps := newTestImpersonationService(t)
+
+		grant, err := svc.StartImpersonation(ctx, "app", 1, 10, 15*time.Minute, "Ticket 42",
+			SessionInfo{IPAddress: "10.0.0.1", UserAgent: "Firefox"})
+		if err != nil {
+			t.Fatalf("StartImpersonation() error = %v", err)
+		}
+
+		if grant.ActorID != 1 || grant.SubjectID != 10 || grant.Token != "impersonation" {
+			t.Errorf("Expected a token of user 10 for user 1, got %+v", grant)
+		}
+		if deps.tokens.duration != 15*time.Minute {
+			t.Errorf("Expected a token valid for 15m, got %v", deps.tokens.duration)
+		}
+
+		notifications := deps.notifications.notified[10]
+		if len(notifications) != 1 || notifications[0].Type != NotificationImpersonationStarted ||
+			notifications[0].Data["actor_id"] != int64(1) {
+			t.Errorf("Expected the subject to be notified of the impersonation by user 1, got %+v", notifications)
+		}
+
+		if len(deps.auditLogs.saved) != 1 {
+			t.Fatalf("Expected one audit entry, got %d", len(deps.auditLogs.saved))
+		}
+		auditLog := deps.auditLogs.saved[0]
+		if auditLog.Action() != entAudit.ActionUserImpersonationStarted || auditLog.ActorID() != 1 ||
+			auditLog.EntityID() != 10 || auditLog.IsImpersonated() {
+			t.Errorf("Expected user 1 to start impersonating user 10, got %q by %d on %d",
+				auditLog.Action(), auditLog.ActorID(), auditLog.EntityID())
+		}
+		if auditLog.NewValues()["reason"] != "Ticket 42" || auditLog.NewValues()["token_id"] != "id-1" {
+			t.Errorf("Expected the reason and the token to be recorded, got %v", auditLog.NewValues())
+		}
+		if auditLog.IPAddress() != "10.0.0.1" || auditLog.UserAgent() != "Firefox" {
+			t.Errorf("Expected the client to be recorded, got %q and %q", auditLog.IPAddress(), auditLog.UserAgent())
+		}
+	})
+
+	durations := []struct {
+		name     string
+		duration time.Duration
+		want     time.Duration
+	}{
+		{name: "duration over the maximum", duration: 3 * time.Hour, want: time.Hour},
+		{name: "no duration", duration: 0, want: time.Hour},
+		{name: "negative duration", duration: -time.Minute, want: time.Hour},
+	}
+
+	for _, tt := range durations {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, deps := newTestImpersonationService(t)
+
+			if _, err := svc.StartImpersonation(ctx, "app", 1, 10, tt.duration, "", SessionInfo{}); err != nil {
+				t.Fatalf("StartImpersonation() error = %v", err)
+			}
+			if deps.tokens.duration != tt.want {
+				t.Errorf("Expected a token valid for %v, got %v", tt.want, deps.tokens.duration)
+			}
+		})
+	}
+
+	refusals := []struct {
+		name      string
+		subjectID int64
+		wantErr   error
+	}{
+		{name: "self impersonation", subjectID: 1, wantErr: ErrImpersonationNotAllowed},
+		{name: "subject managing users", subjectID: 2, wantErr: ErrImpersonationNotAllowed},
+		{name: "inactive subject", subjectID: 11, wantErr: entity.ErrUserNotActive},
+		{name: "missing subject", subjectID: 12, wantErr: shared.ErrNotFound},
+	}
+
+	for _, tt := range refusals {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, deps := newTestImpersonationService(t)
+
+			grant, err := svc.StartImpersonation(ctx, "app", 1, tt.subjectID, 0, "", SessionInfo{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("StartImpersonation() error = %v, want %v", err, tt.wantErr)
+			}
+			if grant != nil || deps.tokens.duration != 0 {
+				t.Error("Expected no token to be issued")
+			}
+			if len(deps.auditLogs.saved) != 0 || len(deps.notifications.notified) != 0 {
+				t.Error("Expected nothing to be recorded or notified")
+			}
+		})
+	}
+}
+
+func TestImpersonationService_RecordImpersonatedRequest(t *testing.T) {
+	svc, deps := newTestImpersonationService(t)
+
+	err := svc.RecordImpersonatedRequest(context.Background(), 1, 10, ImpersonatedRequest{
+		Method:     "DELETE",
+		Path:       "/api/v1/todos/3",
+		StatusCode: 200,
+	})
+	if err != nil {
+		t.Fatalf("RecordImpersonatedRequest() error = %v", err)
+	}
+
+	if len(deps.auditLogs.saved) != 1 {
+		t.Fatalf("Expected one audit entry, got %d", len(deps.auditLogs.saved))
+	}
+	auditLog := deps.auditLogs.saved[0]
+	if auditLog.ActorID() != 10 || auditLog.ImpersonatorID() != 1 || auditLog.Action() != entAudit.ActionImpersonatedRequest {
+		t.Errorf("Expected a request of user 10 impersonated by user 1, got %q by %d impersonated by %d",
+			auditLog.Action(), auditLog.ActorID(), auditLog.ImpersonatorID())
+	}
+}
@@ -0,0 +1,22 @@
+package service
+
+import "context"
+
+// Notification types
+const (
+	NotificationImpersonationStarted = "impersonation_started"
+)
+
+// Notification is a message delivered to a user
+type Notification struct {
+	Type    string
+	Subject string
+	Message string
+	Data    map[string]any
+}
+
+// NotificationService defines operations for notifying users about relevant account events
+type NotificationService interface {
+	// NotifyUser delivers the notification to the user.
+	NotifyUser(ctx context.Context, userID int64, notification Notification) error
+}
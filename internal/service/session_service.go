@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+)
+
+// ClaimSessionID is the custom token claim carrying the session identifier
+const ClaimSessionID = "session_id"
+
+// SessionInfo describes the client device that owns a session
+type SessionInfo struct {
+	DeviceName string
+	IPAddress  string
+	UserAgent  string
+}
+
+// SessionService defines operations for managing authenticated user sessions.
+//
+// Every refresh token issued by the application is bound to a session, which allows
+// users to list the devices where they are logged in and to terminate them.
+type SessionService interface {
+	// StartSession creates a new session for the user and issues its token pair.
+	StartSession(ctx context.Context, issuerName string, userID int64, info SessionInfo) (*entity.Session, *AuthTokens, error)
+
+	// RefreshSession rotates the refresh token bound to a session.
+	//
+	// Reusing a refresh token that was already rotated, even by a concurrent refresh,
+	// revokes the whole session.
+	RefreshSession(ctx context.Context, refreshToken string, info SessionInfo) (*entity.Session, *AuthTokens, error)
+
+	// ValidateSession checks that the session is still usable and records its activity.
+	ValidateSession(ctx context.Context, userID, sessionID int64, info SessionInfo) error
+
+	// ListSessions returns the active sessions of a user.
+	ListSessions(ctx context.Context, userID int64) ([]*entity.Session, error)
+
+	// RevokeSession terminates a single session owned by the user.
+	RevokeSession(ctx context.Context, userID, sessionID int64) error
+
+	// RevokeOtherSessions terminates every session of the user except the given one.
+	//
+	// Returns the number of revoked sessions.
+	RevokeOtherSessions(ctx context.Context, userID, keepSessionID int64) (int64, error)
+}
+
+type sessionService struct {
+	sessionRepository repository.SessionRepository
+	tokenService      TokenService
+	claimsProvider    ClaimsProvider
+}
+
+// NewSessionService creates a new instance.
+//
+// The claims provider supplies the user claims embedded in every issued token,
+// so they are refreshed whenever the session tokens are rotated.
+func NewSessionService(
+	sessionRepository repository.SessionRepository,
+	tokenService TokenService,
+	claimsProvider ClaimsProvider,
+) SessionService {
+	return &sessionService{
+		sessionRepository: sessionRepository,
+		tokenService:      tokenService,
+		claimsProvider:    claimsProvider,
+	}
+}
+
+// SessionIDFromClaims extracts the session identifier from custom token claims
+func SessionIDFromClaims(claims map[string]any) (int64, bool) {
+	value, ok := claims[ClaimSessionID].(string)
+	if !ok {
+		return 0, false
+	}
+
+	sessionID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || sessionID == 0 {
+		return 0, false
+	}
+
+	return sessionID, true
+}
+
+func (s *sessionService) StartSession(
+	ctx context.Context,
+	issuerName string,
+	userID int64,
+	info SessionInfo,
+) (*entity.Session, *AuthTokens, error) {
+	session, err := entity.NewSession(0, userID, info.DeviceName, info.IPAddress, info.UserAgent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Persist first so the generated ID can be embedded in the tokens
+	if err := s.sessionRepository.Save(ctx, session); err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.generateSessionTokens(ctx, issuerName, session)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session.BindRefreshToken(tokens.RefreshMeta.TokenID, tokens.RefreshMeta.ExpiresAt)
+	if err := s.sessionRepository.Save(ctx, session); err != nil {
+		return nil, nil, err
+	}
+
+	return session, tokens, nil
+}
+
+func (s *sessionService) RefreshSession(
+	ctx context.Context,
+	refreshToken string,
+	info SessionInfo,
+) (*entity.Session, *AuthTokens, error) {
+	result, err := s.tokenService.ValidateToken(ctx, refreshToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if result.Metadata.TokenType != TypeRefresh {
+		return nil, nil, &TokenServiceError{
+			Code:    ErrCodeInvalidTokenType,
+			Message: "refresh token expected",
+		}
+	}
+
+	sessionID, ok := SessionIDFromClaims(result.Claims)
+	if !ok {
+		return nil, nil, &TokenServiceError{
+			Code:    ErrCodeInvalidToken,
+			Message: "token is not bound to a session",
+		}
+	}
+
+	session, err := s.findUserSession(ctx, result.UserID, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := session.CanBeUsed(); err != nil {
+		return nil, nil, err
+	}
+
+	// A rotated refresh token being presented again means it may have leaked
+	if session.RefreshTokenID() != result.Metadata.TokenID {
+		return nil, nil, s.revokeReusedSession(ctx, session)
+	}
+
+	// Issue new tokens with up to date user claims
+	tokens, err := s.generateSessionTokens(ctx, result.IssuerName, session)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session.BindRefreshToken(tokens.RefreshMeta.TokenID, tokens.RefreshMeta.ExpiresAt)
+	session.Touch(info.IPAddress, info.UserAgent)
+
+	// The token may have been presented twice at once, only the first rotation succeeds
+	rotated, err := s.sessionRepository.RotateRefreshToken(ctx, session, result.Metadata.TokenID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rotated {
+		return nil, nil, s.revokeReusedSession(ctx, session)
+	}
+
+	// The previous refresh token must not be usable anymore
+	if err := s.tokenService.RevokeToken(ctx, refreshToken); err != nil {
+		return nil, nil, err
+	}
+
+	return session, tokens, nil
+}
+
+func (s *sessionService) ValidateSession(
+	ctx context.Context,
+	userID,
+	sessionID int64,
+	info SessionInfo,
+) error {
+	session, err := s.findUserSession(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if err := session.CanBeUsed(); err != nil {
+		return err
+	}
+
+	// Avoid writing on every request, last seen only needs to be approximate
+	if !session.NeedsTouch() {
+		return nil
+	}
+
+	session.Touch(info.IPAddress, info.UserAgent)
+	return s.sessionRepository.Save(ctx, session)
+}
+
+func (s *sessionService) ListSessions(ctx context.Context, userID int64) ([]*entity.Session, error) {
+	return s.sessionRepository.FindActiveByUserID(ctx, userID)
+}
+
+func (s *sessionService) RevokeSession(ctx context.Context, userID, sessionID int64) error {
+	session, err := s.findUserSession(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.Revoke()
+	return s.sessionRepository.Save(ctx, session)
+}
+
+func (s *sessionService) RevokeOtherSessions(ctx context.Context, userID, keepSessionID int64) (int64, error) {
+	return s.sessionRepository.RevokeAllByUserID(ctx, userID, keepSessionID)
+}
+
+// revokeReusedSession revokes the session whose refresh token was reused,
+// reporting the reuse as ErrRefreshTokenMismatch
+func (s *sessionService) revokeReusedSession(ctx context.Context, session *entity.Session) error {
+	session.Revoke()
+	if err := s.sessionRepository.Save(ctx, session); err != nil {
+		return err
+	}
+	return entity.ErrRefreshTokenMismatch
+}
+
+// generateSessionTokens issues a token pair bound to the session carrying the user claims
+func (s *sessionService) generateSessionTokens(
+	ctx context.Context,
+	issuerName string,
+	session *entity.Session,
+) (*AuthTokens, error) {
+	claims, err := s.claimsProvider.UserClaims(ctx, session.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	claims[ClaimSessionID] = strconv.FormatInt(session.ID(), 10)
+
+	return s.tokenService.GenerateTokens(ctx, issuerName, session.UserID(), claims)
+}
+
+// findUserSession loads a session making sure it belongs to the user
+func (s *sessionService) findUserSession(ctx context.Context, userID, sessionID int64) (*entity.Session, error) {
+	session, err := s.sessionRepository.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, entity.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	if session.UserID() != userID {
+		return nil, entity.ErrSessionNotFound
+	}
+
+	return session, nil
+}
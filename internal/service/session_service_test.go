@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+)
+
+// fakeSessionRepository shares the sessions with the service, keeping the refresh token
+// each session is stored with apart
+type fakeSessionRepository struct {
+	repository.SessionRepository
+	sessions        map[int64]*entity.Session
+	refreshTokenIDs map[int64]string
+	saves           int
+}
+
+func newFakeSessionRepository() *fakeSessionRepository {
+	return &fakeSessionRepository{
+		sessions:        make(map[int64]*entity.Session),
+		refreshTokenIDs: make(map[int64]string),
+	}
+}
+
+func (f *fakeSessionRepository) Save(ctx context.Context, session *entity.Session) error {
+	if session.ID() == 0 {
+		session.SetID(int64(len(f.sessions) + 1))
+	}
+	f.sessions[session.ID()] = session
+	f.refreshTokenIDs[session.ID()] = session.RefreshTokenID()
+	f.saves++
+	return nil
+}
+
+func (f *fakeSessionRepository) RotateRefreshToken(ctx context.Context, session *entity.Session, previousTokenID string) (bool, error) {
+	if f.refreshTokenIDs[session.ID()] != previousTokenID {
+		return false, nil
+	}
+	return true, f.Save(ctx, session)
+}
+
+func (f *fakeSessionRepository) FindByID(ctx context.Context, id int64) (*entity.Session, error) {
+	session, ok := f.sessions[id]
+	if !ok {
+		return nil, shared.ErrNotFound
+	}
+	return session, nil
+}
+
+// fakeTokenService issues opaque tokens and remembers what they were issued for
+type fakeTokenService struct {
+	TokenService
+	issued  map[string]*ValidationResult
+	revoked []string
+}
+
+func newFakeTokenService() *fakeTokenService {
+	return &fakeTokenService{issued: make(map[string]*ValidationResult)}
+}
+
+func (f *fakeTokenService) GenerateTokens(
+	ctx context.Context,
+	issuerName string,
+	userID int64,
+	claims map[string]any,
+) (*AuthTokens, error) {
+	n := len(f.issued)
+	tokens := &AuthTokens{
+		AccessToken:  fmt.Sprintf("access-%d", n+1),
+		RefreshToken: fmt.Sprintf("refresh-%d", n+2),
+		AccessMeta:   TokenMetadata{TokenID: fmt.Sprintf("id-%d", n+1), TokenType: TypeAccess},
+		RefreshMeta: TokenMetadata{
+			TokenID:   fmt.Sprintf("id-%d", n+2),
+			ExpiresAt: time.Now().Add(time.Hour),
+			TokenType: TypeRefresh,
+		},
+	}
+
+	f.issued[tokens.AccessToken] = &ValidationResult{UserID: userID, IssuerName: issuerName, Metadata: tokens.AccessMeta, Claims: claims}
+	f.issued[tokens.RefreshToken] = &ValidationResult{UserID: userID, IssuerName: issuerName, Metadata: tokens.RefreshMeta, Claims: claims}
+	return tokens, nil
+}
+
+func (f *fakeTokenService) ValidateToken(ctx context.Context, token string) (*ValidationResult, error) {
+	result, ok := f.issued[token]
+	if !ok {
+		return nil, &TokenServiceError{Code: ErrCodeInvalidToken, Message: "unknown token"}
+	}
+	return result, nil
+}
+
+func (f *fakeTokenService) RevokeToken(ctx context.Context, token string) error {
+	f.revoked = append(f.revoked, token)
+	return nil
+}
+
+type fakeClaimsProvider struct{}
+
+func (fakeClaimsProvider) UserClaims(ctx context.Context, userID int64) (map[string]any, error) {
+	return map[string]any{"role": "user"}, nil
+}
+
+func newTestSessionService() (SessionService, *fakeSessionRepository, *fakeTokenService) {
+	sessions := newFakeSessionRepository()
+	tokens := newFakeTokenService()
+	return NewSessionService(sessions, tokens, fakeClaimsProvider{}), sessions, tokens
+}
+
+func TestSessionService_StartSession(t *testing.T) {
+	svc, sessions, tokenService := newTestSessionService()
+
+	session, tokens, err := svc.StartSession(context.Background(), "app", 10, SessionInfo{DeviceName: "Laptop"})
+	if err != nil {
+		t.Fatalf("StartSession() error = %v", err)
+	}
+
+	if session.ID() == 0 || sessions.sessions[session.ID()] != session {
+		t.Fatalf("Expected the session to be saved, got ID %d", session.ID())
+	}
+	if session.RefreshTokenID() != tokens.RefreshMeta.TokenID {
+		t.Errorf("Expected refresh token %q to be bound, got %q", tokens.RefreshMeta.TokenID, session.RefreshTokenID())
+	}
+
+	claims := tokenService.issued[tokens.RefreshToken].Claims
+	if id, ok := SessionIDFromClaims(claims); !ok || id != session.ID() {
+		t.Errorf("Expected the tokens to carry session %d, got claims %v", session.ID(), claims)
+	}
+}
+
+func TestSessionService_RefreshSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should rotate the refresh token", func(t *testing.T) {
+		svc, _, tokenService := newTestSessionService()
+		session, tokens, _ := svc.StartSession(ctx, "app", 10, SessionInfo{IPAddress: "10.0.0.1", UserAgent: "Firefox"})
+		session.SetLastSeenAt(time.Now().Add(-time.Hour))
+
+		refreshed, newTokens, err := svc.RefreshSession(ctx, tokens.RefreshToken, SessionInfo{IPAddress: "10.0.0.2"})
+		if err != nil {
+			t.Fatalf("RefreshSession() error = %v", err)
+		}
+
+		if refreshed.ID() != session.ID() {
+			t.Errorf("Expected session %d to be kept, got %d", session.ID(), refreshed.ID())
+		}
+		if refreshed.RefreshTokenID() != newTokens.RefreshMeta.TokenID || newTokens.RefreshMeta.TokenID == tokens.RefreshMeta.TokenID {
+			t.Errorf("Expected the new refresh token to be bound, got %q", refreshed.RefreshTokenID())
+		}
+		if !slices.Equal(tokenService.revoked, []string{tokens.RefreshToken}) {
+			t.Errorf("Expected the previous refresh token to be revoked, got %v", tokenService.revoked)
+		}
+		if refreshed.NeedsTouch() || refreshed.IPAddress() != "10.0.0.2" || refreshed.UserAgent() != "Firefox" {
+			t.Errorf("Expected the session to be touched from 10.0.0.2, got %v from %q", refreshed.LastSeenAt(), refreshed.IPAddress())
+		}
+	})
+
+	t.Run("should revoke the session when a rotated token is reused", func(t *testing.T) {
+		svc, _, _ := newTestSessionService()
+		session, tokens, _ := svc.StartSession(ctx, "app", 10, SessionInfo{})
+		_, newTokens, err := svc.RefreshSession(ctx, tokens.RefreshToken, SessionInfo{})
+		if err != nil {
+			t.Fatalf("RefreshSession() error = %v", err)
+		}
+
+		if _, _, err := svc.RefreshSession(ctx, tokens.RefreshToken, SessionInfo{}); !errors.Is(err, entity.ErrRefreshTokenMismatch) {
+			t.Fatalf("Expected ErrRefreshTokenMismatch, got %v", err)
+		}
+		if !session.IsRevoked() {
+			t.Fatal("Expected the session to be revoked")
+		}
+
+		// The token issued by the rotation belongs to the same family
+		if _, _, err := svc.RefreshSession(ctx, newTokens.RefreshToken, SessionInfo{}); !errors.Is(err, entity.ErrSessionRevoked) {
+			t.Errorf("Expected ErrSessionRevoked, got %v", err)
+		}
+		if err := svc.ValidateSession(ctx, 10, session.ID(), SessionInfo{}); !errors.Is(err, entity.ErrSessionRevoked) {
+			t.Errorf("Expected ErrSessionRevoked, got %v", err)
+		}
+	})
+
+	t.Run("should revoke the session when the token is rotated concurrently", func(t *testing.T) {
+		svc, sessions, tokenService := newTestSessionService()
+		session, tokens, _ := svc.StartSession(ctx, "app", 10, SessionInfo{})
+
+		// Another refresh with the same token rotated it after this one loaded the session
+		sessions.refreshTokenIDs[session.ID()] = "id-concurrent"
+
+		if _, _, err := svc.RefreshSession(ctx, tokens.RefreshToken, SessionInfo{}); !errors.Is(err, entity.ErrRefreshTokenMismatch) {
+			t.Fatalf("Expected ErrRefreshTokenMismatch, got %v", err)
+		}
+		if !sessions.sessions[session.ID()].IsRevoked() {
+			t.Error("Expected the session to be revoked")
+		}
+		if len(tokenService.revoked) != 0 {
+			t.Errorf("Expected no token to be revoked by a failed rotation, got %v", tokenService.revoked)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		token    func(tokens *AuthTokens, ts *fakeTokenService) string
+		expire   bool
+		wantErr  error
+		wantCode string
+	}{
+		{
+			name:     "access token",
+			token:    func(tokens *AuthTokens, ts *fakeTokenService) string { return tokens.AccessToken },
+			wantCode: ErrCodeInvalidTokenType,
+		},
+		{
+			name: "token without session",
+			token: func(tokens *AuthTokens, ts *fakeTokenService) string {
+				ts.issued["unbound"] = &ValidationResult{UserID: 10, Metadata: TokenMetadata{TokenType: TypeRefresh}}
+				return "unbound"
+			},
+			wantCode: ErrCodeInvalidToken,
+		},
+		{
+			name: "session of another user",
+			token: func(tokens *AuthTokens, ts *fakeTokenService) string {
+				result := *ts.issued[tokens.RefreshToken]
+				result.UserID = 20
+				ts.issued["other"] = &result
+				return "other"
+			},
+			wantErr: entity.ErrSessionNotFound,
+		},
+		{
+			name:    "expired session",
+			token:   func(tokens *AuthTokens, ts *fakeTokenService) string { return tokens.RefreshToken },
+			expire:  true,
+			wantErr: entity.ErrSessionExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, sessions, tokenService := newTestSessionService()
+			session, tokens, _ := svc.StartSession(ctx, "app", 10, SessionInfo{})
+			if tt.expire {
+				session.BindRefreshToken(session.RefreshTokenID(), time.Now().Add(-time.Minute))
+			}
+			saves := sessions.saves
+
+			_, _, err := svc.RefreshSession(ctx, tt.token(tokens, tokenService), SessionInfo{})
+
+			var tokenErr *TokenServiceError
+			switch {
+			case tt.wantCode != "":
+				if !errors.As(err, &tokenErr) || tokenErr.Code != tt.wantCode {
+					t.Errorf("Expected error code %s, got %v", tt.wantCode, err)
+				}
+			case !errors.Is(err, tt.wantErr):
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+			if sessions.saves != saves || len(tokenService.revoked) != 0 || session.IsRevoked() {
+				t.Error("Expected the session to be left untouched")
+			}
+		})
+	}
+}
+
+func TestSessionService_ValidateSession(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		userID    int64
+		lastSeen  time.Duration
+		revoke    bool
+		wantErr   error
+		wantSaved bool
+	}{
+		{name: "recently seen", userID: 10, lastSeen: time.Minute},
+		{name: "stale", userID: 10, lastSeen: time.Hour, wantSaved: true},
+		{name: "revoked", userID: 10, lastSeen: time.Hour, revoke: true, wantErr: entity.ErrSessionRevoked},
+		{name: "another user", userID: 20, lastSeen: time.Hour, wantErr: entity.ErrSessionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, sessions, _ := newTestSessionService()
+			session, _, _ := svc.StartSession(ctx, "app", 10, SessionInfo{IPAddress: "10.0.0.1"})
+			session.SetLastSeenAt(time.Now().Add(-tt.lastSeen))
+			if tt.revoke {
+				session.Revoke()
+			}
+			saves := sessions.saves
+
+			err := svc.ValidateSession(ctx, tt.userID, session.ID(), SessionInfo{IPAddress: "10.0.0.2"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateSession() error = %v, want %v", err, tt.wantErr)
+			}
+
+			saved := sessions.saves > saves
+			if saved != tt.wantSaved {
+				t.Errorf("Expected saved = %v, got %v", tt.wantSaved, saved)
+			}
+			if saved && (session.NeedsTouch() || session.IPAddress() != "10.0.0.2") {
+				t.Errorf("Expected the session to be touched from 10.0.0.2, got %v from %q", session.LastSeenAt(), session.IPAddress())
+			}
+		})
+	}
+
+	t.Run("unknown session", func(t *testing.T) {
+		svc, _, _ := newTestSessionService()
+		if err := svc.ValidateSession(ctx, 10, 99, SessionInfo{}); !errors.Is(err, entity.ErrSessionNotFound) {
+			t.Errorf("Expected ErrSessionNotFound, got %v", err)
+		}
+	})
+}
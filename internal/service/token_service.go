@@ -72,10 +72,15 @@ type AuthTokens struct {
 	RefreshMeta TokenMetadata `json:"refresh_meta"`
 }
 
+// ClaimActorID is the custom token claim carrying the user acting on behalf of the token subject
+const ClaimActorID = "actor_id"
+
 // ValidationResult contains the result of token validation
 type ValidationResult struct {
 	// UserID is the identifier of the authenticated user
 	UserID int64 `json:"user_id"`
+	// ActorID is the identifier of the user impersonating UserID, zero for regular tokens
+	ActorID int64 `json:"actor_id,omitempty"`
 	// IssuerName is the name of the token issuer
 	IssuerName string `json:"issuer_name"`
 	// Metadata contains additional token information
@@ -84,14 +89,28 @@ type ValidationResult struct {
 	Claims map[string]any `json:"claims,omitempty"`
 }
 
+// PublicKey is the public part of a token signing key in JSON Web Key format (RFC 7517)
+type PublicKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
 // TokenService defines the interface for token generation and validation
 type TokenService interface {
 	// GenerateTokens creates a new set of access and refresh tokens
 	// ctx: context for cancellation and timeout control
 	// issuerName: identifier for the token issuer (e.g., application name)
 	// userID: unique identifier for the user
+	// claims: optional custom claims embedded in both tokens (e.g., session ID)
 	// Returns: AuthTokens containing both access and refresh tokens with metadata
-	GenerateTokens(ctx context.Context, issuerName string, userID int64) (*AuthTokens, error)
+	GenerateTokens(ctx context.Context, issuerName string, userID int64, claims map[string]any) (*AuthTokens, error)
 
 	// RefreshTokens generates new tokens from a valid refresh token
 	// ctx: context for cancellation and timeout control
@@ -99,6 +118,23 @@ type TokenService interface {
 	// Returns: new AuthTokens pair if the refresh token is valid
 	RefreshTokens(ctx context.Context, refreshToken string) (*AuthTokens, error)
 
+	// GenerateImpersonationToken creates a time-limited access token for the subject user
+	// on behalf of the actor. No refresh token is issued.
+	// ctx: context for cancellation and timeout control
+	// issuerName: identifier for the token issuer (e.g., application name)
+	// actorID: identifier of the user impersonating the subject, carried in the actor claim
+	// subjectID: identifier of the impersonated user, used as the token subject
+	// duration: how long the token is valid
+	// claims: optional custom claims embedded in the token
+	// Returns: the access token and its metadata
+	GenerateImpersonationToken(
+		ctx context.Context,
+		issuerName string,
+		actorID, subjectID int64,
+		duration time.Duration,
+		claims map[string]any,
+	) (string, *TokenMetadata, error)
+
 	// ValidateToken validates any type of token and returns its claims
 	// ctx: context for cancellation and timeout control
 	// token: the token string to validate (can be access or refresh token)
@@ -110,6 +146,11 @@ type TokenService interface {
 	// token: the token string to revoke
 	// Returns: error if revocation fails
 	RevokeToken(ctx context.Context, token string) error
+
+	// PublicKeys returns the public keys that can validate issued tokens
+	// ctx: context for cancellation and timeout control
+	// Returns: the keys still accepted for validation, empty for symmetric signing
+	PublicKeys(ctx context.Context) ([]PublicKey, error)
 }
 
 // TokenServiceError represents domain-specific errors for token operations
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"todolist/internal/domain/shared"
@@ -52,18 +51,21 @@ type SuspiciousCriteria struct {
 }
 
 type userSecurityService struct {
-	userRepository      repository.UserRepository
-	userQueryRepository repository.UserQueryRepository
+	userRepository       repository.UserRepository
+	userQueryRepository  repository.UserQueryRepository
+	authorizationService AuthorizationService
 }
 
 // NewUserSecurityService creates a new instance.
 func NewUserSecurityService(
 	userRepository repository.UserRepository,
 	userQueryRepository repository.UserQueryRepository,
+	authorizationService AuthorizationService,
 ) UserSecurityService {
 	return &userSecurityService{
-		userRepository:      userRepository,
-		userQueryRepository: userQueryRepository,
+		userRepository:       userRepository,
+		userQueryRepository:  userQueryRepository,
+		authorizationService: authorizationService,
 	}
 }
 
@@ -81,11 +83,12 @@ func (s *userSecurityService) ValidateUserPermission(
 		return err
 	}
 
-	if !user.Role().HasPermission(permission) {
-		return errors.New("insufficient permissions")
+	required, err := uservo.ParsePermission(permission)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return s.authorizationService.Authorize(ctx, user.Role(), required)
 }
 
 func (s *userSecurityService) DeactivateInactiveUsers(
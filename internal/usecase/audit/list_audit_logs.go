@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	voAudit "todolist/internal/domain/audit/valueobject"
+	"todolist/internal/domain/shared"
+	"todolist/internal/dto"
+)
+
+var ErrInvalidTimeRange = errors.New("the start of the time range must not be after its end")
+
+// ListAuditLogsUseCase handles searching the audit log
+type ListAuditLogsUseCase interface {
+	Execute(ctx context.Context, filters voAudit.AuditFilterCriteria, options shared.QueryOptions) (*dto.AuditLogListResponse, error)
+}
+
+type listAuditLogsUseCase struct {
+	auditLogRepository rptAudit.AuditLogRepository
+}
+
+// NewListAuditLogsUseCase creates a new instance of ListAuditLogsUseCase
+func NewListAuditLogsUseCase(auditLogRepository rptAudit.AuditLogRepository) ListAuditLogsUseCase {
+	return &listAuditLogsUseCase{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+// Execute lists the audit entries matching the filters, newest first
+func (uc *listAuditLogsUseCase) Execute(
+	ctx context.Context,
+	filters voAudit.AuditFilterCriteria,
+	options shared.QueryOptions,
+) (*dto.AuditLogListResponse, error) {
+	if filters.From != nil && filters.To != nil && filters.From.After(*filters.To) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	auditLogs, err := uc.auditLogRepository.FindByFilters(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := uc.auditLogRepository.CountByFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.AuditLogListResponse{
+		Entries:    make([]*dto.AuditLogResponse, len(auditLogs)),
+		TotalCount: totalCount,
+		Page:       pageOf(options),
+		PageSize:   options.Limit,
+	}
+
+	for i, auditLog := range auditLogs {
+		response.Entries[i] = toAuditLogResponse(auditLog)
+	}
+
+	return response, nil
+}
+
+// toAuditLogResponse converts an audit entry to its DTO
+func toAuditLogResponse(auditLog *entAudit.AuditLog) *dto.AuditLogResponse {
+	return &dto.AuditLogResponse{
+		ID:             auditLog.ID(),
+		ActorID:        auditLog.ActorID(),
+		ImpersonatorID: auditLog.ImpersonatorID(),
+		EntityType:     auditLog.EntityType(),
+		EntityID:       auditLog.EntityID(),
+		Action:         auditLog.Action(),
+		OldValues:      auditLog.OldValues(),
+		NewValues:      auditLog.NewValues(),
+		IPAddress:      auditLog.IPAddress(),
+		UserAgent:      auditLog.UserAgent(),
+		CreatedAt:      auditLog.CreatedAt(),
+	}
+}
+
+// pageOf returns the page number for the query options
+func pageOf(options shared.QueryOptions) int {
+	if options.Limit <= 0 {
+		return 1
+	}
+	return options.Offset/options.Limit + 1
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	vo "todolist/internal/domain/board/valueobject"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+	rptUser "todolist/internal/domain/user/repository"
+	domainService "todolist/internal/domain/user/service"
+	"todolist/internal/dto"
+)
+
+var (
+	// ErrUnknownBoardMember is returned when a member of a board is not a user
+	ErrUnknownBoardMember = errors.New("board member is not a user")
+
+	// ErrUnknownBoardState is returned when a column state is in no workflow of the board users
+	ErrUnknownBoardState = errors.New("board column state is in no workflow of the board users")
+)
+
+// findBoard finds a board the user owns or is a member of. Other boards are
+// reported as ErrBoardNotFound.
+func findBoard(ctx context.Context, boardRepository repository.BoardRepository, userID, boardID int64) (*entity.Board, error) {
+	board, err := boardRepository.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, entity.ErrBoardNotFound
+		}
+		return nil, err
+	}
+
+	if !board.IsVisibleTo(userID) {
+		return nil, entity.ErrBoardNotFound
+	}
+
+	return board, nil
+}
+
+// boardTodoResource describes a todo of a board user for access checks. Todos on a
+// board are shared with its owner and members, granting the shared permissions.
+func boardTodoResource(board *entity.Board, todoUserID int64) domainService.Resource {
+	return domainService.Resource{
+		OwnerID:   todoUserID,
+		MemberIDs: board.UserIDs(),
+	}
+}
+
+// findOwnedBoard finds a board of the user, failing with ErrBoardOwnerOnly for the
+// boards the user is only a member of
+func findOwnedBoard(ctx context.Context, boardRepository repository.BoardRepository, userID, boardID int64) (*entity.Board, error) {
+	board, err := findBoard(ctx, boardRepository, userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !board.IsOwnedBy(userID) {
+		return nil, entity.ErrBoardOwnerOnly
+	}
+
+	return board, nil
+}
+
+// checkBoardMembers checks that the members of a board are users
+func checkBoardMembers(ctx context.Context, userRepository rptUser.UserRepository, memberIDs []int64) error {
+	for _, memberID := range memberIDs {
+		if _, err := userRepository.FindByID(ctx, memberID); err != nil {
+			if errors.Is(err, shared.ErrNotFound) {
+				return ErrUnknownBoardMember
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBoardStates checks that the states of the board columns are in the default
+// workflow or in the workflow of a project of the board users
+func checkBoardStates(ctx context.Context, projectRepository rptProject.ProjectRepository, board *entity.Board) error {
+	known := map[string]bool{}
+	for _, state := range todovo.DefaultWorkflow().States() {
+		known[state.Key()] = true
+	}
+
+	for _, userID := range board.UserIDs() {
+		projects, err := projectRepository.FindByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, project := range projects {
+			for _, state := range project.Workflow().States() {
+				known[state.Key()] = true
+			}
+		}
+	}
+
+	for _, column := range board.Columns() {
+		for _, state := range column.States() {
+			if !known[state] {
+				return fmt.Errorf("%w: %q", ErrUnknownBoardState, state)
+			}
+		}
+	}
+	return nil
+}
+
+// toBoardColumns converts the columns of a request to board columns
+func toBoardColumns(columns []dto.BoardColumnRequest) ([]vo.BoardColumn, error) {
+	boardColumns := make([]vo.BoardColumn, len(columns))
+	for i, column := range columns {
+		var err error
+		if boardColumns[i], err = vo.NewBoardColumn(column.Key, column.Name, column.Statuses, column.States, column.WIPLimit); err != nil {
+			return nil, err
+		}
+	}
+	return boardColumns, nil
+}
+
+// toBoardResponse converts a board to its response
+func toBoardResponse(board *entity.Board) *dto.BoardResponse {
+	response := &dto.BoardResponse{
+		ID:        board.ID(),
+		OwnerID:   board.UserID(),
+		Name:      board.Name(),
+		Columns:   []*dto.BoardColumnResponse{},
+		Swimlane:  board.Swimlane().String(),
+		MemberIDs: board.MemberIDs(),
+		CreatedAt: board.CreatedAt(),
+		UpdatedAt: board.UpdatedAt(),
+	}
+
+	for _, column := range board.Columns() {
+		statuses := []string{}
+		for _, status := range column.Statuses() {
+			statuses = append(statuses, status.String())
+		}
+
+		response.Columns = append(response.Columns, &dto.BoardColumnResponse{
+			Key:      column.Key(),
+			Name:     column.Name(),
+			Statuses: statuses,
+			States:   column.States(),
+			WIPLimit: column.WIPLimit(),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	vo "todolist/internal/domain/board/valueobject"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+// CreateBoardUseCase handles creating boards
+type CreateBoardUseCase interface {
+	Execute(ctx context.Context, userID int64, input dto.CreateBoardRequest) (*dto.BoardResponse, error)
+}
+
+type createBoardUseCase struct {
+	boardRepository   repository.BoardRepository
+	userRepository    rptUser.UserRepository
+	projectRepository rptProject.ProjectRepository
+	idGenerator       shared.IDGenerator
+}
+
+// NewCreateBoardUseCase creates a new instance of CreateBoardUseCase
+func NewCreateBoardUseCase(
+	boardRepository repository.BoardRepository,
+	userRepository rptUser.UserRepository,
+	projectRepository rptProject.ProjectRepository,
+	idGenerator shared.IDGenerator,
+) CreateBoardUseCase {
+	return &createBoardUseCase{
+		boardRepository:   boardRepository,
+		userRepository:    userRepository,
+		projectRepository: projectRepository,
+		idGenerator:       idGenerator,
+	}
+}
+
+// Execute creates a board owned by the user, with a column for each status when
+// no columns are given
+func (uc *createBoardUseCase) Execute(ctx context.Context, userID int64, input dto.CreateBoardRequest) (*dto.BoardResponse, error) {
+	columns := entity.DefaultBoardColumns()
+	if len(input.Columns) > 0 {
+		var err error
+		if columns, err = toBoardColumns(input.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	swimlane, err := vo.NewBoardSwimlane(input.Swimlane)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBoardMembers(ctx, uc.userRepository, input.MemberIDs); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := entity.NewBoard(id, userID, input.Name, columns, swimlane, input.MemberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBoardStates(ctx, uc.projectRepository, board); err != nil {
+		return nil, err
+	}
+
+	if err := uc.boardRepository.Save(ctx, board); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrBoardAlreadyExists
+		}
+		return nil, err
+	}
+
+	return toBoardResponse(board), nil
+}
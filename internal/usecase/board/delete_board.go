@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/board/repository"
+)
+
+// DeleteBoardUseCase handles deleting boards
+type DeleteBoardUseCase interface {
+	Execute(ctx context.Context, userID, boardID int64) error
+}
+
+type deleteBoardUseCase struct {
+	boardRepository repository.BoardRepository
+}
+
+// NewDeleteBoardUseCase creates a new instance of DeleteBoardUseCase
+func NewDeleteBoardUseCase(boardRepository repository.BoardRepository) DeleteBoardUseCase {
+	return &deleteBoardUseCase{
+		boardRepository: boardRepository,
+	}
+}
+
+// Execute deletes a board owned by the user, leaving its todos alone
+func (uc *deleteBoardUseCase) Execute(ctx context.Context, userID, boardID int64) error {
+	board, err := findOwnedBoard(ctx, uc.boardRepository, userID, boardID)
+	if err != nil {
+		return err
+	}
+
+	return uc.boardRepository.Delete(ctx, board.ID())
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	vo "todolist/internal/domain/board/valueobject"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	rptTodo "todolist/internal/domain/todo/repository"
+	rptUser "todolist/internal/domain/user/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+	ucTodo "todolist/internal/usecase/todo"
+)
+
+// maxBoardColumnTodos is the number of todos listed in each column, in their manual order
+const maxBoardColumnTodos = 200
+
+// priorityLanes are the keys of the priority swimlanes, in the order they are listed
+var priorityLanes = []sharedvo.Priority{
+	sharedvo.PriorityCritical,
+	sharedvo.PriorityHigh,
+	sharedvo.PriorityMedium,
+	sharedvo.PriorityLow,
+}
+
+// GetBoardUseCase handles retrieving a board with its todos
+type GetBoardUseCase interface {
+	Execute(ctx context.Context, userID, boardID int64) (*dto.BoardTodosResponse, error)
+}
+
+type getBoardUseCase struct {
+	boardRepository      repository.BoardRepository
+	todoQueryRepository  rptTodo.TodoQueryRepository
+	userRepository       rptUser.UserRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewGetBoardUseCase creates a new instance of GetBoardUseCase
+func NewGetBoardUseCase(
+	boardRepository repository.BoardRepository,
+	todoQueryRepository rptTodo.TodoQueryRepository,
+	userRepository rptUser.UserRepository,
+	authorizationService service.AuthorizationService,
+) GetBoardUseCase {
+	return &getBoardUseCase{
+		boardRepository:      boardRepository,
+		todoQueryRepository:  todoQueryRepository,
+		userRepository:       userRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute retrieves a board the user owns or is a member of, with the todos of
+// each column in their manual order.
+//
+// Only the todos of the board users whose todos the user can read are listed. WIP
+// limits apply to the todos of all the board users, so a column can be over its
+// limit with less todos listed.
+func (uc *getBoardUseCase) Execute(ctx context.Context, userID, boardID int64) (*dto.BoardTodosResponse, error) {
+	board, err := findBoard(ctx, uc.boardRepository, userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	readable, err := uc.readableUsers(ctx, userID, board)
+	if err != nil {
+		return nil, err
+	}
+
+	boardCounts, err := uc.todoQueryRepository.CountByUsersAndState(ctx, board.UserIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	readableCounts, err := uc.todoQueryRepository.CountByUsersAndState(ctx, readable)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BoardTodosResponse{
+		Board:   toBoardResponse(board),
+		Columns: []*dto.BoardColumnTodosResponse{},
+	}
+
+	options := shared.QueryOptions{
+		Limit: maxBoardColumnTodos,
+		Sort:  shared.Sort{{Key: "position"}},
+	}
+
+	for _, column := range board.Columns() {
+		todos, err := uc.todoQueryRepository.FindByUsersInColumn(ctx, readable, board.ColumnFilter(column), options)
+		if err != nil {
+			return nil, err
+		}
+
+		columnResponse := &dto.BoardColumnTodosResponse{
+			Key:       column.Key(),
+			Total:     board.ColumnCount(column, readableCounts),
+			OverLimit: column.IsOverLimit(board.ColumnCount(column, boardCounts)),
+			Todos:     make([]*dto.TodoResponse, len(todos)),
+		}
+		for i, todo := range todos {
+			columnResponse.Todos[i] = ucTodo.ToTodoResponse(todo)
+		}
+
+		response.Columns = append(response.Columns, columnResponse)
+	}
+
+	if board.Swimlane() != vo.BoardSwimlaneNone {
+		if response.Swimlanes, err = uc.swimlanes(ctx, board.Swimlane(), readable, response.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// readableUsers returns the board users whose todos the user can read
+func (uc *getBoardUseCase) readableUsers(ctx context.Context, userID int64, board *entity.Board) ([]int64, error) {
+	readable := []int64{}
+	for _, boardUserID := range board.UserIDs() {
+		err := uc.authorizationService.AuthorizeUser(ctx, userID, uservo.PermissionTodoRead, boardTodoResource(board, boardUserID))
+		if errors.Is(err, service.ErrPermissionDenied) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		readable = append(readable, boardUserID)
+	}
+	return readable, nil
+}
+
+// swimlanes splits the todos of the columns into swimlanes, listing every swimlane
+// even when it has no todos so the rows of the board stay the same
+func (uc *getBoardUseCase) swimlanes(
+	ctx context.Context,
+	swimlane vo.BoardSwimlane,
+	userIDs []int64,
+	columns []*dto.BoardColumnTodosResponse,
+) ([]*dto.BoardSwimlaneResponse, error) {
+	lanes := []*dto.BoardSwimlaneResponse{}
+	laneKey := func(todo *dto.TodoResponse) string { return todo.Priority }
+
+	switch swimlane {
+	case vo.BoardSwimlanePriority:
+		for _, priority := range priorityLanes {
+			lanes = append(lanes, &dto.BoardSwimlaneResponse{Key: priority.String(), Name: priority.String()})
+		}
+	case vo.BoardSwimlaneAssignee:
+		for _, userID := range userIDs {
+			user, err := uc.userRepository.FindByID(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			lanes = append(lanes, &dto.BoardSwimlaneResponse{Key: strconv.FormatInt(userID, 10), Name: user.Username()})
+		}
+		laneKey = func(todo *dto.TodoResponse) string { return strconv.FormatInt(todo.UserID, 10) }
+	}
+
+	for _, lane := range lanes {
+		for _, column := range columns {
+			cell := &dto.BoardCellResponse{Column: column.Key, TodoIDs: []int64{}}
+			for _, todo := range column.Todos {
+				if laneKey(todo) == lane.Key {
+					cell.TodoIDs = append(cell.TodoIDs, todo.ID)
+				}
+			}
+			lane.Cells = append(lane.Cells, cell)
+		}
+	}
+
+	return lanes, nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/board/repository"
+	"todolist/internal/dto"
+)
+
+// ListBoardsUseCase handles listing the boards of a user
+type ListBoardsUseCase interface {
+	Execute(ctx context.Context, userID int64) ([]*dto.BoardResponse, error)
+}
+
+type listBoardsUseCase struct {
+	boardRepository repository.BoardRepository
+}
+
+// NewListBoardsUseCase creates a new instance of ListBoardsUseCase
+func NewListBoardsUseCase(boardRepository repository.BoardRepository) ListBoardsUseCase {
+	return &listBoardsUseCase{
+		boardRepository: boardRepository,
+	}
+}
+
+// Execute lists the boards the user owns or is a member of
+func (uc *listBoardsUseCase) Execute(ctx context.Context, userID int64) ([]*dto.BoardResponse, error) {
+	boards, err := uc.boardRepository.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.BoardResponse, len(boards))
+	for i, board := range boards {
+		response[i] = toBoardResponse(board)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	vo "todolist/internal/domain/board/valueobject"
+	"todolist/internal/domain/shared"
+	todoEntity "todolist/internal/domain/todo/entity"
+	rptTodo "todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+	ucTodo "todolist/internal/usecase/todo"
+)
+
+// MoveBoardTodoUseCase handles moving todos between the columns of a board
+type MoveBoardTodoUseCase interface {
+	Execute(ctx context.Context, userID, boardID int64, input dto.MoveBoardTodoRequest) (*dto.TodoResponse, error)
+}
+
+type moveBoardTodoUseCase struct {
+	boardRepository      repository.BoardRepository
+	todoRepository       rptTodo.TodoRepository
+	todoQueryRepository  rptTodo.TodoQueryRepository
+	authorizationService service.AuthorizationService
+	transactionManager   shared.TransactionManager
+	moveTodoUseCase      ucTodo.MoveTodoUseCase
+}
+
+// NewMoveBoardTodoUseCase creates a new instance of MoveBoardTodoUseCase
+func NewMoveBoardTodoUseCase(
+	boardRepository repository.BoardRepository,
+	todoRepository rptTodo.TodoRepository,
+	todoQueryRepository rptTodo.TodoQueryRepository,
+	authorizationService service.AuthorizationService,
+	transactionManager shared.TransactionManager,
+	moveTodoUseCase ucTodo.MoveTodoUseCase,
+) MoveBoardTodoUseCase {
+	return &moveBoardTodoUseCase{
+		boardRepository:      boardRepository,
+		todoRepository:       todoRepository,
+		todoQueryRepository:  todoQueryRepository,
+		authorizationService: authorizationService,
+		transactionManager:   transactionManager,
+		moveTodoUseCase:      moveTodoUseCase,
+	}
+}
+
+// Execute moves a todo of a board user into a column of the board, changing it to
+// the first state of the column its workflow has, or else to the first status of
+// the column, unless it already is in the column. Todos are
+// only moved into columns below their WIP limit, failing with ErrWIPLimitReached
+// otherwise. The board is locked while its columns are counted, so concurrent
+// moves can not exceed the limit.
+//
+// When todos to place the todo between are given, the todo is also moved in the
+// manual order of its user, as by the todo move.
+func (uc *moveBoardTodoUseCase) Execute(
+	ctx context.Context,
+	userID, boardID int64,
+	input dto.MoveBoardTodoRequest,
+) (*dto.TodoResponse, error) {
+	board, err := findBoard(ctx, uc.boardRepository, userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	column, ok := board.Column(input.Column)
+	if !ok {
+		return nil, entity.ErrBoardColumnNotFound
+	}
+
+	todo, err := uc.todoRepository.FindByID(ctx, input.TodoID)
+	if err != nil || !slices.Contains(board.UserIDs(), todo.UserID()) {
+		return nil, shared.ErrNotFound
+	}
+
+	err = uc.authorizationService.AuthorizeUser(ctx, userID, uservo.PermissionTodoUpdate, boardTodoResource(board, todo.UserID()))
+	if errors.Is(err, service.ErrPermissionDenied) {
+		return nil, todoEntity.ErrUnauthorizedTodoAccess
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var response *dto.TodoResponse
+	err = uc.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if current, ok := board.ColumnOf(todo.Status(), todo.State()); !ok || current.Key() != column.Key() {
+			if err := uc.boardRepository.Lock(ctx, board.ID()); err != nil {
+				return err
+			}
+
+			counts, err := uc.todoQueryRepository.CountByUsersAndState(ctx, board.UserIDs())
+			if err != nil {
+				return err
+			}
+
+			if !column.HasRoomFor(board.ColumnCount(column, counts)) {
+				return entity.ErrWIPLimitReached
+			}
+
+			if err := enterColumn(todo, column); err != nil {
+				return err
+			}
+
+			// A state held by another column takes precedence over the column statuses
+			if placed, ok := board.ColumnOf(todo.Status(), todo.State()); !ok || placed.Key() != column.Key() {
+				return entity.ErrColumnUnreachable
+			}
+
+			if err := uc.todoRepository.Save(ctx, todo); err != nil {
+				return err
+			}
+		}
+
+		if input.AfterID == nil && input.BeforeID == nil {
+			response = ucTodo.ToTodoResponse(todo)
+			return nil
+		}
+
+		response, err = uc.moveTodoUseCase.Execute(ctx, userID, todo.ID(), dto.MoveTodoRequest{
+			AfterID:  input.AfterID,
+			BeforeID: input.BeforeID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// enterColumn moves a todo into the first state of the column its workflow has,
+// or else into the first status of the column
+func enterColumn(todo *todoEntity.Todo, column vo.BoardColumn) error {
+	workflow := todo.Workflow()
+	for _, state := range column.States() {
+		if _, ok := workflow.State(state); ok {
+			return todo.TransitionTo(state, "")
+		}
+	}
+
+	status, ok := column.EntryStatus()
+	if !ok {
+		return entity.ErrColumnUnreachable
+	}
+	return todo.ChangeStatus(status)
+}
@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	vo "todolist/internal/domain/board/valueobject"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	todoEntity "todolist/internal/domain/todo/entity"
+	rptTodo "todolist/internal/domain/todo/repository"
+	todovo "todolist/internal/domain/todo/valueobject"
+	userEntity "todolist/internal/domain/user/entity"
+	domainService "todolist/internal/domain/user/service"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// moveCalls records the repository calls of a board move in order
+type moveCalls []string
+
+type fakeBoardRepository struct {
+	repository.BoardRepository
+	board *entity.Board
+	calls *moveCalls
+}
+
+func (f *fakeBoardRepository) FindByID(ctx context.Context, id int64) (*entity.Board, error) {
+	if f.board == nil || f.board.ID() != id {
+		return nil, shared.ErrNotFound
+	}
+	return f.board, nil
+}
+
+func (f *fakeBoardRepository) Lock(ctx context.Context, id int64) error {
+	*f.calls = append(*f.calls, "lock")
+	return nil
+}
+
+type fakeTodoRepository struct {
+	rptTodo.TodoRepository
+	todo  *todoEntity.Todo
+	calls *moveCalls
+}
+
+func (f *fakeTodoRepository) FindByID(ctx context.Context, id int64) (*todoEntity.Todo, error) {
+	if f.todo == nil || f.todo.ID() != id {
+		return nil, shared.ErrNotFound
+	}
+	return f.todo, nil
+}
+
+func (f *fakeTodoRepository) Save(ctx context.Context, todo *todoEntity.Todo) error {
+	*f.calls = append(*f.calls, "save")
+	return nil
+}
+
+type fakeTodoQueryRepository struct {
+	rptTodo.TodoQueryRepository
+	counts map[todovo.TodoStateKey]int64
+	calls  *moveCalls
+}
+
+func (f *fakeTodoQueryRepository) CountByUsersAndState(ctx context.Context, userIDs []int64) (map[todovo.TodoStateKey]int64, error) {
+	*f.calls = append(*f.calls, "count")
+	return f.counts, nil
+}
+
+// fakeAuthorizationService authorizes every user with the default permissions of plain users
+type fakeAuthorizationService struct {
+	service.AuthorizationService
+}
+
+func (f *fakeAuthorizationService) AuthorizeUser(
+	ctx context.Context,
+	userID int64,
+	permission uservo.Permission,
+	resource domainService.Resource,
+) error {
+	role, err := userEntity.NewRole(0, uservo.RoleUser, "", domainService.DefaultRolePermissions()[uservo.RoleUser])
+	if err != nil {
+		return err
+	}
+	if !domainService.NewAccessPolicy(role).CanAccess(uservo.RoleUser, userID, permission, resource) {
+		return service.ErrPermissionDenied
+	}
+	return nil
+}
+
+type fakeTransactionManager struct{}
+
+func (fakeTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestMoveBoardTodoUseCase_Execute(t *testing.T) {
+	doing, err := vo.NewBoardColumn("doing", "Doing", []string{"in_progress"}, nil, 2)
+	if err != nil {
+		t.Fatalf("NewBoardColumn() error = %v", err)
+	}
+	columns := append(entity.DefaultBoardColumns()[:1], doing)
+
+	tests := []struct {
+		name       string
+		status     todovo.TodoStatus
+		counts     map[todovo.TodoStateKey]int64
+		wantErr    error
+		wantStatus todovo.TodoStatus
+		wantCalls  moveCalls
+	}{
+		{
+			name:       "column below its limit",
+			status:     todovo.StatusPending,
+			counts:     map[todovo.TodoStateKey]int64{{Status: todovo.StatusInProgress, State: "in_progress"}: 1},
+			wantStatus: todovo.StatusInProgress,
+			wantCalls:  moveCalls{"lock", "count", "save"},
+		},
+		{
+			name:       "column at its limit",
+			status:     todovo.StatusPending,
+			counts:     map[todovo.TodoStateKey]int64{{Status: todovo.StatusInProgress, State: "in_progress"}: 2},
+			wantErr:    entity.ErrWIPLimitReached,
+			wantStatus: todovo.StatusPending,
+			wantCalls:  moveCalls{"lock", "count"},
+		},
+		{
+			name:       "todo already in the column",
+			status:     todovo.StatusInProgress,
+			counts:     map[todovo.TodoStateKey]int64{{Status: todovo.StatusInProgress, State: "in_progress"}: 5},
+			wantStatus: todovo.StatusInProgress,
+			wantCalls:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board, err := entity.NewBoard(1, 10, "Team", columns, vo.BoardSwimlaneNone, nil)
+			if err != nil {
+				t.Fatalf("NewBoard() error = %v", err)
+			}
+
+			title, _ := todovo.NewTodoTitle("Todo")
+			todo, err := todoEntity.NewTodo(5, 10, title, todovo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+			if err != nil {
+				t.Fatalf("NewTodo() error = %v", err)
+			}
+			if tt.status != todovo.StatusPending {
+				if err := todo.ChangeStatus(tt.status); err != nil {
+					t.Fatalf("ChangeStatus() error = %v", err)
+				}
+			}
+
+			var calls moveCalls
+			uc := NewMoveBoardTodoUseCase(
+				&fakeBoardRepository{board: board, calls: &calls},
+				&fakeTodoRepository{todo: todo, calls: &calls},
+				&fakeTodoQueryRepository{counts: tt.counts, calls: &calls},
+				&fakeAuthorizationService{},
+				fakeTransactionManager{},
+				nil,
+			)
+
+			_, err = uc.Execute(context.Background(), 10, 1, dto.MoveBoardTodoRequest{TodoID: 5, Column: "doing"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if todo.Status() != tt.wantStatus {
+				t.Errorf("Expected status %v, got %v", tt.wantStatus, todo.Status())
+			}
+			if !slices.Equal(calls, tt.wantCalls) {
+				t.Errorf("Expected calls %v, got %v", tt.wantCalls, calls)
+			}
+		})
+	}
+
+	t.Run("board member moving a todo of the owner", func(t *testing.T) {
+		board, _ := entity.NewBoard(1, 10, "Team", columns, vo.BoardSwimlaneNone, []int64{11})
+		title, _ := todovo.NewTodoTitle("Todo")
+		todo, _ := todoEntity.NewTodo(5, 10, title, todovo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+
+		var calls moveCalls
+		uc := NewMoveBoardTodoUseCase(
+			&fakeBoardRepository{board: board, calls: &calls},
+			&fakeTodoRepository{todo: todo, calls: &calls},
+			&fakeTodoQueryRepository{calls: &calls},
+			&fakeAuthorizationService{},
+			fakeTransactionManager{},
+			nil,
+		)
+
+		// Todos on a board are shared with its members
+		if _, err := uc.Execute(context.Background(), 11, 1, dto.MoveBoardTodoRequest{TodoID: 5, Column: "doing"}); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if todo.Status() != todovo.StatusInProgress {
+			t.Errorf("Expected status %v, got %v", todovo.StatusInProgress, todo.Status())
+		}
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		board, _ := entity.NewBoard(1, 10, "Team", columns, vo.BoardSwimlaneNone, nil)
+		var calls moveCalls
+		uc := NewMoveBoardTodoUseCase(
+			&fakeBoardRepository{board: board, calls: &calls},
+			&fakeTodoRepository{calls: &calls},
+			&fakeTodoQueryRepository{calls: &calls},
+			&fakeAuthorizationService{},
+			fakeTransactionManager{},
+			nil,
+		)
+
+		_, err := uc.Execute(context.Background(), 10, 1, dto.MoveBoardTodoRequest{TodoID: 5, Column: "qa"})
+		if !errors.Is(err, entity.ErrBoardColumnNotFound) {
+			t.Errorf("Expected ErrBoardColumnNotFound, got %v", err)
+		}
+	})
+}
+
+func TestMoveBoardTodoUseCase_Execute_States(t *testing.T) {
+	state := func(key, category string) todovo.WorkflowState {
+		s, err := todovo.NewWorkflowState(key, key, category, nil)
+		if err != nil {
+			t.Fatalf("NewWorkflowState(%q) error = %v", key, err)
+		}
+		return s
+	}
+	workflow, err := todovo.NewWorkflow(
+		[]todovo.WorkflowState{state("open", "open"), state("doing", "in_progress"), state("review", "in_progress")},
+		map[string][]string{"open": {"doing"}, "doing": {"review"}, "review": {"doing"}},
+	)
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+
+	review, _ := vo.NewBoardColumn("review", "Review", nil, []string{"review"}, 1)
+	columns := append(entity.DefaultBoardColumns()[:2], review)
+
+	tests := []struct {
+		name      string
+		workflow  bool
+		counts    map[todovo.TodoStateKey]int64
+		wantErr   error
+		wantState string
+	}{
+		{
+			name:      "todo entering the state of the column",
+			workflow:  true,
+			counts:    map[todovo.TodoStateKey]int64{{Status: todovo.StatusInProgress, State: "doing"}: 3},
+			wantState: "review",
+		},
+		{
+			name:      "column at its limit",
+			workflow:  true,
+			counts:    map[todovo.TodoStateKey]int64{{Status: todovo.StatusInProgress, State: "review"}: 1},
+			wantErr:   entity.ErrWIPLimitReached,
+			wantState: "doing",
+		},
+		{
+			name:      "workflow without the state of the column",
+			workflow:  false,
+			wantErr:   entity.ErrColumnUnreachable,
+			wantState: "in_progress",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board, _ := entity.NewBoard(1, 10, "Team", columns, vo.BoardSwimlaneNone, nil)
+
+			title, _ := todovo.NewTodoTitle("Todo")
+			todo, _ := todoEntity.NewTodo(5, 10, title, todovo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+			if tt.workflow {
+				todo.SetPersistedWorkflow(3, workflow, "doing", "")
+				todo.SetPersistedState(todovo.StatusInProgress, nil, nil, nil)
+			} else if err := todo.ChangeStatus(todovo.StatusInProgress); err != nil {
+				t.Fatalf("ChangeStatus() error = %v", err)
+			}
+
+			var calls moveCalls
+			uc := NewMoveBoardTodoUseCase(
+				&fakeBoardRepository{board: board, calls: &calls},
+				&fakeTodoRepository{todo: todo, calls: &calls},
+				&fakeTodoQueryRepository{counts: tt.counts, calls: &calls},
+				&fakeAuthorizationService{},
+				fakeTransactionManager{},
+				nil,
+			)
+
+			_, err := uc.Execute(context.Background(), 10, 1, dto.MoveBoardTodoRequest{TodoID: 5, Column: "review"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if todo.State() != tt.wantState {
+				t.Errorf("Expected state %q, got %q", tt.wantState, todo.State())
+			}
+			if saved := slices.Contains(calls, "save"); saved != (tt.wantErr == nil) {
+				t.Errorf("Expected the todo to be saved only on success, got calls %v", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	vo "todolist/internal/domain/board/valueobject"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+// UpdateBoardUseCase handles updates of boards
+type UpdateBoardUseCase interface {
+	Execute(ctx context.Context, userID, boardID int64, input dto.UpdateBoardRequest) (*dto.BoardResponse, error)
+}
+
+type updateBoardUseCase struct {
+	boardRepository   repository.BoardRepository
+	userRepository    rptUser.UserRepository
+	projectRepository rptProject.ProjectRepository
+}
+
+// NewUpdateBoardUseCase creates a new instance of UpdateBoardUseCase
+func NewUpdateBoardUseCase(
+	boardRepository repository.BoardRepository,
+	userRepository rptUser.UserRepository,
+	projectRepository rptProject.ProjectRepository,
+) UpdateBoardUseCase {
+	return &updateBoardUseCase{
+		boardRepository:   boardRepository,
+		userRepository:    userRepository,
+		projectRepository: projectRepository,
+	}
+}
+
+// Execute changes the given fields of a board owned by the user. A swimlane of
+// "none" removes the swimlanes, and an empty list of members removes the members.
+func (uc *updateBoardUseCase) Execute(
+	ctx context.Context,
+	userID, boardID int64,
+	input dto.UpdateBoardRequest,
+) (*dto.BoardResponse, error) {
+	board, err := findOwnedBoard(ctx, uc.boardRepository, userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		if err := board.Rename(*input.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Columns != nil {
+		columns, err := toBoardColumns(input.Columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := board.SetColumns(columns); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Swimlane != nil {
+		value := *input.Swimlane
+		if value == "none" {
+			value = ""
+		}
+
+		swimlane, err := vo.NewBoardSwimlane(value)
+		if err != nil {
+			return nil, err
+		}
+		board.SetSwimlane(swimlane)
+	}
+
+	if input.MemberIDs != nil {
+		if err := checkBoardMembers(ctx, uc.userRepository, input.MemberIDs); err != nil {
+			return nil, err
+		}
+		if err := board.SetMembers(input.MemberIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// States are checked against the workflows of the members the board ends up with
+	if input.Columns != nil {
+		if err := checkBoardStates(ctx, uc.projectRepository, board); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.boardRepository.Save(ctx, board); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrBoardAlreadyExists
+		}
+		return nil, err
+	}
+
+	return toBoardResponse(board), nil
+}
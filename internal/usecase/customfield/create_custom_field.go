@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/customfield/entity"
+	"todolist/internal/domain/customfield/repository"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/dto"
+	ucProject "todolist/internal/usecase/project"
+)
+
+// CreateCustomFieldUseCase handles creating custom fields
+type CreateCustomFieldUseCase interface {
+	Execute(ctx context.Context, userID int64, input dto.CreateCustomFieldRequest) (*dto.CustomFieldResponse, error)
+}
+
+type createCustomFieldUseCase struct {
+	customFieldRepository repository.CustomFieldRepository
+	projectRepository     rptProject.ProjectRepository
+	idGenerator           shared.IDGenerator
+}
+
+// NewCreateCustomFieldUseCase creates a new instance of CreateCustomFieldUseCase
+func NewCreateCustomFieldUseCase(
+	customFieldRepository repository.CustomFieldRepository,
+	projectRepository rptProject.ProjectRepository,
+	idGenerator shared.IDGenerator,
+) CreateCustomFieldUseCase {
+	return &createCustomFieldUseCase{
+		customFieldRepository: customFieldRepository,
+		projectRepository:     projectRepository,
+		idGenerator:           idGenerator,
+	}
+}
+
+// Execute creates a custom field of the user's todos, or of the todos of one of the
+// user's projects. Keys are unique per user.
+func (uc *createCustomFieldUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	input dto.CreateCustomFieldRequest,
+) (*dto.CustomFieldResponse, error) {
+	fieldType, err := todovo.NewCustomFieldType(input.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectID int64
+	if input.ProjectID != nil {
+		project, err := ucProject.FindProject(ctx, uc.projectRepository, userID, *input.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		projectID = project.ID()
+	}
+
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	field, err := entity.NewCustomField(id, userID, projectID, input.Key, input.Name, fieldType, input.Options, input.Required)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.customFieldRepository.Save(ctx, field); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrCustomFieldAlreadyExists
+		}
+		return nil, err
+	}
+
+	return toCustomFieldResponse(field), nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/customfield/entity"
+	"todolist/internal/domain/customfield/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/dto"
+)
+
+// findCustomField finds a custom field of the user. Fields of other users are
+// reported as ErrCustomFieldNotFound.
+func findCustomField(ctx context.Context, customFieldRepository repository.CustomFieldRepository, userID, fieldID int64) (*entity.CustomField, error) {
+	field, err := customFieldRepository.FindByID(ctx, fieldID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, entity.ErrCustomFieldNotFound
+		}
+		return nil, err
+	}
+
+	if !field.IsOwnedBy(userID) {
+		return nil, entity.ErrCustomFieldNotFound
+	}
+
+	return field, nil
+}
+
+// toCustomFieldResponse converts a custom field to its response
+func toCustomFieldResponse(field *entity.CustomField) *dto.CustomFieldResponse {
+	response := &dto.CustomFieldResponse{
+		ID:        field.ID(),
+		Key:       field.Key(),
+		Name:      field.Name(),
+		Type:      field.Type().String(),
+		Options:   field.Options(),
+		Required:  field.IsRequired(),
+		CreatedAt: field.CreatedAt(),
+		UpdatedAt: field.UpdatedAt(),
+	}
+
+	if projectID := field.ProjectID(); projectID != 0 {
+		response.ProjectID = &projectID
+	}
+
+	return response
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/customfield/repository"
+)
+
+// DeleteCustomFieldUseCase handles deleting custom fields
+type DeleteCustomFieldUseCase interface {
+	Execute(ctx context.Context, userID, fieldID int64) error
+}
+
+type deleteCustomFieldUseCase struct {
+	customFieldRepository repository.CustomFieldRepository
+}
+
+// NewDeleteCustomFieldUseCase creates a new instance of DeleteCustomFieldUseCase
+func NewDeleteCustomFieldUseCase(customFieldRepository repository.CustomFieldRepository) DeleteCustomFieldUseCase {
+	return &deleteCustomFieldUseCase{
+		customFieldRepository: customFieldRepository,
+	}
+}
+
+// Execute deletes a custom field of the user along with its values on todos
+func (uc *deleteCustomFieldUseCase) Execute(ctx context.Context, userID, fieldID int64) error {
+	field, err := findCustomField(ctx, uc.customFieldRepository, userID, fieldID)
+	if err != nil {
+		return err
+	}
+
+	return uc.customFieldRepository.Delete(ctx, field.ID())
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/customfield/repository"
+	"todolist/internal/dto"
+)
+
+// GetCustomFieldUseCase handles getting a custom field
+type GetCustomFieldUseCase interface {
+	Execute(ctx context.Context, userID, fieldID int64) (*dto.CustomFieldResponse, error)
+}
+
+type getCustomFieldUseCase struct {
+	customFieldRepository repository.CustomFieldRepository
+}
+
+// NewGetCustomFieldUseCase creates a new instance of GetCustomFieldUseCase
+func NewGetCustomFieldUseCase(customFieldRepository repository.CustomFieldRepository) GetCustomFieldUseCase {
+	return &getCustomFieldUseCase{
+		customFieldRepository: customFieldRepository,
+	}
+}
+
+// Execute gets a custom field of the user
+func (uc *getCustomFieldUseCase) Execute(ctx context.Context, userID, fieldID int64) (*dto.CustomFieldResponse, error) {
+	field, err := findCustomField(ctx, uc.customFieldRepository, userID, fieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomFieldResponse(field), nil
+}
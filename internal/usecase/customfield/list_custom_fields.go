@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/customfield/repository"
+	"todolist/internal/dto"
+)
+
+// ListCustomFieldsUseCase handles listing the custom fields of a user
+type ListCustomFieldsUseCase interface {
+	Execute(ctx context.Context, userID int64) ([]*dto.CustomFieldResponse, error)
+}
+
+type listCustomFieldsUseCase struct {
+	customFieldRepository repository.CustomFieldRepository
+}
+
+// NewListCustomFieldsUseCase creates a new instance of ListCustomFieldsUseCase
+func NewListCustomFieldsUseCase(customFieldRepository repository.CustomFieldRepository) ListCustomFieldsUseCase {
+	return &listCustomFieldsUseCase{
+		customFieldRepository: customFieldRepository,
+	}
+}
+
+// Execute lists the custom fields of the user, those of all the user's todos first
+func (uc *listCustomFieldsUseCase) Execute(ctx context.Context, userID int64) ([]*dto.CustomFieldResponse, error) {
+	fields, err := uc.customFieldRepository.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.CustomFieldResponse, len(fields))
+	for i, field := range fields {
+		response[i] = toCustomFieldResponse(field)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/customfield/repository"
+	"todolist/internal/dto"
+)
+
+// UpdateCustomFieldUseCase handles updates of custom fields
+type UpdateCustomFieldUseCase interface {
+	Execute(ctx context.Context, userID, fieldID int64, input dto.UpdateCustomFieldRequest) (*dto.CustomFieldResponse, error)
+}
+
+type updateCustomFieldUseCase struct {
+	customFieldRepository repository.CustomFieldRepository
+}
+
+// NewUpdateCustomFieldUseCase creates a new instance of UpdateCustomFieldUseCase
+func NewUpdateCustomFieldUseCase(customFieldRepository repository.CustomFieldRepository) UpdateCustomFieldUseCase {
+	return &updateCustomFieldUseCase{
+		customFieldRepository: customFieldRepository,
+	}
+}
+
+// Execute changes the given fields of a custom field of the user. The options of
+// select fields can only be replaced by ones keeping the options chosen by todos.
+// Making a field required does not change the todos without value, only their
+// later updates.
+func (uc *updateCustomFieldUseCase) Execute(
+	ctx context.Context,
+	userID, fieldID int64,
+	input dto.UpdateCustomFieldRequest,
+) (*dto.CustomFieldResponse, error) {
+	field, err := findCustomField(ctx, uc.customFieldRepository, userID, fieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		if err := field.Rename(*input.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Options != nil {
+		optionsInUse, err := uc.customFieldRepository.FindOptionsInUse(ctx, field.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := field.ChangeOptions(input.Options, optionsInUse); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Required != nil {
+		field.SetRequired(*input.Required)
+	}
+
+	if err := uc.customFieldRepository.Save(ctx, field); err != nil {
+		return nil, err
+	}
+
+	return toCustomFieldResponse(field), nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	domainService "todolist/internal/domain/user/service"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/service"
+)
+
+// authorizePerson checks if the user holds the permission on the person.
+// The owner of a person is the user account linked to it. Denied access is
+// reported as ErrNotFound so the existence of other people is not disclosed.
+func authorizePerson(
+	ctx context.Context,
+	userRepository rptUser.UserRepository,
+	authorizationService service.AuthorizationService,
+	userID, personID int64,
+	permission uservo.Permission,
+) error {
+	resource := domainService.Resource{}
+
+	owner, err := userRepository.FindByPersonID(ctx, personID)
+	switch {
+	case err == nil:
+		resource.OwnerID = owner.ID()
+	case !errors.Is(err, shared.ErrNotFound):
+		return err
+	}
+
+	err = authorizationService.AuthorizeUser(ctx, userID, permission, resource)
+	if errors.Is(err, service.ErrPermissionDenied) {
+		return shared.ErrNotFound
+	}
+	return err
+}
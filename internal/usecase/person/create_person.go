@@ -2,10 +2,10 @@ package usecase
 
 import (
 	"context"
-	"time"
 	"todolist/internal/domain/person/entity"
 	"todolist/internal/domain/person/repository"
 	vo "todolist/internal/domain/person/valueobject"
+	"todolist/internal/domain/shared"
 	sharedvo "todolist/internal/domain/shared/valueobject"
 	"todolist/internal/dto"
 )
@@ -17,12 +17,17 @@ type CreatePersonUseCase interface {
 
 type createPersonUseCase struct {
 	personRepository repository.PersonRepository
+	idGenerator      shared.IDGenerator
 }
 
 // NewCreatePersonUseCase creates a new instance of CreatePersonUseCase
-func NewCreatePersonUseCase(personRepository repository.PersonRepository) CreatePersonUseCase {
+func NewCreatePersonUseCase(
+	personRepository repository.PersonRepository,
+	idGenerator shared.IDGenerator,
+) CreatePersonUseCase {
 	return &createPersonUseCase{
 		personRepository: personRepository,
+		idGenerator:      idGenerator,
 	}
 }
 
@@ -63,8 +68,13 @@ func (uc *createPersonUseCase) Execute(ctx context.Context, input dto.CreatePers
 	}
 
 	// Create person entity
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	person, err := entity.NewPerson(
-		time.Now().Unix(),
+		id,
 		input.Name,
 		input.Phone,
 		taxID,
@@ -95,5 +105,6 @@ func toPersonResponse(person *entity.Person) *dto.PersonResponse {
 		Phone:     person.Phone(),
 		CreatedAt: person.CreatedAt().Format("2006-01-02T15:04:05Z"),
 		UpdatedAt: person.UpdatedAt().Format("2006-01-02T15:04:05Z"),
+		Version:   person.LockVersion(),
 	}
 }
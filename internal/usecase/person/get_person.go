@@ -4,31 +4,47 @@ import (
 	"context"
 	"todolist/internal/domain/person/repository"
 	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	uservo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
+	"todolist/internal/service"
 )
 
 // GetPersonUseCase handles retrieving a person
 type GetPersonUseCase interface {
-	Execute(ctx context.Context, personID int64) (*dto.PersonResponse, error)
+	Execute(ctx context.Context, userID, personID int64) (*dto.PersonResponse, error)
 }
 
 type getPersonUseCase struct {
-	personRepository repository.PersonRepository
+	personRepository     repository.PersonRepository
+	userRepository       rptUser.UserRepository
+	authorizationService service.AuthorizationService
 }
 
 // NewGetPersonUseCase creates a new instance of GetPersonUseCase
-func NewGetPersonUseCase(personRepository repository.PersonRepository) GetPersonUseCase {
+func NewGetPersonUseCase(
+	personRepository repository.PersonRepository,
+	userRepository rptUser.UserRepository,
+	authorizationService service.AuthorizationService,
+) GetPersonUseCase {
 	return &getPersonUseCase{
-		personRepository: personRepository,
+		personRepository:     personRepository,
+		userRepository:       userRepository,
+		authorizationService: authorizationService,
 	}
 }
 
 // Execute retrieves a person by ID
-func (uc *getPersonUseCase) Execute(ctx context.Context, personID int64) (*dto.PersonResponse, error) {
+func (uc *getPersonUseCase) Execute(ctx context.Context, userID, personID int64) (*dto.PersonResponse, error) {
 	person, err := uc.personRepository.FindByID(ctx, personID)
 	if err != nil {
 		return nil, shared.ErrNotFound
 	}
 
+	// Validate user access
+	if err := authorizePerson(ctx, uc.userRepository, uc.authorizationService, userID, personID, uservo.PermissionPersonRead); err != nil {
+		return nil, err
+	}
+
 	return toPersonResponse(person), nil
 }
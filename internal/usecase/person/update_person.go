@@ -5,33 +5,60 @@ import (
 	"todolist/internal/domain/person/repository"
 	vo "todolist/internal/domain/person/valueobject"
 	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	uservo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
+	"todolist/internal/service"
 )
 
 // UpdatePersonUseCase handles person updates
 type UpdatePersonUseCase interface {
-	Execute(ctx context.Context, personID int64, input dto.UpdatePersonRequest) (*dto.PersonResponse, error)
+	Execute(ctx context.Context, userID, personID int64, input dto.UpdatePersonRequest, expectedVersions []int64) (*dto.PersonResponse, error)
 }
 
 type updatePersonUseCase struct {
-	personRepository repository.PersonRepository
+	personRepository     repository.PersonRepository
+	userRepository       rptUser.UserRepository
+	authorizationService service.AuthorizationService
 }
 
 // NewUpdatePersonUseCase creates a new instance of UpdatePersonUseCase
-func NewUpdatePersonUseCase(personRepository repository.PersonRepository) UpdatePersonUseCase {
+func NewUpdatePersonUseCase(
+	personRepository repository.PersonRepository,
+	userRepository rptUser.UserRepository,
+	authorizationService service.AuthorizationService,
+) UpdatePersonUseCase {
 	return &updatePersonUseCase{
-		personRepository: personRepository,
+		personRepository:     personRepository,
+		userRepository:       userRepository,
+		authorizationService: authorizationService,
 	}
 }
 
-// Execute updates a person
-func (uc *updatePersonUseCase) Execute(ctx context.Context, personID int64, input dto.UpdatePersonRequest) (*dto.PersonResponse, error) {
+// Execute updates a person, failing with shared.ErrOptimisticLock when expected
+// versions are given and the person has none of them
+func (uc *updatePersonUseCase) Execute(
+	ctx context.Context,
+	userID, personID int64,
+	input dto.UpdatePersonRequest,
+	expectedVersions []int64,
+) (*dto.PersonResponse, error) {
 	// Get the person
 	person, err := uc.personRepository.FindByID(ctx, personID)
 	if err != nil {
 		return nil, shared.ErrNotFound
 	}
 
+	// Validate user access
+	if err := authorizePerson(ctx, uc.userRepository, uc.authorizationService, userID, personID, uservo.PermissionPersonUpdate); err != nil {
+		return nil, err
+	}
+
+	// Validate the person was not changed since the client read it
+	if err := person.CheckLockVersion(expectedVersions); err != nil {
+		return nil, err
+	}
+
 	// Update email if provided
 	if input.Email != nil {
 		// Check if new email already exists
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/project/entity"
+	"todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/dto"
+)
+
+// CreateProjectUseCase handles creating projects
+type CreateProjectUseCase interface {
+	Execute(ctx context.Context, userID int64, input dto.CreateProjectRequest) (*dto.ProjectResponse, error)
+}
+
+type createProjectUseCase struct {
+	projectRepository repository.ProjectRepository
+	idGenerator       shared.IDGenerator
+}
+
+// NewCreateProjectUseCase creates a new instance of CreateProjectUseCase
+func NewCreateProjectUseCase(projectRepository repository.ProjectRepository, idGenerator shared.IDGenerator) CreateProjectUseCase {
+	return &createProjectUseCase{
+		projectRepository: projectRepository,
+		idGenerator:       idGenerator,
+	}
+}
+
+// Execute creates a project of the user, following the default workflow when no
+// workflow is given
+func (uc *createProjectUseCase) Execute(ctx context.Context, userID int64, input dto.CreateProjectRequest) (*dto.ProjectResponse, error) {
+	workflow, err := toWorkflow(input.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := entity.NewProject(id, userID, input.Name, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.projectRepository.Save(ctx, project); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrProjectAlreadyExists
+		}
+		return nil, err
+	}
+
+	return toProjectResponse(project), nil
+}
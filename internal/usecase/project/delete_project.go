@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/project/repository"
+)
+
+// DeleteProjectUseCase handles deleting projects
+type DeleteProjectUseCase interface {
+	Execute(ctx context.Context, userID, projectID int64) error
+}
+
+type deleteProjectUseCase struct {
+	projectRepository repository.ProjectRepository
+}
+
+// NewDeleteProjectUseCase creates a new instance of DeleteProjectUseCase
+func NewDeleteProjectUseCase(projectRepository repository.ProjectRepository) DeleteProjectUseCase {
+	return &deleteProjectUseCase{
+		projectRepository: projectRepository,
+	}
+}
+
+// Execute deletes a project of the user, its todos moving to the default workflow
+func (uc *deleteProjectUseCase) Execute(ctx context.Context, userID, projectID int64) error {
+	project, err := FindProject(ctx, uc.projectRepository, userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	return uc.projectRepository.Delete(ctx, project.ID())
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/project/repository"
+	"todolist/internal/dto"
+)
+
+// GetProjectUseCase handles getting a project
+type GetProjectUseCase interface {
+	Execute(ctx context.Context, userID, projectID int64) (*dto.ProjectResponse, error)
+}
+
+type getProjectUseCase struct {
+	projectRepository repository.ProjectRepository
+}
+
+// NewGetProjectUseCase creates a new instance of GetProjectUseCase
+func NewGetProjectUseCase(projectRepository repository.ProjectRepository) GetProjectUseCase {
+	return &getProjectUseCase{
+		projectRepository: projectRepository,
+	}
+}
+
+// Execute gets a project of the user with its workflow
+func (uc *getProjectUseCase) Execute(ctx context.Context, userID, projectID int64) (*dto.ProjectResponse, error) {
+	project, err := FindProject(ctx, uc.projectRepository, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProjectResponse(project), nil
+}
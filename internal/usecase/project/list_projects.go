@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/project/repository"
+	"todolist/internal/dto"
+)
+
+// ListProjectsUseCase handles listing the projects of a user
+type ListProjectsUseCase interface {
+	Execute(ctx context.Context, userID int64) ([]*dto.ProjectResponse, error)
+}
+
+type listProjectsUseCase struct {
+	projectRepository repository.ProjectRepository
+}
+
+// NewListProjectsUseCase creates a new instance of ListProjectsUseCase
+func NewListProjectsUseCase(projectRepository repository.ProjectRepository) ListProjectsUseCase {
+	return &listProjectsUseCase{
+		projectRepository: projectRepository,
+	}
+}
+
+// Execute lists the projects of the user
+func (uc *listProjectsUseCase) Execute(ctx context.Context, userID int64) ([]*dto.ProjectResponse, error) {
+	projects, err := uc.projectRepository.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.ProjectResponse, len(projects))
+	for i, project := range projects {
+		response[i] = toProjectResponse(project)
+	}
+
+	return response, nil
+}
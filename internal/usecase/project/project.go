@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/project/entity"
+	"todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/dto"
+)
+
+// FindProject finds a project of the user, also for the todo use cases assigning
+// todos to projects. Projects of other users are reported as ErrProjectNotFound.
+func FindProject(ctx context.Context, projectRepository repository.ProjectRepository, userID, projectID int64) (*entity.Project, error) {
+	project, err := projectRepository.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, entity.ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	if !project.IsOwnedBy(userID) {
+		return nil, entity.ErrProjectNotFound
+	}
+
+	return project, nil
+}
+
+// toWorkflow converts the workflow of a request to a workflow, the zero workflow
+// standing for the default one when the request has no states
+func toWorkflow(input *dto.WorkflowRequest) (todovo.Workflow, error) {
+	if input == nil || len(input.States) == 0 {
+		return todovo.Workflow{}, nil
+	}
+
+	states := make([]todovo.WorkflowState, len(input.States))
+	for i, state := range input.States {
+		var err error
+		if states[i], err = todovo.NewWorkflowState(state.Key, state.Name, state.Category, state.Requires); err != nil {
+			return todovo.Workflow{}, err
+		}
+	}
+
+	return todovo.NewWorkflow(states, input.Transitions)
+}
+
+// toWorkflowResponse converts a workflow to its response
+func toWorkflowResponse(workflow todovo.Workflow, isDefault bool) *dto.WorkflowResponse {
+	response := &dto.WorkflowResponse{
+		Default:     isDefault,
+		States:      []*dto.WorkflowStateResponse{},
+		Transitions: workflow.Transitions(),
+	}
+
+	for _, state := range workflow.States() {
+		requires := []string{}
+		for _, field := range state.Requires() {
+			requires = append(requires, field.String())
+		}
+
+		response.States = append(response.States, &dto.WorkflowStateResponse{
+			Key:      state.Key(),
+			Name:     state.Name(),
+			Category: state.Category().String(),
+			Status:   state.Status().String(),
+			Requires: requires,
+		})
+	}
+
+	return response
+}
+
+// toProjectResponse converts a project to its response
+func toProjectResponse(project *entity.Project) *dto.ProjectResponse {
+	return &dto.ProjectResponse{
+		ID:        project.ID(),
+		Name:      project.Name(),
+		Workflow:  toWorkflowResponse(project.Workflow(), project.HasDefaultWorkflow()),
+		CreatedAt: project.CreatedAt(),
+		UpdatedAt: project.UpdatedAt(),
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/project/entity"
+	"todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/dto"
+)
+
+// UpdateProjectUseCase handles updates of projects
+type UpdateProjectUseCase interface {
+	Execute(ctx context.Context, userID, projectID int64, input dto.UpdateProjectRequest) (*dto.ProjectResponse, error)
+}
+
+type updateProjectUseCase struct {
+	projectRepository repository.ProjectRepository
+}
+
+// NewUpdateProjectUseCase creates a new instance of UpdateProjectUseCase
+func NewUpdateProjectUseCase(projectRepository repository.ProjectRepository) UpdateProjectUseCase {
+	return &updateProjectUseCase{
+		projectRepository: projectRepository,
+	}
+}
+
+// Execute changes the given fields of a project of the user. The workflow can only
+// be replaced by one keeping the states the project's todos are in, with the same
+// categories.
+func (uc *updateProjectUseCase) Execute(
+	ctx context.Context,
+	userID, projectID int64,
+	input dto.UpdateProjectRequest,
+) (*dto.ProjectResponse, error) {
+	project, err := FindProject(ctx, uc.projectRepository, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		if err := project.Rename(*input.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Workflow != nil {
+		workflow, err := toWorkflow(input.Workflow)
+		if err != nil {
+			return nil, err
+		}
+
+		statesInUse, err := uc.projectRepository.FindStatesInUse(ctx, project.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := project.ChangeWorkflow(workflow, statesInUse); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.projectRepository.Save(ctx, project); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrProjectAlreadyExists
+		}
+		return nil, err
+	}
+
+	return toProjectResponse(project), nil
+}
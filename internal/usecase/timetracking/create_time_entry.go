@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/timetracking/entity"
+	"todolist/internal/domain/timetracking/repository"
+	rptTodo "todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// CreateTimeEntryUseCase handles entering time spent on todos by hand
+type CreateTimeEntryUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64, input dto.CreateTimeEntryRequest) (*dto.TimeEntryResponse, error)
+}
+
+type createTimeEntryUseCase struct {
+	timeEntryRepository  repository.TimeEntryRepository
+	todoRepository       rptTodo.TodoRepository
+	authorizationService service.AuthorizationService
+	idGenerator          shared.IDGenerator
+}
+
+// NewCreateTimeEntryUseCase creates a new instance of CreateTimeEntryUseCase
+func NewCreateTimeEntryUseCase(
+	timeEntryRepository repository.TimeEntryRepository,
+	todoRepository rptTodo.TodoRepository,
+	authorizationService service.AuthorizationService,
+	idGenerator shared.IDGenerator,
+) CreateTimeEntryUseCase {
+	return &createTimeEntryUseCase{
+		timeEntryRepository:  timeEntryRepository,
+		todoRepository:       todoRepository,
+		authorizationService: authorizationService,
+		idGenerator:          idGenerator,
+	}
+}
+
+// Execute records time the user spent on a todo the user can update. Entries may
+// overlap the running timer and other entries, as work is entered after the fact.
+func (uc *createTimeEntryUseCase) Execute(
+	ctx context.Context,
+	userID, todoID int64,
+	input dto.CreateTimeEntryRequest,
+) (*dto.TimeEntryResponse, error) {
+	if _, err := findTodo(ctx, uc.todoRepository, uc.authorizationService, userID, todoID, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := entity.NewTimeEntry(id, userID, todoID, input.StartedAt, input.EndedAt, input.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.timeEntryRepository.Save(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return toTimeEntryResponse(entry, time.Now()), nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/timetracking/entity"
+	"todolist/internal/domain/timetracking/repository"
+)
+
+// DeleteTimeEntryUseCase handles deleting time entries
+type DeleteTimeEntryUseCase interface {
+	Execute(ctx context.Context, userID, entryID int64) error
+}
+
+type deleteTimeEntryUseCase struct {
+	timeEntryRepository repository.TimeEntryRepository
+}
+
+// NewDeleteTimeEntryUseCase creates a new instance of DeleteTimeEntryUseCase
+func NewDeleteTimeEntryUseCase(timeEntryRepository repository.TimeEntryRepository) DeleteTimeEntryUseCase {
+	return &deleteTimeEntryUseCase{
+		timeEntryRepository: timeEntryRepository,
+	}
+}
+
+// Execute deletes a time entry of the user, running timers included. The entries
+// of other users are reported as ErrTimeEntryNotFound.
+func (uc *deleteTimeEntryUseCase) Execute(ctx context.Context, userID, entryID int64) error {
+	entry, err := uc.timeEntryRepository.FindByID(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return entity.ErrTimeEntryNotFound
+		}
+		return err
+	}
+
+	if entry.UserID() != userID {
+		return entity.ErrTimeEntryNotFound
+	}
+
+	return uc.timeEntryRepository.Delete(ctx, entry.ID())
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todolist/internal/domain/timetracking/repository"
+	vo "todolist/internal/domain/timetracking/valueobject"
+	"todolist/internal/dto"
+)
+
+const (
+	// defaultTimeReportPeriod is the period reported when no start is given
+	defaultTimeReportPeriod = 30 * 24 * time.Hour
+
+	// maxTimeReportPeriod is the longest period a report covers
+	maxTimeReportPeriod = 366 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidTimeReportPeriod = errors.New("the report period must start before its end and not exceed 366 days")
+	ErrInvalidTimezone         = errors.New("unknown timezone")
+)
+
+// GetTimeReportUseCase handles reporting the time tracked by users
+type GetTimeReportUseCase interface {
+	Execute(ctx context.Context, userID int64, input dto.TimeReportRequest) (*dto.TimeReportResponse, error)
+}
+
+type getTimeReportUseCase struct {
+	timeEntryRepository repository.TimeEntryRepository
+}
+
+// NewGetTimeReportUseCase creates a new instance of GetTimeReportUseCase
+func NewGetTimeReportUseCase(timeEntryRepository repository.TimeEntryRepository) GetTimeReportUseCase {
+	return &getTimeReportUseCase{
+		timeEntryRepository: timeEntryRepository,
+	}
+}
+
+// Execute sums the time the user tracked in a period by day, tag or project. Time
+// entries count in the period they start in, running timers not being counted
+// until they are stopped.
+func (uc *getTimeReportUseCase) Execute(ctx context.Context, userID int64, input dto.TimeReportRequest) (*dto.TimeReportResponse, error) {
+	grouping, err := vo.NewTimeReportGrouping(input.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = time.UTC.String()
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, ErrInvalidTimezone
+	}
+
+	to := time.Now()
+	if input.To != nil {
+		to = *input.To
+	}
+	from := to.Add(-defaultTimeReportPeriod)
+	if input.From != nil {
+		from = *input.From
+	}
+	if !from.Before(to) || to.Sub(from) > maxTimeReportPeriod {
+		return nil, ErrInvalidTimeReportPeriod
+	}
+
+	tracked, err := uc.timeEntryRepository.FindTracked(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TimeReportResponse{
+		From:     from,
+		To:       to,
+		GroupBy:  grouping.String(),
+		Timezone: location.String(),
+		Rows:     []*dto.TimeReportRowResponse{},
+	}
+
+	for _, item := range tracked {
+		response.Total += int64(item.Duration / time.Second)
+	}
+
+	for _, row := range vo.NewTimeReport(tracked, grouping, location) {
+		response.Rows = append(response.Rows, &dto.TimeReportRowResponse{
+			Key:      row.Key,
+			Name:     row.Name,
+			Duration: int64(row.Duration / time.Second),
+			Entries:  row.Entries,
+		})
+	}
+
+	return response, nil
+}
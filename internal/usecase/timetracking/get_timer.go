@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/timetracking/repository"
+	"todolist/internal/dto"
+)
+
+// GetTimerUseCase handles retrieving the running timer of users
+type GetTimerUseCase interface {
+	Execute(ctx context.Context, userID int64) (*dto.TimeEntryResponse, error)
+}
+
+type getTimerUseCase struct {
+	timeEntryRepository repository.TimeEntryRepository
+}
+
+// NewGetTimerUseCase creates a new instance of GetTimerUseCase
+func NewGetTimerUseCase(timeEntryRepository repository.TimeEntryRepository) GetTimerUseCase {
+	return &getTimerUseCase{
+		timeEntryRepository: timeEntryRepository,
+	}
+}
+
+// Execute retrieves the running timer of the user with the time elapsed so far
+func (uc *getTimerUseCase) Execute(ctx context.Context, userID int64) (*dto.TimeEntryResponse, error) {
+	entry, err := findRunningTimer(ctx, uc.timeEntryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTimeEntryResponse(entry, time.Now()), nil
+}
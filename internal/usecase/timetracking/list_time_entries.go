@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/timetracking/repository"
+	rptTodo "todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// ListTimeEntriesUseCase handles listing the time entries of todos
+type ListTimeEntriesUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64) ([]*dto.TimeEntryResponse, error)
+}
+
+type listTimeEntriesUseCase struct {
+	timeEntryRepository  repository.TimeEntryRepository
+	todoRepository       rptTodo.TodoRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewListTimeEntriesUseCase creates a new instance of ListTimeEntriesUseCase
+func NewListTimeEntriesUseCase(
+	timeEntryRepository repository.TimeEntryRepository,
+	todoRepository rptTodo.TodoRepository,
+	authorizationService service.AuthorizationService,
+) ListTimeEntriesUseCase {
+	return &listTimeEntriesUseCase{
+		timeEntryRepository:  timeEntryRepository,
+		todoRepository:       todoRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute lists the time entries of all users on a todo the user can read, the latest first
+func (uc *listTimeEntriesUseCase) Execute(ctx context.Context, userID, todoID int64) ([]*dto.TimeEntryResponse, error) {
+	if _, err := findTodo(ctx, uc.todoRepository, uc.authorizationService, userID, todoID, uservo.PermissionTodoRead); err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.timeEntryRepository.FindByTodo(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	response := make([]*dto.TimeEntryResponse, len(entries))
+	for i, entry := range entries {
+		response[i] = toTimeEntryResponse(entry, now)
+	}
+
+	return response, nil
+}
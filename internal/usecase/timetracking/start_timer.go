@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/timetracking/entity"
+	"todolist/internal/domain/timetracking/repository"
+	rptTodo "todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// StartTimerUseCase handles starting timers on todos
+type StartTimerUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64, input dto.StartTimerRequest) (*dto.TimeEntryResponse, error)
+}
+
+type startTimerUseCase struct {
+	timeEntryRepository  repository.TimeEntryRepository
+	todoRepository       rptTodo.TodoRepository
+	authorizationService service.AuthorizationService
+	transactionManager   shared.TransactionManager
+	idGenerator          shared.IDGenerator
+}
+
+// NewStartTimerUseCase creates a new instance of StartTimerUseCase
+func NewStartTimerUseCase(
+	timeEntryRepository repository.TimeEntryRepository,
+	todoRepository rptTodo.TodoRepository,
+	authorizationService service.AuthorizationService,
+	transactionManager shared.TransactionManager,
+	idGenerator shared.IDGenerator,
+) StartTimerUseCase {
+	return &startTimerUseCase{
+		timeEntryRepository:  timeEntryRepository,
+		todoRepository:       todoRepository,
+		authorizationService: authorizationService,
+		transactionManager:   transactionManager,
+		idGenerator:          idGenerator,
+	}
+}
+
+// Execute starts a timer of the user on a todo the user can update. Users have one
+// running timer at most, so the timer already running is stopped first.
+func (uc *startTimerUseCase) Execute(
+	ctx context.Context,
+	userID, todoID int64,
+	input dto.StartTimerRequest,
+) (*dto.TimeEntryResponse, error) {
+	if _, err := findTodo(ctx, uc.todoRepository, uc.authorizationService, userID, todoID, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entry, err := entity.StartTimer(id, userID, todoID, now, input.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		running, err := uc.timeEntryRepository.FindRunning(ctx, userID)
+		if err == nil {
+			if err := running.Stop(now); err != nil {
+				return err
+			}
+			if err := uc.timeEntryRepository.Save(ctx, running); err != nil {
+				return err
+			}
+		} else if !errors.Is(err, shared.ErrNotFound) {
+			return err
+		}
+
+		return uc.timeEntryRepository.Save(ctx, entry)
+	})
+	if err != nil {
+		// Another timer was started at the same time
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrTimerAlreadyRunning
+		}
+		return nil, err
+	}
+
+	return toTimeEntryResponse(entry, now), nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/timetracking/repository"
+	"todolist/internal/dto"
+)
+
+// StopTimerUseCase handles stopping the running timer of users
+type StopTimerUseCase interface {
+	Execute(ctx context.Context, userID int64) (*dto.TimeEntryResponse, error)
+}
+
+type stopTimerUseCase struct {
+	timeEntryRepository repository.TimeEntryRepository
+}
+
+// NewStopTimerUseCase creates a new instance of StopTimerUseCase
+func NewStopTimerUseCase(timeEntryRepository repository.TimeEntryRepository) StopTimerUseCase {
+	return &stopTimerUseCase{
+		timeEntryRepository: timeEntryRepository,
+	}
+}
+
+// Execute stops the running timer of the user, its time being tracked on its todo
+func (uc *stopTimerUseCase) Execute(ctx context.Context, userID int64) (*dto.TimeEntryResponse, error) {
+	entry, err := findRunningTimer(ctx, uc.timeEntryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := entry.Stop(now); err != nil {
+		return nil, err
+	}
+
+	if err := uc.timeEntryRepository.Save(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return toTimeEntryResponse(entry, now), nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/timetracking/entity"
+	"todolist/internal/domain/timetracking/repository"
+	todoEntity "todolist/internal/domain/todo/entity"
+	rptTodo "todolist/internal/domain/todo/repository"
+	domainService "todolist/internal/domain/user/service"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// findTodo finds a todo the user has the permission on
+func findTodo(
+	ctx context.Context,
+	todoRepository rptTodo.TodoRepository,
+	authorizationService service.AuthorizationService,
+	userID, todoID int64,
+	permission uservo.Permission,
+) (*todoEntity.Todo, error) {
+	todo, err := todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authorizationService.AuthorizeUser(ctx, userID, permission, domainService.Resource{
+		OwnerID: todo.UserID(),
+	})
+	if errors.Is(err, service.ErrPermissionDenied) {
+		return nil, todoEntity.ErrUnauthorizedTodoAccess
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// findRunningTimer finds the running timer of the user, failing with ErrTimerNotRunning
+// when none runs
+func findRunningTimer(ctx context.Context, timeEntryRepository repository.TimeEntryRepository, userID int64) (*entity.TimeEntry, error) {
+	entry, err := timeEntryRepository.FindRunning(ctx, userID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, entity.ErrTimerNotRunning
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// toTimeEntryResponse converts a time entry to its response, running timers
+// reporting the time elapsed until now
+func toTimeEntryResponse(entry *entity.TimeEntry, now time.Time) *dto.TimeEntryResponse {
+	return &dto.TimeEntryResponse{
+		ID:        entry.ID(),
+		UserID:    entry.UserID(),
+		TodoID:    entry.TodoID(),
+		StartedAt: entry.StartedAt(),
+		EndedAt:   entry.EndedAt(),
+		Duration:  int64(entry.Elapsed(now) / time.Second),
+		Running:   entry.IsRunning(),
+		Note:      entry.Note(),
+		CreatedAt: entry.CreatedAt(),
+	}
+}
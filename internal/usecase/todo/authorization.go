@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	rptBoard "todolist/internal/domain/board/repository"
+	"todolist/internal/domain/todo/entity"
+	domainService "todolist/internal/domain/user/service"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/service"
+)
+
+// authorizeTodo checks if the user holds the permission on the todo.
+// Denied access is reported as ErrUnauthorizedTodoAccess.
+//
+// Todos are shared with the users of the boards their owner is on, granting them
+// the shared permissions.
+func authorizeTodo(
+	ctx context.Context,
+	authorizationService service.AuthorizationService,
+	boardRepository rptBoard.BoardRepository,
+	userID int64,
+	todo *entity.Todo,
+	permission uservo.Permission,
+) error {
+	resource := domainService.Resource{OwnerID: todo.UserID()}
+
+	// The owner needs no sharing
+	if userID != todo.UserID() {
+		memberIDs, err := boardUserIDs(ctx, boardRepository, todo.UserID())
+		if err != nil {
+			return err
+		}
+		resource.MemberIDs = memberIDs
+	}
+
+	err := authorizationService.AuthorizeUser(ctx, userID, permission, resource)
+	if errors.Is(err, service.ErrPermissionDenied) {
+		return entity.ErrUnauthorizedTodoAccess
+	}
+	return err
+}
+
+// boardUserIDs returns the owners and members of the boards the user is on
+func boardUserIDs(ctx context.Context, boardRepository rptBoard.BoardRepository, userID int64) ([]int64, error) {
+	boards, err := boardRepository.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIDs []int64
+	for _, board := range boards {
+		userIDs = append(userIDs, board.UserIDs()...)
+	}
+	slices.Sort(userIDs)
+
+	return slices.Compact(userIDs), nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"todolist/internal/domain/board/entity"
+	"todolist/internal/domain/board/repository"
+	vo "todolist/internal/domain/board/valueobject"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	todoEntity "todolist/internal/domain/todo/entity"
+	todovo "todolist/internal/domain/todo/valueobject"
+	userEntity "todolist/internal/domain/user/entity"
+	domainService "todolist/internal/domain/user/service"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/service"
+)
+
+// fakeBoardRepository finds the boards of a user among the given ones
+type fakeBoardRepository struct {
+	repository.BoardRepository
+	boards []*entity.Board
+}
+
+func (f fakeBoardRepository) FindByUser(ctx context.Context, userID int64) ([]*entity.Board, error) {
+	var boards []*entity.Board
+	for _, board := range f.boards {
+		if slices.Contains(board.UserIDs(), userID) {
+			boards = append(boards, board)
+		}
+	}
+	return boards, nil
+}
+
+// fakePolicyAuthorizationService authorizes every user with the default permissions of plain users
+type fakePolicyAuthorizationService struct {
+	service.AuthorizationService
+}
+
+func (fakePolicyAuthorizationService) AuthorizeUser(
+	ctx context.Context,
+	userID int64,
+	permission uservo.Permission,
+	resource domainService.Resource,
+) error {
+	role, err := userEntity.NewRole(0, uservo.RoleUser, "", domainService.DefaultRolePermissions()[uservo.RoleUser])
+	if err != nil {
+		return err
+	}
+	if !domainService.NewAccessPolicy(role).CanAccess(uservo.RoleUser, userID, permission, resource) {
+		return service.ErrPermissionDenied
+	}
+	return nil
+}
+
+func TestAuthorizeTodo(t *testing.T) {
+	// User 10 shares a board with user 11, user 12 is on another board
+	team, err := entity.NewBoard(1, 10, "Team", entity.DefaultBoardColumns(), vo.BoardSwimlaneNone, []int64{11})
+	if err != nil {
+		t.Fatalf("NewBoard() error = %v", err)
+	}
+	other, err := entity.NewBoard(2, 12, "Other", entity.DefaultBoardColumns(), vo.BoardSwimlaneNone, nil)
+	if err != nil {
+		t.Fatalf("NewBoard() error = %v", err)
+	}
+	boards := fakeBoardRepository{boards: []*entity.Board{team, other}}
+
+	title, _ := todovo.NewTodoTitle("Write report")
+	todo, err := todoEntity.NewTodo(1, 10, title, todovo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+	if err != nil {
+		t.Fatalf("NewTodo() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		userID     int64
+		permission uservo.Permission
+		wantErr    error
+	}{
+		{name: "owner deletes", userID: 10, permission: uservo.PermissionTodoDelete},
+		{name: "board member reads", userID: 11, permission: uservo.PermissionTodoRead},
+		{name: "board member updates", userID: 11, permission: uservo.PermissionTodoUpdate},
+		{name: "board member deletes", userID: 11, permission: uservo.PermissionTodoDelete, wantErr: todoEntity.ErrUnauthorizedTodoAccess},
+		{name: "user of another board reads", userID: 12, permission: uservo.PermissionTodoRead, wantErr: todoEntity.ErrUnauthorizedTodoAccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeTodo(context.Background(), fakePolicyAuthorizationService{}, boards, tt.userID, todo, tt.permission)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("authorizeTodo() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	rptBoard "todolist/internal/domain/board/repository"
+	projectEntity "todolist/internal/domain/project/entity"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// Actions of bulk operations
+const (
+	BulkActionComplete    = "complete"
+	BulkActionCancel      = "cancel"
+	BulkActionReopen      = "reopen"
+	BulkActionStart       = "start"
+	BulkActionSetPriority = "set_priority"
+	BulkActionAddTags     = "add_tags"
+	BulkActionRemoveTags  = "remove_tags"
+	BulkActionMoveProject = "move_to_project"
+	BulkActionDelete      = "delete"
+)
+
+// Modes of bulk operations
+const (
+	BulkModeAtomic     = "atomic"
+	BulkModeBestEffort = "best_effort"
+)
+
+// MaxBulkTodos is the maximum number of todos a bulk operation changes
+const MaxBulkTodos = 500
+
+var (
+	ErrInvalidBulkRequest = errors.New("invalid bulk request")
+	ErrTooManyBulkTodos   = fmt.Errorf("a bulk operation changes at most %d todos", MaxBulkTodos)
+	ErrBulkAborted        = errors.New("bulk operation rolled back")
+)
+
+// BulkTodoUseCase handles actions applied to several todos at once
+type BulkTodoUseCase interface {
+	Execute(ctx context.Context, userID int64, input dto.BulkTodoRequest) (*dto.BulkTodoResponse, error)
+}
+
+type bulkTodoUseCase struct {
+	todoRepository       repository.TodoRepository
+	todoQueryRepository  repository.TodoQueryRepository
+	projectRepository    rptProject.ProjectRepository
+	transactionManager   shared.TransactionManager
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewBulkTodoUseCase creates a new instance of BulkTodoUseCase
+func NewBulkTodoUseCase(
+	todoRepository repository.TodoRepository,
+	todoQueryRepository repository.TodoQueryRepository,
+	projectRepository rptProject.ProjectRepository,
+	transactionManager shared.TransactionManager,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
+) BulkTodoUseCase {
+	return &bulkTodoUseCase{
+		todoRepository:       todoRepository,
+		todoQueryRepository:  todoQueryRepository,
+		projectRepository:    projectRepository,
+		transactionManager:   transactionManager,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute applies the action to the selected todos, through the entity so the
+// status transition rules apply, and reports the result of each todo.
+//
+// In atomic mode the first failing todo rolls back the whole operation, which is
+// reported with ErrBulkAborted along with the results. In best effort mode every
+// todo is changed in its own transaction and failures are only reported.
+func (uc *bulkTodoUseCase) Execute(ctx context.Context, userID int64, input dto.BulkTodoRequest) (*dto.BulkTodoResponse, error) {
+	mode := input.Mode
+	if mode == "" {
+		mode = BulkModeAtomic
+	}
+	if mode != BulkModeAtomic && mode != BulkModeBestEffort {
+		return nil, fmt.Errorf("%w: unknown mode %q", ErrInvalidBulkRequest, input.Mode)
+	}
+
+	apply, permission, err := uc.bulkTodoAction(input)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := uc.selectTodos(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BulkTodoResponse{
+		Action:  input.Action,
+		Mode:    mode,
+		Total:   len(ids),
+		Results: make([]*dto.BulkTodoItemResult, len(ids)),
+	}
+	for i, id := range ids {
+		response.Results[i] = &dto.BulkTodoItemResult{ID: id}
+	}
+
+	if mode == BulkModeBestEffort {
+		for _, result := range response.Results {
+			err := uc.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+				return uc.applyTo(ctx, userID, result, apply, permission)
+			})
+			if err != nil {
+				result.Error = bulkTodoError(err)
+			}
+		}
+		response.Failed = countFailedBulkResults(response.Results)
+		response.Succeeded = response.Total - response.Failed
+		return response, nil
+	}
+
+	var failed *dto.BulkTodoItemResult
+	err = uc.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, result := range response.Results {
+			if err := uc.applyTo(ctx, userID, result, apply, permission); err != nil {
+				failed = result
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		response.Succeeded = response.Total
+		return response, nil
+	}
+	if failed == nil {
+		return nil, err
+	}
+
+	// Nothing was applied, the todo that failed is reported with its error
+	failed.Error = bulkTodoError(err)
+	for _, result := range response.Results {
+		result.Success = false
+		result.Todo = nil
+		if result != failed {
+			result.Error = &dto.ErrorInfo{
+				Code:    "ROLLED_BACK",
+				Message: "Not applied, another todo of the operation failed",
+			}
+		}
+	}
+	response.Failed = response.Total
+	response.RolledBack = true
+
+	return response, ErrBulkAborted
+}
+
+// selectTodos returns the IDs of the todos selected either by IDs or by the filter,
+// the latter only matching todos of the user
+func (uc *bulkTodoUseCase) selectTodos(ctx context.Context, userID int64, input dto.BulkTodoRequest) ([]int64, error) {
+	switch {
+	case len(input.IDs) > 0 && input.Filter != nil:
+		return nil, fmt.Errorf("%w: select todos either by ids or by filter", ErrInvalidBulkRequest)
+
+	case len(input.IDs) > 0:
+		ids := make([]int64, 0, len(input.IDs))
+		for _, id := range input.IDs {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > MaxBulkTodos {
+			return nil, ErrTooManyBulkTodos
+		}
+		return ids, nil
+
+	case input.Filter != nil:
+		filters := vo.TodoFilterCriteria{
+			UserID:      userID,
+			Status:      input.Filter.Status,
+			Priority:    input.Filter.Priority,
+			Tags:        input.Filter.Tags,
+			IsOverdue:   input.Filter.IsOverdue,
+			DueDateFrom: input.Filter.DueDateFrom,
+			DueDateTo:   input.Filter.DueDateTo,
+			SearchTerm:  input.Filter.Search,
+		}
+		todos, err := uc.todoQueryRepository.FindByFilters(ctx, filters, shared.QueryOptions{
+			Limit: MaxBulkTodos + 1,
+			Sort:  shared.Sort{{Key: "id"}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(todos) > MaxBulkTodos {
+			return nil, ErrTooManyBulkTodos
+		}
+
+		ids := make([]int64, len(todos))
+		for i, todo := range todos {
+			ids[i] = todo.ID()
+		}
+		return ids, nil
+
+	default:
+		return nil, fmt.Errorf("%w: select todos by ids or by filter", ErrInvalidBulkRequest)
+	}
+}
+
+// applyTo applies the action to the todo of the result, recording the changed todo
+func (uc *bulkTodoUseCase) applyTo(
+	ctx context.Context,
+	userID int64,
+	result *dto.BulkTodoItemResult,
+	apply bulkTodoChange,
+	permission uservo.Permission,
+) error {
+	todo, err := uc.todoRepository.FindByID(ctx, result.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, permission); err != nil {
+		return err
+	}
+
+	if apply == nil {
+		if err := uc.todoRepository.Delete(ctx, todo.ID()); err != nil {
+			return err
+		}
+		result.Success = true
+		return nil
+	}
+
+	if err := apply(ctx, todo); err != nil {
+		return err
+	}
+
+	if err := uc.todoRepository.Save(ctx, todo); err != nil {
+		return err
+	}
+
+	result.Success = true
+	result.Todo = ToTodoResponse(todo)
+	return nil
+}
+
+// bulkTodoChange changes a todo of a bulk operation, within its transaction
+type bulkTodoChange func(ctx context.Context, todo *entity.Todo) error
+
+// bulkTodoStatusChange returns the change applying the status method of the todo
+func bulkTodoStatusChange(change func(todo *entity.Todo) error) bulkTodoChange {
+	return func(ctx context.Context, todo *entity.Todo) error {
+		return change(todo)
+	}
+}
+
+// bulkTodoAction returns the change the action applies to each todo, nil for deletes,
+// and the permission it requires
+func (uc *bulkTodoUseCase) bulkTodoAction(input dto.BulkTodoRequest) (bulkTodoChange, uservo.Permission, error) {
+	switch input.Action {
+	case BulkActionComplete:
+		return bulkTodoStatusChange((*entity.Todo).Complete), uservo.PermissionTodoUpdate, nil
+
+	case BulkActionCancel:
+		return bulkTodoStatusChange((*entity.Todo).Cancel), uservo.PermissionTodoUpdate, nil
+
+	case BulkActionReopen:
+		return bulkTodoStatusChange((*entity.Todo).Reopen), uservo.PermissionTodoUpdate, nil
+
+	case BulkActionStart:
+		return bulkTodoStatusChange((*entity.Todo).StartProgress), uservo.PermissionTodoUpdate, nil
+
+	case BulkActionSetPriority:
+		priority, err := sharedvo.NewPriorityFromString(input.Priority)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %v", ErrInvalidBulkRequest, err)
+		}
+		return func(ctx context.Context, todo *entity.Todo) error {
+			todo.UpdatePriority(priority)
+			return nil
+		}, uservo.PermissionTodoUpdate, nil
+
+	case BulkActionAddTags, BulkActionRemoveTags:
+		tags := make([]string, 0, len(input.Tags))
+		for _, tag := range input.Tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) == 0 {
+			return nil, "", fmt.Errorf("%w: tags are required", ErrInvalidBulkRequest)
+		}
+		return func(ctx context.Context, todo *entity.Todo) error {
+			for _, tag := range tags {
+				if input.Action == BulkActionAddTags {
+					todo.AddTag(tag)
+				} else {
+					todo.RemoveTag(tag)
+				}
+			}
+			return nil
+		}, uservo.PermissionTodoUpdate, nil
+
+	case BulkActionMoveProject:
+		if input.ProjectID == nil || *input.ProjectID < 0 {
+			return nil, "", fmt.Errorf("%w: project_id is required", ErrInvalidBulkRequest)
+		}
+		// The project must belong to the owner of each todo, zero removing it from its project
+		projectID := *input.ProjectID
+		return func(ctx context.Context, todo *entity.Todo) error {
+			return assignTodoToProject(ctx, uc.projectRepository, todo, projectID)
+		}, uservo.PermissionTodoUpdate, nil
+
+	case BulkActionDelete:
+		return nil, uservo.PermissionTodoDelete, nil
+
+	default:
+		return nil, "", fmt.Errorf("%w: unknown action %q", ErrInvalidBulkRequest, input.Action)
+	}
+}
+
+// bulkTodoError describes the failure of a todo of a bulk operation
+func bulkTodoError(err error) *dto.ErrorInfo {
+	switch {
+	case errors.Is(err, shared.ErrNotFound), errors.Is(err, entity.ErrUnauthorizedTodoAccess):
+		return &dto.ErrorInfo{Code: "NOT_FOUND", Message: "Todo not found"}
+	case errors.Is(err, entity.ErrTodoAlreadyCompleted):
+		return &dto.ErrorInfo{Code: "ALREADY_COMPLETED", Message: "Todo is already completed"}
+	case errors.Is(err, entity.ErrInvalidStatusTransition):
+		return &dto.ErrorInfo{Code: "INVALID_TRANSITION", Message: "Invalid status transition"}
+	case errors.Is(err, entity.ErrMissingRequiredField):
+		return &dto.ErrorInfo{Code: "MISSING_FIELD", Message: err.Error()}
+	case errors.Is(err, projectEntity.ErrProjectNotFound):
+		return &dto.ErrorInfo{Code: "INVALID_PROJECT", Message: "Project not found"}
+	case errors.Is(err, entity.ErrUnknownState):
+		return &dto.ErrorInfo{Code: "INVALID_STATE", Message: err.Error()}
+	case errors.Is(err, shared.ErrOptimisticLock):
+		return &dto.ErrorInfo{Code: "CONFLICT", Message: "Todo was modified, reload it and try again"}
+	default:
+		return &dto.ErrorInfo{Code: "BULK_FAILED", Message: "Failed to change todo"}
+	}
+}
+
+// countFailedBulkResults counts the todos of a bulk operation that were not changed
+func countFailedBulkResults(results []*dto.BulkTodoItemResult) int {
+	failed := 0
+	for _, result := range results {
+		if !result.Success {
+			failed++
+		}
+	}
+	return failed
+}
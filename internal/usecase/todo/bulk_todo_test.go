@@ -0,0 +1,380 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	projectEntity "todolist/internal/domain/project/entity"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+	domainService "todolist/internal/domain/user/service"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// bulkStore holds the status of the stored todos and the writes of the
+// transactions, which are only kept when the transaction commits
+type bulkStore struct {
+	statuses  map[int64]vo.TodoStatus
+	pending   []string
+	committed []string
+}
+
+type fakeBulkTodoRepository struct {
+	repository.TodoRepository
+	store *bulkStore
+}
+
+// FindByID returns a new todo on each call so changes only persist through Save
+func (f *fakeBulkTodoRepository) FindByID(ctx context.Context, id int64) (*entity.Todo, error) {
+	status, ok := f.store.statuses[id]
+	if !ok {
+		return nil, shared.ErrNotFound
+	}
+	return newBulkTestTodo(id, status), nil
+}
+
+func (f *fakeBulkTodoRepository) Save(ctx context.Context, todo *entity.Todo) error {
+	write := "save " + todo.Status().String()
+	if todo.ProjectID() != 0 {
+		write += fmt.Sprintf(" in project %d", todo.ProjectID())
+	}
+	f.store.pending = append(f.store.pending, write)
+	return nil
+}
+
+func (f *fakeBulkTodoRepository) Delete(ctx context.Context, id int64) error {
+	f.store.pending = append(f.store.pending, "delete")
+	return nil
+}
+
+type fakeBulkTodoQueryRepository struct {
+	repository.TodoQueryRepository
+	store   *bulkStore
+	filters vo.TodoFilterCriteria
+	options shared.QueryOptions
+}
+
+func (f *fakeBulkTodoQueryRepository) FindByFilters(
+	ctx context.Context,
+	filters vo.TodoFilterCriteria,
+	options shared.QueryOptions,
+) ([]*entity.Todo, error) {
+	f.filters, f.options = filters, options
+
+	var ids []int64
+	for id, status := range f.store.statuses {
+		if bulkTestOwner(id) != filters.UserID {
+			continue
+		}
+		if len(filters.Status) == 0 || slices.Contains(filters.Status, status.String()) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	if len(ids) > options.Limit {
+		ids = ids[:options.Limit]
+	}
+
+	todos := make([]*entity.Todo, len(ids))
+	for i, id := range ids {
+		todos[i] = newBulkTestTodo(id, f.store.statuses[id])
+	}
+	return todos, nil
+}
+
+type fakeBulkTransactionManager struct {
+	store *bulkStore
+}
+
+func (f fakeBulkTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.store.pending = nil
+	if err := fn(ctx); err != nil {
+		f.store.pending = nil
+		return err
+	}
+	f.store.committed = append(f.store.committed, f.store.pending...)
+	f.store.pending = nil
+	return nil
+}
+
+type fakeBulkAuthorizationService struct {
+	service.AuthorizationService
+}
+
+func (fakeBulkAuthorizationService) AuthorizeUser(
+	ctx context.Context,
+	userID int64,
+	permission uservo.Permission,
+	resource domainService.Resource,
+) error {
+	if userID != resource.OwnerID {
+		return service.ErrPermissionDenied
+	}
+	return nil
+}
+
+// bulkTestOwner returns the owner of a todo, user 10 but for todo 99
+func bulkTestOwner(id int64) int64 {
+	if id == 99 {
+		return 20
+	}
+	return 10
+}
+
+func newBulkTestTodo(id int64, status vo.TodoStatus) *entity.Todo {
+	title, _ := vo.NewTodoTitle("Todo")
+	todo, _ := entity.NewTodo(id, bulkTestOwner(id), title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+	todo.SetPersistedState(status, nil, nil, nil)
+	return todo
+}
+
+func newTestBulkTodoUseCase(statuses map[int64]vo.TodoStatus) (BulkTodoUseCase, *bulkStore, *fakeBulkTodoQueryRepository) {
+	store := &bulkStore{statuses: statuses}
+	query := &fakeBulkTodoQueryRepository{store: store}
+
+	// Project 7 belongs to user 10, project 8 to user 20
+	project, _ := projectEntity.NewProject(7, 10, "Releases", vo.DefaultWorkflow())
+	otherProject, _ := projectEntity.NewProject(8, 20, "Other", vo.DefaultWorkflow())
+
+	uc := NewBulkTodoUseCase(
+		&fakeBulkTodoRepository{store: store},
+		query,
+		fakeProjectRepository{projects: []*projectEntity.Project{project, otherProject}},
+		fakeBulkTransactionManager{store: store},
+		fakeBoardRepository{},
+		fakeBulkAuthorizationService{},
+	)
+	return uc, store, query
+}
+
+func bulkResultCodes(response *dto.BulkTodoResponse) []string {
+	codes := make([]string, len(response.Results))
+	for i, result := range response.Results {
+		switch {
+		case result.Success:
+			codes[i] = "OK"
+		case result.Error != nil:
+			codes[i] = result.Error.Code
+		}
+	}
+	return codes
+}
+
+func TestBulkTodoUseCase_Execute(t *testing.T) {
+	statuses := map[int64]vo.TodoStatus{
+		1:  vo.StatusPending,
+		2:  vo.StatusCompleted,
+		3:  vo.StatusInProgress,
+		99: vo.StatusPending,
+	}
+	ownProjectID, otherProjectID, missingProjectID, noProjectID := int64(7), int64(8), int64(5), int64(0)
+
+	tests := []struct {
+		name           string
+		input          dto.BulkTodoRequest
+		wantErr        error
+		wantCodes      []string
+		wantSucceeded  int
+		wantRolledBack bool
+		wantCommitted  []string
+	}{
+		{
+			name:          "atomic mode",
+			input:         dto.BulkTodoRequest{IDs: []int64{1, 3}, Action: BulkActionComplete},
+			wantCodes:     []string{"OK", "OK"},
+			wantSucceeded: 2,
+			wantCommitted: []string{"save completed", "save completed"},
+		},
+		{
+			name:           "atomic mode with a failing todo",
+			input:          dto.BulkTodoRequest{IDs: []int64{1, 2, 3}, Action: BulkActionComplete},
+			wantErr:        ErrBulkAborted,
+			wantCodes:      []string{"ROLLED_BACK", "ALREADY_COMPLETED", "ROLLED_BACK"},
+			wantRolledBack: true,
+		},
+		{
+			name:           "atomic mode with a todo of another user",
+			input:          dto.BulkTodoRequest{IDs: []int64{99, 1}, Action: BulkActionDelete, Mode: BulkModeAtomic},
+			wantErr:        ErrBulkAborted,
+			wantCodes:      []string{"NOT_FOUND", "ROLLED_BACK"},
+			wantRolledBack: true,
+		},
+		{
+			name:          "best effort mode with a failing todo",
+			input:         dto.BulkTodoRequest{IDs: []int64{1, 2, 3}, Action: BulkActionComplete, Mode: BulkModeBestEffort},
+			wantCodes:     []string{"OK", "ALREADY_COMPLETED", "OK"},
+			wantSucceeded: 2,
+			wantCommitted: []string{"save completed", "save completed"},
+		},
+		{
+			name:          "best effort mode with a missing todo",
+			input:         dto.BulkTodoRequest{IDs: []int64{4, 1, 1}, Action: BulkActionDelete, Mode: BulkModeBestEffort},
+			wantCodes:     []string{"NOT_FOUND", "OK"},
+			wantSucceeded: 1,
+			wantCommitted: []string{"delete"},
+		},
+		{
+			name: "filter",
+			input: dto.BulkTodoRequest{
+				Filter: &dto.BulkTodoFilter{Status: []string{"pending", "in_progress"}},
+				Action: BulkActionCancel,
+			},
+			// The todo of the other user is not selected
+			wantCodes:     []string{"OK", "OK"},
+			wantSucceeded: 2,
+			wantCommitted: []string{"save cancelled", "save cancelled"},
+		},
+		{
+			name:    "ids and filter",
+			input:   dto.BulkTodoRequest{IDs: []int64{1}, Filter: &dto.BulkTodoFilter{}, Action: BulkActionComplete},
+			wantErr: ErrInvalidBulkRequest,
+		},
+		{
+			name:    "unknown mode",
+			input:   dto.BulkTodoRequest{IDs: []int64{1}, Action: BulkActionComplete, Mode: "eventually"},
+			wantErr: ErrInvalidBulkRequest,
+		},
+		{
+			name:          "move to a project",
+			input:         dto.BulkTodoRequest{IDs: []int64{1, 3}, Action: BulkActionMoveProject, ProjectID: &ownProjectID},
+			wantCodes:     []string{"OK", "OK"},
+			wantSucceeded: 2,
+			wantCommitted: []string{"save pending in project 7", "save in_progress in project 7"},
+		},
+		{
+			name:          "move out of projects",
+			input:         dto.BulkTodoRequest{IDs: []int64{1}, Action: BulkActionMoveProject, ProjectID: &noProjectID},
+			wantCodes:     []string{"OK"},
+			wantSucceeded: 1,
+			wantCommitted: []string{"save pending"},
+		},
+		{
+			name:           "move to a project of another user",
+			input:          dto.BulkTodoRequest{IDs: []int64{1, 3}, Action: BulkActionMoveProject, ProjectID: &otherProjectID},
+			wantErr:        ErrBulkAborted,
+			wantCodes:      []string{"INVALID_PROJECT", "ROLLED_BACK"},
+			wantRolledBack: true,
+		},
+		{
+			name:          "best effort move to a missing project",
+			input:         dto.BulkTodoRequest{IDs: []int64{1}, Action: BulkActionMoveProject, ProjectID: &missingProjectID, Mode: BulkModeBestEffort},
+			wantCodes:     []string{"INVALID_PROJECT"},
+			wantSucceeded: 0,
+		},
+		{
+			name:    "move to a project without project",
+			input:   dto.BulkTodoRequest{IDs: []int64{1}, Action: BulkActionMoveProject},
+			wantErr: ErrInvalidBulkRequest,
+		},
+		{
+			name:    "set priority without priority",
+			input:   dto.BulkTodoRequest{IDs: []int64{1}, Action: BulkActionSetPriority},
+			wantErr: ErrInvalidBulkRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, store, query := newTestBulkTodoUseCase(statuses)
+
+			response, err := uc.Execute(context.Background(), 10, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(store.committed, tt.wantCommitted) {
+				t.Errorf("Expected committed writes %v, got %v", tt.wantCommitted, store.committed)
+			}
+			if response == nil {
+				if tt.wantCodes != nil {
+					t.Fatal("Expected a response")
+				}
+				return
+			}
+
+			if codes := bulkResultCodes(response); !slices.Equal(codes, tt.wantCodes) {
+				t.Errorf("Expected results %v, got %v", tt.wantCodes, codes)
+			}
+			if response.Total != len(tt.wantCodes) || response.Succeeded != tt.wantSucceeded ||
+				response.Failed != response.Total-tt.wantSucceeded {
+				t.Errorf("Expected %d todos, %d succeeded, got %d, %d succeeded and %d failed",
+					len(tt.wantCodes), tt.wantSucceeded, response.Total, response.Succeeded, response.Failed)
+			}
+			if response.RolledBack != tt.wantRolledBack {
+				t.Errorf("Expected rolled back = %v, got %v", tt.wantRolledBack, response.RolledBack)
+			}
+			for _, result := range response.Results {
+				if tt.input.Action != BulkActionDelete && (result.Todo != nil) != result.Success {
+					t.Errorf("Expected todo %d to be returned only when changed, got %v", result.ID, result.Todo)
+				}
+			}
+			if tt.input.Filter != nil && query.filters.UserID != 10 {
+				t.Errorf("Expected the filter to be restricted to the user, got user %d", query.filters.UserID)
+			}
+		})
+	}
+}
+
+func TestBulkTodoUseCase_Execute_Limit(t *testing.T) {
+	statuses := make(map[int64]vo.TodoStatus, MaxBulkTodos+1)
+	ids := make([]int64, 0, MaxBulkTodos+1)
+	for id := int64(100); id <= MaxBulkTodos+100; id++ {
+		statuses[id] = vo.StatusPending
+		ids = append(ids, id)
+	}
+
+	tests := []struct {
+		name    string
+		input   dto.BulkTodoRequest
+		wantErr error
+	}{
+		{
+			name:  "ids at the limit",
+			input: dto.BulkTodoRequest{IDs: ids[:MaxBulkTodos], Action: BulkActionStart},
+		},
+		{
+			// Repeated IDs only count once
+			name:  "repeated ids",
+			input: dto.BulkTodoRequest{IDs: append(ids[:MaxBulkTodos:MaxBulkTodos], 100, 101), Action: BulkActionStart},
+		},
+		{
+			name:    "ids over the limit",
+			input:   dto.BulkTodoRequest{IDs: ids, Action: BulkActionStart},
+			wantErr: ErrTooManyBulkTodos,
+		},
+		{
+			name:    "filter over the limit",
+			input:   dto.BulkTodoRequest{Filter: &dto.BulkTodoFilter{}, Action: BulkActionStart},
+			wantErr: ErrTooManyBulkTodos,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, store, query := newTestBulkTodoUseCase(statuses)
+
+			response, err := uc.Execute(context.Background(), 10, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(store.committed) != 0 {
+					t.Errorf("Expected nothing to be written, got %d writes", len(store.committed))
+				}
+				if tt.input.Filter != nil && query.options.Limit != MaxBulkTodos+1 {
+					t.Errorf("Expected the filter to load one todo over the limit, got %d", query.options.Limit)
+				}
+				return
+			}
+			if response.Total != MaxBulkTodos || response.Succeeded != MaxBulkTodos {
+				t.Errorf("Expected %d todos to be changed, got %d of %d", MaxBulkTodos, response.Succeeded, response.Total)
+			}
+		})
+	}
+}
@@ -2,46 +2,57 @@ package usecase
 
 import (
 	"context"
+	rptBoard "todolist/internal/domain/board/repository"
 	"todolist/internal/domain/shared"
 	"todolist/internal/domain/todo/repository"
-	"todolist/internal/domain/todo/service"
+	uservo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
+	"todolist/internal/service"
 )
 
 // CompleteTodoUseCase handles completing todos
 type CompleteTodoUseCase interface {
-	Execute(ctx context.Context, userID, todoID int64) (*dto.TodoResponse, error)
+	Execute(ctx context.Context, userID, todoID int64, expectedVersions []int64) (*dto.TodoResponse, error)
 }
 
 type completeTodoUseCase struct {
-	todoRepository repository.TodoRepository
-	todoService    service.TodoService
+	todoRepository       repository.TodoRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
 }
 
 // NewCompleteTodoUseCase creates a new instance of CompleteTodoUseCase
 func NewCompleteTodoUseCase(
 	todoRepository repository.TodoRepository,
-	todoService service.TodoService,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
 ) CompleteTodoUseCase {
 	return &completeTodoUseCase{
-		todoRepository: todoRepository,
-		todoService:    todoService,
+		todoRepository:       todoRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
 	}
 }
 
-// Execute completes a todo
-func (uc *completeTodoUseCase) Execute(ctx context.Context, userID, todoID int64) (*dto.TodoResponse, error) {
-	// Validate user ownership
-	if err := uc.todoService.ValidateUserOwnership(ctx, todoID, userID); err != nil {
-		return nil, err
-	}
-
+// Execute completes a todo, failing with shared.ErrOptimisticLock when expected
+// versions are given and the todo has none of them
+func (uc *completeTodoUseCase) Execute(ctx context.Context, userID, todoID int64, expectedVersions []int64) (*dto.TodoResponse, error) {
 	// Get the todo
 	todo, err := uc.todoRepository.FindByID(ctx, todoID)
 	if err != nil {
 		return nil, shared.ErrNotFound
 	}
 
+	// Validate user access
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	// Validate the todo was not changed since the client read it
+	if err := todo.CheckLockVersion(expectedVersions); err != nil {
+		return nil, err
+	}
+
 	// Complete the todo
 	if err := todo.Complete(); err != nil {
 		return nil, err
@@ -52,5 +63,5 @@ func (uc *completeTodoUseCase) Execute(ctx context.Context, userID, todoID int64
 		return nil, err
 	}
 
-	return toTodoResponse(todo), nil
+	return ToTodoResponse(todo), nil
 }
@@ -2,37 +2,87 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
+	rptCustomField "todolist/internal/domain/customfield/repository"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
 	sharedvo "todolist/internal/domain/shared/valueobject"
 	"todolist/internal/domain/todo/entity"
 	"todolist/internal/domain/todo/repository"
 	"todolist/internal/domain/todo/service"
 	vo "todolist/internal/domain/todo/valueobject"
 	"todolist/internal/dto"
+	ucProject "todolist/internal/usecase/project"
+	"todolist/pkg/rank"
 )
 
+// Duplicate detection settings
+const (
+	// duplicateSimilarity is the title similarity from which open todos are likely duplicates
+	duplicateSimilarity = 0.5
+
+	// maxDuplicates is the number of likely duplicates listed, the most similar first
+	maxDuplicates = 5
+)
+
+// ErrPossibleDuplicate is returned, as a PossibleDuplicatesError, when the todo to
+// create is likely a duplicate of open todos
+var ErrPossibleDuplicate = errors.New("possible duplicate todo")
+
+// PossibleDuplicatesError lists the open todos the todo to create likely duplicates
+type PossibleDuplicatesError struct {
+	Duplicates []*dto.SimilarTodoResponse
+}
+
+// Error implements error.
+func (e *PossibleDuplicatesError) Error() string {
+	return fmt.Sprintf("%s of %d todos", ErrPossibleDuplicate, len(e.Duplicates))
+}
+
+// Unwrap returns ErrPossibleDuplicate.
+func (e *PossibleDuplicatesError) Unwrap() error {
+	return ErrPossibleDuplicate
+}
+
 // CreateTodoUseCase handles the creation of new todos
 type CreateTodoUseCase interface {
 	Execute(ctx context.Context, userID int64, input dto.CreateTodoRequest) (*dto.TodoResponse, error)
 }
 
 type createTodoUseCase struct {
-	todoRepository repository.TodoRepository
-	todoService    service.TodoService
+	todoRepository        repository.TodoRepository
+	todoQueryRepository   repository.TodoQueryRepository
+	todoService           service.TodoService
+	projectRepository     rptProject.ProjectRepository
+	customFieldRepository rptCustomField.CustomFieldRepository
+	idGenerator           shared.IDGenerator
 }
 
 // NewCreateTodoUseCase creates a new instance of CreateTodoUseCase
 func NewCreateTodoUseCase(
 	todoRepository repository.TodoRepository,
+	todoQueryRepository repository.TodoQueryRepository,
 	todoService service.TodoService,
+	projectRepository rptProject.ProjectRepository,
+	customFieldRepository rptCustomField.CustomFieldRepository,
+	idGenerator shared.IDGenerator,
 ) CreateTodoUseCase {
 	return &createTodoUseCase{
-		todoRepository: todoRepository,
-		todoService:    todoService,
+		todoRepository:        todoRepository,
+		todoQueryRepository:   todoQueryRepository,
+		todoService:           todoService,
+		projectRepository:     projectRepository,
+		customFieldRepository: customFieldRepository,
+		idGenerator:           idGenerator,
 	}
 }
 
-// Execute creates a new todo
+// Execute creates a new todo.
+//
+// When the input asks to check duplicates and open todos of the user have a
+// similar title, the todo is not created and a PossibleDuplicatesError lists them.
 func (uc *createTodoUseCase) Execute(
 	ctx context.Context,
 	userID int64,
@@ -54,6 +104,12 @@ func (uc *createTodoUseCase) Execute(
 		return nil, err
 	}
 
+	if input.CheckDuplicates {
+		if err := uc.checkDuplicates(ctx, userID, title); err != nil {
+			return nil, err
+		}
+	}
+
 	// Suggest due date if not provided
 	dueDate := input.DueDate
 	if dueDate == nil && input.Priority != "low" {
@@ -63,8 +119,13 @@ func (uc *createTodoUseCase) Execute(
 	}
 
 	// Create todo entity
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	todo, err := entity.NewTodo(
-		time.Now().Unix(),
+		id,
 		userID,
 		title,
 		description,
@@ -80,18 +141,76 @@ func (uc *createTodoUseCase) Execute(
 		todo.AddTag(tag)
 	}
 
+	if input.Estimate != nil {
+		if err := todo.UpdateEstimate(time.Duration(*input.Estimate) * time.Second); err != nil {
+			return nil, err
+		}
+	}
+
+	// Follow the workflow of the project, starting in its initial state
+	if input.ProjectID != nil {
+		project, err := ucProject.FindProject(ctx, uc.projectRepository, userID, *input.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if err := todo.AssignToProject(project.ID(), project.Workflow()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Set the custom fields of the todo and its project
+	if err := applyCustomFields(ctx, uc.customFieldRepository, todo, input.CustomFields); err != nil {
+		return nil, err
+	}
+
+	// Place the todo at the end of the manual order
+	lastPosition, err := uc.todoRepository.LastPosition(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := rank.After(lastPosition)
+	if err != nil {
+		return nil, err
+	}
+	todo.MoveTo(position)
+
 	// Save todo
 	if err := uc.todoRepository.Save(ctx, todo); err != nil {
 		return nil, err
 	}
 
 	// Convert to response
-	return toTodoResponse(todo), nil
+	return ToTodoResponse(todo), nil
+}
+
+// checkDuplicates fails with a PossibleDuplicatesError when open todos of the user
+// have a title similar to the title
+func (uc *createTodoUseCase) checkDuplicates(ctx context.Context, userID int64, title vo.TodoTitle) error {
+	similar, err := uc.todoQueryRepository.FindSimilarOpen(ctx, userID, title.Value(), duplicateSimilarity, maxDuplicates)
+	if err != nil {
+		return err
+	}
+
+	if len(similar) == 0 {
+		return nil
+	}
+
+	duplicates := make([]*dto.SimilarTodoResponse, len(similar))
+	for i, todo := range similar {
+		duplicates[i] = &dto.SimilarTodoResponse{
+			Todo:       ToTodoResponse(todo.Todo),
+			Similarity: todo.Similarity,
+		}
+	}
+
+	return &PossibleDuplicatesError{Duplicates: duplicates}
 }
 
-// Helper function to convert entity to DTO
-func toTodoResponse(todo *entity.Todo) *dto.TodoResponse {
-	return &dto.TodoResponse{
+// ToTodoResponse converts a todo to its response, also for the use cases listing
+// todos of other aggregates
+func ToTodoResponse(todo *entity.Todo) *dto.TodoResponse {
+	response := &dto.TodoResponse{
 		ID:          todo.ID(),
 		UserID:      todo.UserID(),
 		Title:       todo.Title().Value(),
@@ -102,7 +221,26 @@ func toTodoResponse(todo *entity.Todo) *dto.TodoResponse {
 		CompletedAt: todo.CompletedAt(),
 		Tags:        todo.Tags(),
 		IsOverdue:   todo.IsOverdue(),
+		Position:    todo.Position(),
+		State:       todo.State(),
+		Resolution:  todo.Resolution(),
+		Estimate:    int64(todo.Estimate() / time.Second),
+		TrackedTime: int64(todo.TrackedTime() / time.Second),
 		CreatedAt:   todo.CreatedAt(),
 		UpdatedAt:   todo.UpdatedAt(),
+		Version:     todo.LockVersion(),
 	}
+
+	if projectID := todo.ProjectID(); projectID != 0 {
+		response.ProjectID = &projectID
+	}
+
+	if customFields := todo.CustomFields(); len(customFields) > 0 {
+		response.CustomFields = make(map[string]any, len(customFields))
+		for _, value := range customFields {
+			response.CustomFields[value.Key()] = value.Value()
+		}
+	}
+
+	return response
 }
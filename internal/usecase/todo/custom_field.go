@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	cfEntity "todolist/internal/domain/customfield/entity"
+	rptCustomField "todolist/internal/domain/customfield/repository"
+	"todolist/internal/domain/todo/entity"
+)
+
+// applyCustomFields sets the values of the custom fields given by key, nil removing
+// them, and drops the values of the fields that no longer apply to the project of
+// the todo. The required fields applying to the todo must then have a value.
+func applyCustomFields(
+	ctx context.Context,
+	customFieldRepository rptCustomField.CustomFieldRepository,
+	todo *entity.Todo,
+	input map[string]any,
+) error {
+	fields, err := customFieldRepository.FindByUser(ctx, todo.UserID())
+	if err != nil {
+		return err
+	}
+	fields = slices.DeleteFunc(fields, func(field *cfEntity.CustomField) bool { return !field.AppliesTo(todo.ProjectID()) })
+
+	for key, value := range input {
+		index := slices.IndexFunc(fields, func(field *cfEntity.CustomField) bool { return field.Key() == key })
+		if index < 0 {
+			return fmt.Errorf("%w: %s", cfEntity.ErrUnknownCustomField, key)
+		}
+
+		if value == nil {
+			todo.RemoveCustomField(fields[index].ID())
+			continue
+		}
+
+		fieldValue, err := fields[index].Parse(value)
+		if err != nil {
+			return err
+		}
+		todo.SetCustomField(fieldValue)
+	}
+
+	for _, value := range todo.CustomFields() {
+		if !slices.ContainsFunc(fields, func(field *cfEntity.CustomField) bool { return field.ID() == value.FieldID() }) {
+			todo.RemoveCustomField(value.FieldID())
+		}
+	}
+
+	for _, field := range fields {
+		if _, ok := todo.CustomField(field.Key()); field.IsRequired() && !ok {
+			return fmt.Errorf("%w: %s", cfEntity.ErrCustomFieldRequired, field.Key())
+		}
+	}
+
+	return nil
+}
@@ -2,38 +2,57 @@ package usecase
 
 import (
 	"context"
+	rptBoard "todolist/internal/domain/board/repository"
+	"todolist/internal/domain/shared"
 	"todolist/internal/domain/todo/repository"
-	"todolist/internal/domain/todo/service"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/service"
 )
 
 // DeleteTodoUseCase handles deleting todos
 type DeleteTodoUseCase interface {
-	Execute(ctx context.Context, userID, todoID int64) error
+	Execute(ctx context.Context, userID, todoID int64, expectedVersions []int64) error
 }
 
 type deleteTodoUseCase struct {
-	todoRepository repository.TodoRepository
-	todoService    service.TodoService
+	todoRepository       repository.TodoRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
 }
 
 // NewDeleteTodoUseCase creates a new instance of DeleteTodoUseCase
 func NewDeleteTodoUseCase(
 	todoRepository repository.TodoRepository,
-	todoService service.TodoService,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
 ) DeleteTodoUseCase {
 	return &deleteTodoUseCase{
-		todoRepository: todoRepository,
-		todoService:    todoService,
+		todoRepository:       todoRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
 	}
 }
 
-// Execute deletes a todo
-func (uc *deleteTodoUseCase) Execute(ctx context.Context, userID, todoID int64) error {
-	// Validate user ownership
-	if err := uc.todoService.ValidateUserOwnership(ctx, todoID, userID); err != nil {
+// Execute deletes a todo, failing with shared.ErrOptimisticLock when expected
+// versions are given and the todo has none of them. The todo is only deleted if it
+// still has the version it was checked with, so a concurrent update is not lost.
+func (uc *deleteTodoUseCase) Execute(ctx context.Context, userID, todoID int64, expectedVersions []int64) error {
+	// Get the todo
+	todo, err := uc.todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return shared.ErrNotFound
+	}
+
+	// Validate user access
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoDelete); err != nil {
+		return err
+	}
+
+	// Validate the todo was not changed since the client read it
+	if err := todo.CheckLockVersion(expectedVersions); err != nil {
 		return err
 	}
 
 	// Delete the todo
-	return uc.todoRepository.Delete(ctx, todoID)
+	return uc.todoRepository.DeleteVersioned(ctx, todoID, todo.LockVersion())
 }
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/todo/repository"
+	"todolist/internal/dto"
+)
+
+// EmptyTrashUseCase handles permanently deleting the todos in the trash of a user
+type EmptyTrashUseCase interface {
+	Execute(ctx context.Context, userID int64) (*dto.EmptyTrashResponse, error)
+}
+
+type emptyTrashUseCase struct {
+	todoRepository repository.TodoRepository
+}
+
+// NewEmptyTrashUseCase creates a new instance of EmptyTrashUseCase
+func NewEmptyTrashUseCase(todoRepository repository.TodoRepository) EmptyTrashUseCase {
+	return &emptyTrashUseCase{
+		todoRepository: todoRepository,
+	}
+}
+
+// Execute permanently deletes the todos in the trash of the user
+func (uc *emptyTrashUseCase) Execute(ctx context.Context, userID int64) (*dto.EmptyTrashResponse, error) {
+	purged, err := uc.todoRepository.PurgeDeletedByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.EmptyTrashResponse{PurgedCount: purged}, nil
+}
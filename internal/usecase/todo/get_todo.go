@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	rptBoard "todolist/internal/domain/board/repository"
 	"todolist/internal/domain/shared"
 	"todolist/internal/domain/todo/repository"
-	"todolist/internal/domain/todo/service"
+	uservo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
+	"todolist/internal/service"
 )
 
 // GetTodoUseCase handles retrieving a single todo
@@ -14,33 +16,36 @@ type GetTodoUseCase interface {
 }
 
 type getTodoUseCase struct {
-	todoRepository repository.TodoRepository
-	todoService    service.TodoService
+	todoRepository       repository.TodoRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
 }
 
 // NewGetTodoUseCase creates a new instance of GetTodoUseCase
 func NewGetTodoUseCase(
 	todoRepository repository.TodoRepository,
-	todoService service.TodoService,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
 ) GetTodoUseCase {
 	return &getTodoUseCase{
-		todoRepository: todoRepository,
-		todoService:    todoService,
+		todoRepository:       todoRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
 	}
 }
 
 // Execute retrieves a todo by ID
 func (uc *getTodoUseCase) Execute(ctx context.Context, userID, todoID int64) (*dto.TodoResponse, error) {
-	// Validate user ownership
-	if err := uc.todoService.ValidateUserOwnership(ctx, todoID, userID); err != nil {
-		return nil, err
-	}
-
 	// Get the todo
 	todo, err := uc.todoRepository.FindByID(ctx, todoID)
 	if err != nil {
 		return nil, shared.ErrNotFound
 	}
 
-	return toTodoResponse(todo), nil
+	// Validate user access
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoRead); err != nil {
+		return nil, err
+	}
+
+	return ToTodoResponse(todo), nil
 }
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	rptBoard "todolist/internal/domain/board/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// GetTodoHistoryUseCase handles retrieving the history timeline of a todo
+type GetTodoHistoryUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64) (*dto.TodoHistoryResponse, error)
+}
+
+type getTodoHistoryUseCase struct {
+	todoRepository       repository.TodoRepository
+	auditLogRepository   rptAudit.AuditLogRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewGetTodoHistoryUseCase creates a new instance of GetTodoHistoryUseCase
+func NewGetTodoHistoryUseCase(
+	todoRepository repository.TodoRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
+) GetTodoHistoryUseCase {
+	return &getTodoHistoryUseCase{
+		todoRepository:       todoRepository,
+		auditLogRepository:   auditLogRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute lists the recorded changes of a todo, newest first
+func (uc *getTodoHistoryUseCase) Execute(ctx context.Context, userID, todoID int64) (*dto.TodoHistoryResponse, error) {
+	todo, err := uc.todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoRead); err != nil {
+		return nil, err
+	}
+
+	history, err := uc.auditLogRepository.FindByEntity(ctx, entAudit.EntityTypeTodo, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TodoHistoryResponse{
+		TodoID:         todoID,
+		CurrentVersion: len(history),
+		Entries:        make([]*dto.TodoHistoryEntryResponse, 0, len(history)),
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		response.Entries = append(response.Entries, toTodoHistoryEntryResponse(history[i], i+1))
+	}
+
+	return response, nil
+}
@@ -3,63 +3,193 @@ package usecase
 import (
 	"context"
 	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
 	"todolist/internal/domain/todo/repository"
 	vo "todolist/internal/domain/todo/valueobject"
+	rptUser "todolist/internal/domain/user/repository"
 	"todolist/internal/dto"
+	"todolist/pkg/cursor"
 )
 
 // ListTodosUseCase handles listing todos with filters
 type ListTodosUseCase interface {
-	Execute(ctx context.Context, userID int64, filters vo.TodoFilterCriteria, options shared.QueryOptions) (*dto.TodoListResponse, error)
+	Execute(ctx context.Context, userID int64, filters vo.TodoFilterCriteria, options shared.QueryOptions, pageCursor string, withFacets bool) (*dto.TodoListResponse, error)
 }
 
+// maxFacetTags is the number of tags listed in the facets, the most used first
+const maxFacetTags = 20
+
 type listTodosUseCase struct {
-	todoQueryRepository repository.TodoQueryRepository
+	todoQueryRepository   repository.TodoQueryRepository
+	userRepository        rptUser.UserRepository
+	cursorCodec           *cursor.Codec
+	defaultSearchLanguage sharedvo.SearchLanguage
 }
 
 // NewListTodosUseCase creates a new instance of ListTodosUseCase
-func NewListTodosUseCase(todoQueryRepository repository.TodoQueryRepository) ListTodosUseCase {
+func NewListTodosUseCase(
+	todoQueryRepository repository.TodoQueryRepository,
+	userRepository rptUser.UserRepository,
+	cursorCodec *cursor.Codec,
+	defaultSearchLanguage sharedvo.SearchLanguage,
+) ListTodosUseCase {
 	return &listTodosUseCase{
-		todoQueryRepository: todoQueryRepository,
+		todoQueryRepository:   todoQueryRepository,
+		userRepository:        userRepository,
+		cursorCodec:           cursorCodec,
+		defaultSearchLanguage: defaultSearchLanguage,
 	}
 }
 
-// Execute lists todos based on filters
+// Execute lists todos based on filters.
+//
+// Pages start at the offset of the options unless a cursor returned by a previous
+// page is given, which fails with cursor.ErrInvalidCursor when it was altered or
+// issued for another ordering. Cursors to the next and previous pages are
+// returned when the ordering supports them.
+//
+// Search terms are searched in the search language of the user, unless the
+// filters set the language.
+//
+// The total counts the todos matching the filters. When withFacets is set, they
+// are also counted by status, priority, tag and due date bucket.
 func (uc *listTodosUseCase) Execute(
 	ctx context.Context,
 	userID int64,
 	filters vo.TodoFilterCriteria,
 	options shared.QueryOptions,
+	pageCursor string,
+	withFacets bool,
 ) (*dto.TodoListResponse, error) {
 	// Ensure user filter is set
 	filters.UserID = userID
 
-	// Get todos
+	if filters.HasSearchTerm() && filters.SearchLanguage == "" {
+		language, err := searchLanguageOf(ctx, uc.userRepository, uc.defaultSearchLanguage, userID)
+		if err != nil {
+			return nil, err
+		}
+		filters.SearchLanguage = language
+	}
+
+	if pageCursor != "" {
+		position, err := decodeTodoCursor(uc.cursorCodec, pageCursor, options)
+		if err != nil {
+			return nil, err
+		}
+		options.Cursor = position
+		options.Offset = 0
+	}
+
+	// Get todos, one more than the page tells whether there are more
+	pageSize := options.Limit
+	if pageSize > 0 {
+		options.Limit++
+	}
+
 	todos, err := uc.todoQueryRepository.FindByFilters(ctx, filters, options)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get total count
-	countFilters := []shared.Filter{
-		{Field: "user_id", Operator: shared.FilterOperatorEqual, Value: userID},
+	hasMore := pageSize > 0 && len(todos) > pageSize
+	if hasMore {
+		if options.Cursor != nil && options.Cursor.Backward {
+			todos = todos[1:]
+		} else {
+			todos = todos[:pageSize]
+		}
 	}
-	totalCount, err := uc.todoQueryRepository.Count(ctx, countFilters)
-	if err != nil {
-		return nil, err
+	options.Limit = pageSize
+
+	// Get total count, which the facets already give
+	var facets *vo.TodoFacets
+	var totalCount int64
+	if withFacets {
+		if facets, err = uc.todoQueryRepository.FacetsByFilters(ctx, filters, maxFacetTags); err != nil {
+			return nil, err
+		}
+		totalCount = facets.Total
+	} else {
+		if totalCount, err = uc.todoQueryRepository.CountByFilters(ctx, filters); err != nil {
+			return nil, err
+		}
 	}
 
 	// Convert to response
 	response := &dto.TodoListResponse{
 		Todos:      make([]*dto.TodoResponse, len(todos)),
 		TotalCount: totalCount,
-		Page:       options.Offset/options.Limit + 1,
+		Page:       pageOf(options),
 		PageSize:   options.Limit,
 	}
 
 	for i, todo := range todos {
-		response.Todos[i] = toTodoResponse(todo)
+		response.Todos[i] = ToTodoResponse(todo)
+	}
+
+	if facets != nil {
+		response.Facets = toTodoFacetsResponse(facets)
+	}
+
+	if err := uc.setCursors(response, todos, options, hasMore); err != nil {
+		return nil, err
 	}
 
 	return response, nil
 }
+
+// setCursors sets the cursors to the pages around the listed todos
+func (uc *listTodosUseCase) setCursors(
+	response *dto.TodoListResponse,
+	todos []*entity.Todo,
+	options shared.QueryOptions,
+	hasMore bool,
+) error {
+	if len(todos) == 0 || !supportsTodoCursor(options.Sort) {
+		return nil
+	}
+
+	backward := options.Cursor != nil && options.Cursor.Backward
+	hasNext := hasMore || backward
+	hasPrev := backward && hasMore || !backward && (options.Cursor != nil || options.Offset > 0)
+
+	var err error
+	if hasNext {
+		if response.NextCursor, err = encodeTodoCursor(uc.cursorCodec, todos[len(todos)-1], options, false); err != nil {
+			return err
+		}
+	}
+	if hasPrev {
+		if response.PrevCursor, err = encodeTodoCursor(uc.cursorCodec, todos[0], options, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toTodoFacetsResponse converts the facets of a todo listing to its response
+func toTodoFacetsResponse(facets *vo.TodoFacets) *dto.TodoFacetsResponse {
+	response := &dto.TodoFacetsResponse{
+		Status:   facets.ByStatus,
+		Priority: facets.ByPriority,
+		Tags:     make([]dto.TagCountResponse, len(facets.ByTag)),
+		DueDate:  facets.ByDueDate,
+	}
+
+	for i, tag := range facets.ByTag {
+		response.Tags[i] = dto.TagCountResponse{Tag: tag.Tag, Count: tag.Count}
+	}
+
+	return response
+}
+
+// pageOf returns the page number for the query options
+func pageOf(options shared.QueryOptions) int {
+	if options.Limit <= 0 {
+		return 1
+	}
+	return options.Offset/options.Limit + 1
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	"todolist/internal/dto"
+)
+
+// ListTrashUseCase handles listing the todos in the trash
+type ListTrashUseCase interface {
+	Execute(ctx context.Context, userID int64, options shared.QueryOptions) (*dto.TrashListResponse, error)
+}
+
+type listTrashUseCase struct {
+	todoQueryRepository repository.TodoQueryRepository
+	retention           time.Duration
+}
+
+// NewListTrashUseCase creates a new instance of ListTrashUseCase.
+//
+// The retention is the time todos stay in the trash, zero when they are kept forever.
+func NewListTrashUseCase(todoQueryRepository repository.TodoQueryRepository, retention time.Duration) ListTrashUseCase {
+	return &listTrashUseCase{
+		todoQueryRepository: todoQueryRepository,
+		retention:           retention,
+	}
+}
+
+// Execute lists the todos in the trash of the user, most recently deleted first
+func (uc *listTrashUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	options shared.QueryOptions,
+) (*dto.TrashListResponse, error) {
+	todos, err := uc.todoQueryRepository.FindDeleted(ctx, userID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := uc.todoQueryRepository.CountDeleted(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TrashListResponse{
+		Todos:      make([]*dto.TrashedTodoResponse, len(todos)),
+		TotalCount: totalCount,
+		Page:       pageOf(options),
+		PageSize:   options.Limit,
+	}
+
+	for i, todo := range todos {
+		response.Todos[i] = toTrashedTodoResponse(todo, uc.retention)
+	}
+
+	return response, nil
+}
+
+// toTrashedTodoResponse converts a todo in the trash to its DTO
+func toTrashedTodoResponse(todo *entity.Todo, retention time.Duration) *dto.TrashedTodoResponse {
+	response := &dto.TrashedTodoResponse{
+		TodoResponse: *ToTodoResponse(todo),
+		PurgeAt:      todo.PurgeAt(retention),
+	}
+
+	if deletedAt := todo.DeletedAt(); deletedAt != nil {
+		response.DeletedAt = *deletedAt
+	}
+
+	return response
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	rptBoard "todolist/internal/domain/board/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+	"todolist/pkg/rank"
+)
+
+// ErrInvalidMove is returned when a todo is moved without neighbors, next to itself
+// or to todos of another user, or between todos given in the wrong order
+var ErrInvalidMove = errors.New("invalid todo move")
+
+// errNoRoom is returned when the positions around a move must be spread evenly
+// before the todo can be placed there
+var errNoRoom = errors.New("no room between the positions")
+
+// MoveTodoUseCase handles placing todos in the manual order of their user
+type MoveTodoUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64, input dto.MoveTodoRequest) (*dto.TodoResponse, error)
+}
+
+type moveTodoUseCase struct {
+	todoRepository       repository.TodoRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
+	maxPositionLength    int
+}
+
+// NewMoveTodoUseCase creates a new instance of MoveTodoUseCase.
+//
+// Positions longer than maxPositionLength are spread evenly before placing a todo.
+func NewMoveTodoUseCase(
+	todoRepository repository.TodoRepository,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
+	maxPositionLength int,
+) MoveTodoUseCase {
+	return &moveTodoUseCase{
+		todoRepository:       todoRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
+		maxPositionLength:    maxPositionLength,
+	}
+}
+
+// Execute moves a todo between two todos of the manual order of its user, or right
+// after or before one of them when only one is given.
+//
+// Only the moved todo changes position, unless the todos around it were never
+// placed or their positions grew too long, in which case the positions of all the
+// todos of the user are spread evenly first.
+func (uc *moveTodoUseCase) Execute(
+	ctx context.Context,
+	userID, todoID int64,
+	input dto.MoveTodoRequest,
+) (*dto.TodoResponse, error) {
+	if input.AfterID == nil && input.BeforeID == nil {
+		return nil, ErrInvalidMove
+	}
+
+	todo, err := uc.todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	position, err := uc.position(ctx, todo, input)
+	if errors.Is(err, errNoRoom) || err == nil && len(position) > uc.maxPositionLength {
+		if err := uc.todoRepository.RebalancePositions(ctx, todo.UserID()); err != nil {
+			return nil, err
+		}
+		position, err = uc.position(ctx, todo, input)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.todoRepository.UpdatePosition(ctx, todo.ID(), position); err != nil {
+		return nil, err
+	}
+	todo.MoveTo(position)
+
+	return ToTodoResponse(todo), nil
+}
+
+// position returns the position between the neighbors of the move, failing with
+// errNoRoom when the positions must be spread evenly first
+func (uc *moveTodoUseCase) position(ctx context.Context, todo *entity.Todo, input dto.MoveTodoRequest) (string, error) {
+	after, err := uc.neighborPosition(ctx, todo, input.AfterID)
+	if err != nil {
+		return "", err
+	}
+
+	before, err := uc.neighborPosition(ctx, todo, input.BeforeID)
+	if err != nil {
+		return "", err
+	}
+
+	// Todos never placed are all at the start of the order, without room between them
+	if input.AfterID != nil && after == "" || input.BeforeID != nil && before == "" {
+		return "", errNoRoom
+	}
+
+	switch {
+	case input.BeforeID == nil:
+		before, err = uc.todoRepository.AdjacentPosition(ctx, todo.UserID(), after, true)
+	case input.AfterID == nil:
+		after, err = uc.todoRepository.AdjacentPosition(ctx, todo.UserID(), before, false)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if after == before {
+		return "", errNoRoom
+	}
+	if before != "" && after > before {
+		return "", ErrInvalidMove
+	}
+
+	return rank.Between(after, before)
+}
+
+// neighborPosition returns the position of a todo the moved todo is placed next
+// to, empty when it is not given
+func (uc *moveTodoUseCase) neighborPosition(ctx context.Context, todo *entity.Todo, neighborID *int64) (string, error) {
+	if neighborID == nil {
+		return "", nil
+	}
+
+	if *neighborID == todo.ID() {
+		return "", ErrInvalidMove
+	}
+
+	neighbor, err := uc.todoRepository.FindByID(ctx, *neighborID)
+	if errors.Is(err, shared.ErrNotFound) {
+		return "", ErrInvalidMove
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if neighbor.UserID() != todo.UserID() {
+		return "", ErrInvalidMove
+	}
+
+	return neighbor.Position(), nil
+}
@@ -0,0 +1,409 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	rptBoard "todolist/internal/domain/board/repository"
+	rptCustomField "todolist/internal/domain/customfield/repository"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+	"todolist/pkg/patch"
+)
+
+// ErrInvalidTodoPatch is returned when the patched todo has unknown fields or invalid values
+var ErrInvalidTodoPatch = errors.New("invalid todo patch")
+
+// todoFieldCustomFields is the field of the patched document holding the custom field
+// values by key
+const todoFieldCustomFields = "custom_fields"
+
+// patchableTodoFields are the fields of the document patches apply to
+var patchableTodoFields = []string{
+	vo.TodoFieldTitle,
+	vo.TodoFieldDescription,
+	vo.TodoFieldStatus,
+	vo.TodoFieldPriority,
+	vo.TodoFieldDueDate,
+	vo.TodoFieldTags,
+	vo.TodoFieldProjectID,
+	vo.TodoFieldState,
+	vo.TodoFieldResolution,
+	vo.TodoFieldEstimate,
+	todoFieldCustomFields,
+}
+
+// todoPatch is a patched todo document
+type todoPatch struct {
+	version      vo.TodoVersion
+	projectID    int64
+	state        string
+	resolution   string
+	estimate     time.Duration
+	customFields map[string]any
+}
+
+// PatchTodoUseCase handles partial updates of todos
+type PatchTodoUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64, input dto.PatchTodoRequest, expectedVersions []int64) (*dto.TodoResponse, error)
+}
+
+type patchTodoUseCase struct {
+	todoRepository        repository.TodoRepository
+	projectRepository     rptProject.ProjectRepository
+	customFieldRepository rptCustomField.CustomFieldRepository
+	boardRepository       rptBoard.BoardRepository
+	authorizationService  service.AuthorizationService
+}
+
+// NewPatchTodoUseCase creates a new instance of PatchTodoUseCase
+func NewPatchTodoUseCase(
+	todoRepository repository.TodoRepository,
+	projectRepository rptProject.ProjectRepository,
+	customFieldRepository rptCustomField.CustomFieldRepository,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
+) PatchTodoUseCase {
+	return &patchTodoUseCase{
+		todoRepository:        todoRepository,
+		projectRepository:     projectRepository,
+		customFieldRepository: customFieldRepository,
+		boardRepository:       boardRepository,
+		authorizationService:  authorizationService,
+	}
+}
+
+// Execute applies the patch to the editable fields of a todo, failing with
+// shared.ErrOptimisticLock when expected versions are given and the todo has
+// none of them.
+//
+// Removing a field, or setting it to null, clears it. The changes are applied
+// through the entity as an update does, so the project, workflow and due date rules
+// apply, the state or status changing last so it can require the patched fields.
+func (uc *patchTodoUseCase) Execute(
+	ctx context.Context,
+	userID, todoID int64,
+	input dto.PatchTodoRequest,
+	expectedVersions []int64,
+) (*dto.TodoResponse, error) {
+	// Get the todo
+	todo, err := uc.todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	// Validate user access
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	// Validate the todo was not changed since the client read it
+	if err := todo.CheckLockVersion(expectedVersions); err != nil {
+		return nil, err
+	}
+
+	// Apply the patch to the todo document
+	document := todoPatchDocument(todo)
+	var patched any
+	if input.JSONPatch != nil {
+		if patched, err = patch.Apply(document, input.JSONPatch); err != nil {
+			return nil, err
+		}
+	} else {
+		patched = patch.ApplyMerge(document, input.MergePatch)
+	}
+
+	changes, err := todoPatchFromDocument(patched)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyPatch(ctx, todo, document, changes); err != nil {
+		return nil, err
+	}
+
+	// Save patched todo
+	if err := uc.todoRepository.Save(ctx, todo); err != nil {
+		return nil, err
+	}
+
+	return ToTodoResponse(todo), nil
+}
+
+// applyPatch applies the changes of a patched document to the todo
+func (uc *patchTodoUseCase) applyPatch(ctx context.Context, todo *entity.Todo, document map[string]any, changes todoPatch) error {
+	state, status := todo.State(), todo.Status()
+
+	// The status changes last, along with the state
+	version := changes.version
+	version.Status = status
+	if err := todo.ApplyVersion(version); err != nil {
+		return err
+	}
+
+	if changes.estimate != todo.Estimate() {
+		if err := todo.UpdateEstimate(changes.estimate); err != nil {
+			return err
+		}
+	}
+
+	projectChanged := changes.projectID != todo.ProjectID()
+	if projectChanged {
+		if err := assignTodoToProject(ctx, uc.projectRepository, todo, changes.projectID); err != nil {
+			return err
+		}
+	}
+
+	// Removed custom fields are cleared
+	if projectChanged || !reflect.DeepEqual(changes.customFields, document[todoFieldCustomFields]) {
+		customFields := maps.Clone(changes.customFields)
+		for key := range document[todoFieldCustomFields].(map[string]any) {
+			if _, ok := customFields[key]; !ok {
+				customFields[key] = nil
+			}
+		}
+		if err := applyCustomFields(ctx, uc.customFieldRepository, todo, customFields); err != nil {
+			return err
+		}
+	}
+
+	// The resolution explains the change when patched, each transition replacing it
+	resolution := ""
+	if changes.resolution != todo.Resolution() {
+		resolution = changes.resolution
+	}
+
+	switch {
+	case changes.state != state:
+		return todo.TransitionTo(changes.state, resolution)
+	case changes.version.Status != status:
+		return transitionTodoToStatus(todo, changes.version.Status, resolution)
+	}
+
+	return nil
+}
+
+// todoPatchDocument returns the editable fields of a todo as a decoded JSON document
+func todoPatchDocument(todo *entity.Todo) map[string]any {
+	tags := make([]any, 0, len(todo.Tags()))
+	for _, tag := range todo.Tags() {
+		tags = append(tags, tag)
+	}
+
+	var dueDate any
+	if todo.DueDate() != nil {
+		dueDate = todo.DueDate().UTC().Format(time.RFC3339Nano)
+	}
+
+	var projectID any
+	if todo.ProjectID() != 0 {
+		projectID = float64(todo.ProjectID())
+	}
+
+	var resolution any
+	if todo.Resolution() != "" {
+		resolution = todo.Resolution()
+	}
+
+	var estimate any
+	if todo.Estimate() != 0 {
+		estimate = float64(todo.Estimate() / time.Second)
+	}
+
+	customFields := make(map[string]any, len(todo.CustomFields()))
+	for _, value := range todo.CustomFields() {
+		customFields[value.Key()] = patchValue(value.Value())
+	}
+
+	return map[string]any{
+		vo.TodoFieldTitle:       todo.Title().Value(),
+		vo.TodoFieldDescription: todo.Description().Value(),
+		vo.TodoFieldStatus:      todo.Status().String(),
+		vo.TodoFieldPriority:    todo.Priority().String(),
+		vo.TodoFieldDueDate:     dueDate,
+		vo.TodoFieldTags:        tags,
+		vo.TodoFieldProjectID:   projectID,
+		vo.TodoFieldState:       todo.State(),
+		vo.TodoFieldResolution:  resolution,
+		vo.TodoFieldEstimate:    estimate,
+		todoFieldCustomFields:   customFields,
+	}
+}
+
+// patchValue returns a custom field value as decoded from JSON
+func patchValue(value any) any {
+	values, ok := value.([]string)
+	if !ok {
+		return value
+	}
+
+	list := make([]any, len(values))
+	for i, value := range values {
+		list[i] = value
+	}
+	return list
+}
+
+// todoPatchFromDocument validates a patched todo document, missing and null fields
+// being cleared
+func todoPatchFromDocument(doc any) (todoPatch, error) {
+	var changes todoPatch
+
+	fields, ok := doc.(map[string]any)
+	if !ok {
+		return changes, fmt.Errorf("%w: the todo must be an object", ErrInvalidTodoPatch)
+	}
+
+	for field := range fields {
+		if !slices.Contains(patchableTodoFields, field) {
+			return changes, fmt.Errorf("%w: %s cannot be patched", ErrInvalidTodoPatch, field)
+		}
+	}
+
+	version, err := todoVersionFromPatch(fields)
+	if err != nil {
+		return changes, err
+	}
+	changes.version = version
+
+	if changes.projectID, err = patchInteger(fields, vo.TodoFieldProjectID); err != nil {
+		return changes, err
+	}
+
+	if changes.state, err = patchString(fields, vo.TodoFieldState); err != nil {
+		return changes, err
+	}
+	if changes.state == "" {
+		return changes, fmt.Errorf("%w: state cannot be cleared", ErrInvalidTodoPatch)
+	}
+
+	if changes.resolution, err = patchString(fields, vo.TodoFieldResolution); err != nil {
+		return changes, err
+	}
+
+	estimate, err := patchInteger(fields, vo.TodoFieldEstimate)
+	if err != nil {
+		return changes, err
+	}
+	changes.estimate = time.Duration(estimate) * time.Second
+
+	customFields, ok := fields[todoFieldCustomFields].(map[string]any)
+	if fields[todoFieldCustomFields] != nil && !ok {
+		return changes, fmt.Errorf("%w: custom_fields must be an object", ErrInvalidTodoPatch)
+	}
+	changes.customFields = maps.Clone(customFields)
+	if changes.customFields == nil {
+		changes.customFields = map[string]any{}
+	}
+
+	return changes, nil
+}
+
+// todoVersionFromPatch validates the fields of a patched todo document kept in its
+// history
+func todoVersionFromPatch(fields map[string]any) (vo.TodoVersion, error) {
+	var version vo.TodoVersion
+
+	title, err := patchString(fields, vo.TodoFieldTitle)
+	if err != nil {
+		return version, err
+	}
+	if version.Title, err = vo.NewTodoTitle(title); err != nil {
+		return version, fmt.Errorf("%w: %w", ErrInvalidTodoPatch, err)
+	}
+
+	description, err := patchString(fields, vo.TodoFieldDescription)
+	if err != nil {
+		return version, err
+	}
+	if version.Description, err = vo.NewTodoDescription(description); err != nil {
+		return version, fmt.Errorf("%w: %w", ErrInvalidTodoPatch, err)
+	}
+
+	status, err := patchString(fields, vo.TodoFieldStatus)
+	if err != nil {
+		return version, err
+	}
+	if version.Status, err = vo.NewTodoStatusFromString(status); err != nil {
+		return version, fmt.Errorf("%w: %w", ErrInvalidTodoPatch, err)
+	}
+
+	priority, err := patchString(fields, vo.TodoFieldPriority)
+	if err != nil {
+		return version, err
+	}
+	if version.Priority, err = sharedvo.NewPriorityFromString(priority); err != nil {
+		return version, fmt.Errorf("%w: %w", ErrInvalidTodoPatch, err)
+	}
+
+	dueDate, err := patchString(fields, vo.TodoFieldDueDate)
+	if err != nil {
+		return version, err
+	}
+	if dueDate != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, dueDate)
+		if err != nil {
+			return version, fmt.Errorf("%w: due_date must be an RFC 3339 date", ErrInvalidTodoPatch)
+		}
+		version.DueDate = &parsed
+	}
+
+	tags, ok := fields[vo.TodoFieldTags].([]any)
+	if fields[vo.TodoFieldTags] != nil && !ok {
+		return version, fmt.Errorf("%w: tags must be an array", ErrInvalidTodoPatch)
+	}
+	version.Tags = make([]string, 0, len(tags))
+	for _, value := range tags {
+		tag, ok := value.(string)
+		if !ok || strings.TrimSpace(tag) == "" {
+			return version, fmt.Errorf("%w: tags must be non empty strings", ErrInvalidTodoPatch)
+		}
+		version.Tags = append(version.Tags, strings.TrimSpace(tag))
+	}
+
+	return version, nil
+}
+
+// patchInteger returns a non negative integer field of a patched document, zero
+// when missing or null
+func patchInteger(fields map[string]any, field string) (int64, error) {
+	value, ok := fields[field]
+	if !ok || value == nil {
+		return 0, nil
+	}
+
+	number, ok := value.(float64)
+	if !ok || number < 0 || number != math.Trunc(number) {
+		return 0, fmt.Errorf("%w: %s must be a non negative integer", ErrInvalidTodoPatch, field)
+	}
+
+	return int64(number), nil
+}
+
+// patchString returns a string field of a patched document, empty when missing or null
+func patchString(fields map[string]any, field string) (string, error) {
+	value, ok := fields[field]
+	if !ok || value == nil {
+		return "", nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s must be a string", ErrInvalidTodoPatch, field)
+	}
+
+	return text, nil
+}
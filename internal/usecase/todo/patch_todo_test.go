@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	cfEntity "todolist/internal/domain/customfield/entity"
+	rptCustomField "todolist/internal/domain/customfield/repository"
+	projectEntity "todolist/internal/domain/project/entity"
+	rptProject "todolist/internal/domain/project/repository"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/dto"
+	"todolist/pkg/patch"
+)
+
+type fakePatchTodoRepository struct {
+	repository.TodoRepository
+	todo  *entity.Todo
+	saved bool
+}
+
+func (f *fakePatchTodoRepository) FindByID(ctx context.Context, id int64) (*entity.Todo, error) {
+	if f.todo.ID() != id {
+		return nil, shared.ErrNotFound
+	}
+	return f.todo, nil
+}
+
+func (f *fakePatchTodoRepository) Save(ctx context.Context, todo *entity.Todo) error {
+	f.saved = true
+	return nil
+}
+
+type fakeProjectRepository struct {
+	rptProject.ProjectRepository
+	projects []*projectEntity.Project
+}
+
+func (f fakeProjectRepository) FindByID(ctx context.Context, id int64) (*projectEntity.Project, error) {
+	for _, project := range f.projects {
+		if project.ID() == id {
+			return project, nil
+		}
+	}
+	return nil, shared.ErrNotFound
+}
+
+type fakeCustomFieldRepository struct {
+	rptCustomField.CustomFieldRepository
+	fields []*cfEntity.CustomField
+}
+
+func (f fakeCustomFieldRepository) FindByUser(ctx context.Context, userID int64) ([]*cfEntity.CustomField, error) {
+	return f.fields, nil
+}
+
+func TestPatchTodoUseCase_Execute(t *testing.T) {
+	state := func(key, category string, requires ...string) vo.WorkflowState {
+		s, err := vo.NewWorkflowState(key, key, category, requires)
+		if err != nil {
+			t.Fatalf("NewWorkflowState(%q) error = %v", key, err)
+		}
+		return s
+	}
+
+	workflow, err := vo.NewWorkflow(
+		[]vo.WorkflowState{
+			state("backlog", "open"),
+			state("doing", "in_progress"),
+			state("review", "in_progress", "description"),
+			state("fixed", "done", "resolution"),
+		},
+		map[string][]string{
+			"backlog": {"doing"},
+			"doing":   {"review", "backlog"},
+			"review":  {"fixed", "doing"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+
+	project, _ := projectEntity.NewProject(7, 10, "Releases", workflow)
+	otherProject, _ := projectEntity.NewProject(8, 20, "Other", workflow)
+	projects := fakeProjectRepository{projects: []*projectEntity.Project{project, otherProject}}
+
+	points, _ := cfEntity.NewCustomField(1, 10, 0, "points", "Points", vo.CustomFieldNumber, nil, false)
+	team, _ := cfEntity.NewCustomField(2, 10, 0, "team", "Team", vo.CustomFieldSelect, []string{"backend", "frontend"}, false)
+	customFields := fakeCustomFieldRepository{fields: []*cfEntity.CustomField{points, team}}
+
+	// newTodo returns a todo of user 10 on the backend team, in the state of the project
+	// workflow when given
+	newTodo := func(t *testing.T, stateKeys ...string) *entity.Todo {
+		t.Helper()
+
+		title, _ := vo.NewTodoTitle("Write report")
+		todo, err := entity.NewTodo(1, 10, title, vo.TodoDescription{}, sharedvo.PriorityMedium, nil)
+		if err != nil {
+			t.Fatalf("NewTodo() error = %v", err)
+		}
+		value, _ := team.Parse("backend")
+		todo.SetCustomField(value)
+
+		if len(stateKeys) > 0 {
+			if err := todo.AssignToProject(7, workflow); err != nil {
+				t.Fatalf("AssignToProject() error = %v", err)
+			}
+		}
+		for _, key := range stateKeys {
+			if key == "review" {
+				description, _ := vo.NewTodoDescription("Ready for review")
+				todo.UpdateDescription(description)
+			}
+			if err := todo.TransitionTo(key, ""); err != nil {
+				t.Fatalf("TransitionTo(%q) error = %v", key, err)
+			}
+		}
+		return todo
+	}
+
+	tests := []struct {
+		name      string
+		todo      *entity.Todo
+		input     dto.PatchTodoRequest
+		wantErr   error
+		wantCheck func(t *testing.T, todo *entity.Todo)
+	}{
+		{
+			name:  "project and estimate",
+			todo:  newTodo(t),
+			input: dto.PatchTodoRequest{MergePatch: map[string]any{"project_id": 7.0, "estimate_seconds": 3600.0}},
+			wantCheck: func(t *testing.T, todo *entity.Todo) {
+				if todo.ProjectID() != 7 || todo.State() != "backlog" {
+					t.Errorf("Expected the todo in the backlog of project 7, got %q of project %d", todo.State(), todo.ProjectID())
+				}
+				if todo.Estimate() != time.Hour {
+					t.Errorf("Expected an estimate of an hour, got %v", todo.Estimate())
+				}
+			},
+		},
+		{
+			name:  "out of the project",
+			todo:  newTodo(t, "doing"),
+			input: dto.PatchTodoRequest{MergePatch: map[string]any{"project_id": nil}},
+			wantCheck: func(t *testing.T, todo *entity.Todo) {
+				if todo.ProjectID() != 0 || todo.State() != "in_progress" {
+					t.Errorf("Expected the todo in progress outside projects, got %q of project %d", todo.State(), todo.ProjectID())
+				}
+			},
+		},
+		{
+			name:    "project of another user",
+			todo:    newTodo(t),
+			input:   dto.PatchTodoRequest{MergePatch: map[string]any{"project_id": 8.0}},
+			wantErr: projectEntity.ErrProjectNotFound,
+		},
+		{
+			name:  "state requiring a field patched along",
+			todo:  newTodo(t, "doing"),
+			input: dto.PatchTodoRequest{MergePatch: map[string]any{"state": "review", "description": "Ready for review"}},
+			wantCheck: func(t *testing.T, todo *entity.Todo) {
+				if todo.State() != "review" || todo.Description().Value() != "Ready for review" {
+					t.Errorf("Expected the todo in review with its description, got %q", todo.State())
+				}
+			},
+		},
+		{
+			name:  "status with the resolution",
+			todo:  newTodo(t, "doing", "review"),
+			input: dto.PatchTodoRequest{MergePatch: map[string]any{"status": "completed", "resolution": "Shipped"}},
+			wantCheck: func(t *testing.T, todo *entity.Todo) {
+				if todo.State() != "fixed" || todo.Resolution() != "Shipped" || !todo.IsCompleted() {
+					t.Errorf("Expected the todo fixed with its resolution, got %q with %q", todo.State(), todo.Resolution())
+				}
+			},
+		},
+		{
+			name:    "state without the required resolution",
+			todo:    newTodo(t, "doing", "review"),
+			input:   dto.PatchTodoRequest{MergePatch: map[string]any{"state": "fixed"}},
+			wantErr: entity.ErrMissingRequiredField,
+		},
+		{
+			name: "custom fields",
+			todo: newTodo(t),
+			input: dto.PatchTodoRequest{JSONPatch: []patch.Operation{
+				{Op: "add", Path: "/custom_fields/points", Value: []byte("3")},
+				{Op: "remove", Path: "/custom_fields/team"},
+			}},
+			wantCheck: func(t *testing.T, todo *entity.Todo) {
+				value, ok := todo.CustomField("points")
+				if number, _ := value.Number(); !ok || number != 3 {
+					t.Errorf("Expected 3 points, got %v", value.Value())
+				}
+				if _, ok := todo.CustomField("team"); ok {
+					t.Error("Expected the team to be removed")
+				}
+			},
+		},
+		{
+			name:    "unknown custom field",
+			todo:    newTodo(t),
+			input:   dto.PatchTodoRequest{MergePatch: map[string]any{"custom_fields": map[string]any{"size": "XL"}}},
+			wantErr: cfEntity.ErrUnknownCustomField,
+		},
+		{
+			name:    "negative estimate",
+			todo:    newTodo(t),
+			input:   dto.PatchTodoRequest{MergePatch: map[string]any{"estimate_seconds": -60.0}},
+			wantErr: ErrInvalidTodoPatch,
+		},
+		{
+			name:    "cleared state",
+			todo:    newTodo(t),
+			input:   dto.PatchTodoRequest{JSONPatch: []patch.Operation{{Op: "remove", Path: "/state"}}},
+			wantErr: ErrInvalidTodoPatch,
+		},
+		{
+			name:    "field that cannot be patched",
+			todo:    newTodo(t),
+			input:   dto.PatchTodoRequest{MergePatch: map[string]any{"position": "a"}},
+			wantErr: ErrInvalidTodoPatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos := &fakePatchTodoRepository{todo: tt.todo}
+			uc := NewPatchTodoUseCase(todos, projects, customFields, fakeBoardRepository{}, fakeBulkAuthorizationService{})
+
+			_, err := uc.Execute(context.Background(), 10, 1, tt.input, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if todos.saved != (tt.wantErr == nil) {
+				t.Errorf("Expected saved = %v, got %v", tt.wantErr == nil, todos.saved)
+			}
+			if tt.wantCheck != nil {
+				tt.wantCheck(t, tt.todo)
+			}
+		})
+	}
+}
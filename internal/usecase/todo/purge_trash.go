@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/todo/repository"
+)
+
+// PurgeTrashUseCase handles enforcing the trash retention period
+type PurgeTrashUseCase interface {
+	Execute(ctx context.Context, now time.Time) (int64, error)
+}
+
+type purgeTrashUseCase struct {
+	todoRepository repository.TodoRepository
+	retention      time.Duration
+}
+
+// NewPurgeTrashUseCase creates a new instance of PurgeTrashUseCase.
+//
+// The retention is the time todos stay in the trash, zero when they are kept forever.
+func NewPurgeTrashUseCase(todoRepository repository.TodoRepository, retention time.Duration) PurgeTrashUseCase {
+	return &purgeTrashUseCase{
+		todoRepository: todoRepository,
+		retention:      retention,
+	}
+}
+
+// Execute permanently deletes the todos that stayed in the trash longer than the retention period.
+//
+// Returns the number of purged todos.
+func (uc *purgeTrashUseCase) Execute(ctx context.Context, now time.Time) (int64, error) {
+	if uc.retention <= 0 {
+		return 0, nil
+	}
+
+	return uc.todoRepository.PurgeDeletedBefore(ctx, now.Add(-uc.retention))
+}
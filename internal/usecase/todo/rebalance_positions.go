@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/todo/repository"
+)
+
+// RebalancePositionsUseCase handles keeping the positions of the manual todo order short
+type RebalancePositionsUseCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+type rebalancePositionsUseCase struct {
+	todoRepository    repository.TodoRepository
+	maxPositionLength int
+}
+
+// NewRebalancePositionsUseCase creates a new instance of RebalancePositionsUseCase.
+//
+// The positions of a user are rebalanced once one is longer than maxPositionLength.
+func NewRebalancePositionsUseCase(todoRepository repository.TodoRepository, maxPositionLength int) RebalancePositionsUseCase {
+	return &rebalancePositionsUseCase{
+		todoRepository:    todoRepository,
+		maxPositionLength: maxPositionLength,
+	}
+}
+
+// Execute spreads evenly the positions of the todos of the users having todos never
+// placed or placed at positions grown too long.
+//
+// Returns the number of users whose todos were rebalanced.
+func (uc *rebalancePositionsUseCase) Execute(ctx context.Context) (int, error) {
+	userIDs, err := uc.todoRepository.FindUsersToRebalance(ctx, uc.maxPositionLength)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, userID := range userIDs {
+		if err := uc.todoRepository.RebalancePositions(ctx, userID); err != nil {
+			return i, err
+		}
+	}
+
+	return len(userIDs), nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	rptBoard "todolist/internal/domain/board/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// RestoreTodoUseCase handles moving todos out of the trash
+type RestoreTodoUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64) (*dto.TodoResponse, error)
+}
+
+type restoreTodoUseCase struct {
+	todoRepository       repository.TodoRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewRestoreTodoUseCase creates a new instance of RestoreTodoUseCase
+func NewRestoreTodoUseCase(
+	todoRepository repository.TodoRepository,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
+) RestoreTodoUseCase {
+	return &restoreTodoUseCase{
+		todoRepository:       todoRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute restores a todo from the trash
+func (uc *restoreTodoUseCase) Execute(ctx context.Context, userID, todoID int64) (*dto.TodoResponse, error) {
+	todo, err := uc.todoRepository.FindDeletedByID(ctx, todoID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	// Restoring requires the same access as deleting
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoDelete); err != nil {
+		return nil, err
+	}
+
+	if err := uc.todoRepository.Restore(ctx, todoID); err != nil {
+		return nil, err
+	}
+
+	restored, err := uc.todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToTodoResponse(restored), nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	rptBoard "todolist/internal/domain/board/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// RevertTodoUseCase handles restoring a todo to a version of its history
+type RevertTodoUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64, version int) (*dto.TodoResponse, error)
+}
+
+type revertTodoUseCase struct {
+	todoRepository       repository.TodoRepository
+	auditLogRepository   rptAudit.AuditLogRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewRevertTodoUseCase creates a new instance of RevertTodoUseCase
+func NewRevertTodoUseCase(
+	todoRepository repository.TodoRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
+) RevertTodoUseCase {
+	return &revertTodoUseCase{
+		todoRepository:       todoRepository,
+		auditLogRepository:   auditLogRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute restores the todo to the given version, recording the revert as a new change
+func (uc *revertTodoUseCase) Execute(ctx context.Context, userID, todoID int64, version int) (*dto.TodoResponse, error) {
+	todo, err := uc.todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	history, err := uc.auditLogRepository.FindByEntity(ctx, entAudit.EntityTypeTodo, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := todoVersionAt(todo, history, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := todo.ApplyVersion(target); err != nil {
+		return nil, err
+	}
+
+	if err := uc.todoRepository.Save(ctx, todo); err != nil {
+		return nil, err
+	}
+
+	return ToTodoResponse(todo), nil
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	rptUser "todolist/internal/domain/user/repository"
+)
+
+// searchLanguageOf returns the search language chosen by the user, or the default
+// language when the user has not chosen one
+func searchLanguageOf(
+	ctx context.Context,
+	userRepository rptUser.UserRepository,
+	defaultLanguage sharedvo.SearchLanguage,
+	userID int64,
+) (sharedvo.SearchLanguage, error) {
+	user, err := userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if language := user.SearchLanguage(); language.IsValid() {
+		return language, nil
+	}
+
+	return defaultLanguage, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+// SearchTodosUseCase handles the full-text search of todos
+type SearchTodosUseCase interface {
+	Execute(ctx context.Context, userID int64, filters vo.TodoFilterCriteria, options shared.QueryOptions) (*dto.TodoSearchResponse, error)
+}
+
+type searchTodosUseCase struct {
+	todoQueryRepository   repository.TodoQueryRepository
+	userRepository        rptUser.UserRepository
+	defaultSearchLanguage sharedvo.SearchLanguage
+}
+
+// NewSearchTodosUseCase creates a new instance of SearchTodosUseCase
+func NewSearchTodosUseCase(
+	todoQueryRepository repository.TodoQueryRepository,
+	userRepository rptUser.UserRepository,
+	defaultSearchLanguage sharedvo.SearchLanguage,
+) SearchTodosUseCase {
+	return &searchTodosUseCase{
+		todoQueryRepository:   todoQueryRepository,
+		userRepository:        userRepository,
+		defaultSearchLanguage: defaultSearchLanguage,
+	}
+}
+
+// Execute searches the todos of the user for the search term of the filters, the
+// most relevant first. The term is searched in the search language of the user,
+// unless the filters set the language. The sort order of the options is ignored.
+func (uc *searchTodosUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	filters vo.TodoFilterCriteria,
+	options shared.QueryOptions,
+) (*dto.TodoSearchResponse, error) {
+	filters.UserID = userID
+
+	if filters.SearchLanguage == "" {
+		language, err := searchLanguageOf(ctx, uc.userRepository, uc.defaultSearchLanguage, userID)
+		if err != nil {
+			return nil, err
+		}
+		filters.SearchLanguage = language
+	}
+
+	results, err := uc.todoQueryRepository.Search(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := uc.todoQueryRepository.CountByFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TodoSearchResponse{
+		Results:    make([]*dto.TodoSearchResultResponse, len(results)),
+		Language:   filters.SearchLanguage.String(),
+		TotalCount: totalCount,
+	}
+
+	for i, result := range results {
+		response.Results[i] = &dto.TodoSearchResultResponse{
+			Todo: ToTodoResponse(result.Todo),
+			Rank: result.Rank,
+			Highlights: dto.TodoHighlightsResponse{
+				Title:       result.TitleHighlight,
+				Description: result.DescriptionHighlight,
+			},
+		}
+	}
+
+	return response, nil
+}
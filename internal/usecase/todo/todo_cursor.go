@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"strconv"
+	"time"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/entity"
+	"todolist/pkg/cursor"
+)
+
+// todoCursor is the payload of the cursors of todo listings, bound to the
+// sort order they were issued for
+type todoCursor struct {
+	OrderBy   string `json:"o"`
+	OrderDesc bool   `json:"d,omitempty"`
+	Value     string `json:"v,omitempty"`
+	ID        int64  `json:"i"`
+	Backward  bool   `json:"b,omitempty"`
+}
+
+// todoCursorColumn is a column todo listings can be paginated by with cursors
+type todoCursorColumn struct {
+	value func(todo *entity.Todo) string
+	parse func(value string) (any, error)
+}
+
+// todoCursorColumns are the ordering columns supported by cursors, which must not
+// be nullable. The ID is not listed as it is part of every cursor.
+var todoCursorColumns = map[string]todoCursorColumn{
+	"created_at": {
+		value: func(todo *entity.Todo) string { return formatCursorTime(todo.CreatedAt()) },
+		parse: parseCursorTime,
+	},
+	"updated_at": {
+		value: func(todo *entity.Todo) string { return formatCursorTime(todo.UpdatedAt()) },
+		parse: parseCursorTime,
+	},
+	"priority": {
+		value: func(todo *entity.Todo) string { return strconv.Itoa(int(todo.Priority())) },
+		parse: func(value string) (any, error) { return strconv.Atoi(value) },
+	},
+	"title": {
+		value: func(todo *entity.Todo) string { return todo.Title().String() },
+		parse: func(value string) (any, error) { return value, nil },
+	},
+	"status": {
+		value: func(todo *entity.Todo) string { return todo.Status().String() },
+		parse: func(value string) (any, error) { return value, nil },
+	},
+	"position": {
+		value: func(todo *entity.Todo) string { return todo.Position() },
+		parse: func(value string) (any, error) { return value, nil },
+	},
+}
+
+// supportsTodoCursor checks if todo listings in the sort order can be paginated
+// with cursors, which needs a sort by at most one key
+func supportsTodoCursor(sort shared.Sort) bool {
+	if len(sort) == 0 {
+		return true
+	}
+	if len(sort) > 1 {
+		return false
+	}
+
+	_, ok := todoCursorColumns[sort[0].Key]
+	return ok || sort[0].Key == "id"
+}
+
+// todoCursorField returns the key todo listings paginated with cursors are sorted by,
+// the zero field when they are only sorted by ID
+func todoCursorField(sort shared.Sort) shared.SortField {
+	if len(sort) == 0 {
+		return shared.SortField{}
+	}
+	return sort[0]
+}
+
+// decodeTodoCursor decodes a cursor into the position it points to, failing with
+// cursor.ErrInvalidCursor when it was not issued for the ordering of the options
+func decodeTodoCursor(codec *cursor.Codec, token string, options shared.QueryOptions) (*shared.Cursor, error) {
+	var payload todoCursor
+	if err := codec.Decode(token, &payload); err != nil {
+		return nil, err
+	}
+
+	field := todoCursorField(options.Sort)
+	if !supportsTodoCursor(options.Sort) || payload.OrderBy != field.Key || payload.OrderDesc != field.Desc {
+		return nil, cursor.ErrInvalidCursor
+	}
+
+	position := &shared.Cursor{ID: payload.ID, Backward: payload.Backward}
+	if column, ok := todoCursorColumns[payload.OrderBy]; ok {
+		value, err := column.parse(payload.Value)
+		if err != nil {
+			return nil, cursor.ErrInvalidCursor
+		}
+		position.Value = value
+	}
+
+	return position, nil
+}
+
+// encodeTodoCursor encodes the position of the todo in the ordering of the options
+func encodeTodoCursor(codec *cursor.Codec, todo *entity.Todo, options shared.QueryOptions, backward bool) (string, error) {
+	field := todoCursorField(options.Sort)
+	payload := todoCursor{
+		OrderBy:   field.Key,
+		OrderDesc: field.Desc,
+		ID:        todo.ID(),
+		Backward:  backward,
+	}
+	if column, ok := todoCursorColumns[field.Key]; ok {
+		payload.Value = column.value(todo)
+	}
+
+	return codec.Encode(payload)
+}
+
+// formatCursorTime formats a time of a cursor, keeping its full precision
+func formatCursorTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// parseCursorTime parses a time of a cursor
+func parseCursorTime(value string) (any, error) {
+	return time.Parse(time.RFC3339Nano, value)
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	"todolist/internal/domain/todo/repository"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/pkg/cursor"
+)
+
+// fakeKeysetTodoQueryRepository lists todos by ID as the repository does, selecting
+// backward pages in reverse order and restoring the listing order
+type fakeKeysetTodoQueryRepository struct {
+	repository.TodoQueryRepository
+	todos []*entity.Todo
+}
+
+func (f *fakeKeysetTodoQueryRepository) FindByFilters(
+	ctx context.Context,
+	filters vo.TodoFilterCriteria,
+	options shared.QueryOptions,
+) ([]*entity.Todo, error) {
+	rows := slices.Clone(f.todos)
+	position := options.Cursor
+
+	switch {
+	case position == nil:
+		rows = rows[min(options.Offset, len(rows)):]
+	case position.Backward:
+		rows = slices.DeleteFunc(rows, func(todo *entity.Todo) bool { return todo.ID() >= position.ID })
+		slices.Reverse(rows)
+	default:
+		rows = slices.DeleteFunc(rows, func(todo *entity.Todo) bool { return todo.ID() <= position.ID })
+	}
+
+	if options.Limit > 0 && len(rows) > options.Limit {
+		rows = rows[:options.Limit]
+	}
+	if position != nil && position.Backward {
+		slices.Reverse(rows)
+	}
+	return rows, nil
+}
+
+func (f *fakeKeysetTodoQueryRepository) CountByFilters(ctx context.Context, filters vo.TodoFilterCriteria) (int64, error) {
+	return int64(len(f.todos)), nil
+}
+
+func newCursorTestTodo(t *testing.T, id int64, title string, priority sharedvo.Priority) *entity.Todo {
+	t.Helper()
+
+	todoTitle, err := vo.NewTodoTitle(title)
+	if err != nil {
+		t.Fatalf("NewTodoTitle() error = %v", err)
+	}
+	todo, err := entity.NewTodo(id, 10, todoTitle, vo.TodoDescription{}, priority, nil)
+	if err != nil {
+		t.Fatalf("NewTodo() error = %v", err)
+	}
+	return todo
+}
+
+func TestListTodosUseCase_Execute_Cursors(t *testing.T) {
+	codec, _ := cursor.NewCodec([]byte("secret"))
+
+	todos := make([]*entity.Todo, 7)
+	for i := range todos {
+		todos[i] = newCursorTestTodo(t, int64(i+1), "Todo", sharedvo.PriorityMedium)
+	}
+	uc := NewListTodosUseCase(&fakeKeysetTodoQueryRepository{todos: todos}, nil, codec, "")
+
+	options := shared.QueryOptions{Limit: 3}
+	list := func(t *testing.T, pageCursor string) ([]int64, string, string) {
+		t.Helper()
+
+		response, err := uc.Execute(context.Background(), 10, vo.TodoFilterCriteria{}, options, pageCursor, false)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		ids := make([]int64, len(response.Todos))
+		for i, todo := range response.Todos {
+			ids[i] = todo.ID
+		}
+		return ids, response.PrevCursor, response.NextCursor
+	}
+
+	// Walk forward to the last page and back to the first one
+	steps := []struct {
+		name     string
+		next     bool
+		wantIDs  []int64
+		wantPrev bool
+		wantNext bool
+	}{
+		{name: "first page", wantIDs: []int64{1, 2, 3}, wantNext: true},
+		{name: "middle page", next: true, wantIDs: []int64{4, 5, 6}, wantPrev: true, wantNext: true},
+		{name: "last page", next: true, wantIDs: []int64{7}, wantPrev: true},
+		{name: "back to the middle page", wantIDs: []int64{4, 5, 6}, wantPrev: true, wantNext: true},
+		{name: "back to the first page", wantIDs: []int64{1, 2, 3}, wantNext: true},
+	}
+
+	var prev, next string
+	for i, step := range steps {
+		pageCursor := ""
+		if i > 0 {
+			pageCursor = prev
+			if step.next {
+				pageCursor = next
+			}
+		}
+
+		ids, gotPrev, gotNext := list(t, pageCursor)
+		if !slices.Equal(ids, step.wantIDs) {
+			t.Fatalf("%s: expected todos %v, got %v", step.name, step.wantIDs, ids)
+		}
+		if (gotPrev != "") != step.wantPrev || (gotNext != "") != step.wantNext {
+			t.Fatalf("%s: expected previous %v and next %v, got %q and %q", step.name, step.wantPrev, step.wantNext, gotPrev, gotNext)
+		}
+		prev, next = gotPrev, gotNext
+	}
+
+	t.Run("page after an offset", func(t *testing.T) {
+		response, err := uc.Execute(context.Background(), 10, vo.TodoFilterCriteria{}, shared.QueryOptions{Limit: 3, Offset: 3}, "", false)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if response.PrevCursor == "" || response.NextCursor == "" {
+			t.Errorf("Expected both cursors, got %q and %q", response.PrevCursor, response.NextCursor)
+		}
+	})
+}
+
+func TestDecodeTodoCursor(t *testing.T) {
+	codec, _ := cursor.NewCodec([]byte("secret"))
+	otherCodec, _ := cursor.NewCodec([]byte("other secret"))
+
+	todo := newCursorTestTodo(t, 5, "Write report", sharedvo.PriorityHigh)
+	byPriority := shared.QueryOptions{Sort: shared.Sort{{Key: "priority", Desc: true}}}
+
+	encode := func(t *testing.T, codec *cursor.Codec, options shared.QueryOptions, backward bool) string {
+		t.Helper()
+		token, err := encodeTodoCursor(codec, todo, options, backward)
+		if err != nil {
+			t.Fatalf("encodeTodoCursor() error = %v", err)
+		}
+		return token
+	}
+
+	t.Run("should decode the position of the todo", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			options   shared.QueryOptions
+			backward  bool
+			wantValue any
+		}{
+			{name: "by id", options: shared.QueryOptions{}},
+			{name: "by id descending", options: shared.QueryOptions{Sort: shared.Sort{{Key: "id", Desc: true}}}},
+			{name: "by priority", options: byPriority, backward: true, wantValue: int(sharedvo.PriorityHigh)},
+			{name: "by title", options: shared.QueryOptions{Sort: shared.Sort{{Key: "title"}}}, wantValue: "Write report"},
+			{name: "by status", options: shared.QueryOptions{Sort: shared.Sort{{Key: "status"}}}, wantValue: "pending"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				position, err := decodeTodoCursor(codec, encode(t, codec, tt.options, tt.backward), tt.options)
+				if err != nil {
+					t.Fatalf("decodeTodoCursor() error = %v", err)
+				}
+				if position.ID != 5 || position.Backward != tt.backward || position.Value != tt.wantValue {
+					t.Errorf("Expected todo 5 at %v, backward %v, got %+v", tt.wantValue, tt.backward, position)
+				}
+			})
+		}
+	})
+
+	t.Run("should keep the full precision of times", func(t *testing.T) {
+		options := shared.QueryOptions{Sort: shared.Sort{{Key: "created_at"}}}
+		position, err := decodeTodoCursor(codec, encode(t, codec, options, false), options)
+		if err != nil {
+			t.Fatalf("decodeTodoCursor() error = %v", err)
+		}
+		if createdAt, ok := position.Value.(time.Time); !ok || !createdAt.Equal(todo.CreatedAt()) {
+			t.Errorf("Expected %v, got %v", todo.CreatedAt(), position.Value)
+		}
+	})
+
+	token := encode(t, codec, byPriority, false)
+	unparsable, _ := codec.Encode(todoCursor{OrderBy: "priority", OrderDesc: true, Value: "high", ID: 5})
+
+	tests := []struct {
+		name    string
+		token   string
+		options shared.QueryOptions
+	}{
+		{name: "tampered payload", token: "x" + token[1:], options: byPriority},
+		{name: "tampered signature", token: token[:len(token)-1] + "x", options: byPriority},
+		{name: "signed with another secret", token: encode(t, otherCodec, byPriority, false), options: byPriority},
+		{name: "not a cursor", token: "page-2", options: byPriority},
+		{name: "other sort key", token: token, options: shared.QueryOptions{Sort: shared.Sort{{Key: "title", Desc: true}}}},
+		{name: "other sort direction", token: token, options: shared.QueryOptions{Sort: shared.Sort{{Key: "priority"}}}},
+		{name: "no sort", token: token, options: shared.QueryOptions{}},
+		{name: "several sort keys", token: token, options: shared.QueryOptions{Sort: shared.Sort{{Key: "priority", Desc: true}, {Key: "title"}}}},
+		{name: "unparsable value", token: unparsable, options: byPriority},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeTodoCursor(codec, tt.token, tt.options); !errors.Is(err, cursor.ErrInvalidCursor) {
+				t.Errorf("Expected ErrInvalidCursor, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+	entAudit "todolist/internal/domain/audit/entity"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
+	vo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/dto"
+)
+
+var (
+	ErrTodoVersionNotFound = errors.New("todo version not found")
+	ErrNothingToUndo       = errors.New("todo has no change to undo")
+)
+
+// todoVersionAt rebuilds a version of the todo from its history, oldest first.
+//
+// Version n is the state right after the nth recorded change. It is rebuilt walking
+// back from the current state, so todos changed before being audited still have
+// their later versions.
+func todoVersionAt(todo *entity.Todo, history []*entAudit.AuditLog, version int) (vo.TodoVersion, error) {
+	if version < 1 || version > len(history) {
+		return vo.TodoVersion{}, ErrTodoVersionNotFound
+	}
+
+	state := todo.Version()
+	for i := len(history) - 1; i >= version; i-- {
+		if err := applyTodoValues(&state, history[i].OldValues()); err != nil {
+			return vo.TodoVersion{}, err
+		}
+	}
+
+	return state, nil
+}
+
+// applyTodoValues overwrites the version fields with the values recorded in an audit entry
+func applyTodoValues(version *vo.TodoVersion, values map[string]any) error {
+	for field, value := range values {
+		var err error
+
+		switch field {
+		case vo.TodoFieldTitle:
+			version.Title, err = vo.NewTodoTitle(fmt.Sprint(value))
+		case vo.TodoFieldDescription:
+			version.Description, err = vo.NewTodoDescription(fmt.Sprint(value))
+		case vo.TodoFieldStatus:
+			version.Status, err = vo.NewTodoStatusFromString(fmt.Sprint(value))
+		case vo.TodoFieldPriority:
+			version.Priority, err = sharedvo.NewPriorityFromString(fmt.Sprint(value))
+		case vo.TodoFieldDueDate:
+			version.DueDate, err = parseHistoryTime(value)
+		case vo.TodoFieldTags:
+			version.Tags = parseHistoryTags(value)
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid %s in todo history: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+// parseHistoryTime parses a timestamp recorded in the history, nil when unset
+func parseHistoryTime(value any) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, fmt.Sprint(value))
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// parseHistoryTags parses the tags recorded in the history
+func parseHistoryTags(value any) []string {
+	switch tags := value.(type) {
+	case []string:
+		return tags
+	case []any:
+		parsed := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			parsed = append(parsed, fmt.Sprint(tag))
+		}
+		return parsed
+	default:
+		return []string{}
+	}
+}
+
+// toTodoHistoryEntryResponse converts a recorded change of a todo to its DTO
+func toTodoHistoryEntryResponse(auditLog *entAudit.AuditLog, version int) *dto.TodoHistoryEntryResponse {
+	fields := slices.AppendSeq(slices.Collect(maps.Keys(auditLog.OldValues())), maps.Keys(auditLog.NewValues()))
+	slices.Sort(fields)
+
+	response := &dto.TodoHistoryEntryResponse{
+		Version:        version,
+		Action:         auditLog.Action(),
+		ActorID:        auditLog.ActorID(),
+		ImpersonatorID: auditLog.ImpersonatorID(),
+		Changes:        []*dto.TodoFieldChangeResponse{},
+		CreatedAt:      auditLog.CreatedAt(),
+	}
+
+	for _, field := range slices.Compact(fields) {
+		response.Changes = append(response.Changes, &dto.TodoFieldChangeResponse{
+			Field:    field,
+			OldValue: auditLog.OldValues()[field],
+			NewValue: auditLog.NewValues()[field],
+		})
+	}
+
+	return response
+}
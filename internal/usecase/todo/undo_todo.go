@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	rptBoard "todolist/internal/domain/board/repository"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/todo/repository"
+	uservo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// UndoTodoUseCase handles undoing the last change of a todo
+type UndoTodoUseCase interface {
+	Execute(ctx context.Context, userID, todoID int64) (*dto.TodoResponse, error)
+}
+
+type undoTodoUseCase struct {
+	todoRepository       repository.TodoRepository
+	auditLogRepository   rptAudit.AuditLogRepository
+	boardRepository      rptBoard.BoardRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewUndoTodoUseCase creates a new instance of UndoTodoUseCase
+func NewUndoTodoUseCase(
+	todoRepository repository.TodoRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
+) UndoTodoUseCase {
+	return &undoTodoUseCase{
+		todoRepository:       todoRepository,
+		auditLogRepository:   auditLogRepository,
+		boardRepository:      boardRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute restores the todo to the version before its last change.
+//
+// The undo is recorded as a new change, so undoing again redoes the change.
+func (uc *undoTodoUseCase) Execute(ctx context.Context, userID, todoID int64) (*dto.TodoResponse, error) {
+	todo, err := uc.todoRepository.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	history, err := uc.auditLogRepository.FindByEntity(ctx, entAudit.EntityTypeTodo, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creations and restores have no previous values to go back to
+	if len(history) == 0 || len(history[len(history)-1].OldValues()) == 0 {
+		return nil, ErrNothingToUndo
+	}
+
+	target := todo.Version()
+	if err := applyTodoValues(&target, history[len(history)-1].OldValues()); err != nil {
+		return nil, err
+	}
+
+	if err := todo.ApplyVersion(target); err != nil {
+		return nil, err
+	}
+
+	if err := uc.todoRepository.Save(ctx, todo); err != nil {
+		return nil, err
+	}
+
+	return ToTodoResponse(todo), nil
+}
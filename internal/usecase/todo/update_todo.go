@@ -2,52 +2,75 @@ package usecase
 
 import (
 	"context"
+	"time"
+	rptBoard "todolist/internal/domain/board/repository"
+	rptCustomField "todolist/internal/domain/customfield/repository"
+	rptProject "todolist/internal/domain/project/repository"
 	"todolist/internal/domain/shared"
 	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/todo/entity"
 	"todolist/internal/domain/todo/repository"
-	"todolist/internal/domain/todo/service"
 	vo "todolist/internal/domain/todo/valueobject"
+	uservo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
+	"todolist/internal/service"
+	ucProject "todolist/internal/usecase/project"
 )
 
 // UpdateTodoUseCase handles updating todos
 type UpdateTodoUseCase interface {
-	Execute(ctx context.Context, userID, todoID int64, input dto.UpdateTodoRequest) (*dto.TodoResponse, error)
+	Execute(ctx context.Context, userID, todoID int64, input dto.UpdateTodoRequest, expectedVersions []int64) (*dto.TodoResponse, error)
 }
 
 type updateTodoUseCase struct {
-	todoRepository repository.TodoRepository
-	todoService    service.TodoService
+	todoRepository        repository.TodoRepository
+	projectRepository     rptProject.ProjectRepository
+	customFieldRepository rptCustomField.CustomFieldRepository
+	boardRepository       rptBoard.BoardRepository
+	authorizationService  service.AuthorizationService
 }
 
 // NewUpdateTodoUseCase creates a new instance of UpdateTodoUseCase
 func NewUpdateTodoUseCase(
 	todoRepository repository.TodoRepository,
-	todoService service.TodoService,
+	projectRepository rptProject.ProjectRepository,
+	customFieldRepository rptCustomField.CustomFieldRepository,
+	boardRepository rptBoard.BoardRepository,
+	authorizationService service.AuthorizationService,
 ) UpdateTodoUseCase {
 	return &updateTodoUseCase{
-		todoRepository: todoRepository,
-		todoService:    todoService,
+		todoRepository:        todoRepository,
+		projectRepository:     projectRepository,
+		customFieldRepository: customFieldRepository,
+		boardRepository:       boardRepository,
+		authorizationService:  authorizationService,
 	}
 }
 
-// Execute updates a todo
+// Execute updates a todo, failing with shared.ErrOptimisticLock when expected
+// versions are given and the todo has none of them
 func (uc *updateTodoUseCase) Execute(
 	ctx context.Context,
 	userID, todoID int64,
 	input dto.UpdateTodoRequest,
+	expectedVersions []int64,
 ) (*dto.TodoResponse, error) {
-	// Validate user ownership
-	if err := uc.todoService.ValidateUserOwnership(ctx, todoID, userID); err != nil {
-		return nil, err
-	}
-
 	// Get the todo
 	todo, err := uc.todoRepository.FindByID(ctx, todoID)
 	if err != nil {
 		return nil, shared.ErrNotFound
 	}
 
+	// Validate user access
+	if err := authorizeTodo(ctx, uc.authorizationService, uc.boardRepository, userID, todo, uservo.PermissionTodoUpdate); err != nil {
+		return nil, err
+	}
+
+	// Validate the todo was not changed since the client read it
+	if err := todo.CheckLockVersion(expectedVersions); err != nil {
+		return nil, err
+	}
+
 	// Update title if provided
 	if input.Title != nil {
 		title, err := vo.NewTodoTitle(*input.Title)
@@ -82,13 +105,45 @@ func (uc *updateTodoUseCase) Execute(
 		}
 	}
 
-	// Update status if provided
-	if input.Status != nil {
+	// Update estimate if provided
+	if input.Estimate != nil {
+		if err := todo.UpdateEstimate(time.Duration(*input.Estimate) * time.Second); err != nil {
+			return nil, err
+		}
+	}
+
+	// Move the todo to the project if provided, zero removing it from its project
+	if input.ProjectID != nil {
+		if err := assignTodoToProject(ctx, uc.projectRepository, todo, *input.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update custom fields if provided, or when the todo moves to another project
+	// with other fields
+	if input.CustomFields != nil || input.ProjectID != nil {
+		if err := applyCustomFields(ctx, uc.customFieldRepository, todo, input.CustomFields); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update state or status if provided, the resolution explaining the change
+	resolution := ""
+	if input.Resolution != nil {
+		resolution = *input.Resolution
+	}
+
+	switch {
+	case input.State != nil:
+		if err := todo.TransitionTo(*input.State, resolution); err != nil {
+			return nil, err
+		}
+	case input.Status != nil:
 		status, err := vo.NewTodoStatusFromString(*input.Status)
 		if err != nil {
 			return nil, err
 		}
-		if err := todo.ChangeStatus(status); err != nil {
+		if err := transitionTodoToStatus(todo, status, resolution); err != nil {
 			return nil, err
 		}
 	}
@@ -98,5 +153,29 @@ func (uc *updateTodoUseCase) Execute(
 		return nil, err
 	}
 
-	return toTodoResponse(todo), nil
+	return ToTodoResponse(todo), nil
+}
+
+// assignTodoToProject moves a todo to a project of its owner, zero removing it
+// from its project
+func assignTodoToProject(ctx context.Context, projectRepository rptProject.ProjectRepository, todo *entity.Todo, projectID int64) error {
+	var workflow vo.Workflow
+	if projectID != 0 {
+		project, err := ucProject.FindProject(ctx, projectRepository, todo.UserID(), projectID)
+		if err != nil {
+			return err
+		}
+		workflow = project.Workflow()
+	}
+	return todo.AssignToProject(projectID, workflow)
+}
+
+// transitionTodoToStatus moves a todo to the first state in the status its workflow
+// allows to move to, the resolution explaining the change
+func transitionTodoToStatus(todo *entity.Todo, status vo.TodoStatus, resolution string) error {
+	state, ok := todo.Workflow().NextState(todo.State(), status)
+	if !ok {
+		return entity.ErrInvalidStatusTransition
+	}
+	return todo.TransitionTo(state.Key(), resolution)
 }
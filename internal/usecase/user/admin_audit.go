@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	entUser "todolist/internal/domain/user/entity"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+var ErrSelfManagement = errors.New("administrators can not change their own account")
+
+// findManagedUser loads the user managed by an administrator, rejecting changes to the own account
+func findManagedUser(ctx context.Context, userRepository rptUser.UserRepository, actorID, userID int64) (*entUser.User, error) {
+	if actorID == userID {
+		return nil, ErrSelfManagement
+	}
+
+	user, err := userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// recordUserAudit records an administrative action performed on a user
+func recordUserAudit(
+	ctx context.Context,
+	auditLogRepository rptAudit.AuditLogRepository,
+	actorID int64,
+	user *entUser.User,
+	action string,
+	oldValues, newValues map[string]any,
+	client dto.ClientInfo,
+) error {
+	auditLog, err := entAudit.NewAuditLog(0, actorID, entAudit.EntityTypeUser, user.ID(), action, oldValues, newValues)
+	if err != nil {
+		return err
+	}
+
+	auditLog.SetClient(client.IPAddress, client.UserAgent)
+
+	return auditLogRepository.Save(ctx, auditLog)
+}
+
+// toAuditLogResponse converts an audit entry to its DTO
+func toAuditLogResponse(auditLog *entAudit.AuditLog) *dto.AuditLogResponse {
+	return &dto.AuditLogResponse{
+		ID:             auditLog.ID(),
+		ActorID:        auditLog.ActorID(),
+		ImpersonatorID: auditLog.ImpersonatorID(),
+		EntityType:     auditLog.EntityType(),
+		EntityID:       auditLog.EntityID(),
+		Action:         auditLog.Action(),
+		OldValues:      auditLog.OldValues(),
+		NewValues:      auditLog.NewValues(),
+		IPAddress:      auditLog.IPAddress(),
+		UserAgent:      auditLog.UserAgent(),
+		CreatedAt:      auditLog.CreatedAt(),
+	}
+}
+
+// pageOf returns the page number for the query options
+func pageOf(options shared.QueryOptions) int {
+	if options.Limit <= 0 {
+		return 1
+	}
+	return options.Offset/options.Limit + 1
+}
@@ -7,6 +7,7 @@ import (
 	"todolist/internal/domain/user/repository"
 	vo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
+	"todolist/internal/service"
 )
 
 var (
@@ -15,22 +16,32 @@ var (
 
 // ChangePasswordUseCase handles password changes
 type ChangePasswordUseCase interface {
-	Execute(ctx context.Context, userID int64, input dto.ChangePasswordRequest) error
+	Execute(ctx context.Context, userID, currentSessionID int64, input dto.ChangePasswordRequest) error
 }
 
 type changePasswordUseCase struct {
 	userRepository repository.UserRepository
+	sessionService service.SessionService
 }
 
 // NewChangePasswordUseCase creates a new instance of ChangePasswordUseCase
-func NewChangePasswordUseCase(userRepository repository.UserRepository) ChangePasswordUseCase {
+func NewChangePasswordUseCase(
+	userRepository repository.UserRepository,
+	sessionService service.SessionService,
+) ChangePasswordUseCase {
 	return &changePasswordUseCase{
 		userRepository: userRepository,
+		sessionService: sessionService,
 	}
 }
 
-// Execute changes user password
-func (uc *changePasswordUseCase) Execute(ctx context.Context, userID int64, input dto.ChangePasswordRequest) error {
+// Execute changes user password, optionally terminating every other session
+func (uc *changePasswordUseCase) Execute(
+	ctx context.Context,
+	userID,
+	currentSessionID int64,
+	input dto.ChangePasswordRequest,
+) error {
 	// Get the user
 	user, err := uc.userRepository.FindByID(ctx, userID)
 	if err != nil {
@@ -52,5 +63,16 @@ func (uc *changePasswordUseCase) Execute(ctx context.Context, userID int64, inpu
 	user.ChangePassword(newPassword)
 
 	// Save updated user
-	return uc.userRepository.Save(ctx, user)
+	if err := uc.userRepository.Save(ctx, user); err != nil {
+		return err
+	}
+
+	// Log out the other devices when requested
+	if input.RevokeOtherSessions {
+		if _, err := uc.sessionService.RevokeOtherSessions(ctx, userID, currentSessionID); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
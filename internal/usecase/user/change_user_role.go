@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	entUser "todolist/internal/domain/user/entity"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// ChangeUserRoleUseCase handles assigning a role to a user
+type ChangeUserRoleUseCase interface {
+	Execute(ctx context.Context, actorID, userID int64, input dto.ChangeUserRoleRequest, client dto.ClientInfo) (*dto.UserResponse, error)
+}
+
+type changeUserRoleUseCase struct {
+	userRepository       rptUser.UserRepository
+	auditLogRepository   rptAudit.AuditLogRepository
+	authorizationService service.AuthorizationService
+	sessionService       service.SessionService
+	transactionManager   shared.TransactionManager
+}
+
+// NewChangeUserRoleUseCase creates a new instance of ChangeUserRoleUseCase
+func NewChangeUserRoleUseCase(
+	userRepository rptUser.UserRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	authorizationService service.AuthorizationService,
+	sessionService service.SessionService,
+	transactionManager shared.TransactionManager,
+) ChangeUserRoleUseCase {
+	return &changeUserRoleUseCase{
+		userRepository:       userRepository,
+		auditLogRepository:   auditLogRepository,
+		authorizationService: authorizationService,
+		sessionService:       sessionService,
+		transactionManager:   transactionManager,
+	}
+}
+
+// Execute assigns the role to the user.
+//
+// The sessions of the user are revoked so the new role is applied to every device.
+// The role, the revocation and the audit entry are saved in a single transaction.
+func (uc *changeUserRoleUseCase) Execute(
+	ctx context.Context,
+	actorID, userID int64,
+	input dto.ChangeUserRoleRequest,
+	client dto.ClientInfo,
+) (*dto.UserResponse, error) {
+	role := vo.UserRole(input.Role)
+	if !role.IsValid() {
+		return nil, entUser.ErrInvalidRoleName
+	}
+
+	// Only defined roles can be assigned
+	if _, err := uc.authorizationService.RolePermissions(ctx, role); err != nil {
+		return nil, err
+	}
+
+	user, err := findManagedUser(ctx, uc.userRepository, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldRole := user.Role()
+	if oldRole == role {
+		return toUserResponse(user), nil
+	}
+
+	user.ChangeRole(role)
+
+	err = uc.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepository.Save(ctx, user); err != nil {
+			return err
+		}
+
+		if _, err := uc.sessionService.RevokeOtherSessions(ctx, user.ID(), 0); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		if err := recordUserAudit(ctx, uc.auditLogRepository, actorID, user, entAudit.ActionUserRoleChanged,
+			map[string]any{"role": oldRole.String()},
+			map[string]any{"role": role.String()},
+			client,
+		); err != nil {
+			return fmt.Errorf("failed to record audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserResponse(user), nil
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	entUser "todolist/internal/domain/user/entity"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+var errWriteFailed = errors.New("write failed")
+
+// adminStore holds the writes of the transactions, which are only kept when the
+// transaction commits
+type adminStore struct {
+	pending   []string
+	committed []string
+	// failing is the write that fails, none when empty
+	failing string
+}
+
+func (s *adminStore) write(write string) error {
+	if write == s.failing {
+		return fmt.Errorf("%w: %s", errWriteFailed, write)
+	}
+	s.pending = append(s.pending, write)
+	return nil
+}
+
+type fakeAdminUserRepository struct {
+	rptUser.UserRepository
+	store *adminStore
+	users map[int64]*entUser.User
+}
+
+func (f fakeAdminUserRepository) FindByID(ctx context.Context, id int64) (*entUser.User, error) {
+	user, ok := f.users[id]
+	if !ok {
+		return nil, shared.ErrNotFound
+	}
+	return user, nil
+}
+
+func (f fakeAdminUserRepository) Save(ctx context.Context, user *entUser.User) error {
+	return f.store.write(fmt.Sprintf("save %s %s", user.Role(), user.Status()))
+}
+
+type fakeAdminAuditLogRepository struct {
+	rptAudit.AuditLogRepository
+	store *adminStore
+}
+
+func (f fakeAdminAuditLogRepository) Save(ctx context.Context, auditLog *entAudit.AuditLog) error {
+	return f.store.write("audit " + auditLog.Action())
+}
+
+type fakeAdminSessionService struct {
+	service.SessionService
+	store *adminStore
+}
+
+func (f fakeAdminSessionService) RevokeOtherSessions(ctx context.Context, userID, keepSessionID int64) (int64, error) {
+	return 1, f.store.write("revoke sessions")
+}
+
+type fakeAdminTransactionManager struct {
+	store *adminStore
+}
+
+func (f fakeAdminTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.store.pending = nil
+	if err := fn(ctx); err != nil {
+		f.store.pending = nil
+		return err
+	}
+	f.store.committed = append(f.store.committed, f.store.pending...)
+	f.store.pending = nil
+	return nil
+}
+
+// fakeAdminAuthorizationService defines the built-in roles only
+type fakeAdminAuthorizationService struct {
+	service.AuthorizationService
+}
+
+func (fakeAdminAuthorizationService) RolePermissions(ctx context.Context, role vo.UserRole) ([]vo.Permission, error) {
+	if !role.IsBuiltIn() {
+		return nil, entUser.ErrRoleNotFound
+	}
+	return []vo.Permission{vo.PermissionAll}, nil
+}
+
+// newAdminTestUsers returns the administrator 1 and the active user 10
+func newAdminTestUsers(t *testing.T) map[int64]*entUser.User {
+	t.Helper()
+
+	users := make(map[int64]*entUser.User)
+	for id, role := range map[int64]vo.UserRole{1: vo.RoleAdmin, 10: vo.RoleUser} {
+		user, err := entUser.NewUser(id, id, fmt.Sprintf("user%d", id), vo.Password{}, role)
+		if err != nil {
+			t.Fatalf("NewUser() error = %v", err)
+		}
+		users[id] = user
+	}
+	return users
+}
+
+func TestChangeUserRoleUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		actorID       int64
+		userID        int64
+		role          string
+		failing       string
+		wantErr       error
+		wantRole      vo.UserRole
+		wantCommitted []string
+	}{
+		{
+			name:          "role change",
+			actorID:       1,
+			userID:        10,
+			role:          "admin",
+			wantRole:      vo.RoleAdmin,
+			wantCommitted: []string{"save admin active", "revoke sessions", "audit " + entAudit.ActionUserRoleChanged},
+		},
+		{
+			name:     "same role",
+			actorID:  1,
+			userID:   10,
+			role:     "user",
+			wantRole: vo.RoleUser,
+		},
+		{
+			name:    "undefined role",
+			actorID: 1,
+			userID:  10,
+			role:    "auditor",
+			wantErr: entUser.ErrRoleNotFound,
+		},
+		{
+			name:    "own role",
+			actorID: 1,
+			userID:  1,
+			role:    "user",
+			wantErr: ErrSelfManagement,
+		},
+		{
+			// Nothing is kept when the sessions can not be revoked
+			name:    "failing session revocation",
+			actorID: 1,
+			userID:  10,
+			role:    "admin",
+			failing: "revoke sessions",
+			wantErr: errWriteFailed,
+		},
+		{
+			// Nothing is kept when the change can not be audited
+			name:    "failing audit",
+			actorID: 1,
+			userID:  10,
+			role:    "admin",
+			failing: "audit " + entAudit.ActionUserRoleChanged,
+			wantErr: errWriteFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &adminStore{failing: tt.failing}
+			uc := NewChangeUserRoleUseCase(
+				fakeAdminUserRepository{store: store, users: newAdminTestUsers(t)},
+				fakeAdminAuditLogRepository{store: store},
+				fakeAdminAuthorizationService{},
+				fakeAdminSessionService{store: store},
+				fakeAdminTransactionManager{store: store},
+			)
+
+			response, err := uc.Execute(context.Background(), tt.actorID, tt.userID,
+				dto.ChangeUserRoleRequest{Role: tt.role}, dto.ClientInfo{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(store.committed, tt.wantCommitted) {
+				t.Errorf("Expected committed writes %v, got %v", tt.wantCommitted, store.committed)
+			}
+			if err == nil && response.Role != tt.wantRole.String() {
+				t.Errorf("Expected role %q, got %q", tt.wantRole, response.Role)
+			}
+		})
+	}
+}
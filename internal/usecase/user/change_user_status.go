@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// ChangeUserStatusUseCase handles activating, deactivating and blocking users
+type ChangeUserStatusUseCase interface {
+	Execute(ctx context.Context, actorID, userID int64, input dto.ChangeUserStatusRequest, client dto.ClientInfo) (*dto.UserResponse, error)
+}
+
+type changeUserStatusUseCase struct {
+	userRepository     rptUser.UserRepository
+	auditLogRepository rptAudit.AuditLogRepository
+	sessionService     service.SessionService
+	transactionManager shared.TransactionManager
+}
+
+// NewChangeUserStatusUseCase creates a new instance of ChangeUserStatusUseCase
+func NewChangeUserStatusUseCase(
+	userRepository rptUser.UserRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	sessionService service.SessionService,
+	transactionManager shared.TransactionManager,
+) ChangeUserStatusUseCase {
+	return &changeUserStatusUseCase{
+		userRepository:     userRepository,
+		auditLogRepository: auditLogRepository,
+		sessionService:     sessionService,
+		transactionManager: transactionManager,
+	}
+}
+
+// Execute changes the status of the user.
+//
+// Deactivated and blocked users have all their sessions revoked. The status, the
+// revocation and the audit entry are saved in a single transaction.
+func (uc *changeUserStatusUseCase) Execute(
+	ctx context.Context,
+	actorID, userID int64,
+	input dto.ChangeUserStatusRequest,
+	client dto.ClientInfo,
+) (*dto.UserResponse, error) {
+	user, err := findManagedUser(ctx, uc.userRepository, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldStatus := user.Status()
+	status := vo.UserStatus(input.Status)
+	if oldStatus == status {
+		return toUserResponse(user), nil
+	}
+
+	switch status {
+	case vo.StatusActive:
+		user.Activate()
+	case vo.StatusInactive:
+		user.Deactivate()
+	case vo.StatusBlocked:
+		user.Block()
+	default:
+		return nil, shared.ErrInvalidOperation
+	}
+
+	newValues := map[string]any{"status": status.String()}
+	if input.Reason != "" {
+		newValues["reason"] = input.Reason
+	}
+
+	err = uc.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepository.Save(ctx, user); err != nil {
+			return err
+		}
+
+		if !user.IsActive() {
+			if _, err := uc.sessionService.RevokeOtherSessions(ctx, user.ID(), 0); err != nil {
+				return fmt.Errorf("failed to revoke sessions: %w", err)
+			}
+		}
+
+		if err := recordUserAudit(ctx, uc.auditLogRepository, actorID, user, entAudit.ActionUserStatusChanged,
+			map[string]any{"status": oldStatus.String()},
+			newValues,
+			client,
+		); err != nil {
+			return fmt.Errorf("failed to record audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserResponse(user), nil
+}
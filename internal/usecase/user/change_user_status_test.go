@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	entAudit "todolist/internal/domain/audit/entity"
+	"todolist/internal/domain/shared"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+)
+
+func TestChangeUserStatusUseCase_Execute(t *testing.T) {
+	audit := "audit " + entAudit.ActionUserStatusChanged
+
+	tests := []struct {
+		name          string
+		userID        int64
+		status        string
+		failing       string
+		wantErr       error
+		wantStatus    vo.UserStatus
+		wantCommitted []string
+	}{
+		{
+			name:          "blocked user",
+			userID:        10,
+			status:        "blocked",
+			wantStatus:    vo.StatusBlocked,
+			wantCommitted: []string{"save user blocked", "revoke sessions", audit},
+		},
+		{
+			name:          "deactivated user",
+			userID:        10,
+			status:        "inactive",
+			wantStatus:    vo.StatusInactive,
+			wantCommitted: []string{"save user inactive", "revoke sessions", audit},
+		},
+		{
+			name:       "same status",
+			userID:     10,
+			status:     "active",
+			wantStatus: vo.StatusActive,
+		},
+		{
+			name:    "unknown status",
+			userID:  10,
+			status:  "pending",
+			wantErr: shared.ErrInvalidOperation,
+		},
+		{
+			name:    "own status",
+			userID:  1,
+			status:  "inactive",
+			wantErr: ErrSelfManagement,
+		},
+		{
+			// Nothing is kept when the sessions can not be revoked
+			name:    "failing session revocation",
+			userID:  10,
+			status:  "blocked",
+			failing: "revoke sessions",
+			wantErr: errWriteFailed,
+		},
+		{
+			// Nothing is kept when the change can not be audited
+			name:    "failing audit",
+			userID:  10,
+			status:  "blocked",
+			failing: audit,
+			wantErr: errWriteFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &adminStore{failing: tt.failing}
+			uc := NewChangeUserStatusUseCase(
+				fakeAdminUserRepository{store: store, users: newAdminTestUsers(t)},
+				fakeAdminAuditLogRepository{store: store},
+				fakeAdminSessionService{store: store},
+				fakeAdminTransactionManager{store: store},
+			)
+
+			response, err := uc.Execute(context.Background(), 1, tt.userID,
+				dto.ChangeUserStatusRequest{Status: tt.status, Reason: "Spam"}, dto.ClientInfo{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(store.committed, tt.wantCommitted) {
+				t.Errorf("Expected committed writes %v, got %v", tt.wantCommitted, store.committed)
+			}
+			if err == nil && response.Status != tt.wantStatus.String() {
+				t.Errorf("Expected status %q, got %q", tt.wantStatus, response.Status)
+			}
+		})
+	}
+}
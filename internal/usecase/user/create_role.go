@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// CreateRoleUseCase handles the creation of custom roles
+type CreateRoleUseCase interface {
+	Execute(ctx context.Context, input dto.CreateRoleRequest) (*dto.RoleResponse, error)
+}
+
+type createRoleUseCase struct {
+	roleRepository       rptUser.RoleRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewCreateRoleUseCase creates a new instance of CreateRoleUseCase
+func NewCreateRoleUseCase(
+	roleRepository rptUser.RoleRepository,
+	authorizationService service.AuthorizationService,
+) CreateRoleUseCase {
+	return &createRoleUseCase{
+		roleRepository:       roleRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute creates a new custom role
+func (uc *createRoleUseCase) Execute(ctx context.Context, input dto.CreateRoleRequest) (*dto.RoleResponse, error) {
+	name := vo.UserRole(input.Name)
+
+	// Configured roles can not be shadowed by custom ones
+	if uc.authorizationService.IsConfiguredRole(name) {
+		return nil, entity.ErrRoleAlreadyExists
+	}
+
+	role, err := entity.NewRole(0, name, input.Description, toPermissions(input.Permissions))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.roleRepository.Save(ctx, role); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrRoleAlreadyExists
+		}
+		return nil, err
+	}
+
+	uc.authorizationService.ReloadPolicy()
+
+	return toRoleResponse(role, false), nil
+}
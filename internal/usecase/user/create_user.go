@@ -2,9 +2,9 @@ package usecase
 
 import (
 	"context"
-	"time"
 	entPerson "todolist/internal/domain/person/entity"
 	repoPerson "todolist/internal/domain/person/repository"
+	"todolist/internal/domain/shared"
 	entUser "todolist/internal/domain/user/entity"
 	repoUser "todolist/internal/domain/user/repository"
 	vo "todolist/internal/domain/user/valueobject"
@@ -19,16 +19,19 @@ type CreateUserUseCase interface {
 type createUserUseCase struct {
 	userRepository   repoUser.UserRepository
 	personRepository repoPerson.PersonRepository
+	idGenerator      shared.IDGenerator
 }
 
 // NewCreateUserUseCase creates a new instance of CreateUserUseCase
 func NewCreateUserUseCase(
 	userRepository repoUser.UserRepository,
 	personRepository repoPerson.PersonRepository,
+	idGenerator shared.IDGenerator,
 ) CreateUserUseCase {
 	return &createUserUseCase{
 		userRepository:   userRepository,
 		personRepository: personRepository,
+		idGenerator:      idGenerator,
 	}
 }
 
@@ -65,8 +68,13 @@ func (uc *createUserUseCase) Execute(ctx context.Context, personID int64, input
 	}
 
 	// Create user entity
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := entUser.NewUser(
-		time.Now().Unix(),
+		id,
 		personID,
 		input.Username,
 		password,
@@ -87,18 +95,26 @@ func (uc *createUserUseCase) Execute(ctx context.Context, personID int64, input
 
 // Helper function to convert entity to DTO with person info
 func toUserResponseWithPerson(user *entUser.User, person *entPerson.Person) *dto.UserResponse {
+	response := toUserResponse(user)
+	response.Person = &dto.PersonInfo{
+		ID:    person.ID(),
+		Name:  person.Name(),
+		Email: person.Email().Value(),
+	}
+
+	return response
+}
+
+// Helper function to convert entity to DTO
+func toUserResponse(user *entUser.User) *dto.UserResponse {
 	return &dto.UserResponse{
-		ID:       user.ID(),
-		PersonID: user.PersonID(),
-		Username: user.Username(),
-		Status:   user.Status().String(),
-		Role:     user.Role().String(),
-		Person: &dto.PersonInfo{
-			ID:    person.ID(),
-			Name:  person.Name(),
-			Email: person.Email().Value(),
-		},
-		CreatedAt: user.CreatedAt().Format("2006-01-02T15:04:05Z"),
-		UpdatedAt: user.UpdatedAt().Format("2006-01-02T15:04:05Z"),
+		ID:                user.ID(),
+		PersonID:          user.PersonID(),
+		Username:          user.Username(),
+		Status:            user.Status().String(),
+		Role:              user.Role().String(),
+		MustResetPassword: user.MustResetPassword(),
+		CreatedAt:         user.CreatedAt().Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:         user.UpdatedAt().Format("2006-01-02T15:04:05Z"),
 	}
 }
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/service"
+)
+
+// DeleteRoleUseCase handles the removal of custom roles
+type DeleteRoleUseCase interface {
+	Execute(ctx context.Context, name string) error
+}
+
+type deleteRoleUseCase struct {
+	roleRepository       rptUser.RoleRepository
+	userQueryRepository  rptUser.UserQueryRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewDeleteRoleUseCase creates a new instance of DeleteRoleUseCase
+func NewDeleteRoleUseCase(
+	roleRepository rptUser.RoleRepository,
+	userQueryRepository rptUser.UserQueryRepository,
+	authorizationService service.AuthorizationService,
+) DeleteRoleUseCase {
+	return &deleteRoleUseCase{
+		roleRepository:       roleRepository,
+		userQueryRepository:  userQueryRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute deletes a custom role that is not assigned to any user
+func (uc *deleteRoleUseCase) Execute(ctx context.Context, name string) error {
+	roleName := vo.UserRole(name)
+
+	if uc.authorizationService.IsConfiguredRole(roleName) {
+		return entity.ErrBuiltInRole
+	}
+
+	counts, err := uc.userQueryRepository.CountByRole(ctx)
+	if err != nil {
+		return err
+	}
+
+	if counts[roleName] > 0 {
+		return entity.ErrRoleInUse
+	}
+
+	if err := uc.roleRepository.Delete(ctx, roleName); err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return entity.ErrRoleNotFound
+		}
+		return err
+	}
+
+	uc.authorizationService.ReloadPolicy()
+
+	return nil
+}
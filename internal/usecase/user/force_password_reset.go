@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	entAudit "todolist/internal/domain/audit/entity"
+	rptAudit "todolist/internal/domain/audit/repository"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// ForcePasswordResetUseCase handles forcing a user to change the password
+type ForcePasswordResetUseCase interface {
+	Execute(ctx context.Context, actorID, userID int64, input dto.ForcePasswordResetRequest, client dto.ClientInfo) (*dto.UserResponse, error)
+}
+
+type forcePasswordResetUseCase struct {
+	userRepository     rptUser.UserRepository
+	auditLogRepository rptAudit.AuditLogRepository
+	sessionService     service.SessionService
+}
+
+// NewForcePasswordResetUseCase creates a new instance of ForcePasswordResetUseCase
+func NewForcePasswordResetUseCase(
+	userRepository rptUser.UserRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+	sessionService service.SessionService,
+) ForcePasswordResetUseCase {
+	return &forcePasswordResetUseCase{
+		userRepository:     userRepository,
+		auditLogRepository: auditLogRepository,
+		sessionService:     sessionService,
+	}
+}
+
+// Execute flags the user to change the password and revokes all the sessions,
+// so the user has to log in and change the password before using the application again
+func (uc *forcePasswordResetUseCase) Execute(
+	ctx context.Context,
+	actorID, userID int64,
+	input dto.ForcePasswordResetRequest,
+	client dto.ClientInfo,
+) (*dto.UserResponse, error) {
+	user, err := findManagedUser(ctx, uc.userRepository, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.RequirePasswordReset()
+	if err := uc.userRepository.Save(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionService.RevokeOtherSessions(ctx, user.ID(), 0); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	var newValues map[string]any
+	if input.Reason != "" {
+		newValues = map[string]any{"reason": input.Reason}
+	}
+
+	if err := recordUserAudit(ctx, uc.auditLogRepository, actorID, user, entAudit.ActionUserPasswordResetForced,
+		nil,
+		newValues,
+		client,
+	); err != nil {
+		return nil, fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	return toUserResponse(user), nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+// GetPreferencesUseCase handles reading the preferences of a user
+type GetPreferencesUseCase interface {
+	Execute(ctx context.Context, userID int64) (*dto.PreferencesResponse, error)
+}
+
+type getPreferencesUseCase struct {
+	userRepository        repository.UserRepository
+	defaultSearchLanguage sharedvo.SearchLanguage
+}
+
+// NewGetPreferencesUseCase creates a new instance of GetPreferencesUseCase
+func NewGetPreferencesUseCase(
+	userRepository repository.UserRepository,
+	defaultSearchLanguage sharedvo.SearchLanguage,
+) GetPreferencesUseCase {
+	return &getPreferencesUseCase{
+		userRepository:        userRepository,
+		defaultSearchLanguage: defaultSearchLanguage,
+	}
+}
+
+// Execute returns the preferences of the user
+func (uc *getPreferencesUseCase) Execute(ctx context.Context, userID int64) (*dto.PreferencesResponse, error) {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	return toPreferencesResponse(user, uc.defaultSearchLanguage), nil
+}
+
+// toPreferencesResponse converts the preferences of a user to their response
+func toPreferencesResponse(user *entity.User, defaultSearchLanguage sharedvo.SearchLanguage) *dto.PreferencesResponse {
+	languages := sharedvo.SearchLanguages()
+
+	response := &dto.PreferencesResponse{
+		SearchLanguage:        user.SearchLanguage().String(),
+		DefaultSearchLanguage: defaultSearchLanguage.String(),
+		SearchLanguages:       make([]string, len(languages)),
+	}
+
+	for i, language := range languages {
+		response.SearchLanguages[i] = language.String()
+	}
+
+	return response
+}
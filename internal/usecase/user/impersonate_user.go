@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// ImpersonateUserUseCase handles issuing impersonation tokens to administrators
+type ImpersonateUserUseCase interface {
+	Execute(ctx context.Context, actorID, userID int64, input dto.ImpersonateUserRequest, client dto.ClientInfo) (*dto.ImpersonationResponse, error)
+}
+
+type impersonateUserUseCase struct {
+	userRepository       rptUser.UserRepository
+	impersonationService service.ImpersonationService
+	tokenIssuerName      string
+}
+
+// NewImpersonateUserUseCase creates a new instance of ImpersonateUserUseCase
+func NewImpersonateUserUseCase(
+	userRepository rptUser.UserRepository,
+	impersonationService service.ImpersonationService,
+	tokenIssuerName string,
+) ImpersonateUserUseCase {
+	return &impersonateUserUseCase{
+		userRepository:       userRepository,
+		impersonationService: impersonationService,
+		tokenIssuerName:      tokenIssuerName,
+	}
+}
+
+// Execute issues a time-limited token to act as the user
+func (uc *impersonateUserUseCase) Execute(
+	ctx context.Context,
+	actorID, userID int64,
+	input dto.ImpersonateUserRequest,
+	client dto.ClientInfo,
+) (*dto.ImpersonationResponse, error) {
+	grant, err := uc.impersonationService.StartImpersonation(
+		ctx,
+		uc.tokenIssuerName,
+		actorID,
+		userID,
+		time.Duration(input.DurationMinutes)*time.Minute,
+		input.Reason,
+		service.SessionInfo{
+			IPAddress: client.IPAddress,
+			UserAgent: client.UserAgent,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := uc.userRepository.FindByID(ctx, grant.SubjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ImpersonationResponse{
+		Token:     grant.Token,
+		ExpiresAt: grant.Metadata.ExpiresAt,
+		ActorID:   grant.ActorID,
+		Subject:   toUserResponse(subject),
+	}, nil
+}
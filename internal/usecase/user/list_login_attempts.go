@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+// ListLoginAttemptsUseCase handles listing the login attempts of a user
+type ListLoginAttemptsUseCase interface {
+	Execute(ctx context.Context, userID int64, options shared.QueryOptions) (*dto.LoginAttemptListResponse, error)
+}
+
+type listLoginAttemptsUseCase struct {
+	userRepository         rptUser.UserRepository
+	loginAttemptRepository rptUser.LoginAttemptRepository
+}
+
+// NewListLoginAttemptsUseCase creates a new instance of ListLoginAttemptsUseCase
+func NewListLoginAttemptsUseCase(
+	userRepository rptUser.UserRepository,
+	loginAttemptRepository rptUser.LoginAttemptRepository,
+) ListLoginAttemptsUseCase {
+	return &listLoginAttemptsUseCase{
+		userRepository:         userRepository,
+		loginAttemptRepository: loginAttemptRepository,
+	}
+}
+
+// Execute lists the login attempts of the user, newest first
+func (uc *listLoginAttemptsUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	options shared.QueryOptions,
+) (*dto.LoginAttemptListResponse, error) {
+	if _, err := uc.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	attempts, err := uc.loginAttemptRepository.FindByUserID(ctx, userID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := uc.loginAttemptRepository.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.LoginAttemptListResponse{
+		Attempts:   make([]*dto.LoginAttemptResponse, len(attempts)),
+		TotalCount: totalCount,
+		Page:       pageOf(options),
+		PageSize:   options.Limit,
+	}
+
+	for i, attempt := range attempts {
+		response.Attempts[i] = &dto.LoginAttemptResponse{
+			ID:         attempt.ID(),
+			Username:   attempt.Username(),
+			Success:    attempt.Success(),
+			IPAddress:  attempt.IPAddress(),
+			UserAgent:  attempt.UserAgent(),
+			FailReason: attempt.FailReason(),
+			CreatedAt:  attempt.CreatedAt(),
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/user/entity"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// ListRolesUseCase handles listing the configured and custom roles
+type ListRolesUseCase interface {
+	Execute(ctx context.Context) ([]*dto.RoleResponse, error)
+}
+
+type listRolesUseCase struct {
+	authorizationService service.AuthorizationService
+}
+
+// NewListRolesUseCase creates a new instance of ListRolesUseCase
+func NewListRolesUseCase(authorizationService service.AuthorizationService) ListRolesUseCase {
+	return &listRolesUseCase{
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute lists every defined role
+func (uc *listRolesUseCase) Execute(ctx context.Context) ([]*dto.RoleResponse, error) {
+	roles, err := uc.authorizationService.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		response = append(response, toRoleResponse(role, uc.authorizationService.IsConfiguredRole(role.Name())))
+	}
+
+	return response, nil
+}
+
+// toRoleResponse converts a role entity to its response
+func toRoleResponse(role *entity.Role, configured bool) *dto.RoleResponse {
+	permissions := make([]string, 0, len(role.Permissions()))
+	for _, permission := range role.Permissions() {
+		permissions = append(permissions, permission.String())
+	}
+
+	response := &dto.RoleResponse{
+		Name:        role.Name().String(),
+		Description: role.Description(),
+		Permissions: permissions,
+		Configured:  configured,
+	}
+
+	// Configured roles are not persisted and have no timestamps
+	if !configured {
+		createdAt, updatedAt := role.CreatedAt(), role.UpdatedAt()
+		response.CreatedAt = &createdAt
+		response.UpdatedAt = &updatedAt
+	}
+
+	return response
+}
+
+// toPermissions converts permission expressions to value objects
+func toPermissions(expressions []string) []vo.Permission {
+	permissions := make([]vo.Permission, len(expressions))
+	for i, expression := range expressions {
+		permissions[i] = vo.Permission(expression)
+	}
+	return permissions
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/user/entity"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// ListSessionsUseCase handles listing the active sessions of a user
+type ListSessionsUseCase interface {
+	Execute(ctx context.Context, userID, currentSessionID int64) ([]*dto.SessionResponse, error)
+}
+
+type listSessionsUseCase struct {
+	sessionService service.SessionService
+}
+
+// NewListSessionsUseCase creates a new instance of ListSessionsUseCase
+func NewListSessionsUseCase(sessionService service.SessionService) ListSessionsUseCase {
+	return &listSessionsUseCase{
+		sessionService: sessionService,
+	}
+}
+
+// Execute lists the active sessions, flagging the one used by the request
+func (uc *listSessionsUseCase) Execute(
+	ctx context.Context,
+	userID,
+	currentSessionID int64,
+) ([]*dto.SessionResponse, error) {
+	sessions, err := uc.sessionService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*dto.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		response[i] = toSessionResponse(session, currentSessionID)
+	}
+
+	return response, nil
+}
+
+// Helper function to convert entity to DTO
+func toSessionResponse(session *entity.Session, currentSessionID int64) *dto.SessionResponse {
+	return &dto.SessionResponse{
+		ID:         session.ID(),
+		DeviceName: session.DeviceName(),
+		IPAddress:  session.IPAddress(),
+		UserAgent:  session.UserAgent(),
+		Current:    session.ID() == currentSessionID,
+		CreatedAt:  session.CreatedAt(),
+		LastSeenAt: session.LastSeenAt(),
+		ExpiresAt:  session.ExpiresAt(),
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	rptAudit "todolist/internal/domain/audit/repository"
+	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+// ListUserAuditTrailUseCase handles listing the audit trail of a user
+type ListUserAuditTrailUseCase interface {
+	Execute(ctx context.Context, userID int64, options shared.QueryOptions) (*dto.AuditLogListResponse, error)
+}
+
+type listUserAuditTrailUseCase struct {
+	userRepository     rptUser.UserRepository
+	auditLogRepository rptAudit.AuditLogRepository
+}
+
+// NewListUserAuditTrailUseCase creates a new instance of ListUserAuditTrailUseCase
+func NewListUserAuditTrailUseCase(
+	userRepository rptUser.UserRepository,
+	auditLogRepository rptAudit.AuditLogRepository,
+) ListUserAuditTrailUseCase {
+	return &listUserAuditTrailUseCase{
+		userRepository:     userRepository,
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+// Execute lists the actions performed by the user or on the user, newest first
+func (uc *listUserAuditTrailUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	options shared.QueryOptions,
+) (*dto.AuditLogListResponse, error) {
+	if _, err := uc.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	auditLogs, err := uc.auditLogRepository.FindByUser(ctx, userID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := uc.auditLogRepository.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.AuditLogListResponse{
+		Entries:    make([]*dto.AuditLogResponse, len(auditLogs)),
+		TotalCount: totalCount,
+		Page:       pageOf(options),
+		PageSize:   options.Limit,
+	}
+
+	for i, auditLog := range auditLogs {
+		response.Entries[i] = toAuditLogResponse(auditLog)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+)
+
+// ListUsersUseCase handles searching and listing users for administration
+type ListUsersUseCase interface {
+	Execute(ctx context.Context, filters vo.UserFilterCriteria, options shared.QueryOptions) (*dto.UserListResponse, error)
+}
+
+type listUsersUseCase struct {
+	userQueryRepository rptUser.UserQueryRepository
+}
+
+// NewListUsersUseCase creates a new instance of ListUsersUseCase
+func NewListUsersUseCase(userQueryRepository rptUser.UserQueryRepository) ListUsersUseCase {
+	return &listUsersUseCase{
+		userQueryRepository: userQueryRepository,
+	}
+}
+
+// Execute lists users based on filters
+func (uc *listUsersUseCase) Execute(
+	ctx context.Context,
+	filters vo.UserFilterCriteria,
+	options shared.QueryOptions,
+) (*dto.UserListResponse, error) {
+	users, err := uc.userQueryRepository.FindByFilters(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := uc.userQueryRepository.CountByFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.UserListResponse{
+		Users:      make([]*dto.UserResponse, len(users)),
+		TotalCount: totalCount,
+		Page:       pageOf(options),
+		PageSize:   options.Limit,
+	}
+
+	for i, user := range users {
+		response.Users[i] = toUserResponse(user)
+	}
+
+	return response, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	rptPerson "todolist/internal/domain/person/repository"
+	entUser "todolist/internal/domain/user/entity"
 	rptUser "todolist/internal/domain/user/repository"
 	vo "todolist/internal/domain/user/valueobject"
 	"todolist/internal/dto"
@@ -18,46 +19,56 @@ var (
 
 // LoginUseCase handles user authentication
 type LoginUseCase interface {
-	Execute(ctx context.Context, input dto.AuthRequest) (*dto.AuthResponse, error)
+	Execute(ctx context.Context, input dto.AuthRequest, client dto.ClientInfo) (*dto.AuthResponse, error)
 }
 
 type loginUseCase struct {
-	userRepository   rptUser.UserRepository
-	personRepository rptPerson.PersonRepository
-	tokenService     service.TokenService
-	tokenIssuerName  string
+	userRepository         rptUser.UserRepository
+	personRepository       rptPerson.PersonRepository
+	loginAttemptRepository rptUser.LoginAttemptRepository
+	sessionService         service.SessionService
+	tokenIssuerName        string
 }
 
 // NewLoginUseCase creates a new instance of LoginUseCase
 func NewLoginUseCase(
 	userRepository rptUser.UserRepository,
 	personRepository rptPerson.PersonRepository,
-	tokenService service.TokenService,
+	loginAttemptRepository rptUser.LoginAttemptRepository,
+	sessionService service.SessionService,
 	tokenIssuerName string,
 ) LoginUseCase {
 	return &loginUseCase{
-		userRepository:   userRepository,
-		personRepository: personRepository,
-		tokenService:     tokenService,
-		tokenIssuerName:  tokenIssuerName,
+		userRepository:         userRepository,
+		personRepository:       personRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		sessionService:         sessionService,
+		tokenIssuerName:        tokenIssuerName,
 	}
 }
 
 // Execute authenticates a user
-func (uc *loginUseCase) Execute(ctx context.Context, input dto.AuthRequest) (*dto.AuthResponse, error) {
+func (uc *loginUseCase) Execute(
+	ctx context.Context,
+	input dto.AuthRequest,
+	client dto.ClientInfo,
+) (*dto.AuthResponse, error) {
 	// Find user by username
 	user, err := uc.userRepository.FindByUsername(ctx, input.Username)
 	if err != nil {
+		uc.recordAttempt(ctx, 0, input.Username, client, entUser.LoginFailUnknownUser)
 		return nil, ErrInvalidCredentials
 	}
 
 	// Check if user is active
 	if user.Status() != vo.StatusActive {
+		uc.recordAttempt(ctx, user.ID(), input.Username, client, entUser.LoginFailUserNotActive)
 		return nil, ErrUserNotActive
 	}
 
 	// Verify password
 	if !user.Password().Matches(input.Password) {
+		uc.recordAttempt(ctx, user.ID(), input.Username, client, entUser.LoginFailInvalidPassword)
 		return nil, ErrInvalidCredentials
 	}
 
@@ -67,14 +78,21 @@ func (uc *loginUseCase) Execute(ctx context.Context, input dto.AuthRequest) (*dt
 		return nil, err
 	}
 
-	// Generate token
-	authTokens, err := uc.tokenService.GenerateTokens(ctx, uc.tokenIssuerName, user.ID())
+	// Start a new session for the device and generate its tokens
+	session, authTokens, err := uc.sessionService.StartSession(ctx, uc.tokenIssuerName, user.ID(), service.SessionInfo{
+		DeviceName: input.DeviceName,
+		IPAddress:  client.IPAddress,
+		UserAgent:  client.UserAgent,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
+	uc.recordAttempt(ctx, user.ID(), input.Username, client, "")
+
 	// Return auth response
 	return &dto.AuthResponse{
+		SessionID:        session.ID(),
 		Token:            authTokens.AccessToken,
 		RefreshToken:     authTokens.RefreshToken,
 		ExpiresAt:        authTokens.AccessMeta.ExpiresAt,
@@ -82,3 +100,20 @@ func (uc *loginUseCase) Execute(ctx context.Context, input dto.AuthRequest) (*dt
 		User:             toUserResponseWithPerson(user, person),
 	}, nil
 }
+
+// recordAttempt records the login attempt for security monitoring; an empty fail reason means success.
+// Recording is best effort and never prevents the authentication.
+func (uc *loginUseCase) recordAttempt(
+	ctx context.Context,
+	userID int64,
+	username string,
+	client dto.ClientInfo,
+	failReason string,
+) {
+	attempt, err := entUser.NewLoginAttempt(0, userID, username, failReason == "", client.IPAddress, client.UserAgent, failReason)
+	if err != nil {
+		return
+	}
+
+	_ = uc.loginAttemptRepository.Save(ctx, attempt)
+}
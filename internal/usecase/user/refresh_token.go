@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	rptPerson "todolist/internal/domain/person/repository"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// RefreshTokenUseCase handles the renewal of authentication tokens
+type RefreshTokenUseCase interface {
+	Execute(ctx context.Context, input dto.RefreshTokenRequest, client dto.ClientInfo) (*dto.AuthResponse, error)
+}
+
+type refreshTokenUseCase struct {
+	userRepository   rptUser.UserRepository
+	personRepository rptPerson.PersonRepository
+	sessionService   service.SessionService
+}
+
+// NewRefreshTokenUseCase creates a new instance of RefreshTokenUseCase
+func NewRefreshTokenUseCase(
+	userRepository rptUser.UserRepository,
+	personRepository rptPerson.PersonRepository,
+	sessionService service.SessionService,
+) RefreshTokenUseCase {
+	return &refreshTokenUseCase{
+		userRepository:   userRepository,
+		personRepository: personRepository,
+		sessionService:   sessionService,
+	}
+}
+
+// Execute rotates the refresh token of the session and issues a new token pair
+func (uc *refreshTokenUseCase) Execute(
+	ctx context.Context,
+	input dto.RefreshTokenRequest,
+	client dto.ClientInfo,
+) (*dto.AuthResponse, error) {
+	// Rotate the session tokens
+	session, authTokens, err := uc.sessionService.RefreshSession(ctx, input.RefreshToken, service.SessionInfo{
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the session owner
+	user, err := uc.userRepository.FindByID(ctx, session.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	// A user deactivated after login can not renew the session
+	if user.Status() != vo.StatusActive {
+		if err := uc.sessionService.RevokeSession(ctx, user.ID(), session.ID()); err != nil {
+			return nil, err
+		}
+		return nil, ErrUserNotActive
+	}
+
+	// Get person info
+	person, err := uc.personRepository.FindByID(ctx, user.PersonID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthResponse{
+		SessionID:        session.ID(),
+		Token:            authTokens.AccessToken,
+		RefreshToken:     authTokens.RefreshToken,
+		ExpiresAt:        authTokens.AccessMeta.ExpiresAt,
+		RefreshExpiresAt: authTokens.RefreshMeta.ExpiresAt,
+		User:             toUserResponseWithPerson(user, person),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// RevokeSessionUseCase handles terminating a single session
+type RevokeSessionUseCase interface {
+	Execute(ctx context.Context, userID, sessionID int64) error
+}
+
+type revokeSessionUseCase struct {
+	sessionService service.SessionService
+}
+
+// NewRevokeSessionUseCase creates a new instance of RevokeSessionUseCase
+func NewRevokeSessionUseCase(sessionService service.SessionService) RevokeSessionUseCase {
+	return &revokeSessionUseCase{
+		sessionService: sessionService,
+	}
+}
+
+// Execute revokes a session owned by the user
+func (uc *revokeSessionUseCase) Execute(ctx context.Context, userID, sessionID int64) error {
+	return uc.sessionService.RevokeSession(ctx, userID, sessionID)
+}
+
+// RevokeOtherSessionsUseCase handles terminating every session but the current one
+type RevokeOtherSessionsUseCase interface {
+	Execute(ctx context.Context, userID, currentSessionID int64) (*dto.RevokeSessionsResponse, error)
+}
+
+type revokeOtherSessionsUseCase struct {
+	sessionService service.SessionService
+}
+
+// NewRevokeOtherSessionsUseCase creates a new instance of RevokeOtherSessionsUseCase
+func NewRevokeOtherSessionsUseCase(sessionService service.SessionService) RevokeOtherSessionsUseCase {
+	return &revokeOtherSessionsUseCase{
+		sessionService: sessionService,
+	}
+}
+
+// Execute revokes all sessions of the user except the current one
+func (uc *revokeOtherSessionsUseCase) Execute(
+	ctx context.Context,
+	userID,
+	currentSessionID int64,
+) (*dto.RevokeSessionsResponse, error) {
+	revoked, err := uc.sessionService.RevokeOtherSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RevokeSessionsResponse{Revoked: revoked}, nil
+}
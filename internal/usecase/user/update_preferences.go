@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	"todolist/internal/domain/user/repository"
+	"todolist/internal/dto"
+)
+
+// UpdatePreferencesUseCase handles changing the preferences of a user
+type UpdatePreferencesUseCase interface {
+	Execute(ctx context.Context, userID int64, input dto.UpdatePreferencesRequest) (*dto.PreferencesResponse, error)
+}
+
+type updatePreferencesUseCase struct {
+	userRepository        repository.UserRepository
+	defaultSearchLanguage sharedvo.SearchLanguage
+}
+
+// NewUpdatePreferencesUseCase creates a new instance of UpdatePreferencesUseCase
+func NewUpdatePreferencesUseCase(
+	userRepository repository.UserRepository,
+	defaultSearchLanguage sharedvo.SearchLanguage,
+) UpdatePreferencesUseCase {
+	return &updatePreferencesUseCase{
+		userRepository:        userRepository,
+		defaultSearchLanguage: defaultSearchLanguage,
+	}
+}
+
+// Execute changes the given preferences of the user. It fails with
+// sharedvo.ErrInvalidSearchLanguage when the search language is not supported.
+func (uc *updatePreferencesUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	input dto.UpdatePreferencesRequest,
+) (*dto.PreferencesResponse, error) {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	if input.SearchLanguage != nil {
+		var language sharedvo.SearchLanguage
+		if *input.SearchLanguage != "" {
+			if language, err = sharedvo.NewSearchLanguage(*input.SearchLanguage); err != nil {
+				return nil, err
+			}
+		}
+		user.ChangeSearchLanguage(language)
+	}
+
+	if err := uc.userRepository.Save(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return toPreferencesResponse(user, uc.defaultSearchLanguage), nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/user/entity"
+	rptUser "todolist/internal/domain/user/repository"
+	vo "todolist/internal/domain/user/valueobject"
+	"todolist/internal/dto"
+	"todolist/internal/service"
+)
+
+// UpdateRoleUseCase handles updates of custom roles
+type UpdateRoleUseCase interface {
+	Execute(ctx context.Context, name string, input dto.UpdateRoleRequest) (*dto.RoleResponse, error)
+}
+
+type updateRoleUseCase struct {
+	roleRepository       rptUser.RoleRepository
+	authorizationService service.AuthorizationService
+}
+
+// NewUpdateRoleUseCase creates a new instance of UpdateRoleUseCase
+func NewUpdateRoleUseCase(
+	roleRepository rptUser.RoleRepository,
+	authorizationService service.AuthorizationService,
+) UpdateRoleUseCase {
+	return &updateRoleUseCase{
+		roleRepository:       roleRepository,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute updates the description and permissions of a custom role
+func (uc *updateRoleUseCase) Execute(ctx context.Context, name string, input dto.UpdateRoleRequest) (*dto.RoleResponse, error) {
+	roleName := vo.UserRole(name)
+
+	if uc.authorizationService.IsConfiguredRole(roleName) {
+		return nil, entity.ErrBuiltInRole
+	}
+
+	role, err := uc.roleRepository.FindByName(ctx, roleName)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, entity.ErrRoleNotFound
+		}
+		return nil, err
+	}
+
+	if input.Description != nil {
+		role.UpdateDescription(*input.Description)
+	}
+
+	if input.Permissions != nil {
+		if err := role.UpdatePermissions(toPermissions(input.Permissions)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.roleRepository.Save(ctx, role); err != nil {
+		return nil, err
+	}
+
+	uc.authorizationService.ReloadPolicy()
+
+	return toRoleResponse(role, false), nil
+}
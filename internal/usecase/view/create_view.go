@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/view/entity"
+	"todolist/internal/domain/view/repository"
+	vo "todolist/internal/domain/view/valueobject"
+	"todolist/internal/dto"
+)
+
+// CreateViewUseCase handles saving new views
+type CreateViewUseCase interface {
+	Execute(ctx context.Context, userID int64, input dto.CreateViewRequest) (*dto.ViewResponse, error)
+}
+
+type createViewUseCase struct {
+	viewRepository repository.ViewRepository
+	idGenerator    shared.IDGenerator
+}
+
+// NewCreateViewUseCase creates a new instance of CreateViewUseCase
+func NewCreateViewUseCase(viewRepository repository.ViewRepository, idGenerator shared.IDGenerator) CreateViewUseCase {
+	return &createViewUseCase{
+		viewRepository: viewRepository,
+		idGenerator:    idGenerator,
+	}
+}
+
+// Execute saves a new view for the user
+func (uc *createViewUseCase) Execute(ctx context.Context, userID int64, input dto.CreateViewRequest) (*dto.ViewResponse, error) {
+	sort, err := toViewSort(input.OrderBy, input.OrderDir)
+	if err != nil {
+		return nil, err
+	}
+
+	grouping, err := vo.NewViewGrouping(input.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uc.idGenerator.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := entity.NewView(id, userID, input.Name, toViewFilter(input.Filter), sort, grouping)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.viewRepository.Save(ctx, view); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrViewAlreadyExists
+		}
+		return nil, err
+	}
+
+	return toViewResponse(view), nil
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/view/repository"
+)
+
+// DeleteViewUseCase handles deleting saved views
+type DeleteViewUseCase interface {
+	Execute(ctx context.Context, userID int64, viewID string) error
+}
+
+type deleteViewUseCase struct {
+	viewRepository repository.ViewRepository
+}
+
+// NewDeleteViewUseCase creates a new instance of DeleteViewUseCase
+func NewDeleteViewUseCase(viewRepository repository.ViewRepository) DeleteViewUseCase {
+	return &deleteViewUseCase{
+		viewRepository: viewRepository,
+	}
+}
+
+// Execute deletes a view saved by the user
+func (uc *deleteViewUseCase) Execute(ctx context.Context, userID int64, viewID string) error {
+	view, err := findSavedView(ctx, uc.viewRepository, userID, viewID)
+	if err != nil {
+		return err
+	}
+
+	return uc.viewRepository.Delete(ctx, view.ID())
+}
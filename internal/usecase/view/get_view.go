@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/view/repository"
+	"todolist/internal/dto"
+)
+
+// GetViewUseCase handles retrieving a view
+type GetViewUseCase interface {
+	Execute(ctx context.Context, userID int64, viewID string) (*dto.ViewResponse, error)
+}
+
+type getViewUseCase struct {
+	viewRepository repository.ViewRepository
+}
+
+// NewGetViewUseCase creates a new instance of GetViewUseCase
+func NewGetViewUseCase(viewRepository repository.ViewRepository) GetViewUseCase {
+	return &getViewUseCase{
+		viewRepository: viewRepository,
+	}
+}
+
+// Execute retrieves a view saved by the user by ID or a built-in view by key
+func (uc *getViewUseCase) Execute(ctx context.Context, userID int64, viewID string) (*dto.ViewResponse, error) {
+	view, err := findView(ctx, uc.viewRepository, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toViewResponse(view), nil
+}
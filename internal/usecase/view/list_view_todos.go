@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"todolist/internal/domain/shared"
+	sharedvo "todolist/internal/domain/shared/valueobject"
+	todovo "todolist/internal/domain/todo/valueobject"
+	"todolist/internal/domain/view/repository"
+	vo "todolist/internal/domain/view/valueobject"
+	"todolist/internal/dto"
+	ucTodo "todolist/internal/usecase/todo"
+)
+
+// pastDueGroup groups the closed todos due before today, which are in no due date bucket
+const pastDueGroup = "past"
+
+// todoGroupOrders are the keys of the groups of each grouping, in the order they are listed
+var todoGroupOrders = map[vo.ViewGrouping][]string{
+	vo.ViewGroupingStatus: {
+		todovo.StatusPending.String(),
+		todovo.StatusInProgress.String(),
+		todovo.StatusCompleted.String(),
+		todovo.StatusCancelled.String(),
+	},
+	vo.ViewGroupingPriority: {
+		sharedvo.PriorityCritical.String(),
+		sharedvo.PriorityHigh.String(),
+		sharedvo.PriorityMedium.String(),
+		sharedvo.PriorityLow.String(),
+	},
+	vo.ViewGroupingDueDate: {
+		todovo.DueBucketOverdue,
+		todovo.DueBucketToday,
+		todovo.DueBucketThisWeek,
+		todovo.DueBucketLater,
+		pastDueGroup,
+		todovo.DueBucketNoDueDate,
+	},
+}
+
+// ListViewTodosUseCase handles listing the todos of a view
+type ListViewTodosUseCase interface {
+	Execute(ctx context.Context, userID int64, viewID string, options shared.QueryOptions, pageCursor string) (*dto.ViewTodosResponse, error)
+}
+
+type listViewTodosUseCase struct {
+	viewRepository   repository.ViewRepository
+	listTodosUseCase ucTodo.ListTodosUseCase
+}
+
+// NewListViewTodosUseCase creates a new instance of ListViewTodosUseCase
+func NewListViewTodosUseCase(
+	viewRepository repository.ViewRepository,
+	listTodosUseCase ucTodo.ListTodosUseCase,
+) ListViewTodosUseCase {
+	return &listViewTodosUseCase{
+		viewRepository:   viewRepository,
+		listTodosUseCase: listTodosUseCase,
+	}
+}
+
+// Execute lists a page of the todos of the user matching the filter of a view, in
+// the order of the view. The sort order of the options is ignored.
+//
+// The todos of grouped views are also grouped, the groups being listed in the
+// order of their keys with the total of their todos in the whole view.
+func (uc *listViewTodosUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	viewID string,
+	options shared.QueryOptions,
+	pageCursor string,
+) (*dto.ViewTodosResponse, error) {
+	view, err := findView(ctx, uc.viewRepository, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	criteria, err := view.Criteria(userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	options.Sort = view.Sort().Fields()
+
+	grouped := view.Grouping() != vo.ViewGroupingNone
+
+	list, err := uc.listTodosUseCase.Execute(ctx, userID, criteria, options, pageCursor, grouped)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ViewTodosResponse{
+		View:       toViewResponse(view),
+		Todos:      list.Todos,
+		TotalCount: list.TotalCount,
+		NextCursor: list.NextCursor,
+		PrevCursor: list.PrevCursor,
+	}
+
+	if grouped {
+		response.Groups = groupTodos(view.Grouping(), list, now)
+	}
+
+	return response, nil
+}
+
+// groupTodos groups the listed todos, taking the totals of the groups from the facets
+func groupTodos(grouping vo.ViewGrouping, list *dto.TodoListResponse, now time.Time) []*dto.TodoGroupResponse {
+	todoIDs := map[string][]int64{}
+	for _, todo := range list.Todos {
+		key := todoGroupKey(grouping, todo, now)
+		todoIDs[key] = append(todoIDs[key], todo.ID)
+	}
+
+	groups := []*dto.TodoGroupResponse{}
+	for _, key := range todoGroupOrders[grouping] {
+		if len(todoIDs[key]) == 0 {
+			continue
+		}
+		groups = append(groups, &dto.TodoGroupResponse{
+			Key:     key,
+			Total:   todoGroupTotal(grouping, key, list),
+			TodoIDs: todoIDs[key],
+		})
+	}
+
+	return groups
+}
+
+// todoGroupKey returns the key of the group of a todo
+func todoGroupKey(grouping vo.ViewGrouping, todo *dto.TodoResponse, now time.Time) string {
+	switch grouping {
+	case vo.ViewGroupingStatus:
+		return todo.Status
+	case vo.ViewGroupingPriority:
+		return todo.Priority
+	default:
+		if bucket := todovo.DueBucketOf(todo.DueDate, todovo.TodoStatus(todo.Status), now); bucket != "" {
+			return bucket
+		}
+		return pastDueGroup
+	}
+}
+
+// todoGroupTotal returns the number of todos of a group in the whole view
+func todoGroupTotal(grouping vo.ViewGrouping, key string, list *dto.TodoListResponse) int64 {
+	if list.Facets == nil {
+		return 0
+	}
+
+	switch grouping {
+	case vo.ViewGroupingStatus:
+		return list.Facets.Status[key]
+	case vo.ViewGroupingPriority:
+		return list.Facets.Priority[key]
+	default:
+		if key != pastDueGroup {
+			return list.Facets.DueDate[key]
+		}
+
+		// The facets leave out the closed todos due before today
+		total := list.TotalCount
+		for _, count := range list.Facets.DueDate {
+			total -= count
+		}
+		return total
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"todolist/internal/domain/view/entity"
+	"todolist/internal/domain/view/repository"
+	"todolist/internal/dto"
+)
+
+// ListViewsUseCase handles listing the views available to a user
+type ListViewsUseCase interface {
+	Execute(ctx context.Context, userID int64) ([]*dto.ViewResponse, error)
+}
+
+type listViewsUseCase struct {
+	viewRepository repository.ViewRepository
+}
+
+// NewListViewsUseCase creates a new instance of ListViewsUseCase
+func NewListViewsUseCase(viewRepository repository.ViewRepository) ListViewsUseCase {
+	return &listViewsUseCase{
+		viewRepository: viewRepository,
+	}
+}
+
+// Execute lists the built-in views followed by the views saved by the user
+func (uc *listViewsUseCase) Execute(ctx context.Context, userID int64) ([]*dto.ViewResponse, error) {
+	views, err := uc.viewRepository.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	views = append(entity.SmartLists(), views...)
+
+	response := make([]*dto.ViewResponse, len(views))
+	for i, view := range views {
+		response[i] = toViewResponse(view)
+	}
+
+	return response, nil
+}
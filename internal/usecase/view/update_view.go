@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/view/entity"
+	"todolist/internal/domain/view/repository"
+	vo "todolist/internal/domain/view/valueobject"
+	"todolist/internal/dto"
+)
+
+// UpdateViewUseCase handles updates of saved views
+type UpdateViewUseCase interface {
+	Execute(ctx context.Context, userID int64, viewID string, input dto.UpdateViewRequest) (*dto.ViewResponse, error)
+}
+
+type updateViewUseCase struct {
+	viewRepository repository.ViewRepository
+}
+
+// NewUpdateViewUseCase creates a new instance of UpdateViewUseCase
+func NewUpdateViewUseCase(viewRepository repository.ViewRepository) UpdateViewUseCase {
+	return &updateViewUseCase{
+		viewRepository: viewRepository,
+	}
+}
+
+// Execute changes the given fields of a view saved by the user. A grouping of
+// "none" removes the grouping.
+func (uc *updateViewUseCase) Execute(
+	ctx context.Context,
+	userID int64,
+	viewID string,
+	input dto.UpdateViewRequest,
+) (*dto.ViewResponse, error) {
+	view, err := findSavedView(ctx, uc.viewRepository, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		if err := view.Rename(*input.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Filter != nil {
+		if err := view.SetFilter(toViewFilter(*input.Filter)); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.OrderBy != nil || input.OrderDir != nil {
+		orderBy, orderDesc := view.Sort().OrderBy(), view.Sort().OrderDesc()
+		if input.OrderBy != nil {
+			orderBy = *input.OrderBy
+		}
+		if input.OrderDir != nil {
+			orderDesc = *input.OrderDir == "desc"
+		}
+
+		sort, err := vo.NewViewSort(orderBy, orderDesc)
+		if err != nil {
+			return nil, err
+		}
+		view.SetSort(sort)
+	}
+
+	if input.GroupBy != nil {
+		groupBy := *input.GroupBy
+		if groupBy == "none" {
+			groupBy = ""
+		}
+
+		grouping, err := vo.NewViewGrouping(groupBy)
+		if err != nil {
+			return nil, err
+		}
+		view.SetGrouping(grouping)
+	}
+
+	if err := uc.viewRepository.Save(ctx, view); err != nil {
+		if errors.Is(err, shared.ErrDuplicateEntry) {
+			return nil, entity.ErrViewAlreadyExists
+		}
+		return nil, err
+	}
+
+	return toViewResponse(view), nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"todolist/internal/domain/shared"
+	"todolist/internal/domain/view/entity"
+	"todolist/internal/domain/view/repository"
+	vo "todolist/internal/domain/view/valueobject"
+	"todolist/internal/dto"
+)
+
+// findView finds the built-in view with the key or the view saved by the user with
+// the ID. Views of other users are reported as ErrViewNotFound.
+func findView(ctx context.Context, viewRepository repository.ViewRepository, userID int64, viewID string) (*entity.View, error) {
+	if view, ok := entity.FindSmartList(viewID); ok {
+		return view, nil
+	}
+
+	id, err := strconv.ParseInt(viewID, 10, 64)
+	if err != nil {
+		return nil, entity.ErrViewNotFound
+	}
+
+	view, err := viewRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, entity.ErrViewNotFound
+		}
+		return nil, err
+	}
+
+	if !view.IsVisibleTo(userID) {
+		return nil, entity.ErrViewNotFound
+	}
+
+	return view, nil
+}
+
+// findSavedView finds a view saved by the user, failing with ErrBuiltInView for built-in views
+func findSavedView(ctx context.Context, viewRepository repository.ViewRepository, userID int64, viewID string) (*entity.View, error) {
+	view, err := findView(ctx, viewRepository, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if view.IsBuiltIn() {
+		return nil, entity.ErrBuiltInView
+	}
+
+	return view, nil
+}
+
+// toViewFilter converts the filter of a request to a view filter
+func toViewFilter(filter dto.ViewFilter) vo.ViewFilter {
+	return vo.ViewFilter{
+		Status:        filter.Status,
+		Priority:      filter.Priority,
+		Tags:          filter.Tags,
+		IsOverdue:     filter.IsOverdue,
+		DueDateFrom:   filter.DueDateFrom,
+		DueDateTo:     filter.DueDateTo,
+		DueInDaysFrom: filter.DueInDaysFrom,
+		DueInDaysTo:   filter.DueInDaysTo,
+		SearchTerm:    filter.Search,
+		Expression:    filter.Filter,
+	}
+}
+
+// toViewSort converts the order of a request to a view sort order
+func toViewSort(orderBy, orderDir string) (vo.ViewSort, error) {
+	return vo.NewViewSort(orderBy, orderDir == "desc")
+}
+
+// toViewResponse converts a view to its response
+func toViewResponse(view *entity.View) *dto.ViewResponse {
+	filter := view.Filter()
+
+	response := &dto.ViewResponse{
+		ID:      view.ID(),
+		Key:     view.Key(),
+		Name:    view.Name(),
+		BuiltIn: view.IsBuiltIn(),
+		Filter: dto.ViewFilter{
+			Status:        filter.Status,
+			Priority:      filter.Priority,
+			Tags:          filter.Tags,
+			IsOverdue:     filter.IsOverdue,
+			DueDateFrom:   filter.DueDateFrom,
+			DueDateTo:     filter.DueDateTo,
+			DueInDaysFrom: filter.DueInDaysFrom,
+			DueInDaysTo:   filter.DueInDaysTo,
+			Search:        filter.SearchTerm,
+			Filter:        filter.Expression,
+		},
+		OrderBy:  view.Sort().OrderBy(),
+		OrderDir: "asc",
+		GroupBy:  view.Grouping().String(),
+	}
+
+	if view.Sort().OrderDesc() {
+		response.OrderDir = "desc"
+	}
+
+	// Built-in views are not persisted
+	if !view.IsBuiltIn() {
+		createdAt, updatedAt := view.CreatedAt(), view.UpdatedAt()
+		response.CreatedAt = &createdAt
+		response.UpdatedAt = &updatedAt
+	}
+
+	return response
+}
@@ -0,0 +1,72 @@
+package auth
+
+/*
+ * jwt_key_rotation.go
+ *
+ * This file implements scheduled rotation of asymmetric signing keys.
+ * When the active key gets older than the rotation interval a new key is generated,
+ * optionally persisted to the key directory, and promoted to active while the
+ * previous key enters its grace period.
+ */
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// KeyRotator rotates the active key of a KeySet on a fixed interval
+type KeyRotator struct {
+	keys      *KeySet
+	algorithm SigningAlgorithm
+	interval  time.Duration
+	dir       string
+}
+
+// NewKeyRotator creates a new rotator. When dir is empty generated keys are kept in memory only.
+func NewKeyRotator(keys *KeySet, algorithm SigningAlgorithm, interval time.Duration, dir string) (*KeyRotator, error) {
+	if keys == nil {
+		return nil, ErrEmptyKeySet
+	}
+
+	if !algorithm.IsAsymmetric() {
+		return nil, fmt.Errorf("%w: only asymmetric keys can be rotated", ErrUnsupportedAlgorithm)
+	}
+
+	if interval <= 0 {
+		return nil, errors.New("invalid key rotation interval")
+	}
+
+	return &KeyRotator{
+		keys:      keys,
+		algorithm: algorithm,
+		interval:  interval,
+		dir:       dir,
+	}, nil
+}
+
+// Interval returns the rotation interval
+func (r *KeyRotator) Interval() time.Duration { return r.interval }
+
+// RotateIfDue rotates the active key when it is older than the rotation interval.
+// Returns the new active key, or nil when no rotation was needed.
+func (r *KeyRotator) RotateIfDue(now time.Time) (*SigningKey, error) {
+	if now.Before(r.keys.Active().CreatedAt().Add(r.interval)) {
+		return nil, nil
+	}
+
+	next, err := GenerateSigningKey(r.algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.dir != "" {
+		if err := SaveKey(r.dir, next); err != nil {
+			return nil, err
+		}
+	}
+
+	r.keys.Rotate(next)
+
+	return next, nil
+}
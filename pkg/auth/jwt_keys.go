@@ -0,0 +1,519 @@
+package auth
+
+/*
+ * jwt_keys.go
+ *
+ * This file implements signing key management for JWT tokens.
+ * It supports symmetric (HS256) and asymmetric (RS256, ES256, EdDSA) keys,
+ * identified by a key ID ("kid") so that several keys can coexist during rotation.
+ *
+ * Retired keys remain available for validation during a grace period, which allows
+ * tokens signed before a rotation to keep working until they naturally expire.
+ * Public keys can be exported as a JSON Web Key Set (RFC 7517).
+ */
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Key management errors
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrUnknownKey           = errors.New("unknown signing key")
+	ErrInvalidKey           = errors.New("invalid signing key")
+	ErrEmptyKeySet          = errors.New("key set has no active key")
+)
+
+// rsaKeyBits is the size of generated RSA keys
+const rsaKeyBits = 2048
+
+// pemFileExt is the extension of key files stored in a key directory
+const pemFileExt = ".pem"
+
+// SigningAlgorithm represents a supported JWT signing algorithm
+type SigningAlgorithm string
+
+const (
+	AlgorithmHS256 SigningAlgorithm = "HS256"
+	AlgorithmRS256 SigningAlgorithm = "RS256"
+	AlgorithmES256 SigningAlgorithm = "ES256"
+	AlgorithmEdDSA SigningAlgorithm = "EdDSA"
+)
+
+// ParseSigningAlgorithm parses an algorithm name, defaulting to HS256 when empty
+func ParseSigningAlgorithm(name string) (SigningAlgorithm, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "", "HS256":
+		return AlgorithmHS256, nil
+	case "RS256":
+		return AlgorithmRS256, nil
+	case "ES256":
+		return AlgorithmES256, nil
+	case "EDDSA", "ED25519":
+		return AlgorithmEdDSA, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, name)
+	}
+}
+
+// IsAsymmetric checks if the algorithm uses a public/private key pair
+func (a SigningAlgorithm) IsAsymmetric() bool { return a != AlgorithmHS256 }
+
+// method returns the jwt signing method for the algorithm
+func (a SigningAlgorithm) method() jwt.SigningMethod {
+	switch a {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256
+	case AlgorithmES256:
+		return jwt.SigningMethodES256
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA
+	default:
+		return jwt.SigningMethodHS256
+	}
+}
+
+// SigningKey is a key used to sign and validate tokens
+type SigningKey struct {
+	id         string
+	algorithm  SigningAlgorithm
+	secret     []byte
+	privateKey crypto.Signer
+	createdAt  time.Time
+	retiresAt  *time.Time
+}
+
+// NewHMACSigningKey creates a symmetric HS256 key from a shared secret
+func NewHMACSigningKey(id, secret string) (*SigningKey, error) {
+	if len(secret) == 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	return &SigningKey{
+		id:        id,
+		algorithm: AlgorithmHS256,
+		secret:    []byte(secret),
+		createdAt: time.Now(),
+	}, nil
+}
+
+// GenerateSigningKey creates a new random asymmetric key for the algorithm
+func GenerateSigningKey(algorithm SigningAlgorithm) (*SigningKey, error) {
+	var (
+		signer crypto.Signer
+		err    error
+	)
+
+	switch algorithm {
+	case AlgorithmRS256:
+		signer, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmES256:
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("generate %s key: %w", algorithm, err)
+	}
+
+	return &SigningKey{
+		id:         generateKeyID(),
+		algorithm:  algorithm,
+		privateKey: signer,
+		createdAt:  time.Now(),
+	}, nil
+}
+
+// ParseSigningKeyPEM parses a PEM encoded private key (PKCS#8, PKCS#1 or SEC 1).
+// The algorithm is inferred from the key type.
+func ParseSigningKeyPEM(id string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrInvalidKey)
+	}
+
+	var (
+		parsed any
+		err    error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		parsed, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+
+	key := &SigningKey{id: id, createdAt: time.Now()}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.algorithm, key.privateKey = AlgorithmRS256, k
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("%w: only P-256 curves are supported", ErrInvalidKey)
+		}
+		key.algorithm, key.privateKey = AlgorithmES256, k
+	case ed25519.PrivateKey:
+		key.algorithm, key.privateKey = AlgorithmEdDSA, k
+	default:
+		return nil, fmt.Errorf("%w: unsupported key type %T", ErrInvalidKey, parsed)
+	}
+
+	return key, nil
+}
+
+// ID returns the key identifier
+func (k *SigningKey) ID() string { return k.id }
+
+// Algorithm returns the signing algorithm of the key
+func (k *SigningKey) Algorithm() SigningAlgorithm { return k.algorithm }
+
+// CreatedAt returns when the key was created
+func (k *SigningKey) CreatedAt() time.Time { return k.createdAt }
+
+// RetiresAt returns when a rotated key stops being accepted, nil while in use
+func (k *SigningKey) RetiresAt() *time.Time { return k.retiresAt }
+
+// EncodePEM encodes the private key as PKCS#8 PEM
+func (k *SigningKey) EncodePEM() ([]byte, error) {
+	if k.privateKey == nil {
+		return nil, fmt.Errorf("%w: symmetric keys can not be exported", ErrInvalidKey)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(k.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("encode key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// isRetired checks if the key grace period is over
+func (k *SigningKey) isRetired(now time.Time) bool {
+	return k.retiresAt != nil && now.After(*k.retiresAt)
+}
+
+// signingMaterial returns the key used to sign tokens
+func (k *SigningKey) signingMaterial() any {
+	if k.algorithm.IsAsymmetric() {
+		return k.privateKey
+	}
+	return k.secret
+}
+
+// verificationMaterial returns the key used to validate tokens
+func (k *SigningKey) verificationMaterial() any {
+	if k.algorithm.IsAsymmetric() {
+		return k.privateKey.Public()
+	}
+	return k.secret
+}
+
+// JSONWebKey is the public representation of a key (RFC 7517)
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet is a set of public keys (RFC 7517)
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// PublicJWK returns the public JWK of the key, false for symmetric keys
+func (k *SigningKey) PublicJWK() (JSONWebKey, bool) {
+	if !k.algorithm.IsAsymmetric() {
+		return JSONWebKey{}, false
+	}
+
+	jwk := JSONWebKey{
+		Use:       "sig",
+		KeyID:     k.id,
+		Algorithm: string(k.algorithm),
+	}
+
+	switch pub := k.privateKey.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = encodeSegment(pub.N.Bytes())
+		jwk.E = encodeSegment(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		ecdhKey, err := pub.ECDH()
+		if err != nil {
+			return JSONWebKey{}, false
+		}
+		// Uncompressed point: 0x04 || X || Y
+		point := ecdhKey.Bytes()
+		size := (len(point) - 1) / 2
+		jwk.KeyType = "EC"
+		jwk.Curve = "P-256"
+		jwk.X = encodeSegment(point[1 : 1+size])
+		jwk.Y = encodeSegment(point[1+size:])
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = encodeSegment(pub)
+	default:
+		return JSONWebKey{}, false
+	}
+
+	return jwk, true
+}
+
+// KeySet holds the active signing key and the rotated keys still in their grace period
+type KeySet struct {
+	mu          sync.RWMutex
+	active      *SigningKey
+	keys        map[string]*SigningKey
+	gracePeriod time.Duration
+}
+
+// NewKeySet creates a key set with the given active key.
+// Previous keys are kept for validation until the grace period elapses.
+func NewKeySet(active *SigningKey, gracePeriod time.Duration, previous ...*SigningKey) (*KeySet, error) {
+	if active == nil {
+		return nil, ErrEmptyKeySet
+	}
+
+	set := &KeySet{
+		active:      active,
+		keys:        map[string]*SigningKey{active.id: active},
+		gracePeriod: gracePeriod,
+	}
+
+	retiresAt := active.createdAt.Add(gracePeriod)
+	for _, key := range previous {
+		if key == nil || key.id == active.id {
+			continue
+		}
+		if key.retiresAt == nil {
+			key.retiresAt = &retiresAt
+		}
+		set.keys[key.id] = key
+	}
+
+	set.prune(time.Now())
+
+	return set, nil
+}
+
+// Active returns the key currently used to sign new tokens
+func (s *KeySet) Active() *SigningKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.active
+}
+
+// Lookup returns a key still accepted for validation
+func (s *KeySet) Lookup(id string) (*SigningKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[id]
+	if !ok || key.isRetired(time.Now()) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+
+	return key, nil
+}
+
+// Rotate makes the given key active and schedules the previous one for retirement
+func (s *KeySet) Rotate(next *SigningKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	retiresAt := now.Add(s.gracePeriod)
+
+	s.active.retiresAt = &retiresAt
+	s.active = next
+	s.keys[next.id] = next
+
+	s.prune(now)
+}
+
+// JWKS returns the public keys still accepted for validation
+func (s *KeySet) JWKS() JSONWebKeySet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(s.keys))}
+
+	for _, key := range s.sortedKeys() {
+		if key.isRetired(now) {
+			continue
+		}
+		if jwk, ok := key.PublicJWK(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+
+	return set
+}
+
+// sortedKeys returns the keys ordered from newest to oldest
+func (s *KeySet) sortedKeys() []*SigningKey {
+	keys := make([]*SigningKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].createdAt.After(keys[j].createdAt)
+	})
+	return keys
+}
+
+// prune removes keys whose grace period has elapsed, must be called with the lock held
+func (s *KeySet) prune(now time.Time) {
+	for id, key := range s.keys {
+		if key != s.active && key.isRetired(now) {
+			delete(s.keys, id)
+		}
+	}
+}
+
+// LoadKeyDir loads every PEM key stored in a directory, ordered from oldest to newest.
+// The key ID is the file name without extension and the creation time its modification time.
+func LoadKeyDir(dir string) ([]*SigningKey, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read key directory: %w", err)
+	}
+
+	keys := make([]*SigningKey, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != pemFileExt {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read key %s: %w", entry.Name(), err)
+		}
+
+		key, err := ParseSigningKeyPEM(strings.TrimSuffix(entry.Name(), pemFileExt), data)
+		if err != nil {
+			return nil, fmt.Errorf("parse key %s: %w", entry.Name(), err)
+		}
+
+		if info, err := entry.Info(); err == nil {
+			key.createdAt = info.ModTime()
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].createdAt.Before(keys[j].createdAt)
+	})
+
+	return keys, nil
+}
+
+// SaveKey writes the private key to the directory as <kid>.pem
+func SaveKey(dir string, key *SigningKey) error {
+	data, err := key.EncodePEM()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create key directory: %w", err)
+	}
+
+	path := filepath.Join(dir, key.id+pemFileExt)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("write key: %w", err)
+	}
+
+	return nil
+}
+
+// generateKeyID creates a unique key identifier
+func generateKeyID() string {
+	return time.Now().UTC().Format("20060102T150405") + "-" + generateTokenID()[:8]
+}
+
+// encodeSegment encodes bytes as unpadded base64url
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// LoadOrCreateKeySet builds a key set from the keys stored in a directory.
+// The newest key becomes active and older ones stay valid for the grace period.
+// When the directory has no keys a new one is generated and, if dir is set, persisted.
+func LoadOrCreateKeySet(dir string, algorithm SigningAlgorithm, gracePeriod time.Duration) (*KeySet, error) {
+	if !algorithm.IsAsymmetric() {
+		return nil, fmt.Errorf("%w: key files require an asymmetric algorithm", ErrUnsupportedAlgorithm)
+	}
+
+	var keys []*SigningKey
+	if dir != "" {
+		loaded, err := LoadKeyDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		keys = loaded
+	}
+
+	if len(keys) == 0 {
+		key, err := GenerateSigningKey(algorithm)
+		if err != nil {
+			return nil, err
+		}
+
+		if dir != "" {
+			if err := SaveKey(dir, key); err != nil {
+				return nil, err
+			}
+		}
+
+		keys = append(keys, key)
+	}
+
+	active := keys[len(keys)-1]
+	if active.algorithm != algorithm {
+		return nil, fmt.Errorf("%w: active key %s uses %s, expected %s", ErrInvalidKey, active.id, active.algorithm, algorithm)
+	}
+
+	return NewKeySet(active, gracePeriod, keys[:len(keys)-1]...)
+}
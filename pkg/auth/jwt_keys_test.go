@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTToken_AsymmetricAlgorithms(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm SigningAlgorithm
+		keyType   string
+	}{
+		{name: "RS256", algorithm: AlgorithmRS256, keyType: "RSA"},
+		{name: "ES256", algorithm: AlgorithmES256, keyType: "EC"},
+		{name: "EdDSA", algorithm: AlgorithmEdDSA, keyType: "OKP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := GenerateSigningKey(tt.algorithm)
+			require.NoError(t, err)
+
+			keys, err := NewKeySet(key, time.Hour)
+			require.NoError(t, err)
+
+			jwtToken, err := NewJWTTokenWithKeys(keys, time.Minute, time.Hour)
+			require.NoError(t, err)
+
+			access, _, err := jwtToken.GenerateTokens(context.Background(), "test", 42, nil)
+			require.NoError(t, err)
+
+			userID, err := jwtToken.ValidateAccessToken(context.Background(), access)
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), userID)
+
+			jwks := jwtToken.JWKS()
+			require.Len(t, jwks.Keys, 1)
+			assert.Equal(t, key.ID(), jwks.Keys[0].KeyID)
+			assert.Equal(t, tt.keyType, jwks.Keys[0].KeyType)
+			assert.Equal(t, string(tt.algorithm), jwks.Keys[0].Algorithm)
+		})
+	}
+}
+
+func TestKeySet_RotationGracePeriod(t *testing.T) {
+	oldKey, err := GenerateSigningKey(AlgorithmES256)
+	require.NoError(t, err)
+
+	keys, err := NewKeySet(oldKey, time.Hour)
+	require.NoError(t, err)
+
+	jwtToken, err := NewJWTTokenWithKeys(keys, time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	oldToken, _, err := jwtToken.GenerateTokens(context.Background(), "test", 1, nil)
+	require.NoError(t, err)
+
+	newKey, err := GenerateSigningKey(AlgorithmES256)
+	require.NoError(t, err)
+	keys.Rotate(newKey)
+
+	assert.Equal(t, newKey.ID(), keys.Active().ID())
+	assert.Len(t, keys.JWKS().Keys, 2)
+
+	// Tokens signed by the rotated key remain valid during the grace period
+	_, err = jwtToken.ValidateAccessToken(context.Background(), oldToken)
+	assert.NoError(t, err)
+
+	// Once the grace period is over the rotated key is no longer accepted
+	expired := time.Now().Add(-time.Second)
+	oldKey.retiresAt = &expired
+
+	_, err = jwtToken.ValidateAccessToken(context.Background(), oldToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.Len(t, keys.JWKS().Keys, 1)
+}
+
+func TestJWTToken_RejectsAlgorithmMismatch(t *testing.T) {
+	hmacToken, err := NewJWTToken("secret", time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	access, _, err := hmacToken.GenerateTokens(context.Background(), "test", 1, nil)
+	require.NoError(t, err)
+
+	key, err := GenerateSigningKey(AlgorithmRS256)
+	require.NoError(t, err)
+	key.id = defaultKeyID
+
+	keys, err := NewKeySet(key, 0)
+	require.NoError(t, err)
+
+	rsaToken, err := NewJWTTokenWithKeys(keys, time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	_, err = rsaToken.ValidateAccessToken(context.Background(), access)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.Empty(t, hmacToken.JWKS().Keys)
+}
+
+func TestLoadOrCreateKeySet(t *testing.T) {
+	dir := t.TempDir()
+
+	created, err := LoadOrCreateKeySet(dir, AlgorithmEdDSA, time.Hour)
+	require.NoError(t, err)
+
+	loaded, err := LoadOrCreateKeySet(dir, AlgorithmEdDSA, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, created.Active().ID(), loaded.Active().ID())
+
+	_, err = LoadOrCreateKeySet(dir, AlgorithmRS256, time.Hour)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestJWTToken_GenerateAccessToken(t *testing.T) {
+	jwtToken, err := NewJWTToken("secret", time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	token, err := jwtToken.GenerateAccessToken(context.Background(), "test", 7, 10*time.Minute, map[string]any{"actor_id": "1"})
+	require.NoError(t, err)
+
+	userID, err := jwtToken.ValidateAccessToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), userID)
+
+	_, _, expiresAt, issuedAt, _, claims, err := jwtToken.GetTokenInfo(token)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, expiresAt.Sub(issuedAt))
+	assert.Equal(t, "1", claims["actor_id"])
+
+	// Access tokens can not be used to obtain new tokens
+	_, _, err = jwtToken.RefreshTokens(context.Background(), token)
+	assert.Error(t, err)
+
+	_, err = jwtToken.GenerateAccessToken(context.Background(), "test", 7, 0, nil)
+	assert.Error(t, err)
+}
@@ -7,8 +7,10 @@ package auth
  * It provides token generation, validation, refresh flows, and revocation
  * without any knowledge of the domain layer.
  *
- * This implementation uses HMAC-SHA256 for token signing and includes
- * in-memory revocation tracking (should be replaced with Redis or similar in production).
+ * Tokens are signed with the active key of a KeySet (HS256, RS256, ES256 or EdDSA)
+ * and carry its "kid" header, so tokens signed by rotated keys remain valid during
+ * their grace period. It includes in-memory revocation tracking (should be replaced
+ * with Redis or similar in production).
  */
 
 import (
@@ -41,6 +43,9 @@ const (
 	tokenTypeRefresh tokenType = "refresh"
 )
 
+// defaultKeyID identifies the key built from a shared secret
+const defaultKeyID = "default"
+
 // jwtClaims defines the JWT claims structure
 type jwtClaims struct {
 	UserID    int64          `json:"user_id"`
@@ -52,16 +57,31 @@ type jwtClaims struct {
 
 // JWTToken implements JWT token operations
 type JWTToken struct {
-	secretKey            []byte
+	keys                 *KeySet
 	accessTokenDuration  time.Duration
 	refreshTokenDuration time.Duration
 	revokedTokens        sync.Map
 }
 
-// NewJWTToken creates a new JWT token instance
+// NewJWTToken creates a new JWT token instance signing with HS256 and a shared secret
 func NewJWTToken(secret string, accessDuration, refreshDuration time.Duration) (*JWTToken, error) {
-	if len(secret) == 0 {
-		return nil, ErrInvalidSecret
+	key, err := NewHMACSigningKey(defaultKeyID, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := NewKeySet(key, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJWTTokenWithKeys(keys, accessDuration, refreshDuration)
+}
+
+// NewJWTTokenWithKeys creates a new JWT token instance signing with the active key of the set
+func NewJWTTokenWithKeys(keys *KeySet, accessDuration, refreshDuration time.Duration) (*JWTToken, error) {
+	if keys == nil {
+		return nil, ErrEmptyKeySet
 	}
 
 	if accessDuration <= 0 || refreshDuration <= 0 {
@@ -69,27 +89,39 @@ func NewJWTToken(secret string, accessDuration, refreshDuration time.Duration) (
 	}
 
 	return &JWTToken{
-		secretKey:            []byte(secret),
+		keys:                 keys,
 		accessTokenDuration:  accessDuration,
 		refreshTokenDuration: refreshDuration,
 	}, nil
 }
 
-// GenerateTokens creates a new access and refresh token pair
-func (s *JWTToken) GenerateTokens(ctx context.Context, issuerName string, userID int64) (accessToken, refreshToken string, err error) {
+// Keys returns the key set used to sign and validate tokens
+func (s *JWTToken) Keys() *KeySet { return s.keys }
+
+// JWKS returns the public keys that can be used to validate issued tokens
+func (s *JWTToken) JWKS() JSONWebKeySet { return s.keys.JWKS() }
+
+// GenerateTokens creates a new access and refresh token pair.
+// The optional custom claims are embedded in both tokens and preserved on refresh.
+func (s *JWTToken) GenerateTokens(
+	ctx context.Context,
+	issuerName string,
+	userID int64,
+	customClaims map[string]any,
+) (accessToken, refreshToken string, err error) {
 	// Check context cancellation
 	if err := ctx.Err(); err != nil {
 		return "", "", fmt.Errorf("context error: %w", err)
 	}
 
 	// Generate access token
-	accessToken, err = s.generateToken(issuerName, userID, s.accessTokenDuration, tokenTypeAccess)
+	accessToken, err = s.generateToken(issuerName, userID, s.accessTokenDuration, tokenTypeAccess, customClaims)
 	if err != nil {
 		return "", "", fmt.Errorf("generate access token: %w", err)
 	}
 
 	// Generate refresh token
-	refreshToken, err = s.generateToken(issuerName, userID, s.refreshTokenDuration, tokenTypeRefresh)
+	refreshToken, err = s.generateToken(issuerName, userID, s.refreshTokenDuration, tokenTypeRefresh, customClaims)
 	if err != nil {
 		return "", "", fmt.Errorf("generate refresh token: %w", err)
 	}
@@ -97,6 +129,32 @@ func (s *JWTToken) GenerateTokens(ctx context.Context, issuerName string, userID
 	return accessToken, refreshToken, nil
 }
 
+// GenerateAccessToken creates a single access token valid for the given duration.
+// No refresh token is issued, so the token can not be renewed once expired.
+func (s *JWTToken) GenerateAccessToken(
+	ctx context.Context,
+	issuerName string,
+	userID int64,
+	duration time.Duration,
+	customClaims map[string]any,
+) (string, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("context error: %w", err)
+	}
+
+	if duration <= 0 {
+		return "", errors.New("invalid access token duration")
+	}
+
+	accessToken, err := s.generateToken(issuerName, userID, duration, tokenTypeAccess, customClaims)
+	if err != nil {
+		return "", fmt.Errorf("generate access token: %w", err)
+	}
+
+	return accessToken, nil
+}
+
 // RefreshTokens validates a refresh token and generates a new token pair
 func (s *JWTToken) RefreshTokens(ctx context.Context, refreshToken string) (newAccessToken, newRefreshToken string, err error) {
 	// Check context cancellation
@@ -115,8 +173,8 @@ func (s *JWTToken) RefreshTokens(ctx context.Context, refreshToken string) (newA
 		return "", "", ErrInvalidTokenType
 	}
 
-	// Generate new token pair
-	return s.GenerateTokens(ctx, claims.Issuer, claims.UserID)
+	// Generate new token pair keeping the original custom claims
+	return s.GenerateTokens(ctx, claims.Issuer, claims.UserID, claims.Custom)
 }
 
 // ValidateAccessToken validates an access token and returns the user ID
@@ -191,12 +249,7 @@ func (s *JWTToken) GetTokenInfo(token string) (
 ) {
 	claims := &jwtClaims{}
 
-	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return s.secretKey, nil
-	})
+	_, err = jwt.ParseWithClaims(token, claims, s.keyFunc)
 
 	if err != nil && !errors.Is(err, jwt.ErrTokenExpired) {
 		return 0, "", time.Time{}, time.Time{}, "", nil, ErrInvalidToken
@@ -206,7 +259,13 @@ func (s *JWTToken) GetTokenInfo(token string) (
 }
 
 // generateToken creates a JWT token with the specified parameters
-func (s *JWTToken) generateToken(issuerName string, userID int64, duration time.Duration, tType tokenType) (string, error) {
+func (s *JWTToken) generateToken(
+	issuerName string,
+	userID int64,
+	duration time.Duration,
+	tType tokenType,
+	customClaims map[string]any,
+) (string, error) {
 	tokenID := generateTokenID()
 	now := time.Now()
 	expiresAt := now.Add(duration)
@@ -215,6 +274,7 @@ func (s *JWTToken) generateToken(issuerName string, userID int64, duration time.
 		UserID:    userID,
 		TokenID:   tokenID,
 		TokenType: tType,
+		Custom:    customClaims,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    issuerName,
 			Subject:   fmt.Sprintf("%d", userID),
@@ -225,20 +285,37 @@ func (s *JWTToken) generateToken(issuerName string, userID int64, duration time.
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+	key := s.keys.Active()
+	token := jwt.NewWithClaims(key.algorithm.method(), claims)
+	token.Header["kid"] = key.id
+
+	return token.SignedString(key.signingMaterial())
+}
+
+// keyFunc resolves the validation key of a token from its "kid" header.
+// Tokens without a key ID are validated against the active key.
+func (s *JWTToken) keyFunc(token *jwt.Token) (any, error) {
+	key := s.keys.Active()
+
+	if kid, ok := token.Header["kid"].(string); ok {
+		var err error
+		if key, err = s.keys.Lookup(kid); err != nil {
+			return nil, err
+		}
+	}
+
+	if token.Method.Alg() != key.algorithm.method().Alg() {
+		return nil, errors.New("invalid signing method")
+	}
+
+	return key.verificationMaterial(), nil
 }
 
 // validateToken parses and validates a token
 func (s *JWTToken) validateToken(tokenString string) (*jwtClaims, error) {
 	claims := &jwtClaims{}
 
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return s.secretKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.keyFunc)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
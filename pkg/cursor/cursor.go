@@ -0,0 +1,84 @@
+package cursor
+
+/*
+ * cursor.go
+ *
+ * This file implements opaque pagination cursors.
+ *
+ * A cursor is a JSON value encoded in URL safe base64 and signed with HMAC-SHA256,
+ * so clients can pass it around but cannot forge or alter it.
+ */
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a cursor is malformed or its signature does not match
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Codec encodes values into signed cursors and decodes them back
+type Codec struct {
+	secret []byte
+}
+
+// NewCodec creates a codec signing cursors with the secret.
+// An empty secret is replaced by a random one, so cursors only work within the process.
+func NewCodec(secret []byte) (*Codec, error) {
+	if len(secret) == 0 {
+		secret = make([]byte, sha256.Size)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Codec{secret: secret}, nil
+}
+
+// Encode encodes the value into a signed cursor
+func (c *Codec) Encode(value any) (string, error) {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(c.sign(payload)), nil
+}
+
+// Decode verifies the signature of the cursor and decodes it into the value
+func (c *Codec) Decode(token string, value any) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidCursor
+	}
+
+	encoding := base64.RawURLEncoding
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	signature, err := encoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, c.sign(payload)) {
+		return ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(payload, value); err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
+
+// sign computes the signature of a payload
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,75 @@
+package cursor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type position struct {
+	Value string `json:"v"`
+	ID    int64  `json:"i"`
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	codec, err := NewCodec([]byte("secret"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	token, err := codec.Encode(position{Value: "2024-01-01T00:00:00Z", ID: 42})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var decoded position
+	if err := codec.Decode(token, &decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if decoded.Value != "2024-01-01T00:00:00Z" || decoded.ID != 42 {
+		t.Errorf("Expected the encoded position, got %+v", decoded)
+	}
+}
+
+func TestCodecDecodeInvalid(t *testing.T) {
+	codec, _ := NewCodec([]byte("secret"))
+	other, _ := NewCodec([]byte("other"))
+
+	token, _ := codec.Encode(position{ID: 42})
+	forged, _ := other.Encode(position{ID: 43})
+	payload, signature, _ := strings.Cut(token, ".")
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"missing signature", payload},
+		{"invalid encoding", "!!!." + signature},
+		{"other secret", forged},
+		{"altered payload", forgedPayload + "." + signature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded position
+			if err := codec.Decode(tt.token, &decoded); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("Expected ErrInvalidCursor, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewCodecRandomSecret(t *testing.T) {
+	first, err := NewCodec(nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	second, _ := NewCodec(nil)
+
+	token, _ := first.Encode(position{ID: 1})
+	if err := second.Decode(token, &position{}); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected codecs with random secrets to reject each other's cursors, got %v", err)
+	}
+}
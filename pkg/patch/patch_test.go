@@ -0,0 +1,128 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func decode(t *testing.T, document string) any {
+	t.Helper()
+
+	var value any
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		t.Fatalf("Failed to decode %s: %v", document, err)
+	}
+	return value
+}
+
+func TestApplyMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+	}{
+		{"replace member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"remove member", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"replace array", `{"a":["b"]}`, `{"a":["c","d"]}`, `{"a":["c","d"]}`},
+		{"merge nested", `{"a":{"b":"c","d":"e"}}`, `{"a":{"d":null,"f":"g"}}`, `{"a":{"b":"c","f":"g"}}`},
+		{"replace document", `{"a":"b"}`, `["c"]`, `["c"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := decode(t, tt.doc)
+			result := ApplyMerge(doc, decode(t, tt.patch))
+
+			if !reflect.DeepEqual(result, decode(t, tt.expected)) {
+				t.Errorf("Expected %s, got %v", tt.expected, result)
+			}
+			if !reflect.DeepEqual(doc, decode(t, tt.doc)) {
+				t.Errorf("Expected the document to be left unchanged, got %v", doc)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+	}{
+		{"add member", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"foo":"bar","baz":"qux"}`},
+		{"add element", `{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+		{"append element", `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":"qux"}]`, `{"foo":["bar","qux"]}`},
+		{"add null", `{"foo":"bar"}`, `[{"op":"add","path":"/foo","value":null}]`, `{"foo":null}`},
+		{"remove member", `{"foo":"bar","baz":"qux"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+		{"remove element", `{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+		{"replace member", `{"foo":"bar"}`, `[{"op":"replace","path":"/foo","value":"qux"}]`, `{"foo":"qux"}`},
+		{"move member", `{"foo":{"bar":"baz"},"qux":{}}`, `[{"op":"move","from":"/foo/bar","path":"/qux/thud"}]`, `{"foo":{},"qux":{"thud":"baz"}}`},
+		{"move element", `{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`},
+		{"copy member", `{"foo":["bar"]}`, `[{"op":"copy","from":"/foo","path":"/baz"}]`, `{"foo":["bar"],"baz":["bar"]}`},
+		{"test value", `{"foo":["bar"]}`, `[{"op":"test","path":"/foo/0","value":"bar"}]`, `{"foo":["bar"]}`},
+		{"escaped path", `{"a/b":1,"m~n":2}`, `[{"op":"remove","path":"/a~1b"},{"op":"remove","path":"/m~0n"}]`, `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operations []Operation
+			if err := json.Unmarshal([]byte(tt.patch), &operations); err != nil {
+				t.Fatalf("Failed to decode patch: %v", err)
+			}
+
+			doc := decode(t, tt.doc)
+			result, err := Apply(doc, operations)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, decode(t, tt.expected)) {
+				t.Errorf("Expected %s, got %v", tt.expected, result)
+			}
+			if !reflect.DeepEqual(doc, decode(t, tt.doc)) {
+				t.Errorf("Expected the document to be left unchanged, got %v", doc)
+			}
+		})
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		patch    string
+		expected error
+	}{
+		{"unknown operation", `[{"op":"merge","path":"/foo"}]`, ErrInvalidPatch},
+		{"invalid path", `[{"op":"remove","path":"foo"}]`, ErrInvalidPatch},
+		{"missing value", `[{"op":"add","path":"/baz"}]`, ErrInvalidPatch},
+		{"move into itself", `[{"op":"move","from":"/foo","path":"/foo/0"}]`, ErrInvalidPatch},
+		{"missing member", `[{"op":"remove","path":"/baz"}]`, ErrPathNotFound},
+		{"replace missing member", `[{"op":"replace","path":"/baz","value":1}]`, ErrPathNotFound},
+		{"index out of range", `[{"op":"add","path":"/foo/5","value":1}]`, ErrPathNotFound},
+		{"leading zero index", `[{"op":"remove","path":"/foo/01"}]`, ErrPathNotFound},
+		{"failed test", `[{"op":"test","path":"/foo/0","value":"baz"}]`, ErrTestFailed},
+		{"failed test after change", `[{"op":"add","path":"/baz","value":1},{"op":"test","path":"/baz","value":2}]`, ErrTestFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operations []Operation
+			if err := json.Unmarshal([]byte(tt.patch), &operations); err != nil {
+				t.Fatalf("Failed to decode patch: %v", err)
+			}
+
+			doc := decode(t, `{"foo":["bar"]}`)
+			if _, err := Apply(doc, operations); !errors.Is(err, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, err)
+			}
+			if !reflect.DeepEqual(doc, decode(t, `{"foo":["bar"]}`)) {
+				t.Errorf("Expected the document to be left unchanged, got %v", doc)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package rank
+
+/*
+ * rank.go
+ *
+ * This file implements lexicographic ranks, the positions of items in a list
+ * ordered by the user.
+ *
+ * A rank is a fraction between 0 and 1 written in base 36, without the leading
+ * "0." and without trailing zeros, so that ranks sort as plain strings. Ranks only
+ * use digits and lowercase letters, which sort the same in the collations of all
+ * the supported databases, case insensitive ones included. There is
+ * always a rank between two others, so moving an item only changes its own rank.
+ * Ranks grow longer as items are moved again and again to the same place, and
+ * are made short again by spreading them evenly over the list.
+ */
+
+import (
+	"errors"
+	"strings"
+)
+
+// digits are the digits of the ranks, in the order they sort as strings
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const base = len(digits)
+
+// ErrInvalidRange is returned when there is no rank between the given ones, as they
+// are not valid ranks or are not in order
+var ErrInvalidRange = errors.New("invalid rank range")
+
+// Between returns a rank after the before rank and before the after rank, the
+// empty string being the start of the list for before and its end for after
+func Between(before, after string) (string, error) {
+	if !IsValid(before) || !IsValid(after) || after != "" && before >= after {
+		return "", ErrInvalidRange
+	}
+
+	return midpoint(before, after), nil
+}
+
+// After returns a rank after the given one, the empty string being the start of
+// the list. The rank is close to the given one rather than halfway to the end of
+// the list, so ranks stay short when items are appended one after the other.
+func After(rank string) (string, error) {
+	if !IsValid(rank) {
+		return "", ErrInvalidRange
+	}
+	if rank == "" {
+		return digits[base/2 : base/2+1], nil
+	}
+
+	for i := 0; i < len(rank); i++ {
+		if digit := strings.IndexByte(digits, rank[i]); digit < base-1 {
+			return rank[:i] + digits[digit+1:digit+2], nil
+		}
+	}
+
+	return rank + digits[base/2:base/2+1], nil
+}
+
+// Spread returns n ranks evenly spread over the list, in order, all of the same
+// length before their trailing zeros are trimmed and leaving room for a full digit
+// between each other
+func Spread(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	length, capacity := 1, base
+	for capacity/(n+1) < base {
+		length++
+		capacity *= base
+	}
+
+	step := capacity / (n + 1)
+	ranks := make([]string, n)
+	for i := range ranks {
+		ranks[i] = format((i+1)*step, length)
+	}
+
+	return ranks
+}
+
+// IsValid checks if the string is a rank, the empty string standing for the ends of the list
+func IsValid(rank string) bool {
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(digits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return !strings.HasSuffix(rank, digits[:1])
+}
+
+// midpoint returns a rank between two valid ranks in order, b being empty for the end of the list
+func midpoint(a, b string) string {
+	// Ranks starting with the same digits only differ after them
+	n := 0
+	for n < len(b) && digitAt(a, n) == strings.IndexByte(digits, b[n]) {
+		n++
+	}
+	if n > 0 {
+		return b[:n] + midpoint(a[min(n, len(a)):], b[n:])
+	}
+
+	low, high := digitAt(a, 0), base
+	if b != "" {
+		high = strings.IndexByte(digits, b[0])
+	}
+
+	if high-low > 1 {
+		return digits[(low+high)/2 : (low+high)/2+1]
+	}
+
+	// The first digit of b alone is before b and after a
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	// There is no digit between the first ones, so the rank continues after the first digit of a
+	return digits[low:low+1] + midpoint(a[min(1, len(a)):], "")
+}
+
+// digitAt returns the value of the digit of the rank at an index, zero past its end
+func digitAt(rank string, index int) int {
+	if index >= len(rank) {
+		return 0
+	}
+	return strings.IndexByte(digits, rank[index])
+}
+
+// format writes the value with the number of digits, without its trailing zeros
+func format(value, length int) string {
+	rank := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		rank[i] = digits[value%base]
+		value /= base
+	}
+	return strings.TrimRight(string(rank), digits[:1])
+}
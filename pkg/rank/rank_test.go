@@ -0,0 +1,130 @@
+package rank
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		before, after string
+		want          string
+	}{
+		{before: "", after: "", want: "i"},
+		{before: "i", after: "", want: "r"},
+		{before: "", after: "i", want: "9"},
+		{before: "", after: "1", want: "0i"},
+		{before: "y", after: "", want: "z"},
+		{before: "z", after: "", want: "zi"},
+		{before: "1", after: "2", want: "1i"},
+		{before: "1", after: "3", want: "2"},
+		{before: "1z", after: "2a", want: "2"},
+		{before: "1", after: "105", want: "102"},
+		{before: "i", after: "i1", want: "i0i"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.before+"_"+tt.after, func(t *testing.T) {
+			got, err := Between(tt.before, tt.after)
+			if err != nil {
+				t.Fatalf("Between(%q, %q) error = %v", tt.before, tt.after, err)
+			}
+			if got != tt.want {
+				t.Errorf("Between(%q, %q) = %q, want %q", tt.before, tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBetweenInvalidRange(t *testing.T) {
+	tests := []struct{ before, after string }{
+		{before: "i", after: "i"},
+		{before: "j", after: "i"},
+		{before: "i0", after: ""},
+		{before: "", after: "i-"},
+		{before: "", after: "I"},
+	}
+
+	for _, tt := range tests {
+		if _, err := Between(tt.before, tt.after); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("Between(%q, %q) error = %v, want ErrInvalidRange", tt.before, tt.after, err)
+		}
+	}
+}
+
+func TestBetweenKeepsOrder(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	ranks := []string{}
+
+	for range 2000 {
+		i := random.Intn(len(ranks) + 1)
+
+		before, after := "", ""
+		if i > 0 {
+			before = ranks[i-1]
+		}
+		if i < len(ranks) {
+			after = ranks[i]
+		}
+
+		rank, err := Between(before, after)
+		if err != nil {
+			t.Fatalf("Between(%q, %q) error = %v", before, after, err)
+		}
+		if !IsValid(rank) || rank <= before || after != "" && rank >= after {
+			t.Fatalf("Between(%q, %q) = %q, out of range", before, after, rank)
+		}
+
+		ranks = slices.Insert(ranks, i, rank)
+	}
+}
+
+func TestAfter(t *testing.T) {
+	tests := []struct{ rank, want string }{
+		{rank: "", want: "i"},
+		{rank: "i", want: "j"},
+		{rank: "i1", want: "j"},
+		{rank: "y", want: "z"},
+		{rank: "z", want: "zi"},
+		{rank: "zy5", want: "zz"},
+		{rank: "zz", want: "zzi"},
+	}
+
+	for _, tt := range tests {
+		got, err := After(tt.rank)
+		if err != nil {
+			t.Fatalf("After(%q) error = %v", tt.rank, err)
+		}
+		if got != tt.want {
+			t.Errorf("After(%q) = %q, want %q", tt.rank, got, tt.want)
+		}
+	}
+
+	if _, err := After("i0"); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("After(%q) error = %v, want ErrInvalidRange", "i0", err)
+	}
+}
+
+func TestSpread(t *testing.T) {
+	for _, n := range []int{1, 2, 34, 35, 36, 1000, 100000} {
+		ranks := Spread(n)
+		if len(ranks) != n {
+			t.Fatalf("Spread(%d) returned %d ranks", n, len(ranks))
+		}
+
+		for i, rank := range ranks {
+			if !IsValid(rank) || rank == "" {
+				t.Fatalf("Spread(%d)[%d] = %q, not a valid rank", n, i, rank)
+			}
+			if i > 0 && ranks[i-1] >= rank {
+				t.Fatalf("Spread(%d) is not in order at %d: %q >= %q", n, i, ranks[i-1], rank)
+			}
+		}
+	}
+
+	if got := Spread(1); !slices.Equal(got, []string{"i"}) {
+		t.Errorf("Spread(1) = %v, want [i]", got)
+	}
+}
@@ -0,0 +1,91 @@
+package trigram
+
+/*
+ * trigram.go
+ *
+ * This file implements the trigram similarity of texts, the way the pg_trgm
+ * extension of PostgreSQL measures it.
+ *
+ * The trigrams of a text are the groups of three consecutive characters of its
+ * lowercase words, each word padded with two spaces before and one after. Texts
+ * sharing most of their trigrams are similar even when some words are misspelled
+ * or in a different order.
+ */
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Set is the set of the trigrams of a text
+type Set map[string]struct{}
+
+// Trigrams returns the trigrams of the words of a text. Characters other than
+// letters and digits separate the words.
+func Trigrams(text string) Set {
+	set := Set{}
+	for _, word := range words(text) {
+		addTrigrams(set, word)
+	}
+	return set
+}
+
+// Similarity returns how similar two texts are, from 0 when they share no trigram
+// to 1 when they have the same trigrams
+func Similarity(a, b string) float64 {
+	return similarity(Trigrams(a), Trigrams(b))
+}
+
+// WordSimilarity returns how similar a text is to the most similar sequence of
+// consecutive words of another text, so that a short query is similar to the
+// long texts containing it
+func WordSimilarity(query, text string) float64 {
+	queryTrigrams := Trigrams(query)
+	if len(queryTrigrams) == 0 {
+		return 0
+	}
+
+	textWords := words(text)
+	best := 0.0
+
+	for start := range textWords {
+		extent := Set{}
+		for end := start; end < len(textWords); end++ {
+			addTrigrams(extent, textWords[end])
+			best = max(best, similarity(queryTrigrams, extent))
+		}
+	}
+
+	return best
+}
+
+// similarity returns the number of shared trigrams over the number of distinct ones
+func similarity(a, b Set) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range a {
+		if _, ok := b[trigram]; ok {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// words returns the lowercase words of a text
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// addTrigrams adds the trigrams of a padded word to a set
+func addTrigrams(set Set, word string) {
+	runes := []rune("  " + word + " ")
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = struct{}{}
+	}
+}
@@ -0,0 +1,65 @@
+package trigram
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTrigrams(t *testing.T) {
+	got := Trigrams("Cat")
+	want := []string{"  c", " ca", "cat", "at "}
+
+	if len(got) != len(want) {
+		t.Fatalf("Trigrams() = %v, want %v", got, want)
+	}
+	for _, trigram := range want {
+		if _, ok := got[trigram]; !ok {
+			t.Errorf("Trigrams() = %v, missing %q", got, trigram)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "word", b: "word", want: 1},
+		{a: "Buy milk", b: "buy  MILK!", want: 1},
+		{a: "word", b: "two words", want: 4.0 / 11},
+		{a: "abc", b: "xyz", want: 0},
+		{a: "", b: "word", want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := Similarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSimilarityToleratesTypos(t *testing.T) {
+	if got := Similarity("Renew the passport", "renew pasport"); got < 0.5 {
+		t.Errorf("Similarity() = %v, want at least 0.5", got)
+	}
+	if got := Similarity("Renew the passport", "Walk the dog"); got > 0.2 {
+		t.Errorf("Similarity() = %v, want at most 0.2", got)
+	}
+}
+
+func TestWordSimilarity(t *testing.T) {
+	text := "Call the dentist to book an appointment next week"
+
+	if got := WordSimilarity("dentist", text); got != 1 {
+		t.Errorf("WordSimilarity() = %v, want 1 for a word of the text", got)
+	}
+	if got := WordSimilarity("dentst", text); got < 0.5 {
+		t.Errorf("WordSimilarity() = %v, want at least 0.5 for a misspelled word", got)
+	}
+	if got := WordSimilarity("groceries", text); got > 0.2 {
+		t.Errorf("WordSimilarity() = %v, want at most 0.2 for an unrelated word", got)
+	}
+	if got := WordSimilarity("", text); got != 0 {
+		t.Errorf("WordSimilarity() = %v, want 0 for an empty query", got)
+	}
+}